@@ -449,6 +449,149 @@ func (x *IsAdminResponse) GetIsAdmin() bool {
 	return false
 }
 
+// Запрос ролей пользователя
+type GetUserRolesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRolesRequest) Reset() {
+	*x = GetUserRolesRequest{}
+	mi := &file_auth_service_auth_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRolesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRolesRequest) ProtoMessage() {}
+
+func (x *GetUserRolesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_auth_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRolesRequest.ProtoReflect.Descriptor instead.
+func (*GetUserRolesRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_auth_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *GetUserRolesRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// Ответ с ролями пользователя
+type GetUserRolesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Roles         []string               `protobuf:"bytes,1,rep,name=roles,proto3" json:"roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetUserRolesResponse) Reset() {
+	*x = GetUserRolesResponse{}
+	mi := &file_auth_service_auth_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetUserRolesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetUserRolesResponse) ProtoMessage() {}
+
+func (x *GetUserRolesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_auth_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetUserRolesResponse.ProtoReflect.Descriptor instead.
+func (*GetUserRolesResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_auth_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *GetUserRolesResponse) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+// Запрос на выдачу или отзыв роли
+type ChangeUserRoleRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Role          string                 `protobuf:"bytes,2,opt,name=role,proto3" json:"role,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ChangeUserRoleRequest) Reset() {
+	*x = ChangeUserRoleRequest{}
+	mi := &file_auth_service_auth_service_proto_msgTypes[9]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ChangeUserRoleRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ChangeUserRoleRequest) ProtoMessage() {}
+
+func (x *ChangeUserRoleRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_auth_service_proto_msgTypes[9]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ChangeUserRoleRequest.ProtoReflect.Descriptor instead.
+func (*ChangeUserRoleRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_auth_service_proto_rawDescGZIP(), []int{9}
+}
+
+func (x *ChangeUserRoleRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *ChangeUserRoleRequest) GetRole() string {
+	if x != nil {
+		return x.Role
+	}
+	return ""
+}
+
 // Запрос на валидацию токена
 type ValidateTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -459,7 +602,7 @@ type ValidateTokenRequest struct {
 
 func (x *ValidateTokenRequest) Reset() {
 	*x = ValidateTokenRequest{}
-	mi := &file_auth_service_auth_service_proto_msgTypes[7]
+	mi := &file_auth_service_auth_service_proto_msgTypes[10]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -471,7 +614,7 @@ func (x *ValidateTokenRequest) String() string {
 func (*ValidateTokenRequest) ProtoMessage() {}
 
 func (x *ValidateTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_service_auth_service_proto_msgTypes[7]
+	mi := &file_auth_service_auth_service_proto_msgTypes[10]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -484,7 +627,7 @@ func (x *ValidateTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateTokenRequest.ProtoReflect.Descriptor instead.
 func (*ValidateTokenRequest) Descriptor() ([]byte, []int) {
-	return file_auth_service_auth_service_proto_rawDescGZIP(), []int{7}
+	return file_auth_service_auth_service_proto_rawDescGZIP(), []int{10}
 }
 
 func (x *ValidateTokenRequest) GetAccessToken() string {
@@ -494,17 +637,18 @@ func (x *ValidateTokenRequest) GetAccessToken() string {
 	return ""
 }
 
-// Ответ на валидацию токена
+// Ответ на валидацию токена; roles - роли из токена на момент его выпуска
 type ValidateTokenResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	Roles         []string               `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
 func (x *ValidateTokenResponse) Reset() {
 	*x = ValidateTokenResponse{}
-	mi := &file_auth_service_auth_service_proto_msgTypes[8]
+	mi := &file_auth_service_auth_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -516,7 +660,7 @@ func (x *ValidateTokenResponse) String() string {
 func (*ValidateTokenResponse) ProtoMessage() {}
 
 func (x *ValidateTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_service_auth_service_proto_msgTypes[8]
+	mi := &file_auth_service_auth_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -529,7 +673,7 @@ func (x *ValidateTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use ValidateTokenResponse.ProtoReflect.Descriptor instead.
 func (*ValidateTokenResponse) Descriptor() ([]byte, []int) {
-	return file_auth_service_auth_service_proto_rawDescGZIP(), []int{8}
+	return file_auth_service_auth_service_proto_rawDescGZIP(), []int{11}
 }
 
 func (x *ValidateTokenResponse) GetUserId() int64 {
@@ -539,6 +683,13 @@ func (x *ValidateTokenResponse) GetUserId() int64 {
 	return 0
 }
 
+func (x *ValidateTokenResponse) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
 // Запрос на получение UserID по refresh токену
 type GetUserIDbyRefreshTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
@@ -549,7 +700,7 @@ type GetUserIDbyRefreshTokenRequest struct {
 
 func (x *GetUserIDbyRefreshTokenRequest) Reset() {
 	*x = GetUserIDbyRefreshTokenRequest{}
-	mi := &file_auth_service_auth_service_proto_msgTypes[9]
+	mi := &file_auth_service_auth_service_proto_msgTypes[12]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -561,7 +712,7 @@ func (x *GetUserIDbyRefreshTokenRequest) String() string {
 func (*GetUserIDbyRefreshTokenRequest) ProtoMessage() {}
 
 func (x *GetUserIDbyRefreshTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_service_auth_service_proto_msgTypes[9]
+	mi := &file_auth_service_auth_service_proto_msgTypes[12]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -574,7 +725,7 @@ func (x *GetUserIDbyRefreshTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserIDbyRefreshTokenRequest.ProtoReflect.Descriptor instead.
 func (*GetUserIDbyRefreshTokenRequest) Descriptor() ([]byte, []int) {
-	return file_auth_service_auth_service_proto_rawDescGZIP(), []int{9}
+	return file_auth_service_auth_service_proto_rawDescGZIP(), []int{12}
 }
 
 func (x *GetUserIDbyRefreshTokenRequest) GetRefreshToken() string {
@@ -594,7 +745,7 @@ type GetUserIDbyRefreshTokenResponse struct {
 
 func (x *GetUserIDbyRefreshTokenResponse) Reset() {
 	*x = GetUserIDbyRefreshTokenResponse{}
-	mi := &file_auth_service_auth_service_proto_msgTypes[10]
+	mi := &file_auth_service_auth_service_proto_msgTypes[13]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -606,7 +757,7 @@ func (x *GetUserIDbyRefreshTokenResponse) String() string {
 func (*GetUserIDbyRefreshTokenResponse) ProtoMessage() {}
 
 func (x *GetUserIDbyRefreshTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_service_auth_service_proto_msgTypes[10]
+	mi := &file_auth_service_auth_service_proto_msgTypes[13]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -619,7 +770,7 @@ func (x *GetUserIDbyRefreshTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetUserIDbyRefreshTokenResponse.ProtoReflect.Descriptor instead.
 func (*GetUserIDbyRefreshTokenResponse) Descriptor() ([]byte, []int) {
-	return file_auth_service_auth_service_proto_rawDescGZIP(), []int{10}
+	return file_auth_service_auth_service_proto_rawDescGZIP(), []int{13}
 }
 
 func (x *GetUserIDbyRefreshTokenResponse) GetUserId() int64 {
@@ -639,7 +790,7 @@ type GenerateAccessTokenRequest struct {
 
 func (x *GenerateAccessTokenRequest) Reset() {
 	*x = GenerateAccessTokenRequest{}
-	mi := &file_auth_service_auth_service_proto_msgTypes[11]
+	mi := &file_auth_service_auth_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -651,7 +802,7 @@ func (x *GenerateAccessTokenRequest) String() string {
 func (*GenerateAccessTokenRequest) ProtoMessage() {}
 
 func (x *GenerateAccessTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_service_auth_service_proto_msgTypes[11]
+	mi := &file_auth_service_auth_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -664,7 +815,7 @@ func (x *GenerateAccessTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateAccessTokenRequest.ProtoReflect.Descriptor instead.
 func (*GenerateAccessTokenRequest) Descriptor() ([]byte, []int) {
-	return file_auth_service_auth_service_proto_rawDescGZIP(), []int{11}
+	return file_auth_service_auth_service_proto_rawDescGZIP(), []int{14}
 }
 
 func (x *GenerateAccessTokenRequest) GetUserId() int64 {
@@ -684,7 +835,7 @@ type GenerateAccessTokenResponse struct {
 
 func (x *GenerateAccessTokenResponse) Reset() {
 	*x = GenerateAccessTokenResponse{}
-	mi := &file_auth_service_auth_service_proto_msgTypes[12]
+	mi := &file_auth_service_auth_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -696,7 +847,7 @@ func (x *GenerateAccessTokenResponse) String() string {
 func (*GenerateAccessTokenResponse) ProtoMessage() {}
 
 func (x *GenerateAccessTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_service_auth_service_proto_msgTypes[12]
+	mi := &file_auth_service_auth_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -709,7 +860,7 @@ func (x *GenerateAccessTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateAccessTokenResponse.ProtoReflect.Descriptor instead.
 func (*GenerateAccessTokenResponse) Descriptor() ([]byte, []int) {
-	return file_auth_service_auth_service_proto_rawDescGZIP(), []int{12}
+	return file_auth_service_auth_service_proto_rawDescGZIP(), []int{15}
 }
 
 func (x *GenerateAccessTokenResponse) GetAccessToken() string {
@@ -729,7 +880,7 @@ type GenerateRefreshTokenRequest struct {
 
 func (x *GenerateRefreshTokenRequest) Reset() {
 	*x = GenerateRefreshTokenRequest{}
-	mi := &file_auth_service_auth_service_proto_msgTypes[13]
+	mi := &file_auth_service_auth_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -741,7 +892,7 @@ func (x *GenerateRefreshTokenRequest) String() string {
 func (*GenerateRefreshTokenRequest) ProtoMessage() {}
 
 func (x *GenerateRefreshTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_service_auth_service_proto_msgTypes[13]
+	mi := &file_auth_service_auth_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -754,7 +905,7 @@ func (x *GenerateRefreshTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateRefreshTokenRequest.ProtoReflect.Descriptor instead.
 func (*GenerateRefreshTokenRequest) Descriptor() ([]byte, []int) {
-	return file_auth_service_auth_service_proto_rawDescGZIP(), []int{13}
+	return file_auth_service_auth_service_proto_rawDescGZIP(), []int{16}
 }
 
 func (x *GenerateRefreshTokenRequest) GetUserId() int64 {
@@ -775,7 +926,7 @@ type GenerateRefreshTokenResponse struct {
 
 func (x *GenerateRefreshTokenResponse) Reset() {
 	*x = GenerateRefreshTokenResponse{}
-	mi := &file_auth_service_auth_service_proto_msgTypes[14]
+	mi := &file_auth_service_auth_service_proto_msgTypes[17]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -787,7 +938,7 @@ func (x *GenerateRefreshTokenResponse) String() string {
 func (*GenerateRefreshTokenResponse) ProtoMessage() {}
 
 func (x *GenerateRefreshTokenResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_service_auth_service_proto_msgTypes[14]
+	mi := &file_auth_service_auth_service_proto_msgTypes[17]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -800,7 +951,7 @@ func (x *GenerateRefreshTokenResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GenerateRefreshTokenResponse.ProtoReflect.Descriptor instead.
 func (*GenerateRefreshTokenResponse) Descriptor() ([]byte, []int) {
-	return file_auth_service_auth_service_proto_rawDescGZIP(), []int{14}
+	return file_auth_service_auth_service_proto_rawDescGZIP(), []int{17}
 }
 
 func (x *GenerateRefreshTokenResponse) GetUserId() int64 {
@@ -817,7 +968,7 @@ func (x *GenerateRefreshTokenResponse) GetRefreshToken() string {
 	return ""
 }
 
-// Запрос на сохранение refresh токена
+// Запрос на сохранение refresh токена; токен начинает новое семейство
 type SaveNewRefreshTokenRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -829,7 +980,7 @@ type SaveNewRefreshTokenRequest struct {
 
 func (x *SaveNewRefreshTokenRequest) Reset() {
 	*x = SaveNewRefreshTokenRequest{}
-	mi := &file_auth_service_auth_service_proto_msgTypes[15]
+	mi := &file_auth_service_auth_service_proto_msgTypes[18]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -841,7 +992,7 @@ func (x *SaveNewRefreshTokenRequest) String() string {
 func (*SaveNewRefreshTokenRequest) ProtoMessage() {}
 
 func (x *SaveNewRefreshTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_service_auth_service_proto_msgTypes[15]
+	mi := &file_auth_service_auth_service_proto_msgTypes[18]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -854,7 +1005,7 @@ func (x *SaveNewRefreshTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use SaveNewRefreshTokenRequest.ProtoReflect.Descriptor instead.
 func (*SaveNewRefreshTokenRequest) Descriptor() ([]byte, []int) {
-	return file_auth_service_auth_service_proto_rawDescGZIP(), []int{15}
+	return file_auth_service_auth_service_proto_rawDescGZIP(), []int{18}
 }
 
 func (x *SaveNewRefreshTokenRequest) GetUserId() int64 {
@@ -888,7 +1039,7 @@ type RemoveOldRefreshTokenRequest struct {
 
 func (x *RemoveOldRefreshTokenRequest) Reset() {
 	*x = RemoveOldRefreshTokenRequest{}
-	mi := &file_auth_service_auth_service_proto_msgTypes[16]
+	mi := &file_auth_service_auth_service_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -900,7 +1051,7 @@ func (x *RemoveOldRefreshTokenRequest) String() string {
 func (*RemoveOldRefreshTokenRequest) ProtoMessage() {}
 
 func (x *RemoveOldRefreshTokenRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_auth_service_auth_service_proto_msgTypes[16]
+	mi := &file_auth_service_auth_service_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -913,7 +1064,7 @@ func (x *RemoveOldRefreshTokenRequest) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use RemoveOldRefreshTokenRequest.ProtoReflect.Descriptor instead.
 func (*RemoveOldRefreshTokenRequest) Descriptor() ([]byte, []int) {
-	return file_auth_service_auth_service_proto_rawDescGZIP(), []int{16}
+	return file_auth_service_auth_service_proto_rawDescGZIP(), []int{19}
 }
 
 func (x *RemoveOldRefreshTokenRequest) GetUserId() int64 {
@@ -930,6 +1081,274 @@ func (x *RemoveOldRefreshTokenRequest) GetRefreshToken() string {
 	return ""
 }
 
+// Запрос на обновление сессии; expires_at - срок действия нового refresh токена
+type RefreshSessionRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	RefreshToken  string                 `protobuf:"bytes,1,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshSessionRequest) Reset() {
+	*x = RefreshSessionRequest{}
+	mi := &file_auth_service_auth_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshSessionRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshSessionRequest) ProtoMessage() {}
+
+func (x *RefreshSessionRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_auth_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshSessionRequest.ProtoReflect.Descriptor instead.
+func (*RefreshSessionRequest) Descriptor() ([]byte, []int) {
+	return file_auth_service_auth_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *RefreshSessionRequest) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *RefreshSessionRequest) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+// Ответ на обновление сессии: новая пара токенов и роли из access токена
+type RefreshSessionResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	AccessToken   string                 `protobuf:"bytes,2,opt,name=access_token,json=accessToken,proto3" json:"access_token,omitempty"`
+	RefreshToken  string                 `protobuf:"bytes,3,opt,name=refresh_token,json=refreshToken,proto3" json:"refresh_token,omitempty"`
+	Roles         []string               `protobuf:"bytes,4,rep,name=roles,proto3" json:"roles,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RefreshSessionResponse) Reset() {
+	*x = RefreshSessionResponse{}
+	mi := &file_auth_service_auth_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RefreshSessionResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RefreshSessionResponse) ProtoMessage() {}
+
+func (x *RefreshSessionResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_auth_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RefreshSessionResponse.ProtoReflect.Descriptor instead.
+func (*RefreshSessionResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_auth_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *RefreshSessionResponse) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RefreshSessionResponse) GetAccessToken() string {
+	if x != nil {
+		return x.AccessToken
+	}
+	return ""
+}
+
+func (x *RefreshSessionResponse) GetRefreshToken() string {
+	if x != nil {
+		return x.RefreshToken
+	}
+	return ""
+}
+
+func (x *RefreshSessionResponse) GetRoles() []string {
+	if x != nil {
+		return x.Roles
+	}
+	return nil
+}
+
+// Открытый ключ в формате JWK (RFC 7517): RSA задается n и e, Ed25519 - crv и x
+type JSONWebKey struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Kty           string                 `protobuf:"bytes,1,opt,name=kty,proto3" json:"kty,omitempty"`
+	Kid           string                 `protobuf:"bytes,2,opt,name=kid,proto3" json:"kid,omitempty"`
+	Use           string                 `protobuf:"bytes,3,opt,name=use,proto3" json:"use,omitempty"`
+	Alg           string                 `protobuf:"bytes,4,opt,name=alg,proto3" json:"alg,omitempty"`
+	N             string                 `protobuf:"bytes,5,opt,name=n,proto3" json:"n,omitempty"`
+	E             string                 `protobuf:"bytes,6,opt,name=e,proto3" json:"e,omitempty"`
+	Crv           string                 `protobuf:"bytes,7,opt,name=crv,proto3" json:"crv,omitempty"`
+	X             string                 `protobuf:"bytes,8,opt,name=x,proto3" json:"x,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *JSONWebKey) Reset() {
+	*x = JSONWebKey{}
+	mi := &file_auth_service_auth_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *JSONWebKey) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*JSONWebKey) ProtoMessage() {}
+
+func (x *JSONWebKey) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_auth_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use JSONWebKey.ProtoReflect.Descriptor instead.
+func (*JSONWebKey) Descriptor() ([]byte, []int) {
+	return file_auth_service_auth_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *JSONWebKey) GetKty() string {
+	if x != nil {
+		return x.Kty
+	}
+	return ""
+}
+
+func (x *JSONWebKey) GetKid() string {
+	if x != nil {
+		return x.Kid
+	}
+	return ""
+}
+
+func (x *JSONWebKey) GetUse() string {
+	if x != nil {
+		return x.Use
+	}
+	return ""
+}
+
+func (x *JSONWebKey) GetAlg() string {
+	if x != nil {
+		return x.Alg
+	}
+	return ""
+}
+
+func (x *JSONWebKey) GetN() string {
+	if x != nil {
+		return x.N
+	}
+	return ""
+}
+
+func (x *JSONWebKey) GetE() string {
+	if x != nil {
+		return x.E
+	}
+	return ""
+}
+
+func (x *JSONWebKey) GetCrv() string {
+	if x != nil {
+		return x.Crv
+	}
+	return ""
+}
+
+func (x *JSONWebKey) GetX() string {
+	if x != nil {
+		return x.X
+	}
+	return ""
+}
+
+// Набор открытых ключей
+type GetJWKSResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Keys          []*JSONWebKey          `protobuf:"bytes,1,rep,name=keys,proto3" json:"keys,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetJWKSResponse) Reset() {
+	*x = GetJWKSResponse{}
+	mi := &file_auth_service_auth_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetJWKSResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetJWKSResponse) ProtoMessage() {}
+
+func (x *GetJWKSResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_auth_service_auth_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetJWKSResponse.ProtoReflect.Descriptor instead.
+func (*GetJWKSResponse) Descriptor() ([]byte, []int) {
+	return file_auth_service_auth_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *GetJWKSResponse) GetKeys() []*JSONWebKey {
+	if x != nil {
+		return x.Keys
+	}
+	return nil
+}
+
 var File_auth_service_auth_service_proto protoreflect.FileDescriptor
 
 const file_auth_service_auth_service_proto_rawDesc = "" +
@@ -965,11 +1384,19 @@ const file_auth_service_auth_service_proto_rawDesc = "" +
 	"\x0eIsAdminRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\",\n" +
 	"\x0fIsAdminResponse\x12\x19\n" +
-	"\bis_admin\x18\x01 \x01(\bR\aisAdmin\"9\n" +
+	"\bis_admin\x18\x01 \x01(\bR\aisAdmin\".\n" +
+	"\x13GetUserRolesRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\",\n" +
+	"\x14GetUserRolesResponse\x12\x14\n" +
+	"\x05roles\x18\x01 \x03(\tR\x05roles\"D\n" +
+	"\x15ChangeUserRoleRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x12\n" +
+	"\x04role\x18\x02 \x01(\tR\x04role\"9\n" +
 	"\x14ValidateTokenRequest\x12!\n" +
-	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\"0\n" +
+	"\faccess_token\x18\x01 \x01(\tR\vaccessToken\"F\n" +
 	"\x15ValidateTokenResponse\x12\x17\n" +
-	"\auser_id\x18\x01 \x01(\x03R\x06userId\"E\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x14\n" +
+	"\x05roles\x18\x02 \x03(\tR\x05roles\"E\n" +
 	"\x1eGetUserIDbyRefreshTokenRequest\x12#\n" +
 	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\":\n" +
 	"\x1fGetUserIDbyRefreshTokenResponse\x12\x17\n" +
@@ -990,18 +1417,45 @@ const file_auth_service_auth_service_proto_rawDesc = "" +
 	"expires_at\x18\x03 \x01(\x03R\texpiresAt\"\\\n" +
 	"\x1cRemoveOldRefreshTokenRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12#\n" +
-	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken2\xf9\x05\n" +
+	"\rrefresh_token\x18\x02 \x01(\tR\frefreshToken\"[\n" +
+	"\x15RefreshSessionRequest\x12#\n" +
+	"\rrefresh_token\x18\x01 \x01(\tR\frefreshToken\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\x03R\texpiresAt\"\x8f\x01\n" +
+	"\x16RefreshSessionResponse\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12!\n" +
+	"\faccess_token\x18\x02 \x01(\tR\vaccessToken\x12#\n" +
+	"\rrefresh_token\x18\x03 \x01(\tR\frefreshToken\x12\x14\n" +
+	"\x05roles\x18\x04 \x03(\tR\x05roles\"\x90\x01\n" +
+	"\n" +
+	"JSONWebKey\x12\x10\n" +
+	"\x03kty\x18\x01 \x01(\tR\x03kty\x12\x10\n" +
+	"\x03kid\x18\x02 \x01(\tR\x03kid\x12\x10\n" +
+	"\x03use\x18\x03 \x01(\tR\x03use\x12\x10\n" +
+	"\x03alg\x18\x04 \x01(\tR\x03alg\x12\f\n" +
+	"\x01n\x18\x05 \x01(\tR\x01n\x12\f\n" +
+	"\x01e\x18\x06 \x01(\tR\x01e\x12\x10\n" +
+	"\x03crv\x18\a \x01(\tR\x03crv\x12\f\n" +
+	"\x01x\x18\b \x01(\tR\x01x\"7\n" +
+	"\x0fGetJWKSResponse\x12$\n" +
+	"\x04keys\x18\x01 \x03(\v2\x10.auth.JSONWebKeyR\x04keys2\xd4\b\n" +
 	"\vAuthService\x123\n" +
 	"\x06SignUp\x12\x13.auth.SignUpRequest\x1a\x14.auth.SignUpResponse\x123\n" +
 	"\x06SignIn\x12\x13.auth.SignInRequest\x1a\x14.auth.SignInResponse\x125\n" +
 	"\x06Logout\x12\x13.auth.LogoutRequest\x1a\x16.google.protobuf.Empty\x126\n" +
-	"\aIsAdmin\x12\x14.auth.IsAdminRequest\x1a\x15.auth.IsAdminResponse\x12H\n" +
+	"\aIsAdmin\x12\x14.auth.IsAdminRequest\x1a\x15.auth.IsAdminResponse\x12E\n" +
+	"\fGetUserRoles\x12\x19.auth.GetUserRolesRequest\x1a\x1a.auth.GetUserRolesResponse\x12D\n" +
+	"\tGrantRole\x12\x1b.auth.ChangeUserRoleRequest\x1a\x1a.auth.GetUserRolesResponse\x12E\n" +
+	"\n" +
+	"RevokeRole\x12\x1b.auth.ChangeUserRoleRequest\x1a\x1a.auth.GetUserRolesResponse\x12H\n" +
 	"\rValidateToken\x12\x1a.auth.ValidateTokenRequest\x1a\x1b.auth.ValidateTokenResponse\x12f\n" +
 	"\x17GetUserIDbyRefreshToken\x12$.auth.GetUserIDbyRefreshTokenRequest\x1a%.auth.GetUserIDbyRefreshTokenResponse\x12Z\n" +
 	"\x13GenerateAccessToken\x12 .auth.GenerateAccessTokenRequest\x1a!.auth.GenerateAccessTokenResponse\x12]\n" +
 	"\x14GenerateRefreshToken\x12!.auth.GenerateRefreshTokenRequest\x1a\".auth.GenerateRefreshTokenResponse\x12O\n" +
 	"\x13SaveNewRefreshToken\x12 .auth.SaveNewRefreshTokenRequest\x1a\x16.google.protobuf.Empty\x12S\n" +
-	"\x15RemoveOldRefreshToken\x12\".auth.RemoveOldRefreshTokenRequest\x1a\x16.google.protobuf.EmptyB\x11Z\x0f/auth_generatedb\x06proto3"
+	"\x15RemoveOldRefreshToken\x12\".auth.RemoveOldRefreshTokenRequest\x1a\x16.google.protobuf.Empty\x12K\n" +
+	"\x0eRefreshSession\x12\x1b.auth.RefreshSessionRequest\x1a\x1c.auth.RefreshSessionResponse\x128\n" +
+	"\aGetJWKS\x12\x16.google.protobuf.Empty\x1a\x15.auth.GetJWKSResponseB\x11Z\x0f/auth_generatedb\x06proto3"
 
 var (
 	file_auth_service_auth_service_proto_rawDescOnce sync.Once
@@ -1015,7 +1469,7 @@ func file_auth_service_auth_service_proto_rawDescGZIP() []byte {
 	return file_auth_service_auth_service_proto_rawDescData
 }
 
-var file_auth_service_auth_service_proto_msgTypes = make([]protoimpl.MessageInfo, 17)
+var file_auth_service_auth_service_proto_msgTypes = make([]protoimpl.MessageInfo, 24)
 var file_auth_service_auth_service_proto_goTypes = []any{
 	(*SignUpRequest)(nil),                   // 0: auth.SignUpRequest
 	(*SignUpResponse)(nil),                  // 1: auth.SignUpResponse
@@ -1024,44 +1478,62 @@ var file_auth_service_auth_service_proto_goTypes = []any{
 	(*LogoutRequest)(nil),                   // 4: auth.LogoutRequest
 	(*IsAdminRequest)(nil),                  // 5: auth.IsAdminRequest
 	(*IsAdminResponse)(nil),                 // 6: auth.IsAdminResponse
-	(*ValidateTokenRequest)(nil),            // 7: auth.ValidateTokenRequest
-	(*ValidateTokenResponse)(nil),           // 8: auth.ValidateTokenResponse
-	(*GetUserIDbyRefreshTokenRequest)(nil),  // 9: auth.GetUserIDbyRefreshTokenRequest
-	(*GetUserIDbyRefreshTokenResponse)(nil), // 10: auth.GetUserIDbyRefreshTokenResponse
-	(*GenerateAccessTokenRequest)(nil),      // 11: auth.GenerateAccessTokenRequest
-	(*GenerateAccessTokenResponse)(nil),     // 12: auth.GenerateAccessTokenResponse
-	(*GenerateRefreshTokenRequest)(nil),     // 13: auth.GenerateRefreshTokenRequest
-	(*GenerateRefreshTokenResponse)(nil),    // 14: auth.GenerateRefreshTokenResponse
-	(*SaveNewRefreshTokenRequest)(nil),      // 15: auth.SaveNewRefreshTokenRequest
-	(*RemoveOldRefreshTokenRequest)(nil),    // 16: auth.RemoveOldRefreshTokenRequest
-	(*emptypb.Empty)(nil),                   // 17: google.protobuf.Empty
+	(*GetUserRolesRequest)(nil),             // 7: auth.GetUserRolesRequest
+	(*GetUserRolesResponse)(nil),            // 8: auth.GetUserRolesResponse
+	(*ChangeUserRoleRequest)(nil),           // 9: auth.ChangeUserRoleRequest
+	(*ValidateTokenRequest)(nil),            // 10: auth.ValidateTokenRequest
+	(*ValidateTokenResponse)(nil),           // 11: auth.ValidateTokenResponse
+	(*GetUserIDbyRefreshTokenRequest)(nil),  // 12: auth.GetUserIDbyRefreshTokenRequest
+	(*GetUserIDbyRefreshTokenResponse)(nil), // 13: auth.GetUserIDbyRefreshTokenResponse
+	(*GenerateAccessTokenRequest)(nil),      // 14: auth.GenerateAccessTokenRequest
+	(*GenerateAccessTokenResponse)(nil),     // 15: auth.GenerateAccessTokenResponse
+	(*GenerateRefreshTokenRequest)(nil),     // 16: auth.GenerateRefreshTokenRequest
+	(*GenerateRefreshTokenResponse)(nil),    // 17: auth.GenerateRefreshTokenResponse
+	(*SaveNewRefreshTokenRequest)(nil),      // 18: auth.SaveNewRefreshTokenRequest
+	(*RemoveOldRefreshTokenRequest)(nil),    // 19: auth.RemoveOldRefreshTokenRequest
+	(*RefreshSessionRequest)(nil),           // 20: auth.RefreshSessionRequest
+	(*RefreshSessionResponse)(nil),          // 21: auth.RefreshSessionResponse
+	(*JSONWebKey)(nil),                      // 22: auth.JSONWebKey
+	(*GetJWKSResponse)(nil),                 // 23: auth.GetJWKSResponse
+	(*emptypb.Empty)(nil),                   // 24: google.protobuf.Empty
 }
 var file_auth_service_auth_service_proto_depIdxs = []int32{
-	0,  // 0: auth.AuthService.SignUp:input_type -> auth.SignUpRequest
-	2,  // 1: auth.AuthService.SignIn:input_type -> auth.SignInRequest
-	4,  // 2: auth.AuthService.Logout:input_type -> auth.LogoutRequest
-	5,  // 3: auth.AuthService.IsAdmin:input_type -> auth.IsAdminRequest
-	7,  // 4: auth.AuthService.ValidateToken:input_type -> auth.ValidateTokenRequest
-	9,  // 5: auth.AuthService.GetUserIDbyRefreshToken:input_type -> auth.GetUserIDbyRefreshTokenRequest
-	11, // 6: auth.AuthService.GenerateAccessToken:input_type -> auth.GenerateAccessTokenRequest
-	13, // 7: auth.AuthService.GenerateRefreshToken:input_type -> auth.GenerateRefreshTokenRequest
-	15, // 8: auth.AuthService.SaveNewRefreshToken:input_type -> auth.SaveNewRefreshTokenRequest
-	16, // 9: auth.AuthService.RemoveOldRefreshToken:input_type -> auth.RemoveOldRefreshTokenRequest
-	1,  // 10: auth.AuthService.SignUp:output_type -> auth.SignUpResponse
-	3,  // 11: auth.AuthService.SignIn:output_type -> auth.SignInResponse
-	17, // 12: auth.AuthService.Logout:output_type -> google.protobuf.Empty
-	6,  // 13: auth.AuthService.IsAdmin:output_type -> auth.IsAdminResponse
-	8,  // 14: auth.AuthService.ValidateToken:output_type -> auth.ValidateTokenResponse
-	10, // 15: auth.AuthService.GetUserIDbyRefreshToken:output_type -> auth.GetUserIDbyRefreshTokenResponse
-	12, // 16: auth.AuthService.GenerateAccessToken:output_type -> auth.GenerateAccessTokenResponse
-	14, // 17: auth.AuthService.GenerateRefreshToken:output_type -> auth.GenerateRefreshTokenResponse
-	17, // 18: auth.AuthService.SaveNewRefreshToken:output_type -> google.protobuf.Empty
-	17, // 19: auth.AuthService.RemoveOldRefreshToken:output_type -> google.protobuf.Empty
-	10, // [10:20] is the sub-list for method output_type
-	0,  // [0:10] is the sub-list for method input_type
-	0,  // [0:0] is the sub-list for extension type_name
-	0,  // [0:0] is the sub-list for extension extendee
-	0,  // [0:0] is the sub-list for field type_name
+	22, // 0: auth.GetJWKSResponse.keys:type_name -> auth.JSONWebKey
+	0,  // 1: auth.AuthService.SignUp:input_type -> auth.SignUpRequest
+	2,  // 2: auth.AuthService.SignIn:input_type -> auth.SignInRequest
+	4,  // 3: auth.AuthService.Logout:input_type -> auth.LogoutRequest
+	5,  // 4: auth.AuthService.IsAdmin:input_type -> auth.IsAdminRequest
+	7,  // 5: auth.AuthService.GetUserRoles:input_type -> auth.GetUserRolesRequest
+	9,  // 6: auth.AuthService.GrantRole:input_type -> auth.ChangeUserRoleRequest
+	9,  // 7: auth.AuthService.RevokeRole:input_type -> auth.ChangeUserRoleRequest
+	10, // 8: auth.AuthService.ValidateToken:input_type -> auth.ValidateTokenRequest
+	12, // 9: auth.AuthService.GetUserIDbyRefreshToken:input_type -> auth.GetUserIDbyRefreshTokenRequest
+	14, // 10: auth.AuthService.GenerateAccessToken:input_type -> auth.GenerateAccessTokenRequest
+	16, // 11: auth.AuthService.GenerateRefreshToken:input_type -> auth.GenerateRefreshTokenRequest
+	18, // 12: auth.AuthService.SaveNewRefreshToken:input_type -> auth.SaveNewRefreshTokenRequest
+	19, // 13: auth.AuthService.RemoveOldRefreshToken:input_type -> auth.RemoveOldRefreshTokenRequest
+	20, // 14: auth.AuthService.RefreshSession:input_type -> auth.RefreshSessionRequest
+	24, // 15: auth.AuthService.GetJWKS:input_type -> google.protobuf.Empty
+	1,  // 16: auth.AuthService.SignUp:output_type -> auth.SignUpResponse
+	3,  // 17: auth.AuthService.SignIn:output_type -> auth.SignInResponse
+	24, // 18: auth.AuthService.Logout:output_type -> google.protobuf.Empty
+	6,  // 19: auth.AuthService.IsAdmin:output_type -> auth.IsAdminResponse
+	8,  // 20: auth.AuthService.GetUserRoles:output_type -> auth.GetUserRolesResponse
+	8,  // 21: auth.AuthService.GrantRole:output_type -> auth.GetUserRolesResponse
+	8,  // 22: auth.AuthService.RevokeRole:output_type -> auth.GetUserRolesResponse
+	11, // 23: auth.AuthService.ValidateToken:output_type -> auth.ValidateTokenResponse
+	13, // 24: auth.AuthService.GetUserIDbyRefreshToken:output_type -> auth.GetUserIDbyRefreshTokenResponse
+	15, // 25: auth.AuthService.GenerateAccessToken:output_type -> auth.GenerateAccessTokenResponse
+	17, // 26: auth.AuthService.GenerateRefreshToken:output_type -> auth.GenerateRefreshTokenResponse
+	24, // 27: auth.AuthService.SaveNewRefreshToken:output_type -> google.protobuf.Empty
+	24, // 28: auth.AuthService.RemoveOldRefreshToken:output_type -> google.protobuf.Empty
+	21, // 29: auth.AuthService.RefreshSession:output_type -> auth.RefreshSessionResponse
+	23, // 30: auth.AuthService.GetJWKS:output_type -> auth.GetJWKSResponse
+	16, // [16:31] is the sub-list for method output_type
+	1,  // [1:16] is the sub-list for method input_type
+	1,  // [1:1] is the sub-list for extension type_name
+	1,  // [1:1] is the sub-list for extension extendee
+	0,  // [0:1] is the sub-list for field type_name
 }
 
 func init() { file_auth_service_auth_service_proto_init() }
@@ -1075,7 +1547,7 @@ func file_auth_service_auth_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_auth_service_auth_service_proto_rawDesc), len(file_auth_service_auth_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   17,
+			NumMessages:   24,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
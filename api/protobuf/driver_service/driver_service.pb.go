@@ -25,6 +25,7 @@ const (
 type FindDriverRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Target        *Location              `protobuf:"bytes,2,opt,name=target,proto3" json:"target,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -66,11 +67,21 @@ func (x *FindDriverRequest) GetOrderId() int64 {
 	return 0
 }
 
+func (x *FindDriverRequest) GetTarget() *Location {
+	if x != nil {
+		return x.Target
+	}
+	return nil
+}
+
 type FindDriverResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Driver        *Driver                `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
-	Success       bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
-	Message       string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	state      protoimpl.MessageState `protogen:"open.v1"`
+	Driver     *Driver                `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	Success    bool                   `protobuf:"varint,2,opt,name=success,proto3" json:"success,omitempty"`
+	Message    string                 `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	DistanceKm float64                `protobuf:"fixed64,4,opt,name=distance_km,json=distanceKm,proto3" json:"distance_km,omitempty"`
+	// reserved_at - время резерва водителя (unix), по нему резерв можно снять через ReleaseDriver
+	ReservedAt    int64 `protobuf:"varint,5,opt,name=reserved_at,json=reservedAt,proto3" json:"reserved_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -126,6 +137,20 @@ func (x *FindDriverResponse) GetMessage() string {
 	return ""
 }
 
+func (x *FindDriverResponse) GetDistanceKm() float64 {
+	if x != nil {
+		return x.DistanceKm
+	}
+	return 0
+}
+
+func (x *FindDriverResponse) GetReservedAt() int64 {
+	if x != nil {
+		return x.ReservedAt
+	}
+	return 0
+}
+
 type UpdateDriverStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	DriverId      int64                  `protobuf:"varint,1,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
@@ -222,6 +247,194 @@ func (x *UpdateDriverStatusResponse) GetSuccess() bool {
 	return false
 }
 
+type ReleaseDriverRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	DriverId int64                  `protobuf:"varint,1,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	// reserved_at - время снимаемого резерва (unix); водитель, зарезервированный с тех пор заново, не освобождается.
+	// 0 - освободить при любом резерве
+	ReservedAt    int64 `protobuf:"varint,2,opt,name=reserved_at,json=reservedAt,proto3" json:"reserved_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseDriverRequest) Reset() {
+	*x = ReleaseDriverRequest{}
+	mi := &file_driver_service_driver_service_proto_msgTypes[4]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseDriverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseDriverRequest) ProtoMessage() {}
+
+func (x *ReleaseDriverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_service_driver_service_proto_msgTypes[4]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseDriverRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseDriverRequest) Descriptor() ([]byte, []int) {
+	return file_driver_service_driver_service_proto_rawDescGZIP(), []int{4}
+}
+
+func (x *ReleaseDriverRequest) GetDriverId() int64 {
+	if x != nil {
+		return x.DriverId
+	}
+	return 0
+}
+
+func (x *ReleaseDriverRequest) GetReservedAt() int64 {
+	if x != nil {
+		return x.ReservedAt
+	}
+	return 0
+}
+
+type ReleaseDriverResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// released - false, если водитель уже свободен или занят другим резервом
+	Released      bool `protobuf:"varint,1,opt,name=released,proto3" json:"released,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseDriverResponse) Reset() {
+	*x = ReleaseDriverResponse{}
+	mi := &file_driver_service_driver_service_proto_msgTypes[5]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseDriverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseDriverResponse) ProtoMessage() {}
+
+func (x *ReleaseDriverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_service_driver_service_proto_msgTypes[5]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseDriverResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseDriverResponse) Descriptor() ([]byte, []int) {
+	return file_driver_service_driver_service_proto_rawDescGZIP(), []int{5}
+}
+
+func (x *ReleaseDriverResponse) GetReleased() bool {
+	if x != nil {
+		return x.Released
+	}
+	return false
+}
+
+type ReserveDriverRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DriverId      int64                  `protobuf:"varint,1,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveDriverRequest) Reset() {
+	*x = ReserveDriverRequest{}
+	mi := &file_driver_service_driver_service_proto_msgTypes[6]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveDriverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveDriverRequest) ProtoMessage() {}
+
+func (x *ReserveDriverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_service_driver_service_proto_msgTypes[6]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveDriverRequest.ProtoReflect.Descriptor instead.
+func (*ReserveDriverRequest) Descriptor() ([]byte, []int) {
+	return file_driver_service_driver_service_proto_rawDescGZIP(), []int{6}
+}
+
+func (x *ReserveDriverRequest) GetDriverId() int64 {
+	if x != nil {
+		return x.DriverId
+	}
+	return 0
+}
+
+type ReserveDriverResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// reserved_at - время резерва (unix) для снятия через ReleaseDriver
+	ReservedAt    int64 `protobuf:"varint,1,opt,name=reserved_at,json=reservedAt,proto3" json:"reserved_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveDriverResponse) Reset() {
+	*x = ReserveDriverResponse{}
+	mi := &file_driver_service_driver_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveDriverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveDriverResponse) ProtoMessage() {}
+
+func (x *ReserveDriverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_service_driver_service_proto_msgTypes[7]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveDriverResponse.ProtoReflect.Descriptor instead.
+func (*ReserveDriverResponse) Descriptor() ([]byte, []int) {
+	return file_driver_service_driver_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReserveDriverResponse) GetReservedAt() int64 {
+	if x != nil {
+		return x.ReservedAt
+	}
+	return 0
+}
+
 type GetAvailableDriversResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Drivers       []*Driver              `protobuf:"bytes,1,rep,name=drivers,proto3" json:"drivers,omitempty"`
@@ -231,7 +444,7 @@ type GetAvailableDriversResponse struct {
 
 func (x *GetAvailableDriversResponse) Reset() {
 	*x = GetAvailableDriversResponse{}
-	mi := &file_driver_service_driver_service_proto_msgTypes[4]
+	mi := &file_driver_service_driver_service_proto_msgTypes[8]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -243,7 +456,7 @@ func (x *GetAvailableDriversResponse) String() string {
 func (*GetAvailableDriversResponse) ProtoMessage() {}
 
 func (x *GetAvailableDriversResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_driver_service_driver_service_proto_msgTypes[4]
+	mi := &file_driver_service_driver_service_proto_msgTypes[8]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -256,7 +469,7 @@ func (x *GetAvailableDriversResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetAvailableDriversResponse.ProtoReflect.Descriptor instead.
 func (*GetAvailableDriversResponse) Descriptor() ([]byte, []int) {
-	return file_driver_service_driver_service_proto_rawDescGZIP(), []int{4}
+	return file_driver_service_driver_service_proto_rawDescGZIP(), []int{8}
 }
 
 func (x *GetAvailableDriversResponse) GetDrivers() []*Driver {
@@ -266,32 +479,31 @@ func (x *GetAvailableDriversResponse) GetDrivers() []*Driver {
 	return nil
 }
 
-type Driver struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	DriverId      int64                  `protobuf:"varint,1,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
-	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
-	Phone         string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
-	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
-	Vehicle       *Vehicle               `protobuf:"bytes,5,opt,name=vehicle,proto3" json:"vehicle,omitempty"`
+type ReportLocationRequest struct {
+	state    protoimpl.MessageState `protogen:"open.v1"`
+	DriverId int64                  `protobuf:"varint,1,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	Location *Location              `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	// recorded_at - время замера на устройстве водителя (unix); 0 - время получения
+	RecordedAt    int64 `protobuf:"varint,3,opt,name=recorded_at,json=recordedAt,proto3" json:"recorded_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Driver) Reset() {
-	*x = Driver{}
-	mi := &file_driver_service_driver_service_proto_msgTypes[5]
+func (x *ReportLocationRequest) Reset() {
+	*x = ReportLocationRequest{}
+	mi := &file_driver_service_driver_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Driver) String() string {
+func (x *ReportLocationRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Driver) ProtoMessage() {}
+func (*ReportLocationRequest) ProtoMessage() {}
 
-func (x *Driver) ProtoReflect() protoreflect.Message {
-	mi := &file_driver_service_driver_service_proto_msgTypes[5]
+func (x *ReportLocationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_service_driver_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -302,69 +514,100 @@ func (x *Driver) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Driver.ProtoReflect.Descriptor instead.
-func (*Driver) Descriptor() ([]byte, []int) {
-	return file_driver_service_driver_service_proto_rawDescGZIP(), []int{5}
+// Deprecated: Use ReportLocationRequest.ProtoReflect.Descriptor instead.
+func (*ReportLocationRequest) Descriptor() ([]byte, []int) {
+	return file_driver_service_driver_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Driver) GetDriverId() int64 {
+func (x *ReportLocationRequest) GetDriverId() int64 {
 	if x != nil {
 		return x.DriverId
 	}
 	return 0
 }
 
-func (x *Driver) GetName() string {
+func (x *ReportLocationRequest) GetLocation() *Location {
 	if x != nil {
-		return x.Name
+		return x.Location
 	}
-	return ""
+	return nil
 }
 
-func (x *Driver) GetPhone() string {
+func (x *ReportLocationRequest) GetRecordedAt() int64 {
 	if x != nil {
-		return x.Phone
+		return x.RecordedAt
 	}
-	return ""
+	return 0
 }
 
-func (x *Driver) GetStatus() string {
+type ReportLocationResponse struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// accepted - false, если пришла точка старше уже сохраненной
+	Accepted      bool `protobuf:"varint,1,opt,name=accepted,proto3" json:"accepted,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReportLocationResponse) Reset() {
+	*x = ReportLocationResponse{}
+	mi := &file_driver_service_driver_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReportLocationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReportLocationResponse) ProtoMessage() {}
+
+func (x *ReportLocationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_service_driver_service_proto_msgTypes[10]
 	if x != nil {
-		return x.Status
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Driver) GetVehicle() *Vehicle {
+// Deprecated: Use ReportLocationResponse.ProtoReflect.Descriptor instead.
+func (*ReportLocationResponse) Descriptor() ([]byte, []int) {
+	return file_driver_service_driver_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *ReportLocationResponse) GetAccepted() bool {
 	if x != nil {
-		return x.Vehicle
+		return x.Accepted
 	}
-	return nil
+	return false
 }
 
-type Vehicle struct {
+type StreamLocationResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Model         string                 `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
-	LicensePlate  string                 `protobuf:"bytes,3,opt,name=license_plate,json=licensePlate,proto3" json:"license_plate,omitempty"`
+	Received      int64                  `protobuf:"varint,1,opt,name=received,proto3" json:"received,omitempty"`
+	Accepted      int64                  `protobuf:"varint,2,opt,name=accepted,proto3" json:"accepted,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Vehicle) Reset() {
-	*x = Vehicle{}
-	mi := &file_driver_service_driver_service_proto_msgTypes[6]
+func (x *StreamLocationResponse) Reset() {
+	*x = StreamLocationResponse{}
+	mi := &file_driver_service_driver_service_proto_msgTypes[11]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Vehicle) String() string {
+func (x *StreamLocationResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Vehicle) ProtoMessage() {}
+func (*StreamLocationResponse) ProtoMessage() {}
 
-func (x *Vehicle) ProtoReflect() protoreflect.Message {
-	mi := &file_driver_service_driver_service_proto_msgTypes[6]
+func (x *StreamLocationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_service_driver_service_proto_msgTypes[11]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -375,56 +618,1039 @@ func (x *Vehicle) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Vehicle.ProtoReflect.Descriptor instead.
-func (*Vehicle) Descriptor() ([]byte, []int) {
-	return file_driver_service_driver_service_proto_rawDescGZIP(), []int{6}
+// Deprecated: Use StreamLocationResponse.ProtoReflect.Descriptor instead.
+func (*StreamLocationResponse) Descriptor() ([]byte, []int) {
+	return file_driver_service_driver_service_proto_rawDescGZIP(), []int{11}
 }
 
-func (x *Vehicle) GetModel() string {
+func (x *StreamLocationResponse) GetReceived() int64 {
 	if x != nil {
-		return x.Model
+		return x.Received
 	}
-	return ""
+	return 0
 }
 
-func (x *Vehicle) GetLicensePlate() string {
+func (x *StreamLocationResponse) GetAccepted() int64 {
 	if x != nil {
-		return x.LicensePlate
+		return x.Accepted
 	}
-	return ""
+	return 0
 }
 
-var File_driver_service_driver_service_proto protoreflect.FileDescriptor
+type GetDriverLocationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DriverId      int64                  `protobuf:"varint,1,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_driver_service_driver_service_proto_rawDesc = "" +
-	"\n" +
-	"#driver_service/driver_service.proto\x12\x06driver\x1a\x1bgoogle/protobuf/empty.proto\".\n" +
-	"\x11FindDriverRequest\x12\x19\n" +
-	"\border_id\x18\x01 \x01(\x03R\aorderId\"p\n" +
-	"\x12FindDriverResponse\x12&\n" +
-	"\x06driver\x18\x01 \x01(\v2\x0e.driver.DriverR\x06driver\x12\x18\n" +
-	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"P\n" +
-	"\x19UpdateDriverStatusRequest\x12\x1b\n" +
-	"\tdriver_id\x18\x01 \x01(\x03R\bdriverId\x12\x16\n" +
-	"\x06status\x18\x02 \x01(\tR\x06status\"6\n" +
-	"\x1aUpdateDriverStatusResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"G\n" +
-	"\x1bGetAvailableDriversResponse\x12(\n" +
-	"\adrivers\x18\x01 \x03(\v2\x0e.driver.DriverR\adrivers\"\x92\x01\n" +
+func (x *GetDriverLocationRequest) Reset() {
+	*x = GetDriverLocationRequest{}
+	mi := &file_driver_service_driver_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDriverLocationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDriverLocationRequest) ProtoMessage() {}
+
+func (x *GetDriverLocationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_service_driver_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDriverLocationRequest.ProtoReflect.Descriptor instead.
+func (*GetDriverLocationRequest) Descriptor() ([]byte, []int) {
+	return file_driver_service_driver_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *GetDriverLocationRequest) GetDriverId() int64 {
+	if x != nil {
+		return x.DriverId
+	}
+	return 0
+}
+
+type GetDriverLocationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DriverId      int64                  `protobuf:"varint,1,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	Location      *Location              `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	RecordedAt    int64                  `protobuf:"varint,3,opt,name=recorded_at,json=recordedAt,proto3" json:"recorded_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDriverLocationResponse) Reset() {
+	*x = GetDriverLocationResponse{}
+	mi := &file_driver_service_driver_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDriverLocationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDriverLocationResponse) ProtoMessage() {}
+
+func (x *GetDriverLocationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_service_driver_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDriverLocationResponse.ProtoReflect.Descriptor instead.
+func (*GetDriverLocationResponse) Descriptor() ([]byte, []int) {
+	return file_driver_service_driver_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *GetDriverLocationResponse) GetDriverId() int64 {
+	if x != nil {
+		return x.DriverId
+	}
+	return 0
+}
+
+func (x *GetDriverLocationResponse) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *GetDriverLocationResponse) GetRecordedAt() int64 {
+	if x != nil {
+		return x.RecordedAt
+	}
+	return 0
+}
+
+type CreateDriverRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Phone         string                 `protobuf:"bytes,2,opt,name=phone,proto3" json:"phone,omitempty"`
+	Email         string                 `protobuf:"bytes,3,opt,name=email,proto3" json:"email,omitempty"`
+	LicenseNumber string                 `protobuf:"bytes,4,opt,name=license_number,json=licenseNumber,proto3" json:"license_number,omitempty"`
+	Car           string                 `protobuf:"bytes,5,opt,name=car,proto3" json:"car,omitempty"`
+	// status - начальный статус, по умолчанию offline
+	Status        string `protobuf:"bytes,6,opt,name=status,proto3" json:"status,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDriverRequest) Reset() {
+	*x = CreateDriverRequest{}
+	mi := &file_driver_service_driver_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDriverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDriverRequest) ProtoMessage() {}
+
+func (x *CreateDriverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_service_driver_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDriverRequest.ProtoReflect.Descriptor instead.
+func (*CreateDriverRequest) Descriptor() ([]byte, []int) {
+	return file_driver_service_driver_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *CreateDriverRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateDriverRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *CreateDriverRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *CreateDriverRequest) GetLicenseNumber() string {
+	if x != nil {
+		return x.LicenseNumber
+	}
+	return ""
+}
+
+func (x *CreateDriverRequest) GetCar() string {
+	if x != nil {
+		return x.Car
+	}
+	return ""
+}
+
+func (x *CreateDriverRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+type CreateDriverResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Driver        *Driver                `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateDriverResponse) Reset() {
+	*x = CreateDriverResponse{}
+	mi := &file_driver_service_driver_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateDriverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateDriverResponse) ProtoMessage() {}
+
+func (x *CreateDriverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_service_driver_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateDriverResponse.ProtoReflect.Descriptor instead.
+func (*CreateDriverResponse) Descriptor() ([]byte, []int) {
+	return file_driver_service_driver_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateDriverResponse) GetDriver() *Driver {
+	if x != nil {
+		return x.Driver
+	}
+	return nil
+}
+
+type GetDriverRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DriverId      int64                  `protobuf:"varint,1,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDriverRequest) Reset() {
+	*x = GetDriverRequest{}
+	mi := &file_driver_service_driver_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDriverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDriverRequest) ProtoMessage() {}
+
+func (x *GetDriverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_service_driver_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDriverRequest.ProtoReflect.Descriptor instead.
+func (*GetDriverRequest) Descriptor() ([]byte, []int) {
+	return file_driver_service_driver_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *GetDriverRequest) GetDriverId() int64 {
+	if x != nil {
+		return x.DriverId
+	}
+	return 0
+}
+
+type GetDriverResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Driver        *Driver                `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDriverResponse) Reset() {
+	*x = GetDriverResponse{}
+	mi := &file_driver_service_driver_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDriverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDriverResponse) ProtoMessage() {}
+
+func (x *GetDriverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_service_driver_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDriverResponse.ProtoReflect.Descriptor instead.
+func (*GetDriverResponse) Descriptor() ([]byte, []int) {
+	return file_driver_service_driver_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetDriverResponse) GetDriver() *Driver {
+	if x != nil {
+		return x.Driver
+	}
+	return nil
+}
+
+type ListDriversRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// status - фильтр по статусу, пустой - все статусы
+	Status          string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	IncludeInactive bool   `protobuf:"varint,2,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"`
+	Page            int32  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize        int32  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListDriversRequest) Reset() {
+	*x = ListDriversRequest{}
+	mi := &file_driver_service_driver_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDriversRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDriversRequest) ProtoMessage() {}
+
+func (x *ListDriversRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_service_driver_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDriversRequest.ProtoReflect.Descriptor instead.
+func (*ListDriversRequest) Descriptor() ([]byte, []int) {
+	return file_driver_service_driver_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *ListDriversRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListDriversRequest) GetIncludeInactive() bool {
+	if x != nil {
+		return x.IncludeInactive
+	}
+	return false
+}
+
+func (x *ListDriversRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListDriversRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListDriversResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Drivers       []*Driver              `protobuf:"bytes,1,rep,name=drivers,proto3" json:"drivers,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListDriversResponse) Reset() {
+	*x = ListDriversResponse{}
+	mi := &file_driver_service_driver_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListDriversResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListDriversResponse) ProtoMessage() {}
+
+func (x *ListDriversResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_service_driver_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListDriversResponse.ProtoReflect.Descriptor instead.
+func (*ListDriversResponse) Descriptor() ([]byte, []int) {
+	return file_driver_service_driver_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListDriversResponse) GetDrivers() []*Driver {
+	if x != nil {
+		return x.Drivers
+	}
+	return nil
+}
+
+func (x *ListDriversResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListDriversResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListDriversResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// UpdateDriverRequest заменяет анкетные данные водителя; статус меняется через UpdateDriverStatus
+type UpdateDriverRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DriverId      int64                  `protobuf:"varint,1,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Phone         string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	Email         string                 `protobuf:"bytes,4,opt,name=email,proto3" json:"email,omitempty"`
+	LicenseNumber string                 `protobuf:"bytes,5,opt,name=license_number,json=licenseNumber,proto3" json:"license_number,omitempty"`
+	Car           string                 `protobuf:"bytes,6,opt,name=car,proto3" json:"car,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDriverRequest) Reset() {
+	*x = UpdateDriverRequest{}
+	mi := &file_driver_service_driver_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDriverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDriverRequest) ProtoMessage() {}
+
+func (x *UpdateDriverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_service_driver_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDriverRequest.ProtoReflect.Descriptor instead.
+func (*UpdateDriverRequest) Descriptor() ([]byte, []int) {
+	return file_driver_service_driver_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *UpdateDriverRequest) GetDriverId() int64 {
+	if x != nil {
+		return x.DriverId
+	}
+	return 0
+}
+
+func (x *UpdateDriverRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateDriverRequest) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *UpdateDriverRequest) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *UpdateDriverRequest) GetLicenseNumber() string {
+	if x != nil {
+		return x.LicenseNumber
+	}
+	return ""
+}
+
+func (x *UpdateDriverRequest) GetCar() string {
+	if x != nil {
+		return x.Car
+	}
+	return ""
+}
+
+type UpdateDriverResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Driver        *Driver                `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateDriverResponse) Reset() {
+	*x = UpdateDriverResponse{}
+	mi := &file_driver_service_driver_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateDriverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateDriverResponse) ProtoMessage() {}
+
+func (x *UpdateDriverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_service_driver_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateDriverResponse.ProtoReflect.Descriptor instead.
+func (*UpdateDriverResponse) Descriptor() ([]byte, []int) {
+	return file_driver_service_driver_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UpdateDriverResponse) GetDriver() *Driver {
+	if x != nil {
+		return x.Driver
+	}
+	return nil
+}
+
+type DeactivateDriverRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DriverId      int64                  `protobuf:"varint,1,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateDriverRequest) Reset() {
+	*x = DeactivateDriverRequest{}
+	mi := &file_driver_service_driver_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateDriverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateDriverRequest) ProtoMessage() {}
+
+func (x *DeactivateDriverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_service_driver_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateDriverRequest.ProtoReflect.Descriptor instead.
+func (*DeactivateDriverRequest) Descriptor() ([]byte, []int) {
+	return file_driver_service_driver_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *DeactivateDriverRequest) GetDriverId() int64 {
+	if x != nil {
+		return x.DriverId
+	}
+	return 0
+}
+
+type DeactivateDriverResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Driver        *Driver                `protobuf:"bytes,1,opt,name=driver,proto3" json:"driver,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeactivateDriverResponse) Reset() {
+	*x = DeactivateDriverResponse{}
+	mi := &file_driver_service_driver_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeactivateDriverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeactivateDriverResponse) ProtoMessage() {}
+
+func (x *DeactivateDriverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_service_driver_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeactivateDriverResponse.ProtoReflect.Descriptor instead.
+func (*DeactivateDriverResponse) Descriptor() ([]byte, []int) {
+	return file_driver_service_driver_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DeactivateDriverResponse) GetDriver() *Driver {
+	if x != nil {
+		return x.Driver
+	}
+	return nil
+}
+
+type Driver struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	DriverId       int64                  `protobuf:"varint,1,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	Name           string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Phone          string                 `protobuf:"bytes,3,opt,name=phone,proto3" json:"phone,omitempty"`
+	Status         string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Vehicle        *Vehicle               `protobuf:"bytes,5,opt,name=vehicle,proto3" json:"vehicle,omitempty"`
+	Location       *Location              `protobuf:"bytes,6,opt,name=location,proto3" json:"location,omitempty"`
+	LastAssignedAt int64                  `protobuf:"varint,7,opt,name=last_assigned_at,json=lastAssignedAt,proto3" json:"last_assigned_at,omitempty"`
+	Email          string                 `protobuf:"bytes,8,opt,name=email,proto3" json:"email,omitempty"`
+	LicenseNumber  string                 `protobuf:"bytes,9,opt,name=license_number,json=licenseNumber,proto3" json:"license_number,omitempty"`
+	Active         bool                   `protobuf:"varint,10,opt,name=active,proto3" json:"active,omitempty"`
+	unknownFields  protoimpl.UnknownFields
+	sizeCache      protoimpl.SizeCache
+}
+
+func (x *Driver) Reset() {
+	*x = Driver{}
+	mi := &file_driver_service_driver_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Driver) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Driver) ProtoMessage() {}
+
+func (x *Driver) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_service_driver_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Driver.ProtoReflect.Descriptor instead.
+func (*Driver) Descriptor() ([]byte, []int) {
+	return file_driver_service_driver_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *Driver) GetDriverId() int64 {
+	if x != nil {
+		return x.DriverId
+	}
+	return 0
+}
+
+func (x *Driver) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Driver) GetPhone() string {
+	if x != nil {
+		return x.Phone
+	}
+	return ""
+}
+
+func (x *Driver) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Driver) GetVehicle() *Vehicle {
+	if x != nil {
+		return x.Vehicle
+	}
+	return nil
+}
+
+func (x *Driver) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *Driver) GetLastAssignedAt() int64 {
+	if x != nil {
+		return x.LastAssignedAt
+	}
+	return 0
+}
+
+func (x *Driver) GetEmail() string {
+	if x != nil {
+		return x.Email
+	}
+	return ""
+}
+
+func (x *Driver) GetLicenseNumber() string {
+	if x != nil {
+		return x.LicenseNumber
+	}
+	return ""
+}
+
+func (x *Driver) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+type Location struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Location) Reset() {
+	*x = Location{}
+	mi := &file_driver_service_driver_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Location) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Location) ProtoMessage() {}
+
+func (x *Location) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_service_driver_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
+	return file_driver_service_driver_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *Location) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Location) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type Vehicle struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Model         string                 `protobuf:"bytes,2,opt,name=model,proto3" json:"model,omitempty"`
+	LicensePlate  string                 `protobuf:"bytes,3,opt,name=license_plate,json=licensePlate,proto3" json:"license_plate,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Vehicle) Reset() {
+	*x = Vehicle{}
+	mi := &file_driver_service_driver_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Vehicle) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Vehicle) ProtoMessage() {}
+
+func (x *Vehicle) ProtoReflect() protoreflect.Message {
+	mi := &file_driver_service_driver_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Vehicle.ProtoReflect.Descriptor instead.
+func (*Vehicle) Descriptor() ([]byte, []int) {
+	return file_driver_service_driver_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Vehicle) GetModel() string {
+	if x != nil {
+		return x.Model
+	}
+	return ""
+}
+
+func (x *Vehicle) GetLicensePlate() string {
+	if x != nil {
+		return x.LicensePlate
+	}
+	return ""
+}
+
+var File_driver_service_driver_service_proto protoreflect.FileDescriptor
+
+const file_driver_service_driver_service_proto_rawDesc = "" +
+	"\n" +
+	"#driver_service/driver_service.proto\x12\x06driver\x1a\x1bgoogle/protobuf/empty.proto\"X\n" +
+	"\x11FindDriverRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12(\n" +
+	"\x06target\x18\x02 \x01(\v2\x10.driver.LocationR\x06target\"\xb2\x01\n" +
+	"\x12FindDriverResponse\x12&\n" +
+	"\x06driver\x18\x01 \x01(\v2\x0e.driver.DriverR\x06driver\x12\x18\n" +
+	"\asuccess\x18\x02 \x01(\bR\asuccess\x12\x18\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\x12\x1f\n" +
+	"\vdistance_km\x18\x04 \x01(\x01R\n" +
+	"distanceKm\x12\x1f\n" +
+	"\vreserved_at\x18\x05 \x01(\x03R\n" +
+	"reservedAt\"P\n" +
+	"\x19UpdateDriverStatusRequest\x12\x1b\n" +
+	"\tdriver_id\x18\x01 \x01(\x03R\bdriverId\x12\x16\n" +
+	"\x06status\x18\x02 \x01(\tR\x06status\"6\n" +
+	"\x1aUpdateDriverStatusResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"T\n" +
+	"\x14ReleaseDriverRequest\x12\x1b\n" +
+	"\tdriver_id\x18\x01 \x01(\x03R\bdriverId\x12\x1f\n" +
+	"\vreserved_at\x18\x02 \x01(\x03R\n" +
+	"reservedAt\"3\n" +
+	"\x15ReleaseDriverResponse\x12\x1a\n" +
+	"\breleased\x18\x01 \x01(\bR\breleased\"3\n" +
+	"\x14ReserveDriverRequest\x12\x1b\n" +
+	"\tdriver_id\x18\x01 \x01(\x03R\bdriverId\"8\n" +
+	"\x15ReserveDriverResponse\x12\x1f\n" +
+	"\vreserved_at\x18\x01 \x01(\x03R\n" +
+	"reservedAt\"G\n" +
+	"\x1bGetAvailableDriversResponse\x12(\n" +
+	"\adrivers\x18\x01 \x03(\v2\x0e.driver.DriverR\adrivers\"\x83\x01\n" +
+	"\x15ReportLocationRequest\x12\x1b\n" +
+	"\tdriver_id\x18\x01 \x01(\x03R\bdriverId\x12,\n" +
+	"\blocation\x18\x02 \x01(\v2\x10.driver.LocationR\blocation\x12\x1f\n" +
+	"\vrecorded_at\x18\x03 \x01(\x03R\n" +
+	"recordedAt\"4\n" +
+	"\x16ReportLocationResponse\x12\x1a\n" +
+	"\baccepted\x18\x01 \x01(\bR\baccepted\"P\n" +
+	"\x16StreamLocationResponse\x12\x1a\n" +
+	"\breceived\x18\x01 \x01(\x03R\breceived\x12\x1a\n" +
+	"\baccepted\x18\x02 \x01(\x03R\baccepted\"7\n" +
+	"\x18GetDriverLocationRequest\x12\x1b\n" +
+	"\tdriver_id\x18\x01 \x01(\x03R\bdriverId\"\x87\x01\n" +
+	"\x19GetDriverLocationResponse\x12\x1b\n" +
+	"\tdriver_id\x18\x01 \x01(\x03R\bdriverId\x12,\n" +
+	"\blocation\x18\x02 \x01(\v2\x10.driver.LocationR\blocation\x12\x1f\n" +
+	"\vrecorded_at\x18\x03 \x01(\x03R\n" +
+	"recordedAt\"\xa6\x01\n" +
+	"\x13CreateDriverRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x14\n" +
+	"\x05phone\x18\x02 \x01(\tR\x05phone\x12\x14\n" +
+	"\x05email\x18\x03 \x01(\tR\x05email\x12%\n" +
+	"\x0elicense_number\x18\x04 \x01(\tR\rlicenseNumber\x12\x10\n" +
+	"\x03car\x18\x05 \x01(\tR\x03car\x12\x16\n" +
+	"\x06status\x18\x06 \x01(\tR\x06status\">\n" +
+	"\x14CreateDriverResponse\x12&\n" +
+	"\x06driver\x18\x01 \x01(\v2\x0e.driver.DriverR\x06driver\"/\n" +
+	"\x10GetDriverRequest\x12\x1b\n" +
+	"\tdriver_id\x18\x01 \x01(\x03R\bdriverId\";\n" +
+	"\x11GetDriverResponse\x12&\n" +
+	"\x06driver\x18\x01 \x01(\v2\x0e.driver.DriverR\x06driver\"\x88\x01\n" +
+	"\x12ListDriversRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12)\n" +
+	"\x10include_inactive\x18\x02 \x01(\bR\x0fincludeInactive\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x86\x01\n" +
+	"\x13ListDriversResponse\x12(\n" +
+	"\adrivers\x18\x01 \x03(\v2\x0e.driver.DriverR\adrivers\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xab\x01\n" +
+	"\x13UpdateDriverRequest\x12\x1b\n" +
+	"\tdriver_id\x18\x01 \x01(\x03R\bdriverId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
+	"\x05phone\x18\x03 \x01(\tR\x05phone\x12\x14\n" +
+	"\x05email\x18\x04 \x01(\tR\x05email\x12%\n" +
+	"\x0elicense_number\x18\x05 \x01(\tR\rlicenseNumber\x12\x10\n" +
+	"\x03car\x18\x06 \x01(\tR\x03car\">\n" +
+	"\x14UpdateDriverResponse\x12&\n" +
+	"\x06driver\x18\x01 \x01(\v2\x0e.driver.DriverR\x06driver\"6\n" +
+	"\x17DeactivateDriverRequest\x12\x1b\n" +
+	"\tdriver_id\x18\x01 \x01(\x03R\bdriverId\"B\n" +
+	"\x18DeactivateDriverResponse\x12&\n" +
+	"\x06driver\x18\x01 \x01(\v2\x0e.driver.DriverR\x06driver\"\xbf\x02\n" +
 	"\x06Driver\x12\x1b\n" +
 	"\tdriver_id\x18\x01 \x01(\x03R\bdriverId\x12\x12\n" +
 	"\x04name\x18\x02 \x01(\tR\x04name\x12\x14\n" +
 	"\x05phone\x18\x03 \x01(\tR\x05phone\x12\x16\n" +
 	"\x06status\x18\x04 \x01(\tR\x06status\x12)\n" +
-	"\avehicle\x18\x05 \x01(\v2\x0f.driver.VehicleR\avehicle\"D\n" +
+	"\avehicle\x18\x05 \x01(\v2\x0f.driver.VehicleR\avehicle\x12,\n" +
+	"\blocation\x18\x06 \x01(\v2\x10.driver.LocationR\blocation\x12(\n" +
+	"\x10last_assigned_at\x18\a \x01(\x03R\x0elastAssignedAt\x12\x14\n" +
+	"\x05email\x18\b \x01(\tR\x05email\x12%\n" +
+	"\x0elicense_number\x18\t \x01(\tR\rlicenseNumber\x12\x16\n" +
+	"\x06active\x18\n" +
+	" \x01(\bR\x06active\"D\n" +
+	"\bLocation\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\"D\n" +
 	"\aVehicle\x12\x14\n" +
 	"\x05model\x18\x02 \x01(\tR\x05model\x12#\n" +
-	"\rlicense_plate\x18\x03 \x01(\tR\flicensePlate2\x8d\x02\n" +
+	"\rlicense_plate\x18\x03 \x01(\tR\flicensePlate2\x9e\b\n" +
 	"\rDriverService\x12K\n" +
 	"\x12FindSuitableDriver\x12\x19.driver.FindDriverRequest\x1a\x1a.driver.FindDriverResponse\x12[\n" +
 	"\x12UpdateDriverStatus\x12!.driver.UpdateDriverStatusRequest\x1a\".driver.UpdateDriverStatusResponse\x12R\n" +
-	"\x13GetAvailableDrivers\x12\x16.google.protobuf.Empty\x1a#.driver.GetAvailableDriversResponseB\tZ\a/driverb\x06proto3"
+	"\x13GetAvailableDrivers\x12\x16.google.protobuf.Empty\x1a#.driver.GetAvailableDriversResponse\x12O\n" +
+	"\x0eReportLocation\x12\x1d.driver.ReportLocationRequest\x1a\x1e.driver.ReportLocationResponse\x12Q\n" +
+	"\x0eStreamLocation\x12\x1d.driver.ReportLocationRequest\x1a\x1e.driver.StreamLocationResponse(\x01\x12X\n" +
+	"\x11GetDriverLocation\x12 .driver.GetDriverLocationRequest\x1a!.driver.GetDriverLocationResponse\x12I\n" +
+	"\fCreateDriver\x12\x1b.driver.CreateDriverRequest\x1a\x1c.driver.CreateDriverResponse\x12@\n" +
+	"\tGetDriver\x12\x18.driver.GetDriverRequest\x1a\x19.driver.GetDriverResponse\x12F\n" +
+	"\vListDrivers\x12\x1a.driver.ListDriversRequest\x1a\x1b.driver.ListDriversResponse\x12I\n" +
+	"\fUpdateDriver\x12\x1b.driver.UpdateDriverRequest\x1a\x1c.driver.UpdateDriverResponse\x12U\n" +
+	"\x10DeactivateDriver\x12\x1f.driver.DeactivateDriverRequest\x1a .driver.DeactivateDriverResponse\x12L\n" +
+	"\rReleaseDriver\x12\x1c.driver.ReleaseDriverRequest\x1a\x1d.driver.ReleaseDriverResponse\x12L\n" +
+	"\rReserveDriver\x12\x1c.driver.ReserveDriverRequest\x1a\x1d.driver.ReserveDriverResponseB\tZ\a/driverb\x06proto3"
 
 var (
 	file_driver_service_driver_service_proto_rawDescOnce sync.Once
@@ -438,32 +1664,81 @@ func file_driver_service_driver_service_proto_rawDescGZIP() []byte {
 	return file_driver_service_driver_service_proto_rawDescData
 }
 
-var file_driver_service_driver_service_proto_msgTypes = make([]protoimpl.MessageInfo, 7)
+var file_driver_service_driver_service_proto_msgTypes = make([]protoimpl.MessageInfo, 27)
 var file_driver_service_driver_service_proto_goTypes = []any{
 	(*FindDriverRequest)(nil),           // 0: driver.FindDriverRequest
 	(*FindDriverResponse)(nil),          // 1: driver.FindDriverResponse
 	(*UpdateDriverStatusRequest)(nil),   // 2: driver.UpdateDriverStatusRequest
 	(*UpdateDriverStatusResponse)(nil),  // 3: driver.UpdateDriverStatusResponse
-	(*GetAvailableDriversResponse)(nil), // 4: driver.GetAvailableDriversResponse
-	(*Driver)(nil),                      // 5: driver.Driver
-	(*Vehicle)(nil),                     // 6: driver.Vehicle
-	(*emptypb.Empty)(nil),               // 7: google.protobuf.Empty
+	(*ReleaseDriverRequest)(nil),        // 4: driver.ReleaseDriverRequest
+	(*ReleaseDriverResponse)(nil),       // 5: driver.ReleaseDriverResponse
+	(*ReserveDriverRequest)(nil),        // 6: driver.ReserveDriverRequest
+	(*ReserveDriverResponse)(nil),       // 7: driver.ReserveDriverResponse
+	(*GetAvailableDriversResponse)(nil), // 8: driver.GetAvailableDriversResponse
+	(*ReportLocationRequest)(nil),       // 9: driver.ReportLocationRequest
+	(*ReportLocationResponse)(nil),      // 10: driver.ReportLocationResponse
+	(*StreamLocationResponse)(nil),      // 11: driver.StreamLocationResponse
+	(*GetDriverLocationRequest)(nil),    // 12: driver.GetDriverLocationRequest
+	(*GetDriverLocationResponse)(nil),   // 13: driver.GetDriverLocationResponse
+	(*CreateDriverRequest)(nil),         // 14: driver.CreateDriverRequest
+	(*CreateDriverResponse)(nil),        // 15: driver.CreateDriverResponse
+	(*GetDriverRequest)(nil),            // 16: driver.GetDriverRequest
+	(*GetDriverResponse)(nil),           // 17: driver.GetDriverResponse
+	(*ListDriversRequest)(nil),          // 18: driver.ListDriversRequest
+	(*ListDriversResponse)(nil),         // 19: driver.ListDriversResponse
+	(*UpdateDriverRequest)(nil),         // 20: driver.UpdateDriverRequest
+	(*UpdateDriverResponse)(nil),        // 21: driver.UpdateDriverResponse
+	(*DeactivateDriverRequest)(nil),     // 22: driver.DeactivateDriverRequest
+	(*DeactivateDriverResponse)(nil),    // 23: driver.DeactivateDriverResponse
+	(*Driver)(nil),                      // 24: driver.Driver
+	(*Location)(nil),                    // 25: driver.Location
+	(*Vehicle)(nil),                     // 26: driver.Vehicle
+	(*emptypb.Empty)(nil),               // 27: google.protobuf.Empty
 }
 var file_driver_service_driver_service_proto_depIdxs = []int32{
-	5, // 0: driver.FindDriverResponse.driver:type_name -> driver.Driver
-	5, // 1: driver.GetAvailableDriversResponse.drivers:type_name -> driver.Driver
-	6, // 2: driver.Driver.vehicle:type_name -> driver.Vehicle
-	0, // 3: driver.DriverService.FindSuitableDriver:input_type -> driver.FindDriverRequest
-	2, // 4: driver.DriverService.UpdateDriverStatus:input_type -> driver.UpdateDriverStatusRequest
-	7, // 5: driver.DriverService.GetAvailableDrivers:input_type -> google.protobuf.Empty
-	1, // 6: driver.DriverService.FindSuitableDriver:output_type -> driver.FindDriverResponse
-	3, // 7: driver.DriverService.UpdateDriverStatus:output_type -> driver.UpdateDriverStatusResponse
-	4, // 8: driver.DriverService.GetAvailableDrivers:output_type -> driver.GetAvailableDriversResponse
-	6, // [6:9] is the sub-list for method output_type
-	3, // [3:6] is the sub-list for method input_type
-	3, // [3:3] is the sub-list for extension type_name
-	3, // [3:3] is the sub-list for extension extendee
-	0, // [0:3] is the sub-list for field type_name
+	25, // 0: driver.FindDriverRequest.target:type_name -> driver.Location
+	24, // 1: driver.FindDriverResponse.driver:type_name -> driver.Driver
+	24, // 2: driver.GetAvailableDriversResponse.drivers:type_name -> driver.Driver
+	25, // 3: driver.ReportLocationRequest.location:type_name -> driver.Location
+	25, // 4: driver.GetDriverLocationResponse.location:type_name -> driver.Location
+	24, // 5: driver.CreateDriverResponse.driver:type_name -> driver.Driver
+	24, // 6: driver.GetDriverResponse.driver:type_name -> driver.Driver
+	24, // 7: driver.ListDriversResponse.drivers:type_name -> driver.Driver
+	24, // 8: driver.UpdateDriverResponse.driver:type_name -> driver.Driver
+	24, // 9: driver.DeactivateDriverResponse.driver:type_name -> driver.Driver
+	26, // 10: driver.Driver.vehicle:type_name -> driver.Vehicle
+	25, // 11: driver.Driver.location:type_name -> driver.Location
+	0,  // 12: driver.DriverService.FindSuitableDriver:input_type -> driver.FindDriverRequest
+	2,  // 13: driver.DriverService.UpdateDriverStatus:input_type -> driver.UpdateDriverStatusRequest
+	27, // 14: driver.DriverService.GetAvailableDrivers:input_type -> google.protobuf.Empty
+	9,  // 15: driver.DriverService.ReportLocation:input_type -> driver.ReportLocationRequest
+	9,  // 16: driver.DriverService.StreamLocation:input_type -> driver.ReportLocationRequest
+	12, // 17: driver.DriverService.GetDriverLocation:input_type -> driver.GetDriverLocationRequest
+	14, // 18: driver.DriverService.CreateDriver:input_type -> driver.CreateDriverRequest
+	16, // 19: driver.DriverService.GetDriver:input_type -> driver.GetDriverRequest
+	18, // 20: driver.DriverService.ListDrivers:input_type -> driver.ListDriversRequest
+	20, // 21: driver.DriverService.UpdateDriver:input_type -> driver.UpdateDriverRequest
+	22, // 22: driver.DriverService.DeactivateDriver:input_type -> driver.DeactivateDriverRequest
+	4,  // 23: driver.DriverService.ReleaseDriver:input_type -> driver.ReleaseDriverRequest
+	6,  // 24: driver.DriverService.ReserveDriver:input_type -> driver.ReserveDriverRequest
+	1,  // 25: driver.DriverService.FindSuitableDriver:output_type -> driver.FindDriverResponse
+	3,  // 26: driver.DriverService.UpdateDriverStatus:output_type -> driver.UpdateDriverStatusResponse
+	8,  // 27: driver.DriverService.GetAvailableDrivers:output_type -> driver.GetAvailableDriversResponse
+	10, // 28: driver.DriverService.ReportLocation:output_type -> driver.ReportLocationResponse
+	11, // 29: driver.DriverService.StreamLocation:output_type -> driver.StreamLocationResponse
+	13, // 30: driver.DriverService.GetDriverLocation:output_type -> driver.GetDriverLocationResponse
+	15, // 31: driver.DriverService.CreateDriver:output_type -> driver.CreateDriverResponse
+	17, // 32: driver.DriverService.GetDriver:output_type -> driver.GetDriverResponse
+	19, // 33: driver.DriverService.ListDrivers:output_type -> driver.ListDriversResponse
+	21, // 34: driver.DriverService.UpdateDriver:output_type -> driver.UpdateDriverResponse
+	23, // 35: driver.DriverService.DeactivateDriver:output_type -> driver.DeactivateDriverResponse
+	5,  // 36: driver.DriverService.ReleaseDriver:output_type -> driver.ReleaseDriverResponse
+	7,  // 37: driver.DriverService.ReserveDriver:output_type -> driver.ReserveDriverResponse
+	25, // [25:38] is the sub-list for method output_type
+	12, // [12:25] is the sub-list for method input_type
+	12, // [12:12] is the sub-list for extension type_name
+	12, // [12:12] is the sub-list for extension extendee
+	0,  // [0:12] is the sub-list for field type_name
 }
 
 func init() { file_driver_service_driver_service_proto_init() }
@@ -477,7 +1752,7 @@ func file_driver_service_driver_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_driver_service_driver_service_proto_rawDesc), len(file_driver_service_driver_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   7,
+			NumMessages:   27,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
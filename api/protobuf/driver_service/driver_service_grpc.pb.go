@@ -23,6 +23,16 @@ const (
 	DriverService_FindSuitableDriver_FullMethodName  = "/driver.DriverService/FindSuitableDriver"
 	DriverService_UpdateDriverStatus_FullMethodName  = "/driver.DriverService/UpdateDriverStatus"
 	DriverService_GetAvailableDrivers_FullMethodName = "/driver.DriverService/GetAvailableDrivers"
+	DriverService_ReportLocation_FullMethodName      = "/driver.DriverService/ReportLocation"
+	DriverService_StreamLocation_FullMethodName      = "/driver.DriverService/StreamLocation"
+	DriverService_GetDriverLocation_FullMethodName   = "/driver.DriverService/GetDriverLocation"
+	DriverService_CreateDriver_FullMethodName        = "/driver.DriverService/CreateDriver"
+	DriverService_GetDriver_FullMethodName           = "/driver.DriverService/GetDriver"
+	DriverService_ListDrivers_FullMethodName         = "/driver.DriverService/ListDrivers"
+	DriverService_UpdateDriver_FullMethodName        = "/driver.DriverService/UpdateDriver"
+	DriverService_DeactivateDriver_FullMethodName    = "/driver.DriverService/DeactivateDriver"
+	DriverService_ReleaseDriver_FullMethodName       = "/driver.DriverService/ReleaseDriver"
+	DriverService_ReserveDriver_FullMethodName       = "/driver.DriverService/ReserveDriver"
 )
 
 // DriverServiceClient is the client API for DriverService service.
@@ -34,6 +44,18 @@ type DriverServiceClient interface {
 	FindSuitableDriver(ctx context.Context, in *FindDriverRequest, opts ...grpc.CallOption) (*FindDriverResponse, error)
 	UpdateDriverStatus(ctx context.Context, in *UpdateDriverStatusRequest, opts ...grpc.CallOption) (*UpdateDriverStatusResponse, error)
 	GetAvailableDrivers(ctx context.Context, in *emptypb.Empty, opts ...grpc.CallOption) (*GetAvailableDriversResponse, error)
+	ReportLocation(ctx context.Context, in *ReportLocationRequest, opts ...grpc.CallOption) (*ReportLocationResponse, error)
+	StreamLocation(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ReportLocationRequest, StreamLocationResponse], error)
+	GetDriverLocation(ctx context.Context, in *GetDriverLocationRequest, opts ...grpc.CallOption) (*GetDriverLocationResponse, error)
+	CreateDriver(ctx context.Context, in *CreateDriverRequest, opts ...grpc.CallOption) (*CreateDriverResponse, error)
+	GetDriver(ctx context.Context, in *GetDriverRequest, opts ...grpc.CallOption) (*GetDriverResponse, error)
+	ListDrivers(ctx context.Context, in *ListDriversRequest, opts ...grpc.CallOption) (*ListDriversResponse, error)
+	UpdateDriver(ctx context.Context, in *UpdateDriverRequest, opts ...grpc.CallOption) (*UpdateDriverResponse, error)
+	DeactivateDriver(ctx context.Context, in *DeactivateDriverRequest, opts ...grpc.CallOption) (*DeactivateDriverResponse, error)
+	// Снимает резерв водителя: busy -> available. Повторный вызов безопасен, поэтому его можно повторять из outbox
+	ReleaseDriver(ctx context.Context, in *ReleaseDriverRequest, opts ...grpc.CallOption) (*ReleaseDriverResponse, error)
+	// Резервирует свободного водителя: available -> busy. Занятый или уволенный водитель - FailedPrecondition
+	ReserveDriver(ctx context.Context, in *ReserveDriverRequest, opts ...grpc.CallOption) (*ReserveDriverResponse, error)
 }
 
 type driverServiceClient struct {
@@ -74,6 +96,109 @@ func (c *driverServiceClient) GetAvailableDrivers(ctx context.Context, in *empty
 	return out, nil
 }
 
+func (c *driverServiceClient) ReportLocation(ctx context.Context, in *ReportLocationRequest, opts ...grpc.CallOption) (*ReportLocationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReportLocationResponse)
+	err := c.cc.Invoke(ctx, DriverService_ReportLocation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) StreamLocation(ctx context.Context, opts ...grpc.CallOption) (grpc.ClientStreamingClient[ReportLocationRequest, StreamLocationResponse], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &DriverService_ServiceDesc.Streams[0], DriverService_StreamLocation_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[ReportLocationRequest, StreamLocationResponse]{ClientStream: stream}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DriverService_StreamLocationClient = grpc.ClientStreamingClient[ReportLocationRequest, StreamLocationResponse]
+
+func (c *driverServiceClient) GetDriverLocation(ctx context.Context, in *GetDriverLocationRequest, opts ...grpc.CallOption) (*GetDriverLocationResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDriverLocationResponse)
+	err := c.cc.Invoke(ctx, DriverService_GetDriverLocation_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) CreateDriver(ctx context.Context, in *CreateDriverRequest, opts ...grpc.CallOption) (*CreateDriverResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CreateDriverResponse)
+	err := c.cc.Invoke(ctx, DriverService_CreateDriver_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) GetDriver(ctx context.Context, in *GetDriverRequest, opts ...grpc.CallOption) (*GetDriverResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetDriverResponse)
+	err := c.cc.Invoke(ctx, DriverService_GetDriver_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) ListDrivers(ctx context.Context, in *ListDriversRequest, opts ...grpc.CallOption) (*ListDriversResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ListDriversResponse)
+	err := c.cc.Invoke(ctx, DriverService_ListDrivers_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) UpdateDriver(ctx context.Context, in *UpdateDriverRequest, opts ...grpc.CallOption) (*UpdateDriverResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(UpdateDriverResponse)
+	err := c.cc.Invoke(ctx, DriverService_UpdateDriver_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) DeactivateDriver(ctx context.Context, in *DeactivateDriverRequest, opts ...grpc.CallOption) (*DeactivateDriverResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(DeactivateDriverResponse)
+	err := c.cc.Invoke(ctx, DriverService_DeactivateDriver_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) ReleaseDriver(ctx context.Context, in *ReleaseDriverRequest, opts ...grpc.CallOption) (*ReleaseDriverResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReleaseDriverResponse)
+	err := c.cc.Invoke(ctx, DriverService_ReleaseDriver_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *driverServiceClient) ReserveDriver(ctx context.Context, in *ReserveDriverRequest, opts ...grpc.CallOption) (*ReserveDriverResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(ReserveDriverResponse)
+	err := c.cc.Invoke(ctx, DriverService_ReserveDriver_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // DriverServiceServer is the server API for DriverService service.
 // All implementations must embed UnimplementedDriverServiceServer
 // for forward compatibility.
@@ -83,6 +208,18 @@ type DriverServiceServer interface {
 	FindSuitableDriver(context.Context, *FindDriverRequest) (*FindDriverResponse, error)
 	UpdateDriverStatus(context.Context, *UpdateDriverStatusRequest) (*UpdateDriverStatusResponse, error)
 	GetAvailableDrivers(context.Context, *emptypb.Empty) (*GetAvailableDriversResponse, error)
+	ReportLocation(context.Context, *ReportLocationRequest) (*ReportLocationResponse, error)
+	StreamLocation(grpc.ClientStreamingServer[ReportLocationRequest, StreamLocationResponse]) error
+	GetDriverLocation(context.Context, *GetDriverLocationRequest) (*GetDriverLocationResponse, error)
+	CreateDriver(context.Context, *CreateDriverRequest) (*CreateDriverResponse, error)
+	GetDriver(context.Context, *GetDriverRequest) (*GetDriverResponse, error)
+	ListDrivers(context.Context, *ListDriversRequest) (*ListDriversResponse, error)
+	UpdateDriver(context.Context, *UpdateDriverRequest) (*UpdateDriverResponse, error)
+	DeactivateDriver(context.Context, *DeactivateDriverRequest) (*DeactivateDriverResponse, error)
+	// Снимает резерв водителя: busy -> available. Повторный вызов безопасен, поэтому его можно повторять из outbox
+	ReleaseDriver(context.Context, *ReleaseDriverRequest) (*ReleaseDriverResponse, error)
+	// Резервирует свободного водителя: available -> busy. Занятый или уволенный водитель - FailedPrecondition
+	ReserveDriver(context.Context, *ReserveDriverRequest) (*ReserveDriverResponse, error)
 	mustEmbedUnimplementedDriverServiceServer()
 }
 
@@ -102,6 +239,36 @@ func (UnimplementedDriverServiceServer) UpdateDriverStatus(context.Context, *Upd
 func (UnimplementedDriverServiceServer) GetAvailableDrivers(context.Context, *emptypb.Empty) (*GetAvailableDriversResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GetAvailableDrivers not implemented")
 }
+func (UnimplementedDriverServiceServer) ReportLocation(context.Context, *ReportLocationRequest) (*ReportLocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReportLocation not implemented")
+}
+func (UnimplementedDriverServiceServer) StreamLocation(grpc.ClientStreamingServer[ReportLocationRequest, StreamLocationResponse]) error {
+	return status.Errorf(codes.Unimplemented, "method StreamLocation not implemented")
+}
+func (UnimplementedDriverServiceServer) GetDriverLocation(context.Context, *GetDriverLocationRequest) (*GetDriverLocationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDriverLocation not implemented")
+}
+func (UnimplementedDriverServiceServer) CreateDriver(context.Context, *CreateDriverRequest) (*CreateDriverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateDriver not implemented")
+}
+func (UnimplementedDriverServiceServer) GetDriver(context.Context, *GetDriverRequest) (*GetDriverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetDriver not implemented")
+}
+func (UnimplementedDriverServiceServer) ListDrivers(context.Context, *ListDriversRequest) (*ListDriversResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListDrivers not implemented")
+}
+func (UnimplementedDriverServiceServer) UpdateDriver(context.Context, *UpdateDriverRequest) (*UpdateDriverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateDriver not implemented")
+}
+func (UnimplementedDriverServiceServer) DeactivateDriver(context.Context, *DeactivateDriverRequest) (*DeactivateDriverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeactivateDriver not implemented")
+}
+func (UnimplementedDriverServiceServer) ReleaseDriver(context.Context, *ReleaseDriverRequest) (*ReleaseDriverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReleaseDriver not implemented")
+}
+func (UnimplementedDriverServiceServer) ReserveDriver(context.Context, *ReserveDriverRequest) (*ReserveDriverResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ReserveDriver not implemented")
+}
 func (UnimplementedDriverServiceServer) mustEmbedUnimplementedDriverServiceServer() {}
 func (UnimplementedDriverServiceServer) testEmbeddedByValue()                       {}
 
@@ -177,6 +344,175 @@ func _DriverService_GetAvailableDrivers_Handler(srv interface{}, ctx context.Con
 	return interceptor(ctx, in, info, handler)
 }
 
+func _DriverService_ReportLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReportLocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).ReportLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_ReportLocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).ReportLocation(ctx, req.(*ReportLocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_StreamLocation_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(DriverServiceServer).StreamLocation(&grpc.GenericServerStream[ReportLocationRequest, StreamLocationResponse]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type DriverService_StreamLocationServer = grpc.ClientStreamingServer[ReportLocationRequest, StreamLocationResponse]
+
+func _DriverService_GetDriverLocation_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDriverLocationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).GetDriverLocation(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_GetDriverLocation_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).GetDriverLocation(ctx, req.(*GetDriverLocationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_CreateDriver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CreateDriverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).CreateDriver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_CreateDriver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).CreateDriver(ctx, req.(*CreateDriverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_GetDriver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetDriverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).GetDriver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_GetDriver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).GetDriver(ctx, req.(*GetDriverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_ListDrivers_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListDriversRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).ListDrivers(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_ListDrivers_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).ListDrivers(ctx, req.(*ListDriversRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_UpdateDriver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(UpdateDriverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).UpdateDriver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_UpdateDriver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).UpdateDriver(ctx, req.(*UpdateDriverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_DeactivateDriver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeactivateDriverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).DeactivateDriver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_DeactivateDriver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).DeactivateDriver(ctx, req.(*DeactivateDriverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_ReleaseDriver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReleaseDriverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).ReleaseDriver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_ReleaseDriver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).ReleaseDriver(ctx, req.(*ReleaseDriverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _DriverService_ReserveDriver_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ReserveDriverRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(DriverServiceServer).ReserveDriver(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: DriverService_ReserveDriver_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(DriverServiceServer).ReserveDriver(ctx, req.(*ReserveDriverRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 // DriverService_ServiceDesc is the grpc.ServiceDesc for DriverService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -196,7 +532,49 @@ var DriverService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "GetAvailableDrivers",
 			Handler:    _DriverService_GetAvailableDrivers_Handler,
 		},
+		{
+			MethodName: "ReportLocation",
+			Handler:    _DriverService_ReportLocation_Handler,
+		},
+		{
+			MethodName: "GetDriverLocation",
+			Handler:    _DriverService_GetDriverLocation_Handler,
+		},
+		{
+			MethodName: "CreateDriver",
+			Handler:    _DriverService_CreateDriver_Handler,
+		},
+		{
+			MethodName: "GetDriver",
+			Handler:    _DriverService_GetDriver_Handler,
+		},
+		{
+			MethodName: "ListDrivers",
+			Handler:    _DriverService_ListDrivers_Handler,
+		},
+		{
+			MethodName: "UpdateDriver",
+			Handler:    _DriverService_UpdateDriver_Handler,
+		},
+		{
+			MethodName: "DeactivateDriver",
+			Handler:    _DriverService_DeactivateDriver_Handler,
+		},
+		{
+			MethodName: "ReleaseDriver",
+			Handler:    _DriverService_ReleaseDriver_Handler,
+		},
+		{
+			MethodName: "ReserveDriver",
+			Handler:    _DriverService_ReserveDriver_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "StreamLocation",
+			Handler:       _DriverService_StreamLocation_Handler,
+			ClientStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "driver_service/driver_service.proto",
 }
@@ -24,13 +24,14 @@ const (
 
 // Messages
 type CreateOrderRequest struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	UserId          int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	DeliveryAddress string                 `protobuf:"bytes,2,opt,name=delivery_address,json=deliveryAddress,proto3" json:"delivery_address,omitempty"`
-	Items           []*OrderItem           `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
-	Time            int64                  `protobuf:"varint,4,opt,name=time,proto3" json:"time,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	UserId           int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DeliveryAddress  string                 `protobuf:"bytes,2,opt,name=delivery_address,json=deliveryAddress,proto3" json:"delivery_address,omitempty"`
+	Items            []*OrderItem           `protobuf:"bytes,3,rep,name=items,proto3" json:"items,omitempty"`
+	Time             int64                  `protobuf:"varint,4,opt,name=time,proto3" json:"time,omitempty"`
+	DeliveryLocation *Location              `protobuf:"bytes,5,opt,name=delivery_location,json=deliveryLocation,proto3" json:"delivery_location,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
 }
 
 func (x *CreateOrderRequest) Reset() {
@@ -91,6 +92,13 @@ func (x *CreateOrderRequest) GetTime() int64 {
 	return 0
 }
 
+func (x *CreateOrderRequest) GetDeliveryLocation() *Location {
+	if x != nil {
+		return x.DeliveryLocation
+	}
+	return nil
+}
+
 type CheckOrderStatusRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
@@ -245,6 +253,9 @@ type UpdateOrderStatusRequest struct {
 	OrderId       int64                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
 	DriverId      int64                  `protobuf:"varint,3,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
 	Status        string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	Reason        string                 `protobuf:"bytes,5,opt,name=reason,proto3" json:"reason,omitempty"`
+	ActorType     string                 `protobuf:"bytes,6,opt,name=actor_type,json=actorType,proto3" json:"actor_type,omitempty"`
+	ActorId       int64                  `protobuf:"varint,7,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -307,6 +318,27 @@ func (x *UpdateOrderStatusRequest) GetStatus() string {
 	return ""
 }
 
+func (x *UpdateOrderStatusRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *UpdateOrderStatusRequest) GetActorType() string {
+	if x != nil {
+		return x.ActorType
+	}
+	return ""
+}
+
+func (x *UpdateOrderStatusRequest) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
 type UpdateOrderStatusResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -783,27 +815,29 @@ func (x *CompleteDeliveryResponse) GetMessage() string {
 	return ""
 }
 
-type GetOrdersByUserRequest struct {
+type CancelOrderRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OrderId       int64                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetOrdersByUserRequest) Reset() {
-	*x = GetOrdersByUserRequest{}
+func (x *CancelOrderRequest) Reset() {
+	*x = CancelOrderRequest{}
 	mi := &file_order_service_order_service_proto_msgTypes[14]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrdersByUserRequest) String() string {
+func (x *CancelOrderRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrdersByUserRequest) ProtoMessage() {}
+func (*CancelOrderRequest) ProtoMessage() {}
 
-func (x *GetOrdersByUserRequest) ProtoReflect() protoreflect.Message {
+func (x *CancelOrderRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_order_service_order_service_proto_msgTypes[14]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -815,39 +849,59 @@ func (x *GetOrdersByUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrdersByUserRequest.ProtoReflect.Descriptor instead.
-func (*GetOrdersByUserRequest) Descriptor() ([]byte, []int) {
+// Deprecated: Use CancelOrderRequest.ProtoReflect.Descriptor instead.
+func (*CancelOrderRequest) Descriptor() ([]byte, []int) {
 	return file_order_service_order_service_proto_rawDescGZIP(), []int{14}
 }
 
-func (x *GetOrdersByUserRequest) GetUserId() int64 {
+func (x *CancelOrderRequest) GetUserId() int64 {
 	if x != nil {
 		return x.UserId
 	}
 	return 0
 }
 
-type GetOrdersByUserResponse struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
-	unknownFields protoimpl.UnknownFields
-	sizeCache     protoimpl.SizeCache
+func (x *CancelOrderRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
 }
 
-func (x *GetOrdersByUserResponse) Reset() {
-	*x = GetOrdersByUserResponse{}
+func (x *CancelOrderRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+type CancelOrderResponse struct {
+	state          protoimpl.MessageState `protogen:"open.v1"`
+	Success        bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	PreviousStatus string                 `protobuf:"bytes,2,opt,name=previous_status,json=previousStatus,proto3" json:"previous_status,omitempty"`
+	// stock_returned и released_driver_id - возврат товара и освобождение водителя записаны вместе с отменой
+	// и будут выполнены с повторами
+	StockReturned    bool   `protobuf:"varint,3,opt,name=stock_returned,json=stockReturned,proto3" json:"stock_returned,omitempty"`
+	ReleasedDriverId int64  `protobuf:"varint,4,opt,name=released_driver_id,json=releasedDriverId,proto3" json:"released_driver_id,omitempty"`
+	Message          string `protobuf:"bytes,5,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *CancelOrderResponse) Reset() {
+	*x = CancelOrderResponse{}
 	mi := &file_order_service_order_service_proto_msgTypes[15]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetOrdersByUserResponse) String() string {
+func (x *CancelOrderResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetOrdersByUserResponse) ProtoMessage() {}
+func (*CancelOrderResponse) ProtoMessage() {}
 
-func (x *GetOrdersByUserResponse) ProtoReflect() protoreflect.Message {
+func (x *CancelOrderResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_order_service_order_service_proto_msgTypes[15]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -859,47 +913,68 @@ func (x *GetOrdersByUserResponse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetOrdersByUserResponse.ProtoReflect.Descriptor instead.
-func (*GetOrdersByUserResponse) Descriptor() ([]byte, []int) {
+// Deprecated: Use CancelOrderResponse.ProtoReflect.Descriptor instead.
+func (*CancelOrderResponse) Descriptor() ([]byte, []int) {
 	return file_order_service_order_service_proto_rawDescGZIP(), []int{15}
 }
 
-func (x *GetOrdersByUserResponse) GetOrders() []*Order {
+func (x *CancelOrderResponse) GetSuccess() bool {
 	if x != nil {
-		return x.Orders
+		return x.Success
 	}
-	return nil
+	return false
 }
 
-// Data structures
-type Order struct {
-	state           protoimpl.MessageState `protogen:"open.v1"`
-	Id              int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
-	UserId          int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
-	DeliveryAddress string                 `protobuf:"bytes,3,opt,name=delivery_address,json=deliveryAddress,proto3" json:"delivery_address,omitempty"`
-	Items           []*OrderItem           `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
-	TotalAmount     float64                `protobuf:"fixed64,5,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
-	CreatedAt       *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
-	Status          string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
-	DriverId        int64                  `protobuf:"varint,8,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
-	unknownFields   protoimpl.UnknownFields
-	sizeCache       protoimpl.SizeCache
+func (x *CancelOrderResponse) GetPreviousStatus() string {
+	if x != nil {
+		return x.PreviousStatus
+	}
+	return ""
 }
 
-func (x *Order) Reset() {
-	*x = Order{}
+func (x *CancelOrderResponse) GetStockReturned() bool {
+	if x != nil {
+		return x.StockReturned
+	}
+	return false
+}
+
+func (x *CancelOrderResponse) GetReleasedDriverId() int64 {
+	if x != nil {
+		return x.ReleasedDriverId
+	}
+	return 0
+}
+
+func (x *CancelOrderResponse) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+type GetOrderTimelineRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OrderId       int64                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderTimelineRequest) Reset() {
+	*x = GetOrderTimelineRequest{}
 	mi := &file_order_service_order_service_proto_msgTypes[16]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Order) String() string {
+func (x *GetOrderTimelineRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Order) ProtoMessage() {}
+func (*GetOrderTimelineRequest) ProtoMessage() {}
 
-func (x *Order) ProtoReflect() protoreflect.Message {
+func (x *GetOrderTimelineRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_order_service_order_service_proto_msgTypes[16]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -911,94 +986,150 @@ func (x *Order) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Order.ProtoReflect.Descriptor instead.
-func (*Order) Descriptor() ([]byte, []int) {
+// Deprecated: Use GetOrderTimelineRequest.ProtoReflect.Descriptor instead.
+func (*GetOrderTimelineRequest) Descriptor() ([]byte, []int) {
 	return file_order_service_order_service_proto_rawDescGZIP(), []int{16}
 }
 
-func (x *Order) GetId() int64 {
+func (x *GetOrderTimelineRequest) GetUserId() int64 {
 	if x != nil {
-		return x.Id
+		return x.UserId
 	}
 	return 0
 }
 
-func (x *Order) GetUserId() int64 {
+func (x *GetOrderTimelineRequest) GetOrderId() int64 {
 	if x != nil {
-		return x.UserId
+		return x.OrderId
 	}
 	return 0
 }
 
-func (x *Order) GetDeliveryAddress() string {
+type GetOrderTimelineResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Changes       []*OrderStatusChange   `protobuf:"bytes,1,rep,name=changes,proto3" json:"changes,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrderTimelineResponse) Reset() {
+	*x = GetOrderTimelineResponse{}
+	mi := &file_order_service_order_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrderTimelineResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrderTimelineResponse) ProtoMessage() {}
+
+func (x *GetOrderTimelineResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_order_service_proto_msgTypes[17]
 	if x != nil {
-		return x.DeliveryAddress
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return ""
+	return mi.MessageOf(x)
 }
 
-func (x *Order) GetItems() []*OrderItem {
+// Deprecated: Use GetOrderTimelineResponse.ProtoReflect.Descriptor instead.
+func (*GetOrderTimelineResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_order_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetOrderTimelineResponse) GetChanges() []*OrderStatusChange {
 	if x != nil {
-		return x.Items
+		return x.Changes
 	}
 	return nil
 }
 
-func (x *Order) GetTotalAmount() float64 {
-	if x != nil {
-		return x.TotalAmount
-	}
-	return 0
+type WatchOrderRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OrderId       int64                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Order) GetCreatedAt() *timestamppb.Timestamp {
+func (x *WatchOrderRequest) Reset() {
+	*x = WatchOrderRequest{}
+	mi := &file_order_service_order_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WatchOrderRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WatchOrderRequest) ProtoMessage() {}
+
+func (x *WatchOrderRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_order_service_proto_msgTypes[18]
 	if x != nil {
-		return x.CreatedAt
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
 	}
-	return nil
+	return mi.MessageOf(x)
 }
 
-func (x *Order) GetStatus() string {
+// Deprecated: Use WatchOrderRequest.ProtoReflect.Descriptor instead.
+func (*WatchOrderRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_order_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *WatchOrderRequest) GetUserId() int64 {
 	if x != nil {
-		return x.Status
+		return x.UserId
 	}
-	return ""
+	return 0
 }
 
-func (x *Order) GetDriverId() int64 {
+func (x *WatchOrderRequest) GetOrderId() int64 {
 	if x != nil {
-		return x.DriverId
+		return x.OrderId
 	}
 	return 0
 }
 
-type OrderItem struct {
-	state         protoimpl.MessageState `protogen:"open.v1"`
-	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
-	ProductId     int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	ProductName   string                 `protobuf:"bytes,3,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
-	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	Price         float64                `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
-	TotalPrice    float64                `protobuf:"fixed64,6,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+// OrderEvent - событие отслеживания заказа; первым приходит снимок заказа
+type OrderEvent struct {
+	state   protoimpl.MessageState `protogen:"open.v1"`
+	OrderId int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	// Types that are valid to be assigned to Event:
+	//
+	//	*OrderEvent_Snapshot
+	//	*OrderEvent_StatusChange
+	//	*OrderEvent_DriverPosition
+	Event         isOrderEvent_Event `protobuf_oneof:"event"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *OrderItem) Reset() {
-	*x = OrderItem{}
-	mi := &file_order_service_order_service_proto_msgTypes[17]
+func (x *OrderEvent) Reset() {
+	*x = OrderEvent{}
+	mi := &file_order_service_order_service_proto_msgTypes[19]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *OrderItem) String() string {
+func (x *OrderEvent) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*OrderItem) ProtoMessage() {}
+func (*OrderEvent) ProtoMessage() {}
 
-func (x *OrderItem) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_order_service_proto_msgTypes[17]
+func (x *OrderEvent) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_order_service_proto_msgTypes[19]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1009,75 +1140,98 @@ func (x *OrderItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use OrderItem.ProtoReflect.Descriptor instead.
-func (*OrderItem) Descriptor() ([]byte, []int) {
-	return file_order_service_order_service_proto_rawDescGZIP(), []int{17}
+// Deprecated: Use OrderEvent.ProtoReflect.Descriptor instead.
+func (*OrderEvent) Descriptor() ([]byte, []int) {
+	return file_order_service_order_service_proto_rawDescGZIP(), []int{19}
 }
 
-func (x *OrderItem) GetOrderId() int64 {
+func (x *OrderEvent) GetOrderId() int64 {
 	if x != nil {
 		return x.OrderId
 	}
 	return 0
 }
 
-func (x *OrderItem) GetProductId() int64 {
+func (x *OrderEvent) GetEvent() isOrderEvent_Event {
 	if x != nil {
-		return x.ProductId
+		return x.Event
 	}
-	return 0
+	return nil
 }
 
-func (x *OrderItem) GetProductName() string {
+func (x *OrderEvent) GetSnapshot() *Order {
 	if x != nil {
-		return x.ProductName
+		if x, ok := x.Event.(*OrderEvent_Snapshot); ok {
+			return x.Snapshot
+		}
 	}
-	return ""
+	return nil
 }
 
-func (x *OrderItem) GetQuantity() int32 {
+func (x *OrderEvent) GetStatusChange() *OrderStatusChange {
 	if x != nil {
-		return x.Quantity
+		if x, ok := x.Event.(*OrderEvent_StatusChange); ok {
+			return x.StatusChange
+		}
 	}
-	return 0
+	return nil
 }
 
-func (x *OrderItem) GetPrice() float64 {
+func (x *OrderEvent) GetDriverPosition() *DriverPosition {
 	if x != nil {
-		return x.Price
+		if x, ok := x.Event.(*OrderEvent_DriverPosition); ok {
+			return x.DriverPosition
+		}
 	}
-	return 0
+	return nil
 }
 
-func (x *OrderItem) GetTotalPrice() float64 {
-	if x != nil {
-		return x.TotalPrice
-	}
-	return 0
+type isOrderEvent_Event interface {
+	isOrderEvent_Event()
 }
 
-type GetDeliveriesByUserRequest struct {
+type OrderEvent_Snapshot struct {
+	Snapshot *Order `protobuf:"bytes,2,opt,name=snapshot,proto3,oneof"`
+}
+
+type OrderEvent_StatusChange struct {
+	StatusChange *OrderStatusChange `protobuf:"bytes,3,opt,name=status_change,json=statusChange,proto3,oneof"`
+}
+
+type OrderEvent_DriverPosition struct {
+	DriverPosition *DriverPosition `protobuf:"bytes,4,opt,name=driver_position,json=driverPosition,proto3,oneof"`
+}
+
+func (*OrderEvent_Snapshot) isOrderEvent_Event() {}
+
+func (*OrderEvent_StatusChange) isOrderEvent_Event() {}
+
+func (*OrderEvent_DriverPosition) isOrderEvent_Event() {}
+
+type DriverPosition struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DriverId      int64                  `protobuf:"varint,1,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	Location      *Location              `protobuf:"bytes,2,opt,name=location,proto3" json:"location,omitempty"`
+	RecordedAt    int64                  `protobuf:"varint,3,opt,name=recorded_at,json=recordedAt,proto3" json:"recorded_at,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDeliveriesByUserRequest) Reset() {
-	*x = GetDeliveriesByUserRequest{}
-	mi := &file_order_service_order_service_proto_msgTypes[18]
+func (x *DriverPosition) Reset() {
+	*x = DriverPosition{}
+	mi := &file_order_service_order_service_proto_msgTypes[20]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *GetDeliveriesByUserRequest) String() string {
+func (x *DriverPosition) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*GetDeliveriesByUserRequest) ProtoMessage() {}
+func (*DriverPosition) ProtoMessage() {}
 
-func (x *GetDeliveriesByUserRequest) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_order_service_proto_msgTypes[18]
+func (x *DriverPosition) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_order_service_proto_msgTypes[20]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1088,28 +1242,949 @@ func (x *GetDeliveriesByUserRequest) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use GetDeliveriesByUserRequest.ProtoReflect.Descriptor instead.
-func (*GetDeliveriesByUserRequest) Descriptor() ([]byte, []int) {
-	return file_order_service_order_service_proto_rawDescGZIP(), []int{18}
+// Deprecated: Use DriverPosition.ProtoReflect.Descriptor instead.
+func (*DriverPosition) Descriptor() ([]byte, []int) {
+	return file_order_service_order_service_proto_rawDescGZIP(), []int{20}
 }
 
-func (x *GetDeliveriesByUserRequest) GetUserId() int64 {
+func (x *DriverPosition) GetDriverId() int64 {
 	if x != nil {
-		return x.UserId
+		return x.DriverId
 	}
 	return 0
 }
 
-type GetDeliveriesByUserResponse struct {
+func (x *DriverPosition) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *DriverPosition) GetRecordedAt() int64 {
+	if x != nil {
+		return x.RecordedAt
+	}
+	return 0
+}
+
+type GetOrdersByUserRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	Deliveries    []*Order               `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *GetDeliveriesByUserResponse) Reset() {
-	*x = GetDeliveriesByUserResponse{}
-	mi := &file_order_service_order_service_proto_msgTypes[19]
+func (x *GetOrdersByUserRequest) Reset() {
+	*x = GetOrdersByUserRequest{}
+	mi := &file_order_service_order_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrdersByUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrdersByUserRequest) ProtoMessage() {}
+
+func (x *GetOrdersByUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_order_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrdersByUserRequest.ProtoReflect.Descriptor instead.
+func (*GetOrdersByUserRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_order_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *GetOrdersByUserRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetOrdersByUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Orders        []*Order               `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetOrdersByUserResponse) Reset() {
+	*x = GetOrdersByUserResponse{}
+	mi := &file_order_service_order_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetOrdersByUserResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetOrdersByUserResponse) ProtoMessage() {}
+
+func (x *GetOrdersByUserResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_order_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetOrdersByUserResponse.ProtoReflect.Descriptor instead.
+func (*GetOrdersByUserResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_order_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *GetOrdersByUserResponse) GetOrders() []*Order {
+	if x != nil {
+		return x.Orders
+	}
+	return nil
+}
+
+// Data structures
+type Order struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	Id               int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	UserId           int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	DeliveryAddress  string                 `protobuf:"bytes,3,opt,name=delivery_address,json=deliveryAddress,proto3" json:"delivery_address,omitempty"`
+	Items            []*OrderItem           `protobuf:"bytes,4,rep,name=items,proto3" json:"items,omitempty"`
+	TotalAmount      float64                `protobuf:"fixed64,5,opt,name=total_amount,json=totalAmount,proto3" json:"total_amount,omitempty"`
+	CreatedAt        *timestamppb.Timestamp `protobuf:"bytes,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	Status           string                 `protobuf:"bytes,7,opt,name=status,proto3" json:"status,omitempty"`
+	DriverId         int64                  `protobuf:"varint,8,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	DeliveryLocation *Location              `protobuf:"bytes,9,opt,name=delivery_location,json=deliveryLocation,proto3" json:"delivery_location,omitempty"`
+	Allocations      []*OrderAllocation     `protobuf:"bytes,10,rep,name=allocations,proto3" json:"allocations,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *Order) Reset() {
+	*x = Order{}
+	mi := &file_order_service_order_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Order) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Order) ProtoMessage() {}
+
+func (x *Order) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_order_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Order.ProtoReflect.Descriptor instead.
+func (*Order) Descriptor() ([]byte, []int) {
+	return file_order_service_order_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *Order) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *Order) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *Order) GetDeliveryAddress() string {
+	if x != nil {
+		return x.DeliveryAddress
+	}
+	return ""
+}
+
+func (x *Order) GetItems() []*OrderItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *Order) GetTotalAmount() float64 {
+	if x != nil {
+		return x.TotalAmount
+	}
+	return 0
+}
+
+func (x *Order) GetCreatedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return nil
+}
+
+func (x *Order) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Order) GetDriverId() int64 {
+	if x != nil {
+		return x.DriverId
+	}
+	return 0
+}
+
+func (x *Order) GetDeliveryLocation() *Location {
+	if x != nil {
+		return x.DeliveryLocation
+	}
+	return nil
+}
+
+func (x *Order) GetAllocations() []*OrderAllocation {
+	if x != nil {
+		return x.Allocations
+	}
+	return nil
+}
+
+// OrderAllocation - часть заказа, которую отгружает склад
+type OrderAllocation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId   int64                  `protobuf:"varint,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	ProductId     int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Lots          []*OrderAllocationLot  `protobuf:"bytes,4,rep,name=lots,proto3" json:"lots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderAllocation) Reset() {
+	*x = OrderAllocation{}
+	mi := &file_order_service_order_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderAllocation) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderAllocation) ProtoMessage() {}
+
+func (x *OrderAllocation) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_order_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderAllocation.ProtoReflect.Descriptor instead.
+func (*OrderAllocation) Descriptor() ([]byte, []int) {
+	return file_order_service_order_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *OrderAllocation) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *OrderAllocation) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *OrderAllocation) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *OrderAllocation) GetLots() []*OrderAllocationLot {
+	if x != nil {
+		return x.Lots
+	}
+	return nil
+}
+
+// OrderAllocationLot - партия, из которой собрана часть позиции заказа
+type OrderAllocationLot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LotId         int64                  `protobuf:"varint,1,opt,name=lot_id,json=lotId,proto3" json:"lot_id,omitempty"`
+	LotNumber     string                 `protobuf:"bytes,2,opt,name=lot_number,json=lotNumber,proto3" json:"lot_number,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderAllocationLot) Reset() {
+	*x = OrderAllocationLot{}
+	mi := &file_order_service_order_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderAllocationLot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderAllocationLot) ProtoMessage() {}
+
+func (x *OrderAllocationLot) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_order_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderAllocationLot.ProtoReflect.Descriptor instead.
+func (*OrderAllocationLot) Descriptor() ([]byte, []int) {
+	return file_order_service_order_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *OrderAllocationLot) GetLotId() int64 {
+	if x != nil {
+		return x.LotId
+	}
+	return 0
+}
+
+func (x *OrderAllocationLot) GetLotNumber() string {
+	if x != nil {
+		return x.LotNumber
+	}
+	return ""
+}
+
+func (x *OrderAllocationLot) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *OrderAllocationLot) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+type Location struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Location) Reset() {
+	*x = Location{}
+	mi := &file_order_service_order_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Location) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Location) ProtoMessage() {}
+
+func (x *Location) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_order_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
+	return file_order_service_order_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *Location) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Location) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type OrderStatusChange struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId       int64                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	OldStatus     string                 `protobuf:"bytes,3,opt,name=old_status,json=oldStatus,proto3" json:"old_status,omitempty"`
+	NewStatus     string                 `protobuf:"bytes,4,opt,name=new_status,json=newStatus,proto3" json:"new_status,omitempty"`
+	ActorType     string                 `protobuf:"bytes,5,opt,name=actor_type,json=actorType,proto3" json:"actor_type,omitempty"`
+	ActorId       int64                  `protobuf:"varint,6,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,7,opt,name=reason,proto3" json:"reason,omitempty"`
+	ChangedAt     *timestamppb.Timestamp `protobuf:"bytes,8,opt,name=changed_at,json=changedAt,proto3" json:"changed_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderStatusChange) Reset() {
+	*x = OrderStatusChange{}
+	mi := &file_order_service_order_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderStatusChange) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderStatusChange) ProtoMessage() {}
+
+func (x *OrderStatusChange) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_order_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderStatusChange.ProtoReflect.Descriptor instead.
+func (*OrderStatusChange) Descriptor() ([]byte, []int) {
+	return file_order_service_order_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *OrderStatusChange) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *OrderStatusChange) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *OrderStatusChange) GetOldStatus() string {
+	if x != nil {
+		return x.OldStatus
+	}
+	return ""
+}
+
+func (x *OrderStatusChange) GetNewStatus() string {
+	if x != nil {
+		return x.NewStatus
+	}
+	return ""
+}
+
+func (x *OrderStatusChange) GetActorType() string {
+	if x != nil {
+		return x.ActorType
+	}
+	return ""
+}
+
+func (x *OrderStatusChange) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *OrderStatusChange) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *OrderStatusChange) GetChangedAt() *timestamppb.Timestamp {
+	if x != nil {
+		return x.ChangedAt
+	}
+	return nil
+}
+
+type OrderItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	ProductId     int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductName   string                 `protobuf:"bytes,3,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Price         float64                `protobuf:"fixed64,5,opt,name=price,proto3" json:"price,omitempty"`
+	TotalPrice    float64                `protobuf:"fixed64,6,opt,name=total_price,json=totalPrice,proto3" json:"total_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *OrderItem) Reset() {
+	*x = OrderItem{}
+	mi := &file_order_service_order_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *OrderItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*OrderItem) ProtoMessage() {}
+
+func (x *OrderItem) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_order_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use OrderItem.ProtoReflect.Descriptor instead.
+func (*OrderItem) Descriptor() ([]byte, []int) {
+	return file_order_service_order_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *OrderItem) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *OrderItem) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *OrderItem) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *OrderItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *OrderItem) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *OrderItem) GetTotalPrice() float64 {
+	if x != nil {
+		return x.TotalPrice
+	}
+	return 0
+}
+
+type RequestDriverAssignmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	OrderId       int64                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestDriverAssignmentRequest) Reset() {
+	*x = RequestDriverAssignmentRequest{}
+	mi := &file_order_service_order_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestDriverAssignmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestDriverAssignmentRequest) ProtoMessage() {}
+
+func (x *RequestDriverAssignmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_order_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestDriverAssignmentRequest.ProtoReflect.Descriptor instead.
+func (*RequestDriverAssignmentRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_order_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *RequestDriverAssignmentRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *RequestDriverAssignmentRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+type RequestDriverAssignmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *AssignmentJob         `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RequestDriverAssignmentResponse) Reset() {
+	*x = RequestDriverAssignmentResponse{}
+	mi := &file_order_service_order_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RequestDriverAssignmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RequestDriverAssignmentResponse) ProtoMessage() {}
+
+func (x *RequestDriverAssignmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_order_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RequestDriverAssignmentResponse.ProtoReflect.Descriptor instead.
+func (*RequestDriverAssignmentResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_order_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *RequestDriverAssignmentResponse) GetJob() *AssignmentJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type GetAssignmentJobRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	JobId         int64                  `protobuf:"varint,2,opt,name=job_id,json=jobId,proto3" json:"job_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAssignmentJobRequest) Reset() {
+	*x = GetAssignmentJobRequest{}
+	mi := &file_order_service_order_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAssignmentJobRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssignmentJobRequest) ProtoMessage() {}
+
+func (x *GetAssignmentJobRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_order_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssignmentJobRequest.ProtoReflect.Descriptor instead.
+func (*GetAssignmentJobRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_order_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetAssignmentJobRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *GetAssignmentJobRequest) GetJobId() int64 {
+	if x != nil {
+		return x.JobId
+	}
+	return 0
+}
+
+type GetAssignmentJobResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Job           *AssignmentJob         `protobuf:"bytes,1,opt,name=job,proto3" json:"job,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetAssignmentJobResponse) Reset() {
+	*x = GetAssignmentJobResponse{}
+	mi := &file_order_service_order_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetAssignmentJobResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetAssignmentJobResponse) ProtoMessage() {}
+
+func (x *GetAssignmentJobResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_order_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetAssignmentJobResponse.ProtoReflect.Descriptor instead.
+func (*GetAssignmentJobResponse) Descriptor() ([]byte, []int) {
+	return file_order_service_order_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetAssignmentJobResponse) GetJob() *AssignmentJob {
+	if x != nil {
+		return x.Job
+	}
+	return nil
+}
+
+type AssignmentJob struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	OrderId       int64                  `protobuf:"varint,2,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Status        string                 `protobuf:"bytes,3,opt,name=status,proto3" json:"status,omitempty"`
+	DriverId      int64                  `protobuf:"varint,4,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	Error         string                 `protobuf:"bytes,5,opt,name=error,proto3" json:"error,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignmentJob) Reset() {
+	*x = AssignmentJob{}
+	mi := &file_order_service_order_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignmentJob) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignmentJob) ProtoMessage() {}
+
+func (x *AssignmentJob) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_order_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignmentJob.ProtoReflect.Descriptor instead.
+func (*AssignmentJob) Descriptor() ([]byte, []int) {
+	return file_order_service_order_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *AssignmentJob) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *AssignmentJob) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *AssignmentJob) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *AssignmentJob) GetDriverId() int64 {
+	if x != nil {
+		return x.DriverId
+	}
+	return 0
+}
+
+func (x *AssignmentJob) GetError() string {
+	if x != nil {
+		return x.Error
+	}
+	return ""
+}
+
+func (x *AssignmentJob) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *AssignmentJob) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type GetDeliveriesByUserRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	UserId        int64                  `protobuf:"varint,1,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeliveriesByUserRequest) Reset() {
+	*x = GetDeliveriesByUserRequest{}
+	mi := &file_order_service_order_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetDeliveriesByUserRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetDeliveriesByUserRequest) ProtoMessage() {}
+
+func (x *GetDeliveriesByUserRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_order_service_order_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetDeliveriesByUserRequest.ProtoReflect.Descriptor instead.
+func (*GetDeliveriesByUserRequest) Descriptor() ([]byte, []int) {
+	return file_order_service_order_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *GetDeliveriesByUserRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type GetDeliveriesByUserResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Deliveries    []*Order               `protobuf:"bytes,1,rep,name=deliveries,proto3" json:"deliveries,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetDeliveriesByUserResponse) Reset() {
+	*x = GetDeliveriesByUserResponse{}
+	mi := &file_order_service_order_service_proto_msgTypes[35]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
@@ -1121,7 +2196,7 @@ func (x *GetDeliveriesByUserResponse) String() string {
 func (*GetDeliveriesByUserResponse) ProtoMessage() {}
 
 func (x *GetDeliveriesByUserResponse) ProtoReflect() protoreflect.Message {
-	mi := &file_order_service_order_service_proto_msgTypes[19]
+	mi := &file_order_service_order_service_proto_msgTypes[35]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -1134,7 +2209,7 @@ func (x *GetDeliveriesByUserResponse) ProtoReflect() protoreflect.Message {
 
 // Deprecated: Use GetDeliveriesByUserResponse.ProtoReflect.Descriptor instead.
 func (*GetDeliveriesByUserResponse) Descriptor() ([]byte, []int) {
-	return file_order_service_order_service_proto_rawDescGZIP(), []int{19}
+	return file_order_service_order_service_proto_rawDescGZIP(), []int{35}
 }
 
 func (x *GetDeliveriesByUserResponse) GetDeliveries() []*Order {
@@ -1148,12 +2223,13 @@ var File_order_service_order_service_proto protoreflect.FileDescriptor
 
 const file_order_service_order_service_proto_rawDesc = "" +
 	"\n" +
-	"!order_service/order_service.proto\x12\x05order\x1a\x1fgoogle/protobuf/timestamp.proto\"\x94\x01\n" +
+	"!order_service/order_service.proto\x12\x05order\x1a\x1fgoogle/protobuf/timestamp.proto\"\xd2\x01\n" +
 	"\x12CreateOrderRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12)\n" +
 	"\x10delivery_address\x18\x02 \x01(\tR\x0fdeliveryAddress\x12&\n" +
 	"\x05items\x18\x03 \x03(\v2\x10.order.OrderItemR\x05items\x12\x12\n" +
-	"\x04time\x18\x04 \x01(\x03R\x04time\"M\n" +
+	"\x04time\x18\x04 \x01(\x03R\x04time\x12<\n" +
+	"\x11delivery_location\x18\x05 \x01(\v2\x0f.order.LocationR\x10deliveryLocation\"M\n" +
 	"\x17CheckOrderStatusRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x19\n" +
 	"\border_id\x18\x02 \x01(\x03R\aorderId\"2\n" +
@@ -1161,12 +2237,16 @@ const file_order_service_order_service_proto_rawDesc = "" +
 	"\x06status\x18\x01 \x01(\tR\x06status\"S\n" +
 	"\x13CreateOrderResponse\x12\"\n" +
 	"\x05order\x18\x01 \x01(\v2\f.order.OrderR\x05order\x12\x18\n" +
-	"\amessage\x18\x02 \x01(\tR\amessage\"\x83\x01\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xd5\x01\n" +
 	"\x18UpdateOrderStatusRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x19\n" +
 	"\border_id\x18\x02 \x01(\x03R\aorderId\x12\x1b\n" +
 	"\tdriver_id\x18\x03 \x01(\x03R\bdriverId\x12\x16\n" +
-	"\x06status\x18\x04 \x01(\tR\x06status\"O\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x16\n" +
+	"\x06reason\x18\x05 \x01(\tR\x06reason\x12\x1d\n" +
+	"\n" +
+	"actor_type\x18\x06 \x01(\tR\tactorType\x12\x19\n" +
+	"\bactor_id\x18\a \x01(\x03R\aactorId\"O\n" +
 	"\x19UpdateOrderStatusResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x18\n" +
 	"\amessage\x18\x02 \x01(\tR\amessage\"I\n" +
@@ -1195,11 +2275,41 @@ const file_order_service_order_service_proto_rawDesc = "" +
 	"\x18CompleteDeliveryResponse\x12\x18\n" +
 	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1b\n" +
 	"\tdriver_id\x18\x02 \x01(\x03R\bdriverId\x12\x18\n" +
-	"\amessage\x18\x03 \x01(\tR\amessage\"1\n" +
+	"\amessage\x18\x03 \x01(\tR\amessage\"`\n" +
+	"\x12CancelOrderRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\x03R\aorderId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\"\xc7\x01\n" +
+	"\x13CancelOrderResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12'\n" +
+	"\x0fprevious_status\x18\x02 \x01(\tR\x0epreviousStatus\x12%\n" +
+	"\x0estock_returned\x18\x03 \x01(\bR\rstockReturned\x12,\n" +
+	"\x12released_driver_id\x18\x04 \x01(\x03R\x10releasedDriverId\x12\x18\n" +
+	"\amessage\x18\x05 \x01(\tR\amessage\"M\n" +
+	"\x17GetOrderTimelineRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\x03R\aorderId\"N\n" +
+	"\x18GetOrderTimelineResponse\x122\n" +
+	"\achanges\x18\x01 \x03(\v2\x18.order.OrderStatusChangeR\achanges\"G\n" +
+	"\x11WatchOrderRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\x03R\aorderId\"\xdf\x01\n" +
+	"\n" +
+	"OrderEvent\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12*\n" +
+	"\bsnapshot\x18\x02 \x01(\v2\f.order.OrderH\x00R\bsnapshot\x12?\n" +
+	"\rstatus_change\x18\x03 \x01(\v2\x18.order.OrderStatusChangeH\x00R\fstatusChange\x12@\n" +
+	"\x0fdriver_position\x18\x04 \x01(\v2\x15.order.DriverPositionH\x00R\x0edriverPositionB\a\n" +
+	"\x05event\"{\n" +
+	"\x0eDriverPosition\x12\x1b\n" +
+	"\tdriver_id\x18\x01 \x01(\x03R\bdriverId\x12+\n" +
+	"\blocation\x18\x02 \x01(\v2\x0f.order.LocationR\blocation\x12\x1f\n" +
+	"\vrecorded_at\x18\x03 \x01(\x03R\n" +
+	"recordedAt\"1\n" +
 	"\x16GetOrdersByUserRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\"?\n" +
 	"\x17GetOrdersByUserResponse\x12$\n" +
-	"\x06orders\x18\x01 \x03(\v2\f.order.OrderR\x06orders\"\x96\x02\n" +
+	"\x06orders\x18\x01 \x03(\v2\f.order.OrderR\x06orders\"\x8e\x03\n" +
 	"\x05Order\x12\x0e\n" +
 	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x17\n" +
 	"\auser_id\x18\x02 \x01(\x03R\x06userId\x12)\n" +
@@ -1209,7 +2319,39 @@ const file_order_service_order_service_proto_rawDesc = "" +
 	"\n" +
 	"created_at\x18\x06 \x01(\v2\x1a.google.protobuf.TimestampR\tcreatedAt\x12\x16\n" +
 	"\x06status\x18\a \x01(\tR\x06status\x12\x1b\n" +
-	"\tdriver_id\x18\b \x01(\x03R\bdriverId\"\xbb\x01\n" +
+	"\tdriver_id\x18\b \x01(\x03R\bdriverId\x12<\n" +
+	"\x11delivery_location\x18\t \x01(\v2\x0f.order.LocationR\x10deliveryLocation\x128\n" +
+	"\vallocations\x18\n" +
+	" \x03(\v2\x16.order.OrderAllocationR\vallocations\"\x9e\x01\n" +
+	"\x0fOrderAllocation\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\x03R\vwarehouseId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x03R\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12-\n" +
+	"\x04lots\x18\x04 \x03(\v2\x19.order.OrderAllocationLotR\x04lots\"\x85\x01\n" +
+	"\x12OrderAllocationLot\x12\x15\n" +
+	"\x06lot_id\x18\x01 \x01(\x03R\x05lotId\x12\x1d\n" +
+	"\n" +
+	"lot_number\x18\x02 \x01(\tR\tlotNumber\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\x03R\texpiresAt\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\"D\n" +
+	"\bLocation\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\"\x89\x02\n" +
+	"\x11OrderStatusChange\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\x03R\aorderId\x12\x1d\n" +
+	"\n" +
+	"old_status\x18\x03 \x01(\tR\toldStatus\x12\x1d\n" +
+	"\n" +
+	"new_status\x18\x04 \x01(\tR\tnewStatus\x12\x1d\n" +
+	"\n" +
+	"actor_type\x18\x05 \x01(\tR\tactorType\x12\x19\n" +
+	"\bactor_id\x18\x06 \x01(\x03R\aactorId\x12\x16\n" +
+	"\x06reason\x18\a \x01(\tR\x06reason\x129\n" +
+	"\n" +
+	"changed_at\x18\b \x01(\v2\x1a.google.protobuf.TimestampR\tchangedAt\"\xbb\x01\n" +
 	"\tOrderItem\x12\x19\n" +
 	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x1d\n" +
 	"\n" +
@@ -1218,13 +2360,33 @@ const file_order_service_order_service_proto_rawDesc = "" +
 	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12\x14\n" +
 	"\x05price\x18\x05 \x01(\x01R\x05price\x12\x1f\n" +
 	"\vtotal_price\x18\x06 \x01(\x01R\n" +
-	"totalPrice\"5\n" +
+	"totalPrice\"T\n" +
+	"\x1eRequestDriverAssignmentRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\x03R\aorderId\"I\n" +
+	"\x1fRequestDriverAssignmentResponse\x12&\n" +
+	"\x03job\x18\x01 \x01(\v2\x14.order.AssignmentJobR\x03job\"I\n" +
+	"\x17GetAssignmentJobRequest\x12\x17\n" +
+	"\auser_id\x18\x01 \x01(\x03R\x06userId\x12\x15\n" +
+	"\x06job_id\x18\x02 \x01(\x03R\x05jobId\"B\n" +
+	"\x18GetAssignmentJobResponse\x12&\n" +
+	"\x03job\x18\x01 \x01(\v2\x14.order.AssignmentJobR\x03job\"\xc3\x01\n" +
+	"\rAssignmentJob\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x19\n" +
+	"\border_id\x18\x02 \x01(\x03R\aorderId\x12\x16\n" +
+	"\x06status\x18\x03 \x01(\tR\x06status\x12\x1b\n" +
+	"\tdriver_id\x18\x04 \x01(\x03R\bdriverId\x12\x14\n" +
+	"\x05error\x18\x05 \x01(\tR\x05error\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\x03R\tupdatedAt\"5\n" +
 	"\x1aGetDeliveriesByUserRequest\x12\x17\n" +
 	"\auser_id\x18\x01 \x01(\x03R\x06userId\"K\n" +
 	"\x1bGetDeliveriesByUserResponse\x12,\n" +
 	"\n" +
 	"deliveries\x18\x01 \x03(\v2\f.order.OrderR\n" +
-	"deliveries2\xf0\x05\n" +
+	"deliveries2\x87\t\n" +
 	"\fOrderService\x12D\n" +
 	"\vCreateOrder\x12\x19.order.CreateOrderRequest\x1a\x1a.order.CreateOrderResponse\x12V\n" +
 	"\x11UpdateOrderStatus\x12\x1f.order.UpdateOrderStatusRequest\x1a .order.UpdateOrderStatusResponse\x12G\n" +
@@ -1234,7 +2396,13 @@ const file_order_service_order_service_proto_rawDesc = "" +
 	"\x10CompleteDelivery\x12\x1e.order.CompleteDeliveryRequest\x1a\x1f.order.CompleteDeliveryResponse\x12V\n" +
 	"\rGetDeliveries\x12!.order.GetDeliveriesByUserRequest\x1a\".order.GetDeliveriesByUserResponse\x12S\n" +
 	"\x10GetOrderItemInfo\x12\x1e.order.GetOrderItemInfoRequest\x1a\x1f.order.GetOrderItemInfoResponse\x12S\n" +
-	"\x10CheckOrderStatus\x12\x1e.order.CheckOrderStatusRequest\x1a\x1f.order.CheckOrderStatusResponseB\bZ\x06/orderb\x06proto3"
+	"\x10CheckOrderStatus\x12\x1e.order.CheckOrderStatusRequest\x1a\x1f.order.CheckOrderStatusResponse\x12S\n" +
+	"\x10GetOrderTimeline\x12\x1e.order.GetOrderTimelineRequest\x1a\x1f.order.GetOrderTimelineResponse\x12D\n" +
+	"\vCancelOrder\x12\x19.order.CancelOrderRequest\x1a\x1a.order.CancelOrderResponse\x12h\n" +
+	"\x17RequestDriverAssignment\x12%.order.RequestDriverAssignmentRequest\x1a&.order.RequestDriverAssignmentResponse\x12S\n" +
+	"\x10GetAssignmentJob\x12\x1e.order.GetAssignmentJobRequest\x1a\x1f.order.GetAssignmentJobResponse\x12;\n" +
+	"\n" +
+	"WatchOrder\x12\x18.order.WatchOrderRequest\x1a\x11.order.OrderEvent0\x01B\bZ\x06/orderb\x06proto3"
 
 var (
 	file_order_service_order_service_proto_rawDescOnce sync.Once
@@ -1248,61 +2416,99 @@ func file_order_service_order_service_proto_rawDescGZIP() []byte {
 	return file_order_service_order_service_proto_rawDescData
 }
 
-var file_order_service_order_service_proto_msgTypes = make([]protoimpl.MessageInfo, 20)
+var file_order_service_order_service_proto_msgTypes = make([]protoimpl.MessageInfo, 36)
 var file_order_service_order_service_proto_goTypes = []any{
-	(*CreateOrderRequest)(nil),          // 0: order.CreateOrderRequest
-	(*CheckOrderStatusRequest)(nil),     // 1: order.CheckOrderStatusRequest
-	(*CheckOrderStatusResponse)(nil),    // 2: order.CheckOrderStatusResponse
-	(*CreateOrderResponse)(nil),         // 3: order.CreateOrderResponse
-	(*UpdateOrderStatusRequest)(nil),    // 4: order.UpdateOrderStatusRequest
-	(*UpdateOrderStatusResponse)(nil),   // 5: order.UpdateOrderStatusResponse
-	(*AssignDriverRequest)(nil),         // 6: order.AssignDriverRequest
-	(*AssignDriverResponse)(nil),        // 7: order.AssignDriverResponse
-	(*GetOrderDetailsRequest)(nil),      // 8: order.GetOrderDetailsRequest
-	(*GetOrderItemInfoRequest)(nil),     // 9: order.GetOrderItemInfoRequest
-	(*GetOrderItemInfoResponse)(nil),    // 10: order.GetOrderItemInfoResponse
-	(*GetOrderDetailsResponse)(nil),     // 11: order.GetOrderDetailsResponse
-	(*CompleteDeliveryRequest)(nil),     // 12: order.CompleteDeliveryRequest
-	(*CompleteDeliveryResponse)(nil),    // 13: order.CompleteDeliveryResponse
-	(*GetOrdersByUserRequest)(nil),      // 14: order.GetOrdersByUserRequest
-	(*GetOrdersByUserResponse)(nil),     // 15: order.GetOrdersByUserResponse
-	(*Order)(nil),                       // 16: order.Order
-	(*OrderItem)(nil),                   // 17: order.OrderItem
-	(*GetDeliveriesByUserRequest)(nil),  // 18: order.GetDeliveriesByUserRequest
-	(*GetDeliveriesByUserResponse)(nil), // 19: order.GetDeliveriesByUserResponse
-	(*timestamppb.Timestamp)(nil),       // 20: google.protobuf.Timestamp
+	(*CreateOrderRequest)(nil),              // 0: order.CreateOrderRequest
+	(*CheckOrderStatusRequest)(nil),         // 1: order.CheckOrderStatusRequest
+	(*CheckOrderStatusResponse)(nil),        // 2: order.CheckOrderStatusResponse
+	(*CreateOrderResponse)(nil),             // 3: order.CreateOrderResponse
+	(*UpdateOrderStatusRequest)(nil),        // 4: order.UpdateOrderStatusRequest
+	(*UpdateOrderStatusResponse)(nil),       // 5: order.UpdateOrderStatusResponse
+	(*AssignDriverRequest)(nil),             // 6: order.AssignDriverRequest
+	(*AssignDriverResponse)(nil),            // 7: order.AssignDriverResponse
+	(*GetOrderDetailsRequest)(nil),          // 8: order.GetOrderDetailsRequest
+	(*GetOrderItemInfoRequest)(nil),         // 9: order.GetOrderItemInfoRequest
+	(*GetOrderItemInfoResponse)(nil),        // 10: order.GetOrderItemInfoResponse
+	(*GetOrderDetailsResponse)(nil),         // 11: order.GetOrderDetailsResponse
+	(*CompleteDeliveryRequest)(nil),         // 12: order.CompleteDeliveryRequest
+	(*CompleteDeliveryResponse)(nil),        // 13: order.CompleteDeliveryResponse
+	(*CancelOrderRequest)(nil),              // 14: order.CancelOrderRequest
+	(*CancelOrderResponse)(nil),             // 15: order.CancelOrderResponse
+	(*GetOrderTimelineRequest)(nil),         // 16: order.GetOrderTimelineRequest
+	(*GetOrderTimelineResponse)(nil),        // 17: order.GetOrderTimelineResponse
+	(*WatchOrderRequest)(nil),               // 18: order.WatchOrderRequest
+	(*OrderEvent)(nil),                      // 19: order.OrderEvent
+	(*DriverPosition)(nil),                  // 20: order.DriverPosition
+	(*GetOrdersByUserRequest)(nil),          // 21: order.GetOrdersByUserRequest
+	(*GetOrdersByUserResponse)(nil),         // 22: order.GetOrdersByUserResponse
+	(*Order)(nil),                           // 23: order.Order
+	(*OrderAllocation)(nil),                 // 24: order.OrderAllocation
+	(*OrderAllocationLot)(nil),              // 25: order.OrderAllocationLot
+	(*Location)(nil),                        // 26: order.Location
+	(*OrderStatusChange)(nil),               // 27: order.OrderStatusChange
+	(*OrderItem)(nil),                       // 28: order.OrderItem
+	(*RequestDriverAssignmentRequest)(nil),  // 29: order.RequestDriverAssignmentRequest
+	(*RequestDriverAssignmentResponse)(nil), // 30: order.RequestDriverAssignmentResponse
+	(*GetAssignmentJobRequest)(nil),         // 31: order.GetAssignmentJobRequest
+	(*GetAssignmentJobResponse)(nil),        // 32: order.GetAssignmentJobResponse
+	(*AssignmentJob)(nil),                   // 33: order.AssignmentJob
+	(*GetDeliveriesByUserRequest)(nil),      // 34: order.GetDeliveriesByUserRequest
+	(*GetDeliveriesByUserResponse)(nil),     // 35: order.GetDeliveriesByUserResponse
+	(*timestamppb.Timestamp)(nil),           // 36: google.protobuf.Timestamp
 }
 var file_order_service_order_service_proto_depIdxs = []int32{
-	17, // 0: order.CreateOrderRequest.items:type_name -> order.OrderItem
-	16, // 1: order.CreateOrderResponse.order:type_name -> order.Order
-	16, // 2: order.GetOrderDetailsResponse.order:type_name -> order.Order
-	16, // 3: order.GetOrdersByUserResponse.orders:type_name -> order.Order
-	17, // 4: order.Order.items:type_name -> order.OrderItem
-	20, // 5: order.Order.created_at:type_name -> google.protobuf.Timestamp
-	16, // 6: order.GetDeliveriesByUserResponse.deliveries:type_name -> order.Order
-	0,  // 7: order.OrderService.CreateOrder:input_type -> order.CreateOrderRequest
-	4,  // 8: order.OrderService.UpdateOrderStatus:input_type -> order.UpdateOrderStatusRequest
-	6,  // 9: order.OrderService.AssignDriver:input_type -> order.AssignDriverRequest
-	8,  // 10: order.OrderService.GetOrderDetails:input_type -> order.GetOrderDetailsRequest
-	14, // 11: order.OrderService.GetOrdersByUser:input_type -> order.GetOrdersByUserRequest
-	12, // 12: order.OrderService.CompleteDelivery:input_type -> order.CompleteDeliveryRequest
-	18, // 13: order.OrderService.GetDeliveries:input_type -> order.GetDeliveriesByUserRequest
-	9,  // 14: order.OrderService.GetOrderItemInfo:input_type -> order.GetOrderItemInfoRequest
-	1,  // 15: order.OrderService.CheckOrderStatus:input_type -> order.CheckOrderStatusRequest
-	3,  // 16: order.OrderService.CreateOrder:output_type -> order.CreateOrderResponse
-	5,  // 17: order.OrderService.UpdateOrderStatus:output_type -> order.UpdateOrderStatusResponse
-	7,  // 18: order.OrderService.AssignDriver:output_type -> order.AssignDriverResponse
-	11, // 19: order.OrderService.GetOrderDetails:output_type -> order.GetOrderDetailsResponse
-	15, // 20: order.OrderService.GetOrdersByUser:output_type -> order.GetOrdersByUserResponse
-	13, // 21: order.OrderService.CompleteDelivery:output_type -> order.CompleteDeliveryResponse
-	19, // 22: order.OrderService.GetDeliveries:output_type -> order.GetDeliveriesByUserResponse
-	10, // 23: order.OrderService.GetOrderItemInfo:output_type -> order.GetOrderItemInfoResponse
-	2,  // 24: order.OrderService.CheckOrderStatus:output_type -> order.CheckOrderStatusResponse
-	16, // [16:25] is the sub-list for method output_type
-	7,  // [7:16] is the sub-list for method input_type
-	7,  // [7:7] is the sub-list for extension type_name
-	7,  // [7:7] is the sub-list for extension extendee
-	0,  // [0:7] is the sub-list for field type_name
+	28, // 0: order.CreateOrderRequest.items:type_name -> order.OrderItem
+	26, // 1: order.CreateOrderRequest.delivery_location:type_name -> order.Location
+	23, // 2: order.CreateOrderResponse.order:type_name -> order.Order
+	23, // 3: order.GetOrderDetailsResponse.order:type_name -> order.Order
+	27, // 4: order.GetOrderTimelineResponse.changes:type_name -> order.OrderStatusChange
+	23, // 5: order.OrderEvent.snapshot:type_name -> order.Order
+	27, // 6: order.OrderEvent.status_change:type_name -> order.OrderStatusChange
+	20, // 7: order.OrderEvent.driver_position:type_name -> order.DriverPosition
+	26, // 8: order.DriverPosition.location:type_name -> order.Location
+	23, // 9: order.GetOrdersByUserResponse.orders:type_name -> order.Order
+	28, // 10: order.Order.items:type_name -> order.OrderItem
+	36, // 11: order.Order.created_at:type_name -> google.protobuf.Timestamp
+	26, // 12: order.Order.delivery_location:type_name -> order.Location
+	24, // 13: order.Order.allocations:type_name -> order.OrderAllocation
+	25, // 14: order.OrderAllocation.lots:type_name -> order.OrderAllocationLot
+	36, // 15: order.OrderStatusChange.changed_at:type_name -> google.protobuf.Timestamp
+	33, // 16: order.RequestDriverAssignmentResponse.job:type_name -> order.AssignmentJob
+	33, // 17: order.GetAssignmentJobResponse.job:type_name -> order.AssignmentJob
+	23, // 18: order.GetDeliveriesByUserResponse.deliveries:type_name -> order.Order
+	0,  // 19: order.OrderService.CreateOrder:input_type -> order.CreateOrderRequest
+	4,  // 20: order.OrderService.UpdateOrderStatus:input_type -> order.UpdateOrderStatusRequest
+	6,  // 21: order.OrderService.AssignDriver:input_type -> order.AssignDriverRequest
+	8,  // 22: order.OrderService.GetOrderDetails:input_type -> order.GetOrderDetailsRequest
+	21, // 23: order.OrderService.GetOrdersByUser:input_type -> order.GetOrdersByUserRequest
+	12, // 24: order.OrderService.CompleteDelivery:input_type -> order.CompleteDeliveryRequest
+	34, // 25: order.OrderService.GetDeliveries:input_type -> order.GetDeliveriesByUserRequest
+	9,  // 26: order.OrderService.GetOrderItemInfo:input_type -> order.GetOrderItemInfoRequest
+	1,  // 27: order.OrderService.CheckOrderStatus:input_type -> order.CheckOrderStatusRequest
+	16, // 28: order.OrderService.GetOrderTimeline:input_type -> order.GetOrderTimelineRequest
+	14, // 29: order.OrderService.CancelOrder:input_type -> order.CancelOrderRequest
+	29, // 30: order.OrderService.RequestDriverAssignment:input_type -> order.RequestDriverAssignmentRequest
+	31, // 31: order.OrderService.GetAssignmentJob:input_type -> order.GetAssignmentJobRequest
+	18, // 32: order.OrderService.WatchOrder:input_type -> order.WatchOrderRequest
+	3,  // 33: order.OrderService.CreateOrder:output_type -> order.CreateOrderResponse
+	5,  // 34: order.OrderService.UpdateOrderStatus:output_type -> order.UpdateOrderStatusResponse
+	7,  // 35: order.OrderService.AssignDriver:output_type -> order.AssignDriverResponse
+	11, // 36: order.OrderService.GetOrderDetails:output_type -> order.GetOrderDetailsResponse
+	22, // 37: order.OrderService.GetOrdersByUser:output_type -> order.GetOrdersByUserResponse
+	13, // 38: order.OrderService.CompleteDelivery:output_type -> order.CompleteDeliveryResponse
+	35, // 39: order.OrderService.GetDeliveries:output_type -> order.GetDeliveriesByUserResponse
+	10, // 40: order.OrderService.GetOrderItemInfo:output_type -> order.GetOrderItemInfoResponse
+	2,  // 41: order.OrderService.CheckOrderStatus:output_type -> order.CheckOrderStatusResponse
+	17, // 42: order.OrderService.GetOrderTimeline:output_type -> order.GetOrderTimelineResponse
+	15, // 43: order.OrderService.CancelOrder:output_type -> order.CancelOrderResponse
+	30, // 44: order.OrderService.RequestDriverAssignment:output_type -> order.RequestDriverAssignmentResponse
+	32, // 45: order.OrderService.GetAssignmentJob:output_type -> order.GetAssignmentJobResponse
+	19, // 46: order.OrderService.WatchOrder:output_type -> order.OrderEvent
+	33, // [33:47] is the sub-list for method output_type
+	19, // [19:33] is the sub-list for method input_type
+	19, // [19:19] is the sub-list for extension type_name
+	19, // [19:19] is the sub-list for extension extendee
+	0,  // [0:19] is the sub-list for field type_name
 }
 
 func init() { file_order_service_order_service_proto_init() }
@@ -1310,13 +2516,18 @@ func file_order_service_order_service_proto_init() {
 	if File_order_service_order_service_proto != nil {
 		return
 	}
+	file_order_service_order_service_proto_msgTypes[19].OneofWrappers = []any{
+		(*OrderEvent_Snapshot)(nil),
+		(*OrderEvent_StatusChange)(nil),
+		(*OrderEvent_DriverPosition)(nil),
+	}
 	type x struct{}
 	out := protoimpl.TypeBuilder{
 		File: protoimpl.DescBuilder{
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_order_service_order_service_proto_rawDesc), len(file_order_service_order_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   20,
+			NumMessages:   36,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
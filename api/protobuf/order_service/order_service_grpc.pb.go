@@ -19,15 +19,20 @@ import (
 const _ = grpc.SupportPackageIsVersion9
 
 const (
-	OrderService_CreateOrder_FullMethodName       = "/order.OrderService/CreateOrder"
-	OrderService_UpdateOrderStatus_FullMethodName = "/order.OrderService/UpdateOrderStatus"
-	OrderService_AssignDriver_FullMethodName      = "/order.OrderService/AssignDriver"
-	OrderService_GetOrderDetails_FullMethodName   = "/order.OrderService/GetOrderDetails"
-	OrderService_GetOrdersByUser_FullMethodName   = "/order.OrderService/GetOrdersByUser"
-	OrderService_CompleteDelivery_FullMethodName  = "/order.OrderService/CompleteDelivery"
-	OrderService_GetDeliveries_FullMethodName     = "/order.OrderService/GetDeliveries"
-	OrderService_GetOrderItemInfo_FullMethodName  = "/order.OrderService/GetOrderItemInfo"
-	OrderService_CheckOrderStatus_FullMethodName  = "/order.OrderService/CheckOrderStatus"
+	OrderService_CreateOrder_FullMethodName             = "/order.OrderService/CreateOrder"
+	OrderService_UpdateOrderStatus_FullMethodName       = "/order.OrderService/UpdateOrderStatus"
+	OrderService_AssignDriver_FullMethodName            = "/order.OrderService/AssignDriver"
+	OrderService_GetOrderDetails_FullMethodName         = "/order.OrderService/GetOrderDetails"
+	OrderService_GetOrdersByUser_FullMethodName         = "/order.OrderService/GetOrdersByUser"
+	OrderService_CompleteDelivery_FullMethodName        = "/order.OrderService/CompleteDelivery"
+	OrderService_GetDeliveries_FullMethodName           = "/order.OrderService/GetDeliveries"
+	OrderService_GetOrderItemInfo_FullMethodName        = "/order.OrderService/GetOrderItemInfo"
+	OrderService_CheckOrderStatus_FullMethodName        = "/order.OrderService/CheckOrderStatus"
+	OrderService_GetOrderTimeline_FullMethodName        = "/order.OrderService/GetOrderTimeline"
+	OrderService_CancelOrder_FullMethodName             = "/order.OrderService/CancelOrder"
+	OrderService_RequestDriverAssignment_FullMethodName = "/order.OrderService/RequestDriverAssignment"
+	OrderService_GetAssignmentJob_FullMethodName        = "/order.OrderService/GetAssignmentJob"
+	OrderService_WatchOrder_FullMethodName              = "/order.OrderService/WatchOrder"
 )
 
 // OrderServiceClient is the client API for OrderService service.
@@ -45,6 +50,11 @@ type OrderServiceClient interface {
 	GetDeliveries(ctx context.Context, in *GetDeliveriesByUserRequest, opts ...grpc.CallOption) (*GetDeliveriesByUserResponse, error)
 	GetOrderItemInfo(ctx context.Context, in *GetOrderItemInfoRequest, opts ...grpc.CallOption) (*GetOrderItemInfoResponse, error)
 	CheckOrderStatus(ctx context.Context, in *CheckOrderStatusRequest, opts ...grpc.CallOption) (*CheckOrderStatusResponse, error)
+	GetOrderTimeline(ctx context.Context, in *GetOrderTimelineRequest, opts ...grpc.CallOption) (*GetOrderTimelineResponse, error)
+	CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error)
+	RequestDriverAssignment(ctx context.Context, in *RequestDriverAssignmentRequest, opts ...grpc.CallOption) (*RequestDriverAssignmentResponse, error)
+	GetAssignmentJob(ctx context.Context, in *GetAssignmentJobRequest, opts ...grpc.CallOption) (*GetAssignmentJobResponse, error)
+	WatchOrder(ctx context.Context, in *WatchOrderRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[OrderEvent], error)
 }
 
 type orderServiceClient struct {
@@ -145,6 +155,65 @@ func (c *orderServiceClient) CheckOrderStatus(ctx context.Context, in *CheckOrde
 	return out, nil
 }
 
+func (c *orderServiceClient) GetOrderTimeline(ctx context.Context, in *GetOrderTimelineRequest, opts ...grpc.CallOption) (*GetOrderTimelineResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetOrderTimelineResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetOrderTimeline_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) CancelOrder(ctx context.Context, in *CancelOrderRequest, opts ...grpc.CallOption) (*CancelOrderResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(CancelOrderResponse)
+	err := c.cc.Invoke(ctx, OrderService_CancelOrder_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) RequestDriverAssignment(ctx context.Context, in *RequestDriverAssignmentRequest, opts ...grpc.CallOption) (*RequestDriverAssignmentResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(RequestDriverAssignmentResponse)
+	err := c.cc.Invoke(ctx, OrderService_RequestDriverAssignment_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) GetAssignmentJob(ctx context.Context, in *GetAssignmentJobRequest, opts ...grpc.CallOption) (*GetAssignmentJobResponse, error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	out := new(GetAssignmentJobResponse)
+	err := c.cc.Invoke(ctx, OrderService_GetAssignmentJob_FullMethodName, in, out, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *orderServiceClient) WatchOrder(ctx context.Context, in *WatchOrderRequest, opts ...grpc.CallOption) (grpc.ServerStreamingClient[OrderEvent], error) {
+	cOpts := append([]grpc.CallOption{grpc.StaticMethod()}, opts...)
+	stream, err := c.cc.NewStream(ctx, &OrderService_ServiceDesc.Streams[0], OrderService_WatchOrder_FullMethodName, cOpts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &grpc.GenericClientStream[WatchOrderRequest, OrderEvent]{ClientStream: stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrderService_WatchOrderClient = grpc.ServerStreamingClient[OrderEvent]
+
 // OrderServiceServer is the server API for OrderService service.
 // All implementations must embed UnimplementedOrderServiceServer
 // for forward compatibility.
@@ -160,6 +229,11 @@ type OrderServiceServer interface {
 	GetDeliveries(context.Context, *GetDeliveriesByUserRequest) (*GetDeliveriesByUserResponse, error)
 	GetOrderItemInfo(context.Context, *GetOrderItemInfoRequest) (*GetOrderItemInfoResponse, error)
 	CheckOrderStatus(context.Context, *CheckOrderStatusRequest) (*CheckOrderStatusResponse, error)
+	GetOrderTimeline(context.Context, *GetOrderTimelineRequest) (*GetOrderTimelineResponse, error)
+	CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error)
+	RequestDriverAssignment(context.Context, *RequestDriverAssignmentRequest) (*RequestDriverAssignmentResponse, error)
+	GetAssignmentJob(context.Context, *GetAssignmentJobRequest) (*GetAssignmentJobResponse, error)
+	WatchOrder(*WatchOrderRequest, grpc.ServerStreamingServer[OrderEvent]) error
 	mustEmbedUnimplementedOrderServiceServer()
 }
 
@@ -197,6 +271,21 @@ func (UnimplementedOrderServiceServer) GetOrderItemInfo(context.Context, *GetOrd
 func (UnimplementedOrderServiceServer) CheckOrderStatus(context.Context, *CheckOrderStatusRequest) (*CheckOrderStatusResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method CheckOrderStatus not implemented")
 }
+func (UnimplementedOrderServiceServer) GetOrderTimeline(context.Context, *GetOrderTimelineRequest) (*GetOrderTimelineResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetOrderTimeline not implemented")
+}
+func (UnimplementedOrderServiceServer) CancelOrder(context.Context, *CancelOrderRequest) (*CancelOrderResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CancelOrder not implemented")
+}
+func (UnimplementedOrderServiceServer) RequestDriverAssignment(context.Context, *RequestDriverAssignmentRequest) (*RequestDriverAssignmentResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RequestDriverAssignment not implemented")
+}
+func (UnimplementedOrderServiceServer) GetAssignmentJob(context.Context, *GetAssignmentJobRequest) (*GetAssignmentJobResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetAssignmentJob not implemented")
+}
+func (UnimplementedOrderServiceServer) WatchOrder(*WatchOrderRequest, grpc.ServerStreamingServer[OrderEvent]) error {
+	return status.Errorf(codes.Unimplemented, "method WatchOrder not implemented")
+}
 func (UnimplementedOrderServiceServer) mustEmbedUnimplementedOrderServiceServer() {}
 func (UnimplementedOrderServiceServer) testEmbeddedByValue()                      {}
 
@@ -380,6 +469,89 @@ func _OrderService_CheckOrderStatus_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
+func _OrderService_GetOrderTimeline_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetOrderTimelineRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetOrderTimeline(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetOrderTimeline_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetOrderTimeline(ctx, req.(*GetOrderTimelineRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_CancelOrder_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CancelOrderRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).CancelOrder(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_CancelOrder_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).CancelOrder(ctx, req.(*CancelOrderRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_RequestDriverAssignment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RequestDriverAssignmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).RequestDriverAssignment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_RequestDriverAssignment_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).RequestDriverAssignment(ctx, req.(*RequestDriverAssignmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_GetAssignmentJob_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetAssignmentJobRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(OrderServiceServer).GetAssignmentJob(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: OrderService_GetAssignmentJob_FullMethodName,
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(OrderServiceServer).GetAssignmentJob(ctx, req.(*GetAssignmentJobRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _OrderService_WatchOrder_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchOrderRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(OrderServiceServer).WatchOrder(m, &grpc.GenericServerStream[WatchOrderRequest, OrderEvent]{ServerStream: stream})
+}
+
+// This type alias is provided for backwards compatibility with existing code that references the prior non-generic stream type by name.
+type OrderService_WatchOrderServer = grpc.ServerStreamingServer[OrderEvent]
+
 // OrderService_ServiceDesc is the grpc.ServiceDesc for OrderService service.
 // It's only intended for direct use with grpc.RegisterService,
 // and not to be introspected or modified (even as a copy)
@@ -423,7 +595,29 @@ var OrderService_ServiceDesc = grpc.ServiceDesc{
 			MethodName: "CheckOrderStatus",
 			Handler:    _OrderService_CheckOrderStatus_Handler,
 		},
+		{
+			MethodName: "GetOrderTimeline",
+			Handler:    _OrderService_GetOrderTimeline_Handler,
+		},
+		{
+			MethodName: "CancelOrder",
+			Handler:    _OrderService_CancelOrder_Handler,
+		},
+		{
+			MethodName: "RequestDriverAssignment",
+			Handler:    _OrderService_RequestDriverAssignment_Handler,
+		},
+		{
+			MethodName: "GetAssignmentJob",
+			Handler:    _OrderService_GetAssignmentJob_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "WatchOrder",
+			Handler:       _OrderService_WatchOrder_Handler,
+			ServerStreams: true,
+		},
 	},
-	Streams:  []grpc.StreamDesc{},
 	Metadata: "order_service/order_service.proto",
 }
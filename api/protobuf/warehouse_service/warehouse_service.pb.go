@@ -10,6 +10,7 @@ import (
 	protoreflect "google.golang.org/protobuf/reflect/protoreflect"
 	protoimpl "google.golang.org/protobuf/runtime/protoimpl"
 	emptypb "google.golang.org/protobuf/types/known/emptypb"
+	wrapperspb "google.golang.org/protobuf/types/known/wrapperspb"
 	reflect "reflect"
 	sync "sync"
 	unsafe "unsafe"
@@ -22,9 +23,11 @@ const (
 	_ = protoimpl.EnforceVersion(protoimpl.MaxVersion - 20)
 )
 
+// CheckStockRequest - warehouse_id проверяет один склад, 0 - сумму по всем активным складам
 type CheckStockRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Items         []*StockItem           `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	WarehouseId   int64                  `protobuf:"varint,2,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -66,6 +69,13 @@ func (x *CheckStockRequest) GetItems() []*StockItem {
 	return nil
 }
 
+func (x *CheckStockRequest) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
 type CheckStockResponse struct {
 	state         protoimpl.MessageState    `protogen:"open.v1"`
 	Available     bool                      `protobuf:"varint,1,opt,name=available,proto3" json:"available,omitempty"`
@@ -170,10 +180,13 @@ func (x *GetWarehouseStockResponse) GetStocks() []*Stock {
 	return nil
 }
 
+// UpdateStockRequest - warehouse_id списывает с одного склада, 0 - склад подбирается по наличию
 type UpdateStockRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Items         []*StockItem           `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
 	Time          int64                  `protobuf:"varint,2,opt,name=Time,proto3" json:"Time,omitempty"`
+	OrderId       int64                  `protobuf:"varint,3,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	WarehouseId   int64                  `protobuf:"varint,4,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
@@ -222,6 +235,20 @@ func (x *UpdateStockRequest) GetTime() int64 {
 	return 0
 }
 
+func (x *UpdateStockRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *UpdateStockRequest) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
 type UpdateStockResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
 	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
@@ -266,30 +293,32 @@ func (x *UpdateStockResponse) GetSuccess() bool {
 	return false
 }
 
-type StockItem struct {
+// ReturnStockRequest - товар заказа возвращается на склады, с которых был списан;
+// без order_id нужен warehouse_id
+type ReturnStockRequest struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	ProductName   string                 `protobuf:"bytes,2,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
-	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	Time          int64                  `protobuf:"varint,4,opt,name=time,proto3" json:"time,omitempty"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Items         []*StockItem           `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	Time          int64                  `protobuf:"varint,3,opt,name=time,proto3" json:"time,omitempty"`
+	WarehouseId   int64                  `protobuf:"varint,4,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StockItem) Reset() {
-	*x = StockItem{}
+func (x *ReturnStockRequest) Reset() {
+	*x = ReturnStockRequest{}
 	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[5]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StockItem) String() string {
+func (x *ReturnStockRequest) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StockItem) ProtoMessage() {}
+func (*ReturnStockRequest) ProtoMessage() {}
 
-func (x *StockItem) ProtoReflect() protoreflect.Message {
+func (x *ReturnStockRequest) ProtoReflect() protoreflect.Message {
 	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[5]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -301,61 +330,60 @@ func (x *StockItem) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StockItem.ProtoReflect.Descriptor instead.
-func (*StockItem) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReturnStockRequest.ProtoReflect.Descriptor instead.
+func (*ReturnStockRequest) Descriptor() ([]byte, []int) {
 	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{5}
 }
 
-func (x *StockItem) GetProductId() int64 {
+func (x *ReturnStockRequest) GetOrderId() int64 {
 	if x != nil {
-		return x.ProductId
+		return x.OrderId
 	}
 	return 0
 }
 
-func (x *StockItem) GetProductName() string {
+func (x *ReturnStockRequest) GetItems() []*StockItem {
 	if x != nil {
-		return x.ProductName
+		return x.Items
 	}
-	return ""
+	return nil
 }
 
-func (x *StockItem) GetQuantity() int32 {
+func (x *ReturnStockRequest) GetTime() int64 {
 	if x != nil {
-		return x.Quantity
+		return x.Time
 	}
 	return 0
 }
 
-func (x *StockItem) GetTime() int64 {
+func (x *ReturnStockRequest) GetWarehouseId() int64 {
 	if x != nil {
-		return x.Time
+		return x.WarehouseId
 	}
 	return 0
 }
 
-type StockItemWithWarehouse struct {
+type ReturnStockResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductName   string                 `protobuf:"bytes,1,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
-	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *StockItemWithWarehouse) Reset() {
-	*x = StockItemWithWarehouse{}
+func (x *ReturnStockResponse) Reset() {
+	*x = ReturnStockResponse{}
 	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[6]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *StockItemWithWarehouse) String() string {
+func (x *ReturnStockResponse) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*StockItemWithWarehouse) ProtoMessage() {}
+func (*ReturnStockResponse) ProtoMessage() {}
 
-func (x *StockItemWithWarehouse) ProtoReflect() protoreflect.Message {
+func (x *ReturnStockResponse) ProtoReflect() protoreflect.Message {
 	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[6]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
@@ -367,51 +395,181 @@ func (x *StockItemWithWarehouse) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use StockItemWithWarehouse.ProtoReflect.Descriptor instead.
-func (*StockItemWithWarehouse) Descriptor() ([]byte, []int) {
+// Deprecated: Use ReturnStockResponse.ProtoReflect.Descriptor instead.
+func (*ReturnStockResponse) Descriptor() ([]byte, []int) {
 	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{6}
 }
 
-func (x *StockItemWithWarehouse) GetProductName() string {
+func (x *ReturnStockResponse) GetSuccess() bool {
 	if x != nil {
-		return x.ProductName
+		return x.Success
 	}
-	return ""
+	return false
 }
 
-func (x *StockItemWithWarehouse) GetQuantity() int32 {
+// ReserveStockRequest - delivery_location нужен для выбора ближайшего склада
+type ReserveStockRequest struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	OrderId          int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Items            []*StockItem           `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	TtlSeconds       int64                  `protobuf:"varint,3,opt,name=ttl_seconds,json=ttlSeconds,proto3" json:"ttl_seconds,omitempty"`
+	Time             int64                  `protobuf:"varint,4,opt,name=time,proto3" json:"time,omitempty"`
+	DeliveryLocation *Location              `protobuf:"bytes,5,opt,name=delivery_location,json=deliveryLocation,proto3" json:"delivery_location,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ReserveStockRequest) Reset() {
+	*x = ReserveStockRequest{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[7]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveStockRequest) ProtoMessage() {}
+
+func (x *ReserveStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[7]
 	if x != nil {
-		return x.Quantity
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveStockRequest.ProtoReflect.Descriptor instead.
+func (*ReserveStockRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{7}
+}
+
+func (x *ReserveStockRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
 	}
 	return 0
 }
 
-type Stock struct {
+func (x *ReserveStockRequest) GetItems() []*StockItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+func (x *ReserveStockRequest) GetTtlSeconds() int64 {
+	if x != nil {
+		return x.TtlSeconds
+	}
+	return 0
+}
+
+func (x *ReserveStockRequest) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+func (x *ReserveStockRequest) GetDeliveryLocation() *Location {
+	if x != nil {
+		return x.DeliveryLocation
+	}
+	return nil
+}
+
+// ReserveStockResponse - allocations показывает, с каких складов отгружается заказ
+type ReserveStockResponse struct {
 	state         protoimpl.MessageState `protogen:"open.v1"`
-	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
-	ProductName   string                 `protobuf:"bytes,2,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,2,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Allocations   []*StockAllocation     `protobuf:"bytes,3,rep,name=allocations,proto3" json:"allocations,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReserveStockResponse) Reset() {
+	*x = ReserveStockResponse{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[8]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReserveStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReserveStockResponse) ProtoMessage() {}
+
+func (x *ReserveStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[8]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReserveStockResponse.ProtoReflect.Descriptor instead.
+func (*ReserveStockResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{8}
+}
+
+func (x *ReserveStockResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReserveStockResponse) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *ReserveStockResponse) GetAllocations() []*StockAllocation {
+	if x != nil {
+		return x.Allocations
+	}
+	return nil
+}
+
+type StockAllocation struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId   int64                  `protobuf:"varint,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	ProductId     int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
 	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
-	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
-	Time          int64                  `protobuf:"varint,5,opt,name=time,proto3" json:"time,omitempty"`
+	Lots          []*AllocationLot       `protobuf:"bytes,4,rep,name=lots,proto3" json:"lots,omitempty"`
 	unknownFields protoimpl.UnknownFields
 	sizeCache     protoimpl.SizeCache
 }
 
-func (x *Stock) Reset() {
-	*x = Stock{}
-	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[7]
+func (x *StockAllocation) Reset() {
+	*x = StockAllocation{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[9]
 	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 	ms.StoreMessageInfo(mi)
 }
 
-func (x *Stock) String() string {
+func (x *StockAllocation) String() string {
 	return protoimpl.X.MessageStringOf(x)
 }
 
-func (*Stock) ProtoMessage() {}
+func (*StockAllocation) ProtoMessage() {}
 
-func (x *Stock) ProtoReflect() protoreflect.Message {
-	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[7]
+func (x *StockAllocation) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[9]
 	if x != nil {
 		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
 		if ms.LoadMessageInfo() == nil {
@@ -422,84 +580,5600 @@ func (x *Stock) ProtoReflect() protoreflect.Message {
 	return mi.MessageOf(x)
 }
 
-// Deprecated: Use Stock.ProtoReflect.Descriptor instead.
-func (*Stock) Descriptor() ([]byte, []int) {
-	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{7}
+// Deprecated: Use StockAllocation.ProtoReflect.Descriptor instead.
+func (*StockAllocation) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{9}
 }
 
-func (x *Stock) GetProductId() int64 {
+func (x *StockAllocation) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *StockAllocation) GetProductId() int64 {
 	if x != nil {
 		return x.ProductId
 	}
 	return 0
 }
 
-func (x *Stock) GetProductName() string {
+func (x *StockAllocation) GetQuantity() int32 {
 	if x != nil {
-		return x.ProductName
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *StockAllocation) GetLots() []*AllocationLot {
+	if x != nil {
+		return x.Lots
+	}
+	return nil
+}
+
+// AllocationLot - часть количества, взятая из партии; expires_at 0 - товар не портится
+type AllocationLot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LotId         int64                  `protobuf:"varint,1,opt,name=lot_id,json=lotId,proto3" json:"lot_id,omitempty"`
+	LotNumber     string                 `protobuf:"bytes,2,opt,name=lot_number,json=lotNumber,proto3" json:"lot_number,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,3,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	Quantity      int32                  `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AllocationLot) Reset() {
+	*x = AllocationLot{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[10]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AllocationLot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AllocationLot) ProtoMessage() {}
+
+func (x *AllocationLot) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[10]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AllocationLot.ProtoReflect.Descriptor instead.
+func (*AllocationLot) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{10}
+}
+
+func (x *AllocationLot) GetLotId() int64 {
+	if x != nil {
+		return x.LotId
+	}
+	return 0
+}
+
+func (x *AllocationLot) GetLotNumber() string {
+	if x != nil {
+		return x.LotNumber
 	}
 	return ""
 }
 
-func (x *Stock) GetQuantity() int32 {
+func (x *AllocationLot) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *AllocationLot) GetQuantity() int32 {
 	if x != nil {
 		return x.Quantity
 	}
 	return 0
 }
 
-func (x *Stock) GetPrice() float64 {
+type CommitReservationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Time          int64                  `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CommitReservationRequest) Reset() {
+	*x = CommitReservationRequest{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[11]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitReservationRequest) ProtoMessage() {}
+
+func (x *CommitReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[11]
 	if x != nil {
-		return x.Price
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitReservationRequest.ProtoReflect.Descriptor instead.
+func (*CommitReservationRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{11}
+}
+
+func (x *CommitReservationRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
 	}
 	return 0
 }
 
-func (x *Stock) GetTime() int64 {
+func (x *CommitReservationRequest) GetTime() int64 {
 	if x != nil {
 		return x.Time
 	}
 	return 0
 }
 
-var File_warehouse_service_warehouse_service_proto protoreflect.FileDescriptor
+type CommitReservationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
 
-const file_warehouse_service_warehouse_service_proto_rawDesc = "" +
-	"\n" +
-	")warehouse_service/warehouse_service.proto\x12\twarehouse\x1a\x1bgoogle/protobuf/empty.proto\"?\n" +
-	"\x11CheckStockRequest\x12*\n" +
-	"\x05items\x18\x01 \x03(\v2\x14.warehouse.StockItemR\x05items\"\x85\x01\n" +
-	"\x12CheckStockResponse\x12\x1c\n" +
-	"\tavailable\x18\x01 \x01(\bR\tavailable\x127\n" +
-	"\x05items\x18\x02 \x03(\v2!.warehouse.StockItemWithWarehouseR\x05items\x12\x18\n" +
+func (x *CommitReservationResponse) Reset() {
+	*x = CommitReservationResponse{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[12]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CommitReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CommitReservationResponse) ProtoMessage() {}
+
+func (x *CommitReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[12]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CommitReservationResponse.ProtoReflect.Descriptor instead.
+func (*CommitReservationResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{12}
+}
+
+func (x *CommitReservationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+type ReleaseReservationRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	OrderId       int64                  `protobuf:"varint,1,opt,name=order_id,json=orderId,proto3" json:"order_id,omitempty"`
+	Time          int64                  `protobuf:"varint,2,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseReservationRequest) Reset() {
+	*x = ReleaseReservationRequest{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[13]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseReservationRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseReservationRequest) ProtoMessage() {}
+
+func (x *ReleaseReservationRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[13]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseReservationRequest.ProtoReflect.Descriptor instead.
+func (*ReleaseReservationRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{13}
+}
+
+func (x *ReleaseReservationRequest) GetOrderId() int64 {
+	if x != nil {
+		return x.OrderId
+	}
+	return 0
+}
+
+func (x *ReleaseReservationRequest) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+type ReleaseReservationResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Success       bool                   `protobuf:"varint,1,opt,name=success,proto3" json:"success,omitempty"`
+	Released      bool                   `protobuf:"varint,2,opt,name=released,proto3" json:"released,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReleaseReservationResponse) Reset() {
+	*x = ReleaseReservationResponse{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[14]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReleaseReservationResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReleaseReservationResponse) ProtoMessage() {}
+
+func (x *ReleaseReservationResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[14]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReleaseReservationResponse.ProtoReflect.Descriptor instead.
+func (*ReleaseReservationResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{14}
+}
+
+func (x *ReleaseReservationResponse) GetSuccess() bool {
+	if x != nil {
+		return x.Success
+	}
+	return false
+}
+
+func (x *ReleaseReservationResponse) GetReleased() bool {
+	if x != nil {
+		return x.Released
+	}
+	return false
+}
+
+type CreateProductRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	Sku             string                 `protobuf:"bytes,1,opt,name=sku,proto3" json:"sku,omitempty"`
+	Name            string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Description     string                 `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	UnitPrice       float64                `protobuf:"fixed64,4,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	WeightGrams     int32                  `protobuf:"varint,5,opt,name=weight_grams,json=weightGrams,proto3" json:"weight_grams,omitempty"`
+	Dimensions      *Dimensions            `protobuf:"bytes,6,opt,name=dimensions,proto3" json:"dimensions,omitempty"`
+	ReorderPoint    int32                  `protobuf:"varint,7,opt,name=reorder_point,json=reorderPoint,proto3" json:"reorder_point,omitempty"`
+	ReorderQuantity int32                  `protobuf:"varint,8,opt,name=reorder_quantity,json=reorderQuantity,proto3" json:"reorder_quantity,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *CreateProductRequest) Reset() {
+	*x = CreateProductRequest{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[15]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductRequest) ProtoMessage() {}
+
+func (x *CreateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[15]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductRequest.ProtoReflect.Descriptor instead.
+func (*CreateProductRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{15}
+}
+
+func (x *CreateProductRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *CreateProductRequest) GetUnitPrice() float64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *CreateProductRequest) GetWeightGrams() int32 {
+	if x != nil {
+		return x.WeightGrams
+	}
+	return 0
+}
+
+func (x *CreateProductRequest) GetDimensions() *Dimensions {
+	if x != nil {
+		return x.Dimensions
+	}
+	return nil
+}
+
+func (x *CreateProductRequest) GetReorderPoint() int32 {
+	if x != nil {
+		return x.ReorderPoint
+	}
+	return 0
+}
+
+func (x *CreateProductRequest) GetReorderQuantity() int32 {
+	if x != nil {
+		return x.ReorderQuantity
+	}
+	return 0
+}
+
+type CreateProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateProductResponse) Reset() {
+	*x = CreateProductResponse{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[16]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateProductResponse) ProtoMessage() {}
+
+func (x *CreateProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[16]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateProductResponse.ProtoReflect.Descriptor instead.
+func (*CreateProductResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{16}
+}
+
+func (x *CreateProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type GetProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductRequest) Reset() {
+	*x = GetProductRequest{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[17]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductRequest) ProtoMessage() {}
+
+func (x *GetProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[17]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductRequest.ProtoReflect.Descriptor instead.
+func (*GetProductRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{17}
+}
+
+func (x *GetProductRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+type GetProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductResponse) Reset() {
+	*x = GetProductResponse{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[18]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductResponse) ProtoMessage() {}
+
+func (x *GetProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[18]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductResponse.ProtoReflect.Descriptor instead.
+func (*GetProductResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{18}
+}
+
+func (x *GetProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type ListProductsRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IncludeInactive bool                   `protobuf:"varint,1,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"`
+	Page            int32                  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize        int32                  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListProductsRequest) Reset() {
+	*x = ListProductsRequest{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[19]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsRequest) ProtoMessage() {}
+
+func (x *ListProductsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[19]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsRequest.ProtoReflect.Descriptor instead.
+func (*ListProductsRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{19}
+}
+
+func (x *ListProductsRequest) GetIncludeInactive() bool {
+	if x != nil {
+		return x.IncludeInactive
+	}
+	return false
+}
+
+func (x *ListProductsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListProductsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type ListProductsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListProductsResponse) Reset() {
+	*x = ListProductsResponse{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[20]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListProductsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListProductsResponse) ProtoMessage() {}
+
+func (x *ListProductsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[20]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListProductsResponse.ProtoReflect.Descriptor instead.
+func (*ListProductsResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{20}
+}
+
+func (x *ListProductsResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+func (x *ListProductsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListProductsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListProductsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// UpdateProductRequest заменяет карточку товара; active не передан - признак не меняется
+type UpdateProductRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProductId       int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Sku             string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Name            string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description     string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	UnitPrice       float64                `protobuf:"fixed64,5,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	WeightGrams     int32                  `protobuf:"varint,6,opt,name=weight_grams,json=weightGrams,proto3" json:"weight_grams,omitempty"`
+	Dimensions      *Dimensions            `protobuf:"bytes,7,opt,name=dimensions,proto3" json:"dimensions,omitempty"`
+	Active          *wrapperspb.BoolValue  `protobuf:"bytes,8,opt,name=active,proto3" json:"active,omitempty"`
+	ReorderPoint    int32                  `protobuf:"varint,9,opt,name=reorder_point,json=reorderPoint,proto3" json:"reorder_point,omitempty"`
+	ReorderQuantity int32                  `protobuf:"varint,10,opt,name=reorder_quantity,json=reorderQuantity,proto3" json:"reorder_quantity,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *UpdateProductRequest) Reset() {
+	*x = UpdateProductRequest{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[21]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductRequest) ProtoMessage() {}
+
+func (x *UpdateProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[21]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductRequest.ProtoReflect.Descriptor instead.
+func (*UpdateProductRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{21}
+}
+
+func (x *UpdateProductRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *UpdateProductRequest) GetUnitPrice() float64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetWeightGrams() int32 {
+	if x != nil {
+		return x.WeightGrams
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetDimensions() *Dimensions {
+	if x != nil {
+		return x.Dimensions
+	}
+	return nil
+}
+
+func (x *UpdateProductRequest) GetActive() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Active
+	}
+	return nil
+}
+
+func (x *UpdateProductRequest) GetReorderPoint() int32 {
+	if x != nil {
+		return x.ReorderPoint
+	}
+	return 0
+}
+
+func (x *UpdateProductRequest) GetReorderQuantity() int32 {
+	if x != nil {
+		return x.ReorderQuantity
+	}
+	return 0
+}
+
+type UpdateProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateProductResponse) Reset() {
+	*x = UpdateProductResponse{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[22]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateProductResponse) ProtoMessage() {}
+
+func (x *UpdateProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[22]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateProductResponse.ProtoReflect.Descriptor instead.
+func (*UpdateProductResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{22}
+}
+
+func (x *UpdateProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+// DeleteProductRequest снимает товар с продажи; карточка остается для истории заказов
+type DeleteProductRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductRequest) Reset() {
+	*x = DeleteProductRequest{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[23]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductRequest) ProtoMessage() {}
+
+func (x *DeleteProductRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[23]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductRequest.ProtoReflect.Descriptor instead.
+func (*DeleteProductRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{23}
+}
+
+func (x *DeleteProductRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+type DeleteProductResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Product       *Product               `protobuf:"bytes,1,opt,name=product,proto3" json:"product,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DeleteProductResponse) Reset() {
+	*x = DeleteProductResponse{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[24]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DeleteProductResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DeleteProductResponse) ProtoMessage() {}
+
+func (x *DeleteProductResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[24]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DeleteProductResponse.ProtoReflect.Descriptor instead.
+func (*DeleteProductResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{24}
+}
+
+func (x *DeleteProductResponse) GetProduct() *Product {
+	if x != nil {
+		return x.Product
+	}
+	return nil
+}
+
+type GetProductPricesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Names         []string               `protobuf:"bytes,1,rep,name=names,proto3" json:"names,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductPricesRequest) Reset() {
+	*x = GetProductPricesRequest{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[25]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductPricesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductPricesRequest) ProtoMessage() {}
+
+func (x *GetProductPricesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[25]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductPricesRequest.ProtoReflect.Descriptor instead.
+func (*GetProductPricesRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{25}
+}
+
+func (x *GetProductPricesRequest) GetNames() []string {
+	if x != nil {
+		return x.Names
+	}
+	return nil
+}
+
+// GetProductPricesResponse содержит только активные товары; ненайденные наименования пропускаются
+type GetProductPricesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Products      []*Product             `protobuf:"bytes,1,rep,name=products,proto3" json:"products,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetProductPricesResponse) Reset() {
+	*x = GetProductPricesResponse{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[26]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetProductPricesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetProductPricesResponse) ProtoMessage() {}
+
+func (x *GetProductPricesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[26]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetProductPricesResponse.ProtoReflect.Descriptor instead.
+func (*GetProductPricesResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{26}
+}
+
+func (x *GetProductPricesResponse) GetProducts() []*Product {
+	if x != nil {
+		return x.Products
+	}
+	return nil
+}
+
+type Product struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	ProductId       int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Sku             string                 `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	Name            string                 `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	Description     string                 `protobuf:"bytes,4,opt,name=description,proto3" json:"description,omitempty"`
+	UnitPrice       float64                `protobuf:"fixed64,5,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	WeightGrams     int32                  `protobuf:"varint,6,opt,name=weight_grams,json=weightGrams,proto3" json:"weight_grams,omitempty"`
+	Dimensions      *Dimensions            `protobuf:"bytes,7,opt,name=dimensions,proto3" json:"dimensions,omitempty"`
+	Active          bool                   `protobuf:"varint,8,opt,name=active,proto3" json:"active,omitempty"`
+	CreatedAt       int64                  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt       int64                  `protobuf:"varint,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	ReorderPoint    int32                  `protobuf:"varint,11,opt,name=reorder_point,json=reorderPoint,proto3" json:"reorder_point,omitempty"`
+	ReorderQuantity int32                  `protobuf:"varint,12,opt,name=reorder_quantity,json=reorderQuantity,proto3" json:"reorder_quantity,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *Product) Reset() {
+	*x = Product{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[27]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Product) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Product) ProtoMessage() {}
+
+func (x *Product) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[27]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Product.ProtoReflect.Descriptor instead.
+func (*Product) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{27}
+}
+
+func (x *Product) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *Product) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *Product) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Product) GetDescription() string {
+	if x != nil {
+		return x.Description
+	}
+	return ""
+}
+
+func (x *Product) GetUnitPrice() float64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+func (x *Product) GetWeightGrams() int32 {
+	if x != nil {
+		return x.WeightGrams
+	}
+	return 0
+}
+
+func (x *Product) GetDimensions() *Dimensions {
+	if x != nil {
+		return x.Dimensions
+	}
+	return nil
+}
+
+func (x *Product) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Product) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Product) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Product) GetReorderPoint() int32 {
+	if x != nil {
+		return x.ReorderPoint
+	}
+	return 0
+}
+
+func (x *Product) GetReorderQuantity() int32 {
+	if x != nil {
+		return x.ReorderQuantity
+	}
+	return 0
+}
+
+type Dimensions struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LengthMm      int32                  `protobuf:"varint,1,opt,name=length_mm,json=lengthMm,proto3" json:"length_mm,omitempty"`
+	WidthMm       int32                  `protobuf:"varint,2,opt,name=width_mm,json=widthMm,proto3" json:"width_mm,omitempty"`
+	HeightMm      int32                  `protobuf:"varint,3,opt,name=height_mm,json=heightMm,proto3" json:"height_mm,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Dimensions) Reset() {
+	*x = Dimensions{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[28]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Dimensions) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Dimensions) ProtoMessage() {}
+
+func (x *Dimensions) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[28]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Dimensions.ProtoReflect.Descriptor instead.
+func (*Dimensions) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{28}
+}
+
+func (x *Dimensions) GetLengthMm() int32 {
+	if x != nil {
+		return x.LengthMm
+	}
+	return 0
+}
+
+func (x *Dimensions) GetWidthMm() int32 {
+	if x != nil {
+		return x.WidthMm
+	}
+	return 0
+}
+
+func (x *Dimensions) GetHeightMm() int32 {
+	if x != nil {
+		return x.HeightMm
+	}
+	return 0
+}
+
+type CreateReceivingDocumentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Supplier      string                 `protobuf:"bytes,1,opt,name=supplier,proto3" json:"supplier,omitempty"`
+	Reference     string                 `protobuf:"bytes,2,opt,name=reference,proto3" json:"reference,omitempty"`
+	Note          string                 `protobuf:"bytes,3,opt,name=note,proto3" json:"note,omitempty"`
+	Lines         []*ReceivingLine       `protobuf:"bytes,4,rep,name=lines,proto3" json:"lines,omitempty"`
+	UserId        int64                  `protobuf:"varint,5,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	WarehouseId   int64                  `protobuf:"varint,6,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReceivingDocumentRequest) Reset() {
+	*x = CreateReceivingDocumentRequest{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[29]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReceivingDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReceivingDocumentRequest) ProtoMessage() {}
+
+func (x *CreateReceivingDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[29]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReceivingDocumentRequest.ProtoReflect.Descriptor instead.
+func (*CreateReceivingDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{29}
+}
+
+func (x *CreateReceivingDocumentRequest) GetSupplier() string {
+	if x != nil {
+		return x.Supplier
+	}
+	return ""
+}
+
+func (x *CreateReceivingDocumentRequest) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *CreateReceivingDocumentRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *CreateReceivingDocumentRequest) GetLines() []*ReceivingLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *CreateReceivingDocumentRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+func (x *CreateReceivingDocumentRequest) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+type CreateReceivingDocumentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Document      *ReceivingDocument     `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateReceivingDocumentResponse) Reset() {
+	*x = CreateReceivingDocumentResponse{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[30]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateReceivingDocumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateReceivingDocumentResponse) ProtoMessage() {}
+
+func (x *CreateReceivingDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[30]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateReceivingDocumentResponse.ProtoReflect.Descriptor instead.
+func (*CreateReceivingDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{30}
+}
+
+func (x *CreateReceivingDocumentResponse) GetDocument() *ReceivingDocument {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+type GetReceivingDocumentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DocumentId    int64                  `protobuf:"varint,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReceivingDocumentRequest) Reset() {
+	*x = GetReceivingDocumentRequest{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[31]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReceivingDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReceivingDocumentRequest) ProtoMessage() {}
+
+func (x *GetReceivingDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[31]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReceivingDocumentRequest.ProtoReflect.Descriptor instead.
+func (*GetReceivingDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{31}
+}
+
+func (x *GetReceivingDocumentRequest) GetDocumentId() int64 {
+	if x != nil {
+		return x.DocumentId
+	}
+	return 0
+}
+
+type GetReceivingDocumentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Document      *ReceivingDocument     `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetReceivingDocumentResponse) Reset() {
+	*x = GetReceivingDocumentResponse{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[32]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetReceivingDocumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetReceivingDocumentResponse) ProtoMessage() {}
+
+func (x *GetReceivingDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[32]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetReceivingDocumentResponse.ProtoReflect.Descriptor instead.
+func (*GetReceivingDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{32}
+}
+
+func (x *GetReceivingDocumentResponse) GetDocument() *ReceivingDocument {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+type ListReceivingDocumentsRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// status - фильтр по статусу, пустой - все документы
+	Status        string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	Page          int32  `protobuf:"varint,2,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32  `protobuf:"varint,3,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReceivingDocumentsRequest) Reset() {
+	*x = ListReceivingDocumentsRequest{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[33]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReceivingDocumentsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReceivingDocumentsRequest) ProtoMessage() {}
+
+func (x *ListReceivingDocumentsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[33]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReceivingDocumentsRequest.ProtoReflect.Descriptor instead.
+func (*ListReceivingDocumentsRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{33}
+}
+
+func (x *ListReceivingDocumentsRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListReceivingDocumentsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListReceivingDocumentsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ListReceivingDocumentsResponse - документы без строк
+type ListReceivingDocumentsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Documents     []*ReceivingDocument   `protobuf:"bytes,1,rep,name=documents,proto3" json:"documents,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReceivingDocumentsResponse) Reset() {
+	*x = ListReceivingDocumentsResponse{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[34]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReceivingDocumentsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReceivingDocumentsResponse) ProtoMessage() {}
+
+func (x *ListReceivingDocumentsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[34]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReceivingDocumentsResponse.ProtoReflect.Descriptor instead.
+func (*ListReceivingDocumentsResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{34}
+}
+
+func (x *ListReceivingDocumentsResponse) GetDocuments() []*ReceivingDocument {
+	if x != nil {
+		return x.Documents
+	}
+	return nil
+}
+
+func (x *ListReceivingDocumentsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListReceivingDocumentsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListReceivingDocumentsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// RecordReceivedQuantitiesRequest задает фактически принятое количество по строкам черновика
+type RecordReceivedQuantitiesRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DocumentId    int64                  `protobuf:"varint,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	Lines         []*ReceivingLine       `protobuf:"bytes,2,rep,name=lines,proto3" json:"lines,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordReceivedQuantitiesRequest) Reset() {
+	*x = RecordReceivedQuantitiesRequest{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[35]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordReceivedQuantitiesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordReceivedQuantitiesRequest) ProtoMessage() {}
+
+func (x *RecordReceivedQuantitiesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[35]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordReceivedQuantitiesRequest.ProtoReflect.Descriptor instead.
+func (*RecordReceivedQuantitiesRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{35}
+}
+
+func (x *RecordReceivedQuantitiesRequest) GetDocumentId() int64 {
+	if x != nil {
+		return x.DocumentId
+	}
+	return 0
+}
+
+func (x *RecordReceivedQuantitiesRequest) GetLines() []*ReceivingLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+type RecordReceivedQuantitiesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Document      *ReceivingDocument     `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *RecordReceivedQuantitiesResponse) Reset() {
+	*x = RecordReceivedQuantitiesResponse{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[36]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *RecordReceivedQuantitiesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*RecordReceivedQuantitiesResponse) ProtoMessage() {}
+
+func (x *RecordReceivedQuantitiesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[36]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use RecordReceivedQuantitiesResponse.ProtoReflect.Descriptor instead.
+func (*RecordReceivedQuantitiesResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{36}
+}
+
+func (x *RecordReceivedQuantitiesResponse) GetDocument() *ReceivingDocument {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+type PostReceivingDocumentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DocumentId    int64                  `protobuf:"varint,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostReceivingDocumentRequest) Reset() {
+	*x = PostReceivingDocumentRequest{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[37]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostReceivingDocumentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostReceivingDocumentRequest) ProtoMessage() {}
+
+func (x *PostReceivingDocumentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[37]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostReceivingDocumentRequest.ProtoReflect.Descriptor instead.
+func (*PostReceivingDocumentRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{37}
+}
+
+func (x *PostReceivingDocumentRequest) GetDocumentId() int64 {
+	if x != nil {
+		return x.DocumentId
+	}
+	return 0
+}
+
+func (x *PostReceivingDocumentRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type PostReceivingDocumentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Document      *ReceivingDocument     `protobuf:"bytes,1,opt,name=document,proto3" json:"document,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *PostReceivingDocumentResponse) Reset() {
+	*x = PostReceivingDocumentResponse{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[38]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *PostReceivingDocumentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*PostReceivingDocumentResponse) ProtoMessage() {}
+
+func (x *PostReceivingDocumentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[38]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use PostReceivingDocumentResponse.ProtoReflect.Descriptor instead.
+func (*PostReceivingDocumentResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{38}
+}
+
+func (x *PostReceivingDocumentResponse) GetDocument() *ReceivingDocument {
+	if x != nil {
+		return x.Document
+	}
+	return nil
+}
+
+type ReceivingDocument struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	DocumentId    int64                  `protobuf:"varint,1,opt,name=document_id,json=documentId,proto3" json:"document_id,omitempty"`
+	Supplier      string                 `protobuf:"bytes,2,opt,name=supplier,proto3" json:"supplier,omitempty"`
+	Reference     string                 `protobuf:"bytes,3,opt,name=reference,proto3" json:"reference,omitempty"`
+	Note          string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	Status        string                 `protobuf:"bytes,5,opt,name=status,proto3" json:"status,omitempty"`
+	Lines         []*ReceivingLine       `protobuf:"bytes,6,rep,name=lines,proto3" json:"lines,omitempty"`
+	CreatedBy     int64                  `protobuf:"varint,7,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	PostedBy      int64                  `protobuf:"varint,8,opt,name=posted_by,json=postedBy,proto3" json:"posted_by,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,9,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,10,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	PostedAt      int64                  `protobuf:"varint,11,opt,name=posted_at,json=postedAt,proto3" json:"posted_at,omitempty"`
+	WarehouseId   int64                  `protobuf:"varint,12,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceivingDocument) Reset() {
+	*x = ReceivingDocument{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[39]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceivingDocument) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceivingDocument) ProtoMessage() {}
+
+func (x *ReceivingDocument) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[39]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceivingDocument.ProtoReflect.Descriptor instead.
+func (*ReceivingDocument) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{39}
+}
+
+func (x *ReceivingDocument) GetDocumentId() int64 {
+	if x != nil {
+		return x.DocumentId
+	}
+	return 0
+}
+
+func (x *ReceivingDocument) GetSupplier() string {
+	if x != nil {
+		return x.Supplier
+	}
+	return ""
+}
+
+func (x *ReceivingDocument) GetReference() string {
+	if x != nil {
+		return x.Reference
+	}
+	return ""
+}
+
+func (x *ReceivingDocument) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *ReceivingDocument) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ReceivingDocument) GetLines() []*ReceivingLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *ReceivingDocument) GetCreatedBy() int64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+func (x *ReceivingDocument) GetPostedBy() int64 {
+	if x != nil {
+		return x.PostedBy
+	}
+	return 0
+}
+
+func (x *ReceivingDocument) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *ReceivingDocument) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *ReceivingDocument) GetPostedAt() int64 {
+	if x != nil {
+		return x.PostedAt
+	}
+	return 0
+}
+
+func (x *ReceivingDocument) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+// ReceivingLine - lot_number и expires_at задают партию поступления; пустой lot_number - партия по номеру документа
+type ReceivingLine struct {
+	state            protoimpl.MessageState `protogen:"open.v1"`
+	ProductId        int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductName      string                 `protobuf:"bytes,2,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	ExpectedQuantity int32                  `protobuf:"varint,3,opt,name=expected_quantity,json=expectedQuantity,proto3" json:"expected_quantity,omitempty"`
+	ReceivedQuantity int32                  `protobuf:"varint,4,opt,name=received_quantity,json=receivedQuantity,proto3" json:"received_quantity,omitempty"`
+	LotNumber        string                 `protobuf:"bytes,5,opt,name=lot_number,json=lotNumber,proto3" json:"lot_number,omitempty"`
+	ExpiresAt        int64                  `protobuf:"varint,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	unknownFields    protoimpl.UnknownFields
+	sizeCache        protoimpl.SizeCache
+}
+
+func (x *ReceivingLine) Reset() {
+	*x = ReceivingLine{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[40]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceivingLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceivingLine) ProtoMessage() {}
+
+func (x *ReceivingLine) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[40]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceivingLine.ProtoReflect.Descriptor instead.
+func (*ReceivingLine) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{40}
+}
+
+func (x *ReceivingLine) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ReceivingLine) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *ReceivingLine) GetExpectedQuantity() int32 {
+	if x != nil {
+		return x.ExpectedQuantity
+	}
+	return 0
+}
+
+func (x *ReceivingLine) GetReceivedQuantity() int32 {
+	if x != nil {
+		return x.ReceivedQuantity
+	}
+	return 0
+}
+
+func (x *ReceivingLine) GetLotNumber() string {
+	if x != nil {
+		return x.LotNumber
+	}
+	return ""
+}
+
+func (x *ReceivingLine) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+// ListStockMovementsRequest - журнал движения одного товара; since и until - unix-время, 0 не ограничивает
+type ListStockMovementsRequest struct {
+	state     protoimpl.MessageState `protogen:"open.v1"`
+	ProductId int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Reason    string                 `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Since     int64                  `protobuf:"varint,3,opt,name=since,proto3" json:"since,omitempty"`
+	Until     int64                  `protobuf:"varint,4,opt,name=until,proto3" json:"until,omitempty"`
+	Page      int32                  `protobuf:"varint,5,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize  int32                  `protobuf:"varint,6,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	// warehouse_id - 0 показывает движения по всем складам
+	WarehouseId   int64 `protobuf:"varint,7,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStockMovementsRequest) Reset() {
+	*x = ListStockMovementsRequest{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[41]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStockMovementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStockMovementsRequest) ProtoMessage() {}
+
+func (x *ListStockMovementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[41]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStockMovementsRequest.ProtoReflect.Descriptor instead.
+func (*ListStockMovementsRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{41}
+}
+
+func (x *ListStockMovementsRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ListStockMovementsRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ListStockMovementsRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *ListStockMovementsRequest) GetUntil() int64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
+func (x *ListStockMovementsRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListStockMovementsRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+func (x *ListStockMovementsRequest) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+type ListStockMovementsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Movements     []*StockMovement       `protobuf:"bytes,1,rep,name=movements,proto3" json:"movements,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStockMovementsResponse) Reset() {
+	*x = ListStockMovementsResponse{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[42]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStockMovementsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStockMovementsResponse) ProtoMessage() {}
+
+func (x *ListStockMovementsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[42]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStockMovementsResponse.ProtoReflect.Descriptor instead.
+func (*ListStockMovementsResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{42}
+}
+
+func (x *ListStockMovementsResponse) GetMovements() []*StockMovement {
+	if x != nil {
+		return x.Movements
+	}
+	return nil
+}
+
+func (x *ListStockMovementsResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListStockMovementsResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListStockMovementsResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+type StockMovement struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Id            int64                  `protobuf:"varint,1,opt,name=id,proto3" json:"id,omitempty"`
+	ProductId     int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Delta         int32                  `protobuf:"varint,3,opt,name=delta,proto3" json:"delta,omitempty"`
+	Reason        string                 `protobuf:"bytes,4,opt,name=reason,proto3" json:"reason,omitempty"`
+	ReferenceId   int64                  `protobuf:"varint,5,opt,name=reference_id,json=referenceId,proto3" json:"reference_id,omitempty"`
+	ActorId       int64                  `protobuf:"varint,6,opt,name=actor_id,json=actorId,proto3" json:"actor_id,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,7,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	WarehouseId   int64                  `protobuf:"varint,8,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	LotId         int64                  `protobuf:"varint,9,opt,name=lot_id,json=lotId,proto3" json:"lot_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockMovement) Reset() {
+	*x = StockMovement{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[43]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockMovement) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockMovement) ProtoMessage() {}
+
+func (x *StockMovement) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[43]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockMovement.ProtoReflect.Descriptor instead.
+func (*StockMovement) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{43}
+}
+
+func (x *StockMovement) GetId() int64 {
+	if x != nil {
+		return x.Id
+	}
+	return 0
+}
+
+func (x *StockMovement) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *StockMovement) GetDelta() int32 {
+	if x != nil {
+		return x.Delta
+	}
+	return 0
+}
+
+func (x *StockMovement) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *StockMovement) GetReferenceId() int64 {
+	if x != nil {
+		return x.ReferenceId
+	}
+	return 0
+}
+
+func (x *StockMovement) GetActorId() int64 {
+	if x != nil {
+		return x.ActorId
+	}
+	return 0
+}
+
+func (x *StockMovement) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *StockMovement) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *StockMovement) GetLotId() int64 {
+	if x != nil {
+		return x.LotId
+	}
+	return 0
+}
+
+// ReconcileStockRequest - пустой product_ids проверяет все товары
+type ReconcileStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductIds    []int64                `protobuf:"varint,1,rep,packed,name=product_ids,json=productIds,proto3" json:"product_ids,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconcileStockRequest) Reset() {
+	*x = ReconcileStockRequest{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[44]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileStockRequest) ProtoMessage() {}
+
+func (x *ReconcileStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[44]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileStockRequest.ProtoReflect.Descriptor instead.
+func (*ReconcileStockRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{44}
+}
+
+func (x *ReconcileStockRequest) GetProductIds() []int64 {
+	if x != nil {
+		return x.ProductIds
+	}
+	return nil
+}
+
+type ReconcileStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Checked       int64                  `protobuf:"varint,1,opt,name=checked,proto3" json:"checked,omitempty"`
+	Discrepancies []*StockDiscrepancy    `protobuf:"bytes,2,rep,name=discrepancies,proto3" json:"discrepancies,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReconcileStockResponse) Reset() {
+	*x = ReconcileStockResponse{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[45]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReconcileStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReconcileStockResponse) ProtoMessage() {}
+
+func (x *ReconcileStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[45]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReconcileStockResponse.ProtoReflect.Descriptor instead.
+func (*ReconcileStockResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{45}
+}
+
+func (x *ReconcileStockResponse) GetChecked() int64 {
+	if x != nil {
+		return x.Checked
+	}
+	return 0
+}
+
+func (x *ReconcileStockResponse) GetDiscrepancies() []*StockDiscrepancy {
+	if x != nil {
+		return x.Discrepancies
+	}
+	return nil
+}
+
+type StockDiscrepancy struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductName   string                 `protobuf:"bytes,2,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	OnHand        int64                  `protobuf:"varint,3,opt,name=on_hand,json=onHand,proto3" json:"on_hand,omitempty"`
+	LedgerTotal   int64                  `protobuf:"varint,4,opt,name=ledger_total,json=ledgerTotal,proto3" json:"ledger_total,omitempty"`
+	WarehouseId   int64                  `protobuf:"varint,5,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockDiscrepancy) Reset() {
+	*x = StockDiscrepancy{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[46]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockDiscrepancy) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockDiscrepancy) ProtoMessage() {}
+
+func (x *StockDiscrepancy) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[46]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockDiscrepancy.ProtoReflect.Descriptor instead.
+func (*StockDiscrepancy) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{46}
+}
+
+func (x *StockDiscrepancy) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *StockDiscrepancy) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *StockDiscrepancy) GetOnHand() int64 {
+	if x != nil {
+		return x.OnHand
+	}
+	return 0
+}
+
+func (x *StockDiscrepancy) GetLedgerTotal() int64 {
+	if x != nil {
+		return x.LedgerTotal
+	}
+	return 0
+}
+
+func (x *StockDiscrepancy) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+type StockItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductName   string                 `protobuf:"bytes,2,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Time          int64                  `protobuf:"varint,4,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockItem) Reset() {
+	*x = StockItem{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[47]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockItem) ProtoMessage() {}
+
+func (x *StockItem) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[47]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockItem.ProtoReflect.Descriptor instead.
+func (*StockItem) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{47}
+}
+
+func (x *StockItem) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *StockItem) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *StockItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *StockItem) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+// StockItemWithWarehouse - доступный остаток товара на одном складе
+type StockItemWithWarehouse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductName   string                 `protobuf:"bytes,1,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	Quantity      int32                  `protobuf:"varint,2,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	WarehouseId   int64                  `protobuf:"varint,3,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	ProductId     int64                  `protobuf:"varint,4,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockItemWithWarehouse) Reset() {
+	*x = StockItemWithWarehouse{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[48]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockItemWithWarehouse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockItemWithWarehouse) ProtoMessage() {}
+
+func (x *StockItemWithWarehouse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[48]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockItemWithWarehouse.ProtoReflect.Descriptor instead.
+func (*StockItemWithWarehouse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{48}
+}
+
+func (x *StockItemWithWarehouse) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *StockItemWithWarehouse) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *StockItemWithWarehouse) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *StockItemWithWarehouse) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+type Stock struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductName   string                 `protobuf:"bytes,2,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Price         float64                `protobuf:"fixed64,4,opt,name=price,proto3" json:"price,omitempty"`
+	Time          int64                  `protobuf:"varint,5,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Stock) Reset() {
+	*x = Stock{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[49]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Stock) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Stock) ProtoMessage() {}
+
+func (x *Stock) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[49]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Stock.ProtoReflect.Descriptor instead.
+func (*Stock) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{49}
+}
+
+func (x *Stock) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *Stock) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *Stock) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *Stock) GetPrice() float64 {
+	if x != nil {
+		return x.Price
+	}
+	return 0
+}
+
+func (x *Stock) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+type Location struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Latitude      float64                `protobuf:"fixed64,1,opt,name=latitude,proto3" json:"latitude,omitempty"`
+	Longitude     float64                `protobuf:"fixed64,2,opt,name=longitude,proto3" json:"longitude,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Location) Reset() {
+	*x = Location{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[50]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Location) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Location) ProtoMessage() {}
+
+func (x *Location) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[50]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Location.ProtoReflect.Descriptor instead.
+func (*Location) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{50}
+}
+
+func (x *Location) GetLatitude() float64 {
+	if x != nil {
+		return x.Latitude
+	}
+	return 0
+}
+
+func (x *Location) GetLongitude() float64 {
+	if x != nil {
+		return x.Longitude
+	}
+	return 0
+}
+
+type Warehouse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId   int64                  `protobuf:"varint,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Address       string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Location      *Location              `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	Active        bool                   `protobuf:"varint,5,opt,name=active,proto3" json:"active,omitempty"`
+	CreatedAt     int64                  `protobuf:"varint,6,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt     int64                  `protobuf:"varint,7,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *Warehouse) Reset() {
+	*x = Warehouse{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[51]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Warehouse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Warehouse) ProtoMessage() {}
+
+func (x *Warehouse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[51]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Warehouse.ProtoReflect.Descriptor instead.
+func (*Warehouse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{51}
+}
+
+func (x *Warehouse) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *Warehouse) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *Warehouse) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *Warehouse) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *Warehouse) GetActive() bool {
+	if x != nil {
+		return x.Active
+	}
+	return false
+}
+
+func (x *Warehouse) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Warehouse) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+type CreateWarehouseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Name          string                 `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Address       string                 `protobuf:"bytes,2,opt,name=address,proto3" json:"address,omitempty"`
+	Location      *Location              `protobuf:"bytes,3,opt,name=location,proto3" json:"location,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWarehouseRequest) Reset() {
+	*x = CreateWarehouseRequest{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[52]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWarehouseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWarehouseRequest) ProtoMessage() {}
+
+func (x *CreateWarehouseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[52]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWarehouseRequest.ProtoReflect.Descriptor instead.
+func (*CreateWarehouseRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{52}
+}
+
+func (x *CreateWarehouseRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *CreateWarehouseRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *CreateWarehouseRequest) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+type CreateWarehouseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Warehouse     *Warehouse             `protobuf:"bytes,1,opt,name=warehouse,proto3" json:"warehouse,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateWarehouseResponse) Reset() {
+	*x = CreateWarehouseResponse{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[53]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateWarehouseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateWarehouseResponse) ProtoMessage() {}
+
+func (x *CreateWarehouseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[53]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateWarehouseResponse.ProtoReflect.Descriptor instead.
+func (*CreateWarehouseResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{53}
+}
+
+func (x *CreateWarehouseResponse) GetWarehouse() *Warehouse {
+	if x != nil {
+		return x.Warehouse
+	}
+	return nil
+}
+
+type GetWarehouseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId   int64                  `protobuf:"varint,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWarehouseRequest) Reset() {
+	*x = GetWarehouseRequest{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[54]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWarehouseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWarehouseRequest) ProtoMessage() {}
+
+func (x *GetWarehouseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[54]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWarehouseRequest.ProtoReflect.Descriptor instead.
+func (*GetWarehouseRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{54}
+}
+
+func (x *GetWarehouseRequest) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+type GetWarehouseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Warehouse     *Warehouse             `protobuf:"bytes,1,opt,name=warehouse,proto3" json:"warehouse,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWarehouseResponse) Reset() {
+	*x = GetWarehouseResponse{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[55]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWarehouseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWarehouseResponse) ProtoMessage() {}
+
+func (x *GetWarehouseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[55]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWarehouseResponse.ProtoReflect.Descriptor instead.
+func (*GetWarehouseResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{55}
+}
+
+func (x *GetWarehouseResponse) GetWarehouse() *Warehouse {
+	if x != nil {
+		return x.Warehouse
+	}
+	return nil
+}
+
+type ListWarehousesRequest struct {
+	state           protoimpl.MessageState `protogen:"open.v1"`
+	IncludeInactive bool                   `protobuf:"varint,1,opt,name=include_inactive,json=includeInactive,proto3" json:"include_inactive,omitempty"`
+	unknownFields   protoimpl.UnknownFields
+	sizeCache       protoimpl.SizeCache
+}
+
+func (x *ListWarehousesRequest) Reset() {
+	*x = ListWarehousesRequest{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[56]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWarehousesRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWarehousesRequest) ProtoMessage() {}
+
+func (x *ListWarehousesRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[56]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWarehousesRequest.ProtoReflect.Descriptor instead.
+func (*ListWarehousesRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{56}
+}
+
+func (x *ListWarehousesRequest) GetIncludeInactive() bool {
+	if x != nil {
+		return x.IncludeInactive
+	}
+	return false
+}
+
+type ListWarehousesResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Warehouses    []*Warehouse           `protobuf:"bytes,1,rep,name=warehouses,proto3" json:"warehouses,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListWarehousesResponse) Reset() {
+	*x = ListWarehousesResponse{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[57]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListWarehousesResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListWarehousesResponse) ProtoMessage() {}
+
+func (x *ListWarehousesResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[57]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListWarehousesResponse.ProtoReflect.Descriptor instead.
+func (*ListWarehousesResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{57}
+}
+
+func (x *ListWarehousesResponse) GetWarehouses() []*Warehouse {
+	if x != nil {
+		return x.Warehouses
+	}
+	return nil
+}
+
+// UpdateWarehouseRequest заменяет данные склада; active не передан - признак не меняется
+type UpdateWarehouseRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId   int64                  `protobuf:"varint,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	Name          string                 `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	Address       string                 `protobuf:"bytes,3,opt,name=address,proto3" json:"address,omitempty"`
+	Location      *Location              `protobuf:"bytes,4,opt,name=location,proto3" json:"location,omitempty"`
+	Active        *wrapperspb.BoolValue  `protobuf:"bytes,5,opt,name=active,proto3" json:"active,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWarehouseRequest) Reset() {
+	*x = UpdateWarehouseRequest{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[58]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWarehouseRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWarehouseRequest) ProtoMessage() {}
+
+func (x *UpdateWarehouseRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[58]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWarehouseRequest.ProtoReflect.Descriptor instead.
+func (*UpdateWarehouseRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{58}
+}
+
+func (x *UpdateWarehouseRequest) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *UpdateWarehouseRequest) GetName() string {
+	if x != nil {
+		return x.Name
+	}
+	return ""
+}
+
+func (x *UpdateWarehouseRequest) GetAddress() string {
+	if x != nil {
+		return x.Address
+	}
+	return ""
+}
+
+func (x *UpdateWarehouseRequest) GetLocation() *Location {
+	if x != nil {
+		return x.Location
+	}
+	return nil
+}
+
+func (x *UpdateWarehouseRequest) GetActive() *wrapperspb.BoolValue {
+	if x != nil {
+		return x.Active
+	}
+	return nil
+}
+
+type UpdateWarehouseResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Warehouse     *Warehouse             `protobuf:"bytes,1,opt,name=warehouse,proto3" json:"warehouse,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *UpdateWarehouseResponse) Reset() {
+	*x = UpdateWarehouseResponse{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[59]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *UpdateWarehouseResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*UpdateWarehouseResponse) ProtoMessage() {}
+
+func (x *UpdateWarehouseResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[59]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use UpdateWarehouseResponse.ProtoReflect.Descriptor instead.
+func (*UpdateWarehouseResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{59}
+}
+
+func (x *UpdateWarehouseResponse) GetWarehouse() *Warehouse {
+	if x != nil {
+		return x.Warehouse
+	}
+	return nil
+}
+
+type GetWarehouseInventoryRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId   int64                  `protobuf:"varint,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWarehouseInventoryRequest) Reset() {
+	*x = GetWarehouseInventoryRequest{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[60]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWarehouseInventoryRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWarehouseInventoryRequest) ProtoMessage() {}
+
+func (x *GetWarehouseInventoryRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[60]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWarehouseInventoryRequest.ProtoReflect.Descriptor instead.
+func (*GetWarehouseInventoryRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{60}
+}
+
+func (x *GetWarehouseInventoryRequest) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+type GetWarehouseInventoryResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Warehouse     *Warehouse             `protobuf:"bytes,1,opt,name=warehouse,proto3" json:"warehouse,omitempty"`
+	Items         []*WarehouseStockItem  `protobuf:"bytes,2,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetWarehouseInventoryResponse) Reset() {
+	*x = GetWarehouseInventoryResponse{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[61]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetWarehouseInventoryResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetWarehouseInventoryResponse) ProtoMessage() {}
+
+func (x *GetWarehouseInventoryResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[61]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetWarehouseInventoryResponse.ProtoReflect.Descriptor instead.
+func (*GetWarehouseInventoryResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{61}
+}
+
+func (x *GetWarehouseInventoryResponse) GetWarehouse() *Warehouse {
+	if x != nil {
+		return x.Warehouse
+	}
+	return nil
+}
+
+func (x *GetWarehouseInventoryResponse) GetItems() []*WarehouseStockItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+type WarehouseStockItem struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductName   string                 `protobuf:"bytes,2,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Reserved      int32                  `protobuf:"varint,4,opt,name=reserved,proto3" json:"reserved,omitempty"`
+	Available     int32                  `protobuf:"varint,5,opt,name=available,proto3" json:"available,omitempty"`
+	LastUpdated   int64                  `protobuf:"varint,6,opt,name=last_updated,json=lastUpdated,proto3" json:"last_updated,omitempty"`
+	Sku           string                 `protobuf:"bytes,7,opt,name=sku,proto3" json:"sku,omitempty"`
+	UnitPrice     float64                `protobuf:"fixed64,8,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *WarehouseStockItem) Reset() {
+	*x = WarehouseStockItem{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[62]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *WarehouseStockItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*WarehouseStockItem) ProtoMessage() {}
+
+func (x *WarehouseStockItem) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[62]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use WarehouseStockItem.ProtoReflect.Descriptor instead.
+func (*WarehouseStockItem) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{62}
+}
+
+func (x *WarehouseStockItem) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *WarehouseStockItem) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *WarehouseStockItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *WarehouseStockItem) GetReserved() int32 {
+	if x != nil {
+		return x.Reserved
+	}
+	return 0
+}
+
+func (x *WarehouseStockItem) GetAvailable() int32 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+func (x *WarehouseStockItem) GetLastUpdated() int64 {
+	if x != nil {
+		return x.LastUpdated
+	}
+	return 0
+}
+
+func (x *WarehouseStockItem) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *WarehouseStockItem) GetUnitPrice() float64 {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return 0
+}
+
+// CreateTransferRequest - черновик перемещения; driver_id можно назначить сразу или позже
+type CreateTransferRequest struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	SourceWarehouseId      int64                  `protobuf:"varint,1,opt,name=source_warehouse_id,json=sourceWarehouseId,proto3" json:"source_warehouse_id,omitempty"`
+	DestinationWarehouseId int64                  `protobuf:"varint,2,opt,name=destination_warehouse_id,json=destinationWarehouseId,proto3" json:"destination_warehouse_id,omitempty"`
+	Lines                  []*TransferLine        `protobuf:"bytes,3,rep,name=lines,proto3" json:"lines,omitempty"`
+	Note                   string                 `protobuf:"bytes,4,opt,name=note,proto3" json:"note,omitempty"`
+	DriverId               int64                  `protobuf:"varint,5,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	UserId                 int64                  `protobuf:"varint,6,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *CreateTransferRequest) Reset() {
+	*x = CreateTransferRequest{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[63]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTransferRequest) ProtoMessage() {}
+
+func (x *CreateTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[63]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTransferRequest.ProtoReflect.Descriptor instead.
+func (*CreateTransferRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{63}
+}
+
+func (x *CreateTransferRequest) GetSourceWarehouseId() int64 {
+	if x != nil {
+		return x.SourceWarehouseId
+	}
+	return 0
+}
+
+func (x *CreateTransferRequest) GetDestinationWarehouseId() int64 {
+	if x != nil {
+		return x.DestinationWarehouseId
+	}
+	return 0
+}
+
+func (x *CreateTransferRequest) GetLines() []*TransferLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *CreateTransferRequest) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *CreateTransferRequest) GetDriverId() int64 {
+	if x != nil {
+		return x.DriverId
+	}
+	return 0
+}
+
+func (x *CreateTransferRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type CreateTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfer      *Transfer              `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *CreateTransferResponse) Reset() {
+	*x = CreateTransferResponse{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[64]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *CreateTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*CreateTransferResponse) ProtoMessage() {}
+
+func (x *CreateTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[64]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use CreateTransferResponse.ProtoReflect.Descriptor instead.
+func (*CreateTransferResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{64}
+}
+
+func (x *CreateTransferResponse) GetTransfer() *Transfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+type GetTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransferId    int64                  `protobuf:"varint,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransferRequest) Reset() {
+	*x = GetTransferRequest{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[65]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransferRequest) ProtoMessage() {}
+
+func (x *GetTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[65]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransferRequest.ProtoReflect.Descriptor instead.
+func (*GetTransferRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{65}
+}
+
+func (x *GetTransferRequest) GetTransferId() int64 {
+	if x != nil {
+		return x.TransferId
+	}
+	return 0
+}
+
+type GetTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfer      *Transfer              `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *GetTransferResponse) Reset() {
+	*x = GetTransferResponse{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[66]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *GetTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*GetTransferResponse) ProtoMessage() {}
+
+func (x *GetTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[66]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use GetTransferResponse.ProtoReflect.Descriptor instead.
+func (*GetTransferResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{66}
+}
+
+func (x *GetTransferResponse) GetTransfer() *Transfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+type ListTransfersRequest struct {
+	state protoimpl.MessageState `protogen:"open.v1"`
+	// status - фильтр по статусу, пустой - все перемещения
+	Status string `protobuf:"bytes,1,opt,name=status,proto3" json:"status,omitempty"`
+	// warehouse_id - перемещения, где склад источник или получатель; 0 - все
+	WarehouseId   int64 `protobuf:"varint,2,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	Page          int32 `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32 `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransfersRequest) Reset() {
+	*x = ListTransfersRequest{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[67]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransfersRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransfersRequest) ProtoMessage() {}
+
+func (x *ListTransfersRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[67]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransfersRequest.ProtoReflect.Descriptor instead.
+func (*ListTransfersRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{67}
+}
+
+func (x *ListTransfersRequest) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *ListTransfersRequest) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *ListTransfersRequest) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListTransfersRequest) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// ListTransfersResponse - перемещения без строк
+type ListTransfersResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfers     []*Transfer            `protobuf:"bytes,1,rep,name=transfers,proto3" json:"transfers,omitempty"`
+	Total         int64                  `protobuf:"varint,2,opt,name=total,proto3" json:"total,omitempty"`
+	Page          int32                  `protobuf:"varint,3,opt,name=page,proto3" json:"page,omitempty"`
+	PageSize      int32                  `protobuf:"varint,4,opt,name=page_size,json=pageSize,proto3" json:"page_size,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListTransfersResponse) Reset() {
+	*x = ListTransfersResponse{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[68]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListTransfersResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListTransfersResponse) ProtoMessage() {}
+
+func (x *ListTransfersResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[68]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListTransfersResponse.ProtoReflect.Descriptor instead.
+func (*ListTransfersResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{68}
+}
+
+func (x *ListTransfersResponse) GetTransfers() []*Transfer {
+	if x != nil {
+		return x.Transfers
+	}
+	return nil
+}
+
+func (x *ListTransfersResponse) GetTotal() int64 {
+	if x != nil {
+		return x.Total
+	}
+	return 0
+}
+
+func (x *ListTransfersResponse) GetPage() int32 {
+	if x != nil {
+		return x.Page
+	}
+	return 0
+}
+
+func (x *ListTransfersResponse) GetPageSize() int32 {
+	if x != nil {
+		return x.PageSize
+	}
+	return 0
+}
+
+// AssignTransferDriverRequest - driver_id 0 снимает водителя с перемещения
+type AssignTransferDriverRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransferId    int64                  `protobuf:"varint,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	DriverId      int64                  `protobuf:"varint,2,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignTransferDriverRequest) Reset() {
+	*x = AssignTransferDriverRequest{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[69]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignTransferDriverRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignTransferDriverRequest) ProtoMessage() {}
+
+func (x *AssignTransferDriverRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[69]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignTransferDriverRequest.ProtoReflect.Descriptor instead.
+func (*AssignTransferDriverRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{69}
+}
+
+func (x *AssignTransferDriverRequest) GetTransferId() int64 {
+	if x != nil {
+		return x.TransferId
+	}
+	return 0
+}
+
+func (x *AssignTransferDriverRequest) GetDriverId() int64 {
+	if x != nil {
+		return x.DriverId
+	}
+	return 0
+}
+
+type AssignTransferDriverResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfer      *Transfer              `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *AssignTransferDriverResponse) Reset() {
+	*x = AssignTransferDriverResponse{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[70]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *AssignTransferDriverResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*AssignTransferDriverResponse) ProtoMessage() {}
+
+func (x *AssignTransferDriverResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[70]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use AssignTransferDriverResponse.ProtoReflect.Descriptor instead.
+func (*AssignTransferDriverResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{70}
+}
+
+func (x *AssignTransferDriverResponse) GetTransfer() *Transfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+type DispatchTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransferId    int64                  `protobuf:"varint,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DispatchTransferRequest) Reset() {
+	*x = DispatchTransferRequest{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[71]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DispatchTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DispatchTransferRequest) ProtoMessage() {}
+
+func (x *DispatchTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[71]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DispatchTransferRequest.ProtoReflect.Descriptor instead.
+func (*DispatchTransferRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{71}
+}
+
+func (x *DispatchTransferRequest) GetTransferId() int64 {
+	if x != nil {
+		return x.TransferId
+	}
+	return 0
+}
+
+func (x *DispatchTransferRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type DispatchTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfer      *Transfer              `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *DispatchTransferResponse) Reset() {
+	*x = DispatchTransferResponse{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[72]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *DispatchTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*DispatchTransferResponse) ProtoMessage() {}
+
+func (x *DispatchTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[72]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use DispatchTransferResponse.ProtoReflect.Descriptor instead.
+func (*DispatchTransferResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{72}
+}
+
+func (x *DispatchTransferResponse) GetTransfer() *Transfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+type ReceiveTransferRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	TransferId    int64                  `protobuf:"varint,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	UserId        int64                  `protobuf:"varint,2,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiveTransferRequest) Reset() {
+	*x = ReceiveTransferRequest{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[73]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiveTransferRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveTransferRequest) ProtoMessage() {}
+
+func (x *ReceiveTransferRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[73]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveTransferRequest.ProtoReflect.Descriptor instead.
+func (*ReceiveTransferRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{73}
+}
+
+func (x *ReceiveTransferRequest) GetTransferId() int64 {
+	if x != nil {
+		return x.TransferId
+	}
+	return 0
+}
+
+func (x *ReceiveTransferRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+type ReceiveTransferResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Transfer      *Transfer              `protobuf:"bytes,1,opt,name=transfer,proto3" json:"transfer,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ReceiveTransferResponse) Reset() {
+	*x = ReceiveTransferResponse{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[74]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReceiveTransferResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReceiveTransferResponse) ProtoMessage() {}
+
+func (x *ReceiveTransferResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[74]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReceiveTransferResponse.ProtoReflect.Descriptor instead.
+func (*ReceiveTransferResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{74}
+}
+
+func (x *ReceiveTransferResponse) GetTransfer() *Transfer {
+	if x != nil {
+		return x.Transfer
+	}
+	return nil
+}
+
+type Transfer struct {
+	state                  protoimpl.MessageState `protogen:"open.v1"`
+	TransferId             int64                  `protobuf:"varint,1,opt,name=transfer_id,json=transferId,proto3" json:"transfer_id,omitempty"`
+	SourceWarehouseId      int64                  `protobuf:"varint,2,opt,name=source_warehouse_id,json=sourceWarehouseId,proto3" json:"source_warehouse_id,omitempty"`
+	DestinationWarehouseId int64                  `protobuf:"varint,3,opt,name=destination_warehouse_id,json=destinationWarehouseId,proto3" json:"destination_warehouse_id,omitempty"`
+	Status                 string                 `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	DriverId               int64                  `protobuf:"varint,5,opt,name=driver_id,json=driverId,proto3" json:"driver_id,omitempty"`
+	Note                   string                 `protobuf:"bytes,6,opt,name=note,proto3" json:"note,omitempty"`
+	Lines                  []*TransferLine        `protobuf:"bytes,7,rep,name=lines,proto3" json:"lines,omitempty"`
+	CreatedBy              int64                  `protobuf:"varint,8,opt,name=created_by,json=createdBy,proto3" json:"created_by,omitempty"`
+	DispatchedBy           int64                  `protobuf:"varint,9,opt,name=dispatched_by,json=dispatchedBy,proto3" json:"dispatched_by,omitempty"`
+	ReceivedBy             int64                  `protobuf:"varint,10,opt,name=received_by,json=receivedBy,proto3" json:"received_by,omitempty"`
+	CreatedAt              int64                  `protobuf:"varint,11,opt,name=created_at,json=createdAt,proto3" json:"created_at,omitempty"`
+	UpdatedAt              int64                  `protobuf:"varint,12,opt,name=updated_at,json=updatedAt,proto3" json:"updated_at,omitempty"`
+	DispatchedAt           int64                  `protobuf:"varint,13,opt,name=dispatched_at,json=dispatchedAt,proto3" json:"dispatched_at,omitempty"`
+	ReceivedAt             int64                  `protobuf:"varint,14,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
+	unknownFields          protoimpl.UnknownFields
+	sizeCache              protoimpl.SizeCache
+}
+
+func (x *Transfer) Reset() {
+	*x = Transfer{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[75]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *Transfer) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*Transfer) ProtoMessage() {}
+
+func (x *Transfer) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[75]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use Transfer.ProtoReflect.Descriptor instead.
+func (*Transfer) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{75}
+}
+
+func (x *Transfer) GetTransferId() int64 {
+	if x != nil {
+		return x.TransferId
+	}
+	return 0
+}
+
+func (x *Transfer) GetSourceWarehouseId() int64 {
+	if x != nil {
+		return x.SourceWarehouseId
+	}
+	return 0
+}
+
+func (x *Transfer) GetDestinationWarehouseId() int64 {
+	if x != nil {
+		return x.DestinationWarehouseId
+	}
+	return 0
+}
+
+func (x *Transfer) GetStatus() string {
+	if x != nil {
+		return x.Status
+	}
+	return ""
+}
+
+func (x *Transfer) GetDriverId() int64 {
+	if x != nil {
+		return x.DriverId
+	}
+	return 0
+}
+
+func (x *Transfer) GetNote() string {
+	if x != nil {
+		return x.Note
+	}
+	return ""
+}
+
+func (x *Transfer) GetLines() []*TransferLine {
+	if x != nil {
+		return x.Lines
+	}
+	return nil
+}
+
+func (x *Transfer) GetCreatedBy() int64 {
+	if x != nil {
+		return x.CreatedBy
+	}
+	return 0
+}
+
+func (x *Transfer) GetDispatchedBy() int64 {
+	if x != nil {
+		return x.DispatchedBy
+	}
+	return 0
+}
+
+func (x *Transfer) GetReceivedBy() int64 {
+	if x != nil {
+		return x.ReceivedBy
+	}
+	return 0
+}
+
+func (x *Transfer) GetCreatedAt() int64 {
+	if x != nil {
+		return x.CreatedAt
+	}
+	return 0
+}
+
+func (x *Transfer) GetUpdatedAt() int64 {
+	if x != nil {
+		return x.UpdatedAt
+	}
+	return 0
+}
+
+func (x *Transfer) GetDispatchedAt() int64 {
+	if x != nil {
+		return x.DispatchedAt
+	}
+	return 0
+}
+
+func (x *Transfer) GetReceivedAt() int64 {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return 0
+}
+
+type TransferLine struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	ProductId     int64                  `protobuf:"varint,1,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductName   string                 `protobuf:"bytes,2,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	Quantity      int32                  `protobuf:"varint,3,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *TransferLine) Reset() {
+	*x = TransferLine{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[76]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *TransferLine) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*TransferLine) ProtoMessage() {}
+
+func (x *TransferLine) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[76]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use TransferLine.ProtoReflect.Descriptor instead.
+func (*TransferLine) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{76}
+}
+
+func (x *TransferLine) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *TransferLine) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *TransferLine) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+// ListReplenishmentRequest - warehouse_id 0 показывает все активные склады
+type ListReplenishmentRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId   int64                  `protobuf:"varint,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReplenishmentRequest) Reset() {
+	*x = ListReplenishmentRequest{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[77]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReplenishmentRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReplenishmentRequest) ProtoMessage() {}
+
+func (x *ListReplenishmentRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[77]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReplenishmentRequest.ProtoReflect.Descriptor instead.
+func (*ListReplenishmentRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{77}
+}
+
+func (x *ListReplenishmentRequest) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+type ListReplenishmentResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Items         []*ReplenishmentItem   `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListReplenishmentResponse) Reset() {
+	*x = ListReplenishmentResponse{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[78]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListReplenishmentResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListReplenishmentResponse) ProtoMessage() {}
+
+func (x *ListReplenishmentResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[78]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListReplenishmentResponse.ProtoReflect.Descriptor instead.
+func (*ListReplenishmentResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{78}
+}
+
+func (x *ListReplenishmentResponse) GetItems() []*ReplenishmentItem {
+	if x != nil {
+		return x.Items
+	}
+	return nil
+}
+
+// ReplenishmentItem - товар ниже точки заказа; suggested_quantity - рекомендуемое количество к заказу
+type ReplenishmentItem struct {
+	state             protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId       int64                  `protobuf:"varint,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	ProductId         int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Sku               string                 `protobuf:"bytes,3,opt,name=sku,proto3" json:"sku,omitempty"`
+	ProductName       string                 `protobuf:"bytes,4,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	Quantity          int32                  `protobuf:"varint,5,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Reserved          int32                  `protobuf:"varint,6,opt,name=reserved,proto3" json:"reserved,omitempty"`
+	Available         int32                  `protobuf:"varint,7,opt,name=available,proto3" json:"available,omitempty"`
+	ReorderPoint      int32                  `protobuf:"varint,8,opt,name=reorder_point,json=reorderPoint,proto3" json:"reorder_point,omitempty"`
+	ReorderQuantity   int32                  `protobuf:"varint,9,opt,name=reorder_quantity,json=reorderQuantity,proto3" json:"reorder_quantity,omitempty"`
+	SuggestedQuantity int32                  `protobuf:"varint,10,opt,name=suggested_quantity,json=suggestedQuantity,proto3" json:"suggested_quantity,omitempty"`
+	DetectedAt        int64                  `protobuf:"varint,11,opt,name=detected_at,json=detectedAt,proto3" json:"detected_at,omitempty"`
+	unknownFields     protoimpl.UnknownFields
+	sizeCache         protoimpl.SizeCache
+}
+
+func (x *ReplenishmentItem) Reset() {
+	*x = ReplenishmentItem{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[79]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ReplenishmentItem) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ReplenishmentItem) ProtoMessage() {}
+
+func (x *ReplenishmentItem) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[79]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ReplenishmentItem.ProtoReflect.Descriptor instead.
+func (*ReplenishmentItem) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{79}
+}
+
+func (x *ReplenishmentItem) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *ReplenishmentItem) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ReplenishmentItem) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *ReplenishmentItem) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *ReplenishmentItem) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ReplenishmentItem) GetReserved() int32 {
+	if x != nil {
+		return x.Reserved
+	}
+	return 0
+}
+
+func (x *ReplenishmentItem) GetAvailable() int32 {
+	if x != nil {
+		return x.Available
+	}
+	return 0
+}
+
+func (x *ReplenishmentItem) GetReorderPoint() int32 {
+	if x != nil {
+		return x.ReorderPoint
+	}
+	return 0
+}
+
+func (x *ReplenishmentItem) GetReorderQuantity() int32 {
+	if x != nil {
+		return x.ReorderQuantity
+	}
+	return 0
+}
+
+func (x *ReplenishmentItem) GetSuggestedQuantity() int32 {
+	if x != nil {
+		return x.SuggestedQuantity
+	}
+	return 0
+}
+
+func (x *ReplenishmentItem) GetDetectedAt() int64 {
+	if x != nil {
+		return x.DetectedAt
+	}
+	return 0
+}
+
+// ListStockLotsRequest - партии с остатком; expiring_within_days > 0 оставляет партии, срок годности которых
+// истекает в ближайшие дни, включая просроченные. Нулевые warehouse_id и product_id не ограничивают выборку
+type ListStockLotsRequest struct {
+	state              protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId        int64                  `protobuf:"varint,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	ProductId          int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ExpiringWithinDays int32                  `protobuf:"varint,3,opt,name=expiring_within_days,json=expiringWithinDays,proto3" json:"expiring_within_days,omitempty"`
+	Time               int64                  `protobuf:"varint,4,opt,name=time,proto3" json:"time,omitempty"`
+	unknownFields      protoimpl.UnknownFields
+	sizeCache          protoimpl.SizeCache
+}
+
+func (x *ListStockLotsRequest) Reset() {
+	*x = ListStockLotsRequest{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[80]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStockLotsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStockLotsRequest) ProtoMessage() {}
+
+func (x *ListStockLotsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[80]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStockLotsRequest.ProtoReflect.Descriptor instead.
+func (*ListStockLotsRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{80}
+}
+
+func (x *ListStockLotsRequest) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *ListStockLotsRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ListStockLotsRequest) GetExpiringWithinDays() int32 {
+	if x != nil {
+		return x.ExpiringWithinDays
+	}
+	return 0
+}
+
+func (x *ListStockLotsRequest) GetTime() int64 {
+	if x != nil {
+		return x.Time
+	}
+	return 0
+}
+
+type ListStockLotsResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Lots          []*StockLot            `protobuf:"bytes,1,rep,name=lots,proto3" json:"lots,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ListStockLotsResponse) Reset() {
+	*x = ListStockLotsResponse{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[81]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ListStockLotsResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ListStockLotsResponse) ProtoMessage() {}
+
+func (x *ListStockLotsResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[81]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ListStockLotsResponse.ProtoReflect.Descriptor instead.
+func (*ListStockLotsResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{81}
+}
+
+func (x *ListStockLotsResponse) GetLots() []*StockLot {
+	if x != nil {
+		return x.Lots
+	}
+	return nil
+}
+
+type StockLot struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	LotId         int64                  `protobuf:"varint,1,opt,name=lot_id,json=lotId,proto3" json:"lot_id,omitempty"`
+	WarehouseId   int64                  `protobuf:"varint,2,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	ProductId     int64                  `protobuf:"varint,3,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	ProductName   string                 `protobuf:"bytes,4,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	LotNumber     string                 `protobuf:"bytes,5,opt,name=lot_number,json=lotNumber,proto3" json:"lot_number,omitempty"`
+	ExpiresAt     int64                  `protobuf:"varint,6,opt,name=expires_at,json=expiresAt,proto3" json:"expires_at,omitempty"`
+	ReceivedAt    int64                  `protobuf:"varint,7,opt,name=received_at,json=receivedAt,proto3" json:"received_at,omitempty"`
+	Quantity      int32                  `protobuf:"varint,8,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	Reserved      int32                  `protobuf:"varint,9,opt,name=reserved,proto3" json:"reserved,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *StockLot) Reset() {
+	*x = StockLot{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[82]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *StockLot) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*StockLot) ProtoMessage() {}
+
+func (x *StockLot) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[82]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use StockLot.ProtoReflect.Descriptor instead.
+func (*StockLot) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{82}
+}
+
+func (x *StockLot) GetLotId() int64 {
+	if x != nil {
+		return x.LotId
+	}
+	return 0
+}
+
+func (x *StockLot) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *StockLot) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *StockLot) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *StockLot) GetLotNumber() string {
+	if x != nil {
+		return x.LotNumber
+	}
+	return ""
+}
+
+func (x *StockLot) GetExpiresAt() int64 {
+	if x != nil {
+		return x.ExpiresAt
+	}
+	return 0
+}
+
+func (x *StockLot) GetReceivedAt() int64 {
+	if x != nil {
+		return x.ReceivedAt
+	}
+	return 0
+}
+
+func (x *StockLot) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *StockLot) GetReserved() int32 {
+	if x != nil {
+		return x.Reserved
+	}
+	return 0
+}
+
+// ImportStockRequest - пакет фактических остатков. Пакет применяется целиком или не применяется вовсе;
+// dry_run только проверяет строки и считает изменения. warehouse_id - склад строк без своего warehouse_id;
+// если он задан, строки с другим складом отклоняются. 0 - склад задается в каждой строке
+type ImportStockRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId   int64                  `protobuf:"varint,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	Rows          []*ImportStockRow      `protobuf:"bytes,2,rep,name=rows,proto3" json:"rows,omitempty"`
+	DryRun        bool                   `protobuf:"varint,3,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+	UserId        int64                  `protobuf:"varint,4,opt,name=user_id,json=userId,proto3" json:"user_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportStockRequest) Reset() {
+	*x = ImportStockRequest{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[83]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportStockRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportStockRequest) ProtoMessage() {}
+
+func (x *ImportStockRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[83]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportStockRequest.ProtoReflect.Descriptor instead.
+func (*ImportStockRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{83}
+}
+
+func (x *ImportStockRequest) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *ImportStockRequest) GetRows() []*ImportStockRow {
+	if x != nil {
+		return x.Rows
+	}
+	return nil
+}
+
+func (x *ImportStockRequest) GetDryRun() bool {
+	if x != nil {
+		return x.DryRun
+	}
+	return false
+}
+
+func (x *ImportStockRequest) GetUserId() int64 {
+	if x != nil {
+		return x.UserId
+	}
+	return 0
+}
+
+// ImportStockRow - товар ищется по sku, если он задан, иначе по наименованию; quantity - фактический остаток,
+// unit_price не передан - цена не меняется. line - номер строки в исходном файле для отчета об ошибках.
+// warehouse_id 0 - склад из запроса
+type ImportStockRow struct {
+	state         protoimpl.MessageState  `protogen:"open.v1"`
+	Line          int32                   `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Sku           string                  `protobuf:"bytes,2,opt,name=sku,proto3" json:"sku,omitempty"`
+	ProductName   string                  `protobuf:"bytes,3,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	Quantity      int32                   `protobuf:"varint,4,opt,name=quantity,proto3" json:"quantity,omitempty"`
+	UnitPrice     *wrapperspb.DoubleValue `protobuf:"bytes,5,opt,name=unit_price,json=unitPrice,proto3" json:"unit_price,omitempty"`
+	WarehouseId   int64                   `protobuf:"varint,6,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportStockRow) Reset() {
+	*x = ImportStockRow{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[84]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportStockRow) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportStockRow) ProtoMessage() {}
+
+func (x *ImportStockRow) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[84]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportStockRow.ProtoReflect.Descriptor instead.
+func (*ImportStockRow) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{84}
+}
+
+func (x *ImportStockRow) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportStockRow) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *ImportStockRow) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *ImportStockRow) GetQuantity() int32 {
+	if x != nil {
+		return x.Quantity
+	}
+	return 0
+}
+
+func (x *ImportStockRow) GetUnitPrice() *wrapperspb.DoubleValue {
+	if x != nil {
+		return x.UnitPrice
+	}
+	return nil
+}
+
+func (x *ImportStockRow) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+// ImportStockResponse - applied false, если был dry_run или хотя бы одна строка не прошла проверку
+type ImportStockResponse struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Applied       bool                   `protobuf:"varint,1,opt,name=applied,proto3" json:"applied,omitempty"`
+	Results       []*ImportStockResult   `protobuf:"bytes,2,rep,name=results,proto3" json:"results,omitempty"`
+	Errors        []*ImportRowError      `protobuf:"bytes,3,rep,name=errors,proto3" json:"errors,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportStockResponse) Reset() {
+	*x = ImportStockResponse{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[85]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportStockResponse) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportStockResponse) ProtoMessage() {}
+
+func (x *ImportStockResponse) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[85]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportStockResponse.ProtoReflect.Descriptor instead.
+func (*ImportStockResponse) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{85}
+}
+
+func (x *ImportStockResponse) GetApplied() bool {
+	if x != nil {
+		return x.Applied
+	}
+	return false
+}
+
+func (x *ImportStockResponse) GetResults() []*ImportStockResult {
+	if x != nil {
+		return x.Results
+	}
+	return nil
+}
+
+func (x *ImportStockResponse) GetErrors() []*ImportRowError {
+	if x != nil {
+		return x.Errors
+	}
+	return nil
+}
+
+type ImportStockResult struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          int32                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	ProductId     int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Sku           string                 `protobuf:"bytes,3,opt,name=sku,proto3" json:"sku,omitempty"`
+	ProductName   string                 `protobuf:"bytes,4,opt,name=product_name,json=productName,proto3" json:"product_name,omitempty"`
+	OldQuantity   int32                  `protobuf:"varint,5,opt,name=old_quantity,json=oldQuantity,proto3" json:"old_quantity,omitempty"`
+	NewQuantity   int32                  `protobuf:"varint,6,opt,name=new_quantity,json=newQuantity,proto3" json:"new_quantity,omitempty"`
+	OldUnitPrice  float64                `protobuf:"fixed64,7,opt,name=old_unit_price,json=oldUnitPrice,proto3" json:"old_unit_price,omitempty"`
+	NewUnitPrice  float64                `protobuf:"fixed64,8,opt,name=new_unit_price,json=newUnitPrice,proto3" json:"new_unit_price,omitempty"`
+	WarehouseId   int64                  `protobuf:"varint,9,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportStockResult) Reset() {
+	*x = ImportStockResult{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[86]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportStockResult) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportStockResult) ProtoMessage() {}
+
+func (x *ImportStockResult) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[86]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportStockResult.ProtoReflect.Descriptor instead.
+func (*ImportStockResult) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{86}
+}
+
+func (x *ImportStockResult) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportStockResult) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ImportStockResult) GetSku() string {
+	if x != nil {
+		return x.Sku
+	}
+	return ""
+}
+
+func (x *ImportStockResult) GetProductName() string {
+	if x != nil {
+		return x.ProductName
+	}
+	return ""
+}
+
+func (x *ImportStockResult) GetOldQuantity() int32 {
+	if x != nil {
+		return x.OldQuantity
+	}
+	return 0
+}
+
+func (x *ImportStockResult) GetNewQuantity() int32 {
+	if x != nil {
+		return x.NewQuantity
+	}
+	return 0
+}
+
+func (x *ImportStockResult) GetOldUnitPrice() float64 {
+	if x != nil {
+		return x.OldUnitPrice
+	}
+	return 0
+}
+
+func (x *ImportStockResult) GetNewUnitPrice() float64 {
+	if x != nil {
+		return x.NewUnitPrice
+	}
+	return 0
+}
+
+func (x *ImportStockResult) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+type ImportRowError struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	Line          int32                  `protobuf:"varint,1,opt,name=line,proto3" json:"line,omitempty"`
+	Message       string                 `protobuf:"bytes,2,opt,name=message,proto3" json:"message,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ImportRowError) Reset() {
+	*x = ImportRowError{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[87]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ImportRowError) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ImportRowError) ProtoMessage() {}
+
+func (x *ImportRowError) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[87]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ImportRowError.ProtoReflect.Descriptor instead.
+func (*ImportRowError) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{87}
+}
+
+func (x *ImportRowError) GetLine() int32 {
+	if x != nil {
+		return x.Line
+	}
+	return 0
+}
+
+func (x *ImportRowError) GetMessage() string {
+	if x != nil {
+		return x.Message
+	}
+	return ""
+}
+
+// ExportStockMovementsRequest - журнал движения в хронологическом порядке; нулевые поля не ограничивают выборку
+type ExportStockMovementsRequest struct {
+	state         protoimpl.MessageState `protogen:"open.v1"`
+	WarehouseId   int64                  `protobuf:"varint,1,opt,name=warehouse_id,json=warehouseId,proto3" json:"warehouse_id,omitempty"`
+	ProductId     int64                  `protobuf:"varint,2,opt,name=product_id,json=productId,proto3" json:"product_id,omitempty"`
+	Reason        string                 `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Since         int64                  `protobuf:"varint,4,opt,name=since,proto3" json:"since,omitempty"`
+	Until         int64                  `protobuf:"varint,5,opt,name=until,proto3" json:"until,omitempty"`
+	unknownFields protoimpl.UnknownFields
+	sizeCache     protoimpl.SizeCache
+}
+
+func (x *ExportStockMovementsRequest) Reset() {
+	*x = ExportStockMovementsRequest{}
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[88]
+	ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+	ms.StoreMessageInfo(mi)
+}
+
+func (x *ExportStockMovementsRequest) String() string {
+	return protoimpl.X.MessageStringOf(x)
+}
+
+func (*ExportStockMovementsRequest) ProtoMessage() {}
+
+func (x *ExportStockMovementsRequest) ProtoReflect() protoreflect.Message {
+	mi := &file_warehouse_service_warehouse_service_proto_msgTypes[88]
+	if x != nil {
+		ms := protoimpl.X.MessageStateOf(protoimpl.Pointer(x))
+		if ms.LoadMessageInfo() == nil {
+			ms.StoreMessageInfo(mi)
+		}
+		return ms
+	}
+	return mi.MessageOf(x)
+}
+
+// Deprecated: Use ExportStockMovementsRequest.ProtoReflect.Descriptor instead.
+func (*ExportStockMovementsRequest) Descriptor() ([]byte, []int) {
+	return file_warehouse_service_warehouse_service_proto_rawDescGZIP(), []int{88}
+}
+
+func (x *ExportStockMovementsRequest) GetWarehouseId() int64 {
+	if x != nil {
+		return x.WarehouseId
+	}
+	return 0
+}
+
+func (x *ExportStockMovementsRequest) GetProductId() int64 {
+	if x != nil {
+		return x.ProductId
+	}
+	return 0
+}
+
+func (x *ExportStockMovementsRequest) GetReason() string {
+	if x != nil {
+		return x.Reason
+	}
+	return ""
+}
+
+func (x *ExportStockMovementsRequest) GetSince() int64 {
+	if x != nil {
+		return x.Since
+	}
+	return 0
+}
+
+func (x *ExportStockMovementsRequest) GetUntil() int64 {
+	if x != nil {
+		return x.Until
+	}
+	return 0
+}
+
+var File_warehouse_service_warehouse_service_proto protoreflect.FileDescriptor
+
+const file_warehouse_service_warehouse_service_proto_rawDesc = "" +
+	"\n" +
+	")warehouse_service/warehouse_service.proto\x12\twarehouse\x1a\x1bgoogle/protobuf/empty.proto\x1a\x1egoogle/protobuf/wrappers.proto\"b\n" +
+	"\x11CheckStockRequest\x12*\n" +
+	"\x05items\x18\x01 \x03(\v2\x14.warehouse.StockItemR\x05items\x12!\n" +
+	"\fwarehouse_id\x18\x02 \x01(\x03R\vwarehouseId\"\x85\x01\n" +
+	"\x12CheckStockResponse\x12\x1c\n" +
+	"\tavailable\x18\x01 \x01(\bR\tavailable\x127\n" +
+	"\x05items\x18\x02 \x03(\v2!.warehouse.StockItemWithWarehouseR\x05items\x12\x18\n" +
 	"\amessage\x18\x03 \x01(\tR\amessage\"E\n" +
 	"\x19GetWarehouseStockResponse\x12(\n" +
-	"\x06stocks\x18\x01 \x03(\v2\x10.warehouse.StockR\x06stocks\"T\n" +
+	"\x06stocks\x18\x01 \x03(\v2\x10.warehouse.StockR\x06stocks\"\x92\x01\n" +
 	"\x12UpdateStockRequest\x12*\n" +
 	"\x05items\x18\x01 \x03(\v2\x14.warehouse.StockItemR\x05items\x12\x12\n" +
-	"\x04Time\x18\x02 \x01(\x03R\x04Time\"/\n" +
+	"\x04Time\x18\x02 \x01(\x03R\x04Time\x12\x19\n" +
+	"\border_id\x18\x03 \x01(\x03R\aorderId\x12!\n" +
+	"\fwarehouse_id\x18\x04 \x01(\x03R\vwarehouseId\"/\n" +
 	"\x13UpdateStockResponse\x12\x18\n" +
-	"\asuccess\x18\x01 \x01(\bR\asuccess\"}\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\x92\x01\n" +
+	"\x12ReturnStockRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12*\n" +
+	"\x05items\x18\x02 \x03(\v2\x14.warehouse.StockItemR\x05items\x12\x12\n" +
+	"\x04time\x18\x03 \x01(\x03R\x04time\x12!\n" +
+	"\fwarehouse_id\x18\x04 \x01(\x03R\vwarehouseId\"/\n" +
+	"\x13ReturnStockResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"\xd3\x01\n" +
+	"\x13ReserveStockRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12*\n" +
+	"\x05items\x18\x02 \x03(\v2\x14.warehouse.StockItemR\x05items\x12\x1f\n" +
+	"\vttl_seconds\x18\x03 \x01(\x03R\n" +
+	"ttlSeconds\x12\x12\n" +
+	"\x04time\x18\x04 \x01(\x03R\x04time\x12@\n" +
+	"\x11delivery_location\x18\x05 \x01(\v2\x13.warehouse.LocationR\x10deliveryLocation\"\x8d\x01\n" +
+	"\x14ReserveStockResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x02 \x01(\x03R\texpiresAt\x12<\n" +
+	"\vallocations\x18\x03 \x03(\v2\x1a.warehouse.StockAllocationR\vallocations\"\x9d\x01\n" +
+	"\x0fStockAllocation\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\x03R\vwarehouseId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x03R\tproductId\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12,\n" +
+	"\x04lots\x18\x04 \x03(\v2\x18.warehouse.AllocationLotR\x04lots\"\x80\x01\n" +
+	"\rAllocationLot\x12\x15\n" +
+	"\x06lot_id\x18\x01 \x01(\x03R\x05lotId\x12\x1d\n" +
+	"\n" +
+	"lot_number\x18\x02 \x01(\tR\tlotNumber\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x03 \x01(\x03R\texpiresAt\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\"I\n" +
+	"\x18CommitReservationRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x12\n" +
+	"\x04time\x18\x02 \x01(\x03R\x04time\"5\n" +
+	"\x19CommitReservationResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\"J\n" +
+	"\x19ReleaseReservationRequest\x12\x19\n" +
+	"\border_id\x18\x01 \x01(\x03R\aorderId\x12\x12\n" +
+	"\x04time\x18\x02 \x01(\x03R\x04time\"R\n" +
+	"\x1aReleaseReservationResponse\x12\x18\n" +
+	"\asuccess\x18\x01 \x01(\bR\asuccess\x12\x1a\n" +
+	"\breleased\x18\x02 \x01(\bR\breleased\"\xa7\x02\n" +
+	"\x14CreateProductRequest\x12\x10\n" +
+	"\x03sku\x18\x01 \x01(\tR\x03sku\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x03 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"unit_price\x18\x04 \x01(\x01R\tunitPrice\x12!\n" +
+	"\fweight_grams\x18\x05 \x01(\x05R\vweightGrams\x125\n" +
+	"\n" +
+	"dimensions\x18\x06 \x01(\v2\x15.warehouse.DimensionsR\n" +
+	"dimensions\x12#\n" +
+	"\rreorder_point\x18\a \x01(\x05R\freorderPoint\x12)\n" +
+	"\x10reorder_quantity\x18\b \x01(\x05R\x0freorderQuantity\"E\n" +
+	"\x15CreateProductResponse\x12,\n" +
+	"\aproduct\x18\x01 \x01(\v2\x12.warehouse.ProductR\aproduct\"2\n" +
+	"\x11GetProductRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\"B\n" +
+	"\x12GetProductResponse\x12,\n" +
+	"\aproduct\x18\x01 \x01(\v2\x12.warehouse.ProductR\aproduct\"q\n" +
+	"\x13ListProductsRequest\x12)\n" +
+	"\x10include_inactive\x18\x01 \x01(\bR\x0fincludeInactive\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\x8d\x01\n" +
+	"\x14ListProductsResponse\x12.\n" +
+	"\bproducts\x18\x01 \x03(\v2\x12.warehouse.ProductR\bproducts\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\xfa\x02\n" +
+	"\x14UpdateProductRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"unit_price\x18\x05 \x01(\x01R\tunitPrice\x12!\n" +
+	"\fweight_grams\x18\x06 \x01(\x05R\vweightGrams\x125\n" +
+	"\n" +
+	"dimensions\x18\a \x01(\v2\x15.warehouse.DimensionsR\n" +
+	"dimensions\x122\n" +
+	"\x06active\x18\b \x01(\v2\x1a.google.protobuf.BoolValueR\x06active\x12#\n" +
+	"\rreorder_point\x18\t \x01(\x05R\freorderPoint\x12)\n" +
+	"\x10reorder_quantity\x18\n" +
+	" \x01(\x05R\x0freorderQuantity\"E\n" +
+	"\x15UpdateProductResponse\x12,\n" +
+	"\aproduct\x18\x01 \x01(\v2\x12.warehouse.ProductR\aproduct\"5\n" +
+	"\x14DeleteProductRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\"E\n" +
+	"\x15DeleteProductResponse\x12,\n" +
+	"\aproduct\x18\x01 \x01(\v2\x12.warehouse.ProductR\aproduct\"/\n" +
+	"\x17GetProductPricesRequest\x12\x14\n" +
+	"\x05names\x18\x01 \x03(\tR\x05names\"J\n" +
+	"\x18GetProductPricesResponse\x12.\n" +
+	"\bproducts\x18\x01 \x03(\v2\x12.warehouse.ProductR\bproducts\"\x8f\x03\n" +
+	"\aProduct\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x12\x12\n" +
+	"\x04name\x18\x03 \x01(\tR\x04name\x12 \n" +
+	"\vdescription\x18\x04 \x01(\tR\vdescription\x12\x1d\n" +
+	"\n" +
+	"unit_price\x18\x05 \x01(\x01R\tunitPrice\x12!\n" +
+	"\fweight_grams\x18\x06 \x01(\x05R\vweightGrams\x125\n" +
+	"\n" +
+	"dimensions\x18\a \x01(\v2\x15.warehouse.DimensionsR\n" +
+	"dimensions\x12\x16\n" +
+	"\x06active\x18\b \x01(\bR\x06active\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\x03R\tupdatedAt\x12#\n" +
+	"\rreorder_point\x18\v \x01(\x05R\freorderPoint\x12)\n" +
+	"\x10reorder_quantity\x18\f \x01(\x05R\x0freorderQuantity\"a\n" +
+	"\n" +
+	"Dimensions\x12\x1b\n" +
+	"\tlength_mm\x18\x01 \x01(\x05R\blengthMm\x12\x19\n" +
+	"\bwidth_mm\x18\x02 \x01(\x05R\awidthMm\x12\x1b\n" +
+	"\theight_mm\x18\x03 \x01(\x05R\bheightMm\"\xda\x01\n" +
+	"\x1eCreateReceivingDocumentRequest\x12\x1a\n" +
+	"\bsupplier\x18\x01 \x01(\tR\bsupplier\x12\x1c\n" +
+	"\treference\x18\x02 \x01(\tR\treference\x12\x12\n" +
+	"\x04note\x18\x03 \x01(\tR\x04note\x12.\n" +
+	"\x05lines\x18\x04 \x03(\v2\x18.warehouse.ReceivingLineR\x05lines\x12\x17\n" +
+	"\auser_id\x18\x05 \x01(\x03R\x06userId\x12!\n" +
+	"\fwarehouse_id\x18\x06 \x01(\x03R\vwarehouseId\"[\n" +
+	"\x1fCreateReceivingDocumentResponse\x128\n" +
+	"\bdocument\x18\x01 \x01(\v2\x1c.warehouse.ReceivingDocumentR\bdocument\">\n" +
+	"\x1bGetReceivingDocumentRequest\x12\x1f\n" +
+	"\vdocument_id\x18\x01 \x01(\x03R\n" +
+	"documentId\"X\n" +
+	"\x1cGetReceivingDocumentResponse\x128\n" +
+	"\bdocument\x18\x01 \x01(\v2\x1c.warehouse.ReceivingDocumentR\bdocument\"h\n" +
+	"\x1dListReceivingDocumentsRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12\x12\n" +
+	"\x04page\x18\x02 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x03 \x01(\x05R\bpageSize\"\xa3\x01\n" +
+	"\x1eListReceivingDocumentsResponse\x12:\n" +
+	"\tdocuments\x18\x01 \x03(\v2\x1c.warehouse.ReceivingDocumentR\tdocuments\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"r\n" +
+	"\x1fRecordReceivedQuantitiesRequest\x12\x1f\n" +
+	"\vdocument_id\x18\x01 \x01(\x03R\n" +
+	"documentId\x12.\n" +
+	"\x05lines\x18\x02 \x03(\v2\x18.warehouse.ReceivingLineR\x05lines\"\\\n" +
+	" RecordReceivedQuantitiesResponse\x128\n" +
+	"\bdocument\x18\x01 \x01(\v2\x1c.warehouse.ReceivingDocumentR\bdocument\"X\n" +
+	"\x1cPostReceivingDocumentRequest\x12\x1f\n" +
+	"\vdocument_id\x18\x01 \x01(\x03R\n" +
+	"documentId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"Y\n" +
+	"\x1dPostReceivingDocumentResponse\x128\n" +
+	"\bdocument\x18\x01 \x01(\v2\x1c.warehouse.ReceivingDocumentR\bdocument\"\x84\x03\n" +
+	"\x11ReceivingDocument\x12\x1f\n" +
+	"\vdocument_id\x18\x01 \x01(\x03R\n" +
+	"documentId\x12\x1a\n" +
+	"\bsupplier\x18\x02 \x01(\tR\bsupplier\x12\x1c\n" +
+	"\treference\x18\x03 \x01(\tR\treference\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\x12\x16\n" +
+	"\x06status\x18\x05 \x01(\tR\x06status\x12.\n" +
+	"\x05lines\x18\x06 \x03(\v2\x18.warehouse.ReceivingLineR\x05lines\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\a \x01(\x03R\tcreatedBy\x12\x1b\n" +
+	"\tposted_by\x18\b \x01(\x03R\bpostedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\t \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\n" +
+	" \x01(\x03R\tupdatedAt\x12\x1b\n" +
+	"\tposted_at\x18\v \x01(\x03R\bpostedAt\x12!\n" +
+	"\fwarehouse_id\x18\f \x01(\x03R\vwarehouseId\"\xe9\x01\n" +
+	"\rReceivingLine\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12!\n" +
+	"\fproduct_name\x18\x02 \x01(\tR\vproductName\x12+\n" +
+	"\x11expected_quantity\x18\x03 \x01(\x05R\x10expectedQuantity\x12+\n" +
+	"\x11received_quantity\x18\x04 \x01(\x05R\x10receivedQuantity\x12\x1d\n" +
+	"\n" +
+	"lot_number\x18\x05 \x01(\tR\tlotNumber\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\x03R\texpiresAt\"\xd2\x01\n" +
+	"\x19ListStockMovementsRequest\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12\x16\n" +
+	"\x06reason\x18\x02 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05since\x18\x03 \x01(\x03R\x05since\x12\x14\n" +
+	"\x05until\x18\x04 \x01(\x03R\x05until\x12\x12\n" +
+	"\x04page\x18\x05 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x06 \x01(\x05R\bpageSize\x12!\n" +
+	"\fwarehouse_id\x18\a \x01(\x03R\vwarehouseId\"\x9b\x01\n" +
+	"\x1aListStockMovementsResponse\x126\n" +
+	"\tmovements\x18\x01 \x03(\v2\x18.warehouse.StockMovementR\tmovements\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x83\x02\n" +
+	"\rStockMovement\x12\x0e\n" +
+	"\x02id\x18\x01 \x01(\x03R\x02id\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x03R\tproductId\x12\x14\n" +
+	"\x05delta\x18\x03 \x01(\x05R\x05delta\x12\x16\n" +
+	"\x06reason\x18\x04 \x01(\tR\x06reason\x12!\n" +
+	"\freference_id\x18\x05 \x01(\x03R\vreferenceId\x12\x19\n" +
+	"\bactor_id\x18\x06 \x01(\x03R\aactorId\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\a \x01(\x03R\tcreatedAt\x12!\n" +
+	"\fwarehouse_id\x18\b \x01(\x03R\vwarehouseId\x12\x15\n" +
+	"\x06lot_id\x18\t \x01(\x03R\x05lotId\"8\n" +
+	"\x15ReconcileStockRequest\x12\x1f\n" +
+	"\vproduct_ids\x18\x01 \x03(\x03R\n" +
+	"productIds\"u\n" +
+	"\x16ReconcileStockResponse\x12\x18\n" +
+	"\achecked\x18\x01 \x01(\x03R\achecked\x12A\n" +
+	"\rdiscrepancies\x18\x02 \x03(\v2\x1b.warehouse.StockDiscrepancyR\rdiscrepancies\"\xb3\x01\n" +
+	"\x10StockDiscrepancy\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12!\n" +
+	"\fproduct_name\x18\x02 \x01(\tR\vproductName\x12\x17\n" +
+	"\aon_hand\x18\x03 \x01(\x03R\x06onHand\x12!\n" +
+	"\fledger_total\x18\x04 \x01(\x03R\vledgerTotal\x12!\n" +
+	"\fwarehouse_id\x18\x05 \x01(\x03R\vwarehouseId\"}\n" +
 	"\tStockItem\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\x03R\tproductId\x12!\n" +
 	"\fproduct_name\x18\x02 \x01(\tR\vproductName\x12\x1a\n" +
 	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x12\n" +
-	"\x04time\x18\x04 \x01(\x03R\x04time\"W\n" +
+	"\x04time\x18\x04 \x01(\x03R\x04time\"\x99\x01\n" +
 	"\x16StockItemWithWarehouse\x12!\n" +
 	"\fproduct_name\x18\x01 \x01(\tR\vproductName\x12\x1a\n" +
-	"\bquantity\x18\x02 \x01(\x05R\bquantity\"\x8f\x01\n" +
+	"\bquantity\x18\x02 \x01(\x05R\bquantity\x12!\n" +
+	"\fwarehouse_id\x18\x03 \x01(\x03R\vwarehouseId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x04 \x01(\x03R\tproductId\"\x8f\x01\n" +
 	"\x05Stock\x12\x1d\n" +
 	"\n" +
 	"product_id\x18\x01 \x01(\x03R\tproductId\x12!\n" +
 	"\fproduct_name\x18\x02 \x01(\tR\vproductName\x12\x1a\n" +
 	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x14\n" +
 	"\x05price\x18\x04 \x01(\x01R\x05price\x12\x12\n" +
-	"\x04time\x18\x05 \x01(\x03R\x04time2\x8a\x02\n" +
+	"\x04time\x18\x05 \x01(\x03R\x04time\"D\n" +
+	"\bLocation\x12\x1a\n" +
+	"\blatitude\x18\x01 \x01(\x01R\blatitude\x12\x1c\n" +
+	"\tlongitude\x18\x02 \x01(\x01R\tlongitude\"\xe3\x01\n" +
+	"\tWarehouse\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\x03R\vwarehouseId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\x12/\n" +
+	"\blocation\x18\x04 \x01(\v2\x13.warehouse.LocationR\blocation\x12\x16\n" +
+	"\x06active\x18\x05 \x01(\bR\x06active\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\x06 \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\a \x01(\x03R\tupdatedAt\"w\n" +
+	"\x16CreateWarehouseRequest\x12\x12\n" +
+	"\x04name\x18\x01 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x02 \x01(\tR\aaddress\x12/\n" +
+	"\blocation\x18\x03 \x01(\v2\x13.warehouse.LocationR\blocation\"M\n" +
+	"\x17CreateWarehouseResponse\x122\n" +
+	"\twarehouse\x18\x01 \x01(\v2\x14.warehouse.WarehouseR\twarehouse\"8\n" +
+	"\x13GetWarehouseRequest\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\x03R\vwarehouseId\"J\n" +
+	"\x14GetWarehouseResponse\x122\n" +
+	"\twarehouse\x18\x01 \x01(\v2\x14.warehouse.WarehouseR\twarehouse\"B\n" +
+	"\x15ListWarehousesRequest\x12)\n" +
+	"\x10include_inactive\x18\x01 \x01(\bR\x0fincludeInactive\"N\n" +
+	"\x16ListWarehousesResponse\x124\n" +
+	"\n" +
+	"warehouses\x18\x01 \x03(\v2\x14.warehouse.WarehouseR\n" +
+	"warehouses\"\xce\x01\n" +
+	"\x16UpdateWarehouseRequest\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\x03R\vwarehouseId\x12\x12\n" +
+	"\x04name\x18\x02 \x01(\tR\x04name\x12\x18\n" +
+	"\aaddress\x18\x03 \x01(\tR\aaddress\x12/\n" +
+	"\blocation\x18\x04 \x01(\v2\x13.warehouse.LocationR\blocation\x122\n" +
+	"\x06active\x18\x05 \x01(\v2\x1a.google.protobuf.BoolValueR\x06active\"M\n" +
+	"\x17UpdateWarehouseResponse\x122\n" +
+	"\twarehouse\x18\x01 \x01(\v2\x14.warehouse.WarehouseR\twarehouse\"A\n" +
+	"\x1cGetWarehouseInventoryRequest\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\x03R\vwarehouseId\"\x88\x01\n" +
+	"\x1dGetWarehouseInventoryResponse\x122\n" +
+	"\twarehouse\x18\x01 \x01(\v2\x14.warehouse.WarehouseR\twarehouse\x123\n" +
+	"\x05items\x18\x02 \x03(\v2\x1d.warehouse.WarehouseStockItemR\x05items\"\x80\x02\n" +
+	"\x12WarehouseStockItem\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12!\n" +
+	"\fproduct_name\x18\x02 \x01(\tR\vproductName\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\x12\x1a\n" +
+	"\breserved\x18\x04 \x01(\x05R\breserved\x12\x1c\n" +
+	"\tavailable\x18\x05 \x01(\x05R\tavailable\x12!\n" +
+	"\flast_updated\x18\x06 \x01(\x03R\vlastUpdated\x12\x10\n" +
+	"\x03sku\x18\a \x01(\tR\x03sku\x12\x1d\n" +
+	"\n" +
+	"unit_price\x18\b \x01(\x01R\tunitPrice\"\xfa\x01\n" +
+	"\x15CreateTransferRequest\x12.\n" +
+	"\x13source_warehouse_id\x18\x01 \x01(\x03R\x11sourceWarehouseId\x128\n" +
+	"\x18destination_warehouse_id\x18\x02 \x01(\x03R\x16destinationWarehouseId\x12-\n" +
+	"\x05lines\x18\x03 \x03(\v2\x17.warehouse.TransferLineR\x05lines\x12\x12\n" +
+	"\x04note\x18\x04 \x01(\tR\x04note\x12\x1b\n" +
+	"\tdriver_id\x18\x05 \x01(\x03R\bdriverId\x12\x17\n" +
+	"\auser_id\x18\x06 \x01(\x03R\x06userId\"I\n" +
+	"\x16CreateTransferResponse\x12/\n" +
+	"\btransfer\x18\x01 \x01(\v2\x13.warehouse.TransferR\btransfer\"5\n" +
+	"\x12GetTransferRequest\x12\x1f\n" +
+	"\vtransfer_id\x18\x01 \x01(\x03R\n" +
+	"transferId\"F\n" +
+	"\x13GetTransferResponse\x12/\n" +
+	"\btransfer\x18\x01 \x01(\v2\x13.warehouse.TransferR\btransfer\"\x82\x01\n" +
+	"\x14ListTransfersRequest\x12\x16\n" +
+	"\x06status\x18\x01 \x01(\tR\x06status\x12!\n" +
+	"\fwarehouse_id\x18\x02 \x01(\x03R\vwarehouseId\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"\x91\x01\n" +
+	"\x15ListTransfersResponse\x121\n" +
+	"\ttransfers\x18\x01 \x03(\v2\x13.warehouse.TransferR\ttransfers\x12\x14\n" +
+	"\x05total\x18\x02 \x01(\x03R\x05total\x12\x12\n" +
+	"\x04page\x18\x03 \x01(\x05R\x04page\x12\x1b\n" +
+	"\tpage_size\x18\x04 \x01(\x05R\bpageSize\"[\n" +
+	"\x1bAssignTransferDriverRequest\x12\x1f\n" +
+	"\vtransfer_id\x18\x01 \x01(\x03R\n" +
+	"transferId\x12\x1b\n" +
+	"\tdriver_id\x18\x02 \x01(\x03R\bdriverId\"O\n" +
+	"\x1cAssignTransferDriverResponse\x12/\n" +
+	"\btransfer\x18\x01 \x01(\v2\x13.warehouse.TransferR\btransfer\"S\n" +
+	"\x17DispatchTransferRequest\x12\x1f\n" +
+	"\vtransfer_id\x18\x01 \x01(\x03R\n" +
+	"transferId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"K\n" +
+	"\x18DispatchTransferResponse\x12/\n" +
+	"\btransfer\x18\x01 \x01(\v2\x13.warehouse.TransferR\btransfer\"R\n" +
+	"\x16ReceiveTransferRequest\x12\x1f\n" +
+	"\vtransfer_id\x18\x01 \x01(\x03R\n" +
+	"transferId\x12\x17\n" +
+	"\auser_id\x18\x02 \x01(\x03R\x06userId\"J\n" +
+	"\x17ReceiveTransferResponse\x12/\n" +
+	"\btransfer\x18\x01 \x01(\v2\x13.warehouse.TransferR\btransfer\"\xf6\x03\n" +
+	"\bTransfer\x12\x1f\n" +
+	"\vtransfer_id\x18\x01 \x01(\x03R\n" +
+	"transferId\x12.\n" +
+	"\x13source_warehouse_id\x18\x02 \x01(\x03R\x11sourceWarehouseId\x128\n" +
+	"\x18destination_warehouse_id\x18\x03 \x01(\x03R\x16destinationWarehouseId\x12\x16\n" +
+	"\x06status\x18\x04 \x01(\tR\x06status\x12\x1b\n" +
+	"\tdriver_id\x18\x05 \x01(\x03R\bdriverId\x12\x12\n" +
+	"\x04note\x18\x06 \x01(\tR\x04note\x12-\n" +
+	"\x05lines\x18\a \x03(\v2\x17.warehouse.TransferLineR\x05lines\x12\x1d\n" +
+	"\n" +
+	"created_by\x18\b \x01(\x03R\tcreatedBy\x12#\n" +
+	"\rdispatched_by\x18\t \x01(\x03R\fdispatchedBy\x12\x1f\n" +
+	"\vreceived_by\x18\n" +
+	" \x01(\x03R\n" +
+	"receivedBy\x12\x1d\n" +
+	"\n" +
+	"created_at\x18\v \x01(\x03R\tcreatedAt\x12\x1d\n" +
+	"\n" +
+	"updated_at\x18\f \x01(\x03R\tupdatedAt\x12#\n" +
+	"\rdispatched_at\x18\r \x01(\x03R\fdispatchedAt\x12\x1f\n" +
+	"\vreceived_at\x18\x0e \x01(\x03R\n" +
+	"receivedAt\"l\n" +
+	"\fTransferLine\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x01 \x01(\x03R\tproductId\x12!\n" +
+	"\fproduct_name\x18\x02 \x01(\tR\vproductName\x12\x1a\n" +
+	"\bquantity\x18\x03 \x01(\x05R\bquantity\"=\n" +
+	"\x18ListReplenishmentRequest\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\x03R\vwarehouseId\"O\n" +
+	"\x19ListReplenishmentResponse\x122\n" +
+	"\x05items\x18\x01 \x03(\v2\x1c.warehouse.ReplenishmentItemR\x05items\"\x80\x03\n" +
+	"\x11ReplenishmentItem\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\x03R\vwarehouseId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x03R\tproductId\x12\x10\n" +
+	"\x03sku\x18\x03 \x01(\tR\x03sku\x12!\n" +
+	"\fproduct_name\x18\x04 \x01(\tR\vproductName\x12\x1a\n" +
+	"\bquantity\x18\x05 \x01(\x05R\bquantity\x12\x1a\n" +
+	"\breserved\x18\x06 \x01(\x05R\breserved\x12\x1c\n" +
+	"\tavailable\x18\a \x01(\x05R\tavailable\x12#\n" +
+	"\rreorder_point\x18\b \x01(\x05R\freorderPoint\x12)\n" +
+	"\x10reorder_quantity\x18\t \x01(\x05R\x0freorderQuantity\x12-\n" +
+	"\x12suggested_quantity\x18\n" +
+	" \x01(\x05R\x11suggestedQuantity\x12\x1f\n" +
+	"\vdetected_at\x18\v \x01(\x03R\n" +
+	"detectedAt\"\x9e\x01\n" +
+	"\x14ListStockLotsRequest\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\x03R\vwarehouseId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x03R\tproductId\x120\n" +
+	"\x14expiring_within_days\x18\x03 \x01(\x05R\x12expiringWithinDays\x12\x12\n" +
+	"\x04time\x18\x04 \x01(\x03R\x04time\"@\n" +
+	"\x15ListStockLotsResponse\x12'\n" +
+	"\x04lots\x18\x01 \x03(\v2\x13.warehouse.StockLotR\x04lots\"\x9d\x02\n" +
+	"\bStockLot\x12\x15\n" +
+	"\x06lot_id\x18\x01 \x01(\x03R\x05lotId\x12!\n" +
+	"\fwarehouse_id\x18\x02 \x01(\x03R\vwarehouseId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x03 \x01(\x03R\tproductId\x12!\n" +
+	"\fproduct_name\x18\x04 \x01(\tR\vproductName\x12\x1d\n" +
+	"\n" +
+	"lot_number\x18\x05 \x01(\tR\tlotNumber\x12\x1d\n" +
+	"\n" +
+	"expires_at\x18\x06 \x01(\x03R\texpiresAt\x12\x1f\n" +
+	"\vreceived_at\x18\a \x01(\x03R\n" +
+	"receivedAt\x12\x1a\n" +
+	"\bquantity\x18\b \x01(\x05R\bquantity\x12\x1a\n" +
+	"\breserved\x18\t \x01(\x05R\breserved\"\x98\x01\n" +
+	"\x12ImportStockRequest\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\x03R\vwarehouseId\x12-\n" +
+	"\x04rows\x18\x02 \x03(\v2\x19.warehouse.ImportStockRowR\x04rows\x12\x17\n" +
+	"\adry_run\x18\x03 \x01(\bR\x06dryRun\x12\x17\n" +
+	"\auser_id\x18\x04 \x01(\x03R\x06userId\"\xd5\x01\n" +
+	"\x0eImportStockRow\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x10\n" +
+	"\x03sku\x18\x02 \x01(\tR\x03sku\x12!\n" +
+	"\fproduct_name\x18\x03 \x01(\tR\vproductName\x12\x1a\n" +
+	"\bquantity\x18\x04 \x01(\x05R\bquantity\x12;\n" +
+	"\n" +
+	"unit_price\x18\x05 \x01(\v2\x1c.google.protobuf.DoubleValueR\tunitPrice\x12!\n" +
+	"\fwarehouse_id\x18\x06 \x01(\x03R\vwarehouseId\"\x9a\x01\n" +
+	"\x13ImportStockResponse\x12\x18\n" +
+	"\aapplied\x18\x01 \x01(\bR\aapplied\x126\n" +
+	"\aresults\x18\x02 \x03(\v2\x1c.warehouse.ImportStockResultR\aresults\x121\n" +
+	"\x06errors\x18\x03 \x03(\v2\x19.warehouse.ImportRowErrorR\x06errors\"\xb0\x02\n" +
+	"\x11ImportStockResult\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x03R\tproductId\x12\x10\n" +
+	"\x03sku\x18\x03 \x01(\tR\x03sku\x12!\n" +
+	"\fproduct_name\x18\x04 \x01(\tR\vproductName\x12!\n" +
+	"\fold_quantity\x18\x05 \x01(\x05R\voldQuantity\x12!\n" +
+	"\fnew_quantity\x18\x06 \x01(\x05R\vnewQuantity\x12$\n" +
+	"\x0eold_unit_price\x18\a \x01(\x01R\foldUnitPrice\x12$\n" +
+	"\x0enew_unit_price\x18\b \x01(\x01R\fnewUnitPrice\x12!\n" +
+	"\fwarehouse_id\x18\t \x01(\x03R\vwarehouseId\">\n" +
+	"\x0eImportRowError\x12\x12\n" +
+	"\x04line\x18\x01 \x01(\x05R\x04line\x12\x18\n" +
+	"\amessage\x18\x02 \x01(\tR\amessage\"\xa3\x01\n" +
+	"\x1bExportStockMovementsRequest\x12!\n" +
+	"\fwarehouse_id\x18\x01 \x01(\x03R\vwarehouseId\x12\x1d\n" +
+	"\n" +
+	"product_id\x18\x02 \x01(\x03R\tproductId\x12\x16\n" +
+	"\x06reason\x18\x03 \x01(\tR\x06reason\x12\x14\n" +
+	"\x05since\x18\x04 \x01(\x03R\x05since\x12\x14\n" +
+	"\x05until\x18\x05 \x01(\x03R\x05until2\x85\x19\n" +
 	"\x10WarehouseService\x12U\n" +
 	"\x16CheckStockAvailability\x12\x1c.warehouse.CheckStockRequest\x1a\x1d.warehouse.CheckStockResponse\x12Q\n" +
 	"\x11GetWarehouseStock\x12\x16.google.protobuf.Empty\x1a$.warehouse.GetWarehouseStockResponse\x12L\n" +
-	"\vUpdateStock\x12\x1d.warehouse.UpdateStockRequest\x1a\x1e.warehouse.UpdateStockResponseB\fZ\n" +
+	"\vUpdateStock\x12\x1d.warehouse.UpdateStockRequest\x1a\x1e.warehouse.UpdateStockResponse\x12L\n" +
+	"\vReturnStock\x12\x1d.warehouse.ReturnStockRequest\x1a\x1e.warehouse.ReturnStockResponse\x12O\n" +
+	"\fReserveStock\x12\x1e.warehouse.ReserveStockRequest\x1a\x1f.warehouse.ReserveStockResponse\x12^\n" +
+	"\x11CommitReservation\x12#.warehouse.CommitReservationRequest\x1a$.warehouse.CommitReservationResponse\x12a\n" +
+	"\x12ReleaseReservation\x12$.warehouse.ReleaseReservationRequest\x1a%.warehouse.ReleaseReservationResponse\x12R\n" +
+	"\rCreateProduct\x12\x1f.warehouse.CreateProductRequest\x1a .warehouse.CreateProductResponse\x12I\n" +
+	"\n" +
+	"GetProduct\x12\x1c.warehouse.GetProductRequest\x1a\x1d.warehouse.GetProductResponse\x12O\n" +
+	"\fListProducts\x12\x1e.warehouse.ListProductsRequest\x1a\x1f.warehouse.ListProductsResponse\x12R\n" +
+	"\rUpdateProduct\x12\x1f.warehouse.UpdateProductRequest\x1a .warehouse.UpdateProductResponse\x12R\n" +
+	"\rDeleteProduct\x12\x1f.warehouse.DeleteProductRequest\x1a .warehouse.DeleteProductResponse\x12[\n" +
+	"\x10GetProductPrices\x12\".warehouse.GetProductPricesRequest\x1a#.warehouse.GetProductPricesResponse\x12p\n" +
+	"\x17CreateReceivingDocument\x12).warehouse.CreateReceivingDocumentRequest\x1a*.warehouse.CreateReceivingDocumentResponse\x12g\n" +
+	"\x14GetReceivingDocument\x12&.warehouse.GetReceivingDocumentRequest\x1a'.warehouse.GetReceivingDocumentResponse\x12m\n" +
+	"\x16ListReceivingDocuments\x12(.warehouse.ListReceivingDocumentsRequest\x1a).warehouse.ListReceivingDocumentsResponse\x12s\n" +
+	"\x18RecordReceivedQuantities\x12*.warehouse.RecordReceivedQuantitiesRequest\x1a+.warehouse.RecordReceivedQuantitiesResponse\x12j\n" +
+	"\x15PostReceivingDocument\x12'.warehouse.PostReceivingDocumentRequest\x1a(.warehouse.PostReceivingDocumentResponse\x12a\n" +
+	"\x12ListStockMovements\x12$.warehouse.ListStockMovementsRequest\x1a%.warehouse.ListStockMovementsResponse\x12U\n" +
+	"\x0eReconcileStock\x12 .warehouse.ReconcileStockRequest\x1a!.warehouse.ReconcileStockResponse\x12X\n" +
+	"\x0fCreateWarehouse\x12!.warehouse.CreateWarehouseRequest\x1a\".warehouse.CreateWarehouseResponse\x12O\n" +
+	"\fGetWarehouse\x12\x1e.warehouse.GetWarehouseRequest\x1a\x1f.warehouse.GetWarehouseResponse\x12U\n" +
+	"\x0eListWarehouses\x12 .warehouse.ListWarehousesRequest\x1a!.warehouse.ListWarehousesResponse\x12X\n" +
+	"\x0fUpdateWarehouse\x12!.warehouse.UpdateWarehouseRequest\x1a\".warehouse.UpdateWarehouseResponse\x12j\n" +
+	"\x15GetWarehouseInventory\x12'.warehouse.GetWarehouseInventoryRequest\x1a(.warehouse.GetWarehouseInventoryResponse\x12U\n" +
+	"\x0eCreateTransfer\x12 .warehouse.CreateTransferRequest\x1a!.warehouse.CreateTransferResponse\x12L\n" +
+	"\vGetTransfer\x12\x1d.warehouse.GetTransferRequest\x1a\x1e.warehouse.GetTransferResponse\x12R\n" +
+	"\rListTransfers\x12\x1f.warehouse.ListTransfersRequest\x1a .warehouse.ListTransfersResponse\x12g\n" +
+	"\x14AssignTransferDriver\x12&.warehouse.AssignTransferDriverRequest\x1a'.warehouse.AssignTransferDriverResponse\x12[\n" +
+	"\x10DispatchTransfer\x12\".warehouse.DispatchTransferRequest\x1a#.warehouse.DispatchTransferResponse\x12X\n" +
+	"\x0fReceiveTransfer\x12!.warehouse.ReceiveTransferRequest\x1a\".warehouse.ReceiveTransferResponse\x12^\n" +
+	"\x11ListReplenishment\x12#.warehouse.ListReplenishmentRequest\x1a$.warehouse.ListReplenishmentResponse\x12R\n" +
+	"\rListStockLots\x12\x1f.warehouse.ListStockLotsRequest\x1a .warehouse.ListStockLotsResponse\x12L\n" +
+	"\vImportStock\x12\x1d.warehouse.ImportStockRequest\x1a\x1e.warehouse.ImportStockResponse\x12Z\n" +
+	"\x14ExportStockMovements\x12&.warehouse.ExportStockMovementsRequest\x1a\x18.warehouse.StockMovement0\x01B\fZ\n" +
 	"/warehouseb\x06proto3"
 
 var (
@@ -514,34 +6188,230 @@ func file_warehouse_service_warehouse_service_proto_rawDescGZIP() []byte {
 	return file_warehouse_service_warehouse_service_proto_rawDescData
 }
 
-var file_warehouse_service_warehouse_service_proto_msgTypes = make([]protoimpl.MessageInfo, 8)
+var file_warehouse_service_warehouse_service_proto_msgTypes = make([]protoimpl.MessageInfo, 89)
 var file_warehouse_service_warehouse_service_proto_goTypes = []any{
-	(*CheckStockRequest)(nil),         // 0: warehouse.CheckStockRequest
-	(*CheckStockResponse)(nil),        // 1: warehouse.CheckStockResponse
-	(*GetWarehouseStockResponse)(nil), // 2: warehouse.GetWarehouseStockResponse
-	(*UpdateStockRequest)(nil),        // 3: warehouse.UpdateStockRequest
-	(*UpdateStockResponse)(nil),       // 4: warehouse.UpdateStockResponse
-	(*StockItem)(nil),                 // 5: warehouse.StockItem
-	(*StockItemWithWarehouse)(nil),    // 6: warehouse.StockItemWithWarehouse
-	(*Stock)(nil),                     // 7: warehouse.Stock
-	(*emptypb.Empty)(nil),             // 8: google.protobuf.Empty
+	(*CheckStockRequest)(nil),                // 0: warehouse.CheckStockRequest
+	(*CheckStockResponse)(nil),               // 1: warehouse.CheckStockResponse
+	(*GetWarehouseStockResponse)(nil),        // 2: warehouse.GetWarehouseStockResponse
+	(*UpdateStockRequest)(nil),               // 3: warehouse.UpdateStockRequest
+	(*UpdateStockResponse)(nil),              // 4: warehouse.UpdateStockResponse
+	(*ReturnStockRequest)(nil),               // 5: warehouse.ReturnStockRequest
+	(*ReturnStockResponse)(nil),              // 6: warehouse.ReturnStockResponse
+	(*ReserveStockRequest)(nil),              // 7: warehouse.ReserveStockRequest
+	(*ReserveStockResponse)(nil),             // 8: warehouse.ReserveStockResponse
+	(*StockAllocation)(nil),                  // 9: warehouse.StockAllocation
+	(*AllocationLot)(nil),                    // 10: warehouse.AllocationLot
+	(*CommitReservationRequest)(nil),         // 11: warehouse.CommitReservationRequest
+	(*CommitReservationResponse)(nil),        // 12: warehouse.CommitReservationResponse
+	(*ReleaseReservationRequest)(nil),        // 13: warehouse.ReleaseReservationRequest
+	(*ReleaseReservationResponse)(nil),       // 14: warehouse.ReleaseReservationResponse
+	(*CreateProductRequest)(nil),             // 15: warehouse.CreateProductRequest
+	(*CreateProductResponse)(nil),            // 16: warehouse.CreateProductResponse
+	(*GetProductRequest)(nil),                // 17: warehouse.GetProductRequest
+	(*GetProductResponse)(nil),               // 18: warehouse.GetProductResponse
+	(*ListProductsRequest)(nil),              // 19: warehouse.ListProductsRequest
+	(*ListProductsResponse)(nil),             // 20: warehouse.ListProductsResponse
+	(*UpdateProductRequest)(nil),             // 21: warehouse.UpdateProductRequest
+	(*UpdateProductResponse)(nil),            // 22: warehouse.UpdateProductResponse
+	(*DeleteProductRequest)(nil),             // 23: warehouse.DeleteProductRequest
+	(*DeleteProductResponse)(nil),            // 24: warehouse.DeleteProductResponse
+	(*GetProductPricesRequest)(nil),          // 25: warehouse.GetProductPricesRequest
+	(*GetProductPricesResponse)(nil),         // 26: warehouse.GetProductPricesResponse
+	(*Product)(nil),                          // 27: warehouse.Product
+	(*Dimensions)(nil),                       // 28: warehouse.Dimensions
+	(*CreateReceivingDocumentRequest)(nil),   // 29: warehouse.CreateReceivingDocumentRequest
+	(*CreateReceivingDocumentResponse)(nil),  // 30: warehouse.CreateReceivingDocumentResponse
+	(*GetReceivingDocumentRequest)(nil),      // 31: warehouse.GetReceivingDocumentRequest
+	(*GetReceivingDocumentResponse)(nil),     // 32: warehouse.GetReceivingDocumentResponse
+	(*ListReceivingDocumentsRequest)(nil),    // 33: warehouse.ListReceivingDocumentsRequest
+	(*ListReceivingDocumentsResponse)(nil),   // 34: warehouse.ListReceivingDocumentsResponse
+	(*RecordReceivedQuantitiesRequest)(nil),  // 35: warehouse.RecordReceivedQuantitiesRequest
+	(*RecordReceivedQuantitiesResponse)(nil), // 36: warehouse.RecordReceivedQuantitiesResponse
+	(*PostReceivingDocumentRequest)(nil),     // 37: warehouse.PostReceivingDocumentRequest
+	(*PostReceivingDocumentResponse)(nil),    // 38: warehouse.PostReceivingDocumentResponse
+	(*ReceivingDocument)(nil),                // 39: warehouse.ReceivingDocument
+	(*ReceivingLine)(nil),                    // 40: warehouse.ReceivingLine
+	(*ListStockMovementsRequest)(nil),        // 41: warehouse.ListStockMovementsRequest
+	(*ListStockMovementsResponse)(nil),       // 42: warehouse.ListStockMovementsResponse
+	(*StockMovement)(nil),                    // 43: warehouse.StockMovement
+	(*ReconcileStockRequest)(nil),            // 44: warehouse.ReconcileStockRequest
+	(*ReconcileStockResponse)(nil),           // 45: warehouse.ReconcileStockResponse
+	(*StockDiscrepancy)(nil),                 // 46: warehouse.StockDiscrepancy
+	(*StockItem)(nil),                        // 47: warehouse.StockItem
+	(*StockItemWithWarehouse)(nil),           // 48: warehouse.StockItemWithWarehouse
+	(*Stock)(nil),                            // 49: warehouse.Stock
+	(*Location)(nil),                         // 50: warehouse.Location
+	(*Warehouse)(nil),                        // 51: warehouse.Warehouse
+	(*CreateWarehouseRequest)(nil),           // 52: warehouse.CreateWarehouseRequest
+	(*CreateWarehouseResponse)(nil),          // 53: warehouse.CreateWarehouseResponse
+	(*GetWarehouseRequest)(nil),              // 54: warehouse.GetWarehouseRequest
+	(*GetWarehouseResponse)(nil),             // 55: warehouse.GetWarehouseResponse
+	(*ListWarehousesRequest)(nil),            // 56: warehouse.ListWarehousesRequest
+	(*ListWarehousesResponse)(nil),           // 57: warehouse.ListWarehousesResponse
+	(*UpdateWarehouseRequest)(nil),           // 58: warehouse.UpdateWarehouseRequest
+	(*UpdateWarehouseResponse)(nil),          // 59: warehouse.UpdateWarehouseResponse
+	(*GetWarehouseInventoryRequest)(nil),     // 60: warehouse.GetWarehouseInventoryRequest
+	(*GetWarehouseInventoryResponse)(nil),    // 61: warehouse.GetWarehouseInventoryResponse
+	(*WarehouseStockItem)(nil),               // 62: warehouse.WarehouseStockItem
+	(*CreateTransferRequest)(nil),            // 63: warehouse.CreateTransferRequest
+	(*CreateTransferResponse)(nil),           // 64: warehouse.CreateTransferResponse
+	(*GetTransferRequest)(nil),               // 65: warehouse.GetTransferRequest
+	(*GetTransferResponse)(nil),              // 66: warehouse.GetTransferResponse
+	(*ListTransfersRequest)(nil),             // 67: warehouse.ListTransfersRequest
+	(*ListTransfersResponse)(nil),            // 68: warehouse.ListTransfersResponse
+	(*AssignTransferDriverRequest)(nil),      // 69: warehouse.AssignTransferDriverRequest
+	(*AssignTransferDriverResponse)(nil),     // 70: warehouse.AssignTransferDriverResponse
+	(*DispatchTransferRequest)(nil),          // 71: warehouse.DispatchTransferRequest
+	(*DispatchTransferResponse)(nil),         // 72: warehouse.DispatchTransferResponse
+	(*ReceiveTransferRequest)(nil),           // 73: warehouse.ReceiveTransferRequest
+	(*ReceiveTransferResponse)(nil),          // 74: warehouse.ReceiveTransferResponse
+	(*Transfer)(nil),                         // 75: warehouse.Transfer
+	(*TransferLine)(nil),                     // 76: warehouse.TransferLine
+	(*ListReplenishmentRequest)(nil),         // 77: warehouse.ListReplenishmentRequest
+	(*ListReplenishmentResponse)(nil),        // 78: warehouse.ListReplenishmentResponse
+	(*ReplenishmentItem)(nil),                // 79: warehouse.ReplenishmentItem
+	(*ListStockLotsRequest)(nil),             // 80: warehouse.ListStockLotsRequest
+	(*ListStockLotsResponse)(nil),            // 81: warehouse.ListStockLotsResponse
+	(*StockLot)(nil),                         // 82: warehouse.StockLot
+	(*ImportStockRequest)(nil),               // 83: warehouse.ImportStockRequest
+	(*ImportStockRow)(nil),                   // 84: warehouse.ImportStockRow
+	(*ImportStockResponse)(nil),              // 85: warehouse.ImportStockResponse
+	(*ImportStockResult)(nil),                // 86: warehouse.ImportStockResult
+	(*ImportRowError)(nil),                   // 87: warehouse.ImportRowError
+	(*ExportStockMovementsRequest)(nil),      // 88: warehouse.ExportStockMovementsRequest
+	(*wrapperspb.BoolValue)(nil),             // 89: google.protobuf.BoolValue
+	(*wrapperspb.DoubleValue)(nil),           // 90: google.protobuf.DoubleValue
+	(*emptypb.Empty)(nil),                    // 91: google.protobuf.Empty
 }
 var file_warehouse_service_warehouse_service_proto_depIdxs = []int32{
-	5, // 0: warehouse.CheckStockRequest.items:type_name -> warehouse.StockItem
-	6, // 1: warehouse.CheckStockResponse.items:type_name -> warehouse.StockItemWithWarehouse
-	7, // 2: warehouse.GetWarehouseStockResponse.stocks:type_name -> warehouse.Stock
-	5, // 3: warehouse.UpdateStockRequest.items:type_name -> warehouse.StockItem
-	0, // 4: warehouse.WarehouseService.CheckStockAvailability:input_type -> warehouse.CheckStockRequest
-	8, // 5: warehouse.WarehouseService.GetWarehouseStock:input_type -> google.protobuf.Empty
-	3, // 6: warehouse.WarehouseService.UpdateStock:input_type -> warehouse.UpdateStockRequest
-	1, // 7: warehouse.WarehouseService.CheckStockAvailability:output_type -> warehouse.CheckStockResponse
-	2, // 8: warehouse.WarehouseService.GetWarehouseStock:output_type -> warehouse.GetWarehouseStockResponse
-	4, // 9: warehouse.WarehouseService.UpdateStock:output_type -> warehouse.UpdateStockResponse
-	7, // [7:10] is the sub-list for method output_type
-	4, // [4:7] is the sub-list for method input_type
-	4, // [4:4] is the sub-list for extension type_name
-	4, // [4:4] is the sub-list for extension extendee
-	0, // [0:4] is the sub-list for field type_name
+	47, // 0: warehouse.CheckStockRequest.items:type_name -> warehouse.StockItem
+	48, // 1: warehouse.CheckStockResponse.items:type_name -> warehouse.StockItemWithWarehouse
+	49, // 2: warehouse.GetWarehouseStockResponse.stocks:type_name -> warehouse.Stock
+	47, // 3: warehouse.UpdateStockRequest.items:type_name -> warehouse.StockItem
+	47, // 4: warehouse.ReturnStockRequest.items:type_name -> warehouse.StockItem
+	47, // 5: warehouse.ReserveStockRequest.items:type_name -> warehouse.StockItem
+	50, // 6: warehouse.ReserveStockRequest.delivery_location:type_name -> warehouse.Location
+	9,  // 7: warehouse.ReserveStockResponse.allocations:type_name -> warehouse.StockAllocation
+	10, // 8: warehouse.StockAllocation.lots:type_name -> warehouse.AllocationLot
+	28, // 9: warehouse.CreateProductRequest.dimensions:type_name -> warehouse.Dimensions
+	27, // 10: warehouse.CreateProductResponse.product:type_name -> warehouse.Product
+	27, // 11: warehouse.GetProductResponse.product:type_name -> warehouse.Product
+	27, // 12: warehouse.ListProductsResponse.products:type_name -> warehouse.Product
+	28, // 13: warehouse.UpdateProductRequest.dimensions:type_name -> warehouse.Dimensions
+	89, // 14: warehouse.UpdateProductRequest.active:type_name -> google.protobuf.BoolValue
+	27, // 15: warehouse.UpdateProductResponse.product:type_name -> warehouse.Product
+	27, // 16: warehouse.DeleteProductResponse.product:type_name -> warehouse.Product
+	27, // 17: warehouse.GetProductPricesResponse.products:type_name -> warehouse.Product
+	28, // 18: warehouse.Product.dimensions:type_name -> warehouse.Dimensions
+	40, // 19: warehouse.CreateReceivingDocumentRequest.lines:type_name -> warehouse.ReceivingLine
+	39, // 20: warehouse.CreateReceivingDocumentResponse.document:type_name -> warehouse.ReceivingDocument
+	39, // 21: warehouse.GetReceivingDocumentResponse.document:type_name -> warehouse.ReceivingDocument
+	39, // 22: warehouse.ListReceivingDocumentsResponse.documents:type_name -> warehouse.ReceivingDocument
+	40, // 23: warehouse.RecordReceivedQuantitiesRequest.lines:type_name -> warehouse.ReceivingLine
+	39, // 24: warehouse.RecordReceivedQuantitiesResponse.document:type_name -> warehouse.ReceivingDocument
+	39, // 25: warehouse.PostReceivingDocumentResponse.document:type_name -> warehouse.ReceivingDocument
+	40, // 26: warehouse.ReceivingDocument.lines:type_name -> warehouse.ReceivingLine
+	43, // 27: warehouse.ListStockMovementsResponse.movements:type_name -> warehouse.StockMovement
+	46, // 28: warehouse.ReconcileStockResponse.discrepancies:type_name -> warehouse.StockDiscrepancy
+	50, // 29: warehouse.Warehouse.location:type_name -> warehouse.Location
+	50, // 30: warehouse.CreateWarehouseRequest.location:type_name -> warehouse.Location
+	51, // 31: warehouse.CreateWarehouseResponse.warehouse:type_name -> warehouse.Warehouse
+	51, // 32: warehouse.GetWarehouseResponse.warehouse:type_name -> warehouse.Warehouse
+	51, // 33: warehouse.ListWarehousesResponse.warehouses:type_name -> warehouse.Warehouse
+	50, // 34: warehouse.UpdateWarehouseRequest.location:type_name -> warehouse.Location
+	89, // 35: warehouse.UpdateWarehouseRequest.active:type_name -> google.protobuf.BoolValue
+	51, // 36: warehouse.UpdateWarehouseResponse.warehouse:type_name -> warehouse.Warehouse
+	51, // 37: warehouse.GetWarehouseInventoryResponse.warehouse:type_name -> warehouse.Warehouse
+	62, // 38: warehouse.GetWarehouseInventoryResponse.items:type_name -> warehouse.WarehouseStockItem
+	76, // 39: warehouse.CreateTransferRequest.lines:type_name -> warehouse.TransferLine
+	75, // 40: warehouse.CreateTransferResponse.transfer:type_name -> warehouse.Transfer
+	75, // 41: warehouse.GetTransferResponse.transfer:type_name -> warehouse.Transfer
+	75, // 42: warehouse.ListTransfersResponse.transfers:type_name -> warehouse.Transfer
+	75, // 43: warehouse.AssignTransferDriverResponse.transfer:type_name -> warehouse.Transfer
+	75, // 44: warehouse.DispatchTransferResponse.transfer:type_name -> warehouse.Transfer
+	75, // 45: warehouse.ReceiveTransferResponse.transfer:type_name -> warehouse.Transfer
+	76, // 46: warehouse.Transfer.lines:type_name -> warehouse.TransferLine
+	79, // 47: warehouse.ListReplenishmentResponse.items:type_name -> warehouse.ReplenishmentItem
+	82, // 48: warehouse.ListStockLotsResponse.lots:type_name -> warehouse.StockLot
+	84, // 49: warehouse.ImportStockRequest.rows:type_name -> warehouse.ImportStockRow
+	90, // 50: warehouse.ImportStockRow.unit_price:type_name -> google.protobuf.DoubleValue
+	86, // 51: warehouse.ImportStockResponse.results:type_name -> warehouse.ImportStockResult
+	87, // 52: warehouse.ImportStockResponse.errors:type_name -> warehouse.ImportRowError
+	0,  // 53: warehouse.WarehouseService.CheckStockAvailability:input_type -> warehouse.CheckStockRequest
+	91, // 54: warehouse.WarehouseService.GetWarehouseStock:input_type -> google.protobuf.Empty
+	3,  // 55: warehouse.WarehouseService.UpdateStock:input_type -> warehouse.UpdateStockRequest
+	5,  // 56: warehouse.WarehouseService.ReturnStock:input_type -> warehouse.ReturnStockRequest
+	7,  // 57: warehouse.WarehouseService.ReserveStock:input_type -> warehouse.ReserveStockRequest
+	11, // 58: warehouse.WarehouseService.CommitReservation:input_type -> warehouse.CommitReservationRequest
+	13, // 59: warehouse.WarehouseService.ReleaseReservation:input_type -> warehouse.ReleaseReservationRequest
+	15, // 60: warehouse.WarehouseService.CreateProduct:input_type -> warehouse.CreateProductRequest
+	17, // 61: warehouse.WarehouseService.GetProduct:input_type -> warehouse.GetProductRequest
+	19, // 62: warehouse.WarehouseService.ListProducts:input_type -> warehouse.ListProductsRequest
+	21, // 63: warehouse.WarehouseService.UpdateProduct:input_type -> warehouse.UpdateProductRequest
+	23, // 64: warehouse.WarehouseService.DeleteProduct:input_type -> warehouse.DeleteProductRequest
+	25, // 65: warehouse.WarehouseService.GetProductPrices:input_type -> warehouse.GetProductPricesRequest
+	29, // 66: warehouse.WarehouseService.CreateReceivingDocument:input_type -> warehouse.CreateReceivingDocumentRequest
+	31, // 67: warehouse.WarehouseService.GetReceivingDocument:input_type -> warehouse.GetReceivingDocumentRequest
+	33, // 68: warehouse.WarehouseService.ListReceivingDocuments:input_type -> warehouse.ListReceivingDocumentsRequest
+	35, // 69: warehouse.WarehouseService.RecordReceivedQuantities:input_type -> warehouse.RecordReceivedQuantitiesRequest
+	37, // 70: warehouse.WarehouseService.PostReceivingDocument:input_type -> warehouse.PostReceivingDocumentRequest
+	41, // 71: warehouse.WarehouseService.ListStockMovements:input_type -> warehouse.ListStockMovementsRequest
+	44, // 72: warehouse.WarehouseService.ReconcileStock:input_type -> warehouse.ReconcileStockRequest
+	52, // 73: warehouse.WarehouseService.CreateWarehouse:input_type -> warehouse.CreateWarehouseRequest
+	54, // 74: warehouse.WarehouseService.GetWarehouse:input_type -> warehouse.GetWarehouseRequest
+	56, // 75: warehouse.WarehouseService.ListWarehouses:input_type -> warehouse.ListWarehousesRequest
+	58, // 76: warehouse.WarehouseService.UpdateWarehouse:input_type -> warehouse.UpdateWarehouseRequest
+	60, // 77: warehouse.WarehouseService.GetWarehouseInventory:input_type -> warehouse.GetWarehouseInventoryRequest
+	63, // 78: warehouse.WarehouseService.CreateTransfer:input_type -> warehouse.CreateTransferRequest
+	65, // 79: warehouse.WarehouseService.GetTransfer:input_type -> warehouse.GetTransferRequest
+	67, // 80: warehouse.WarehouseService.ListTransfers:input_type -> warehouse.ListTransfersRequest
+	69, // 81: warehouse.WarehouseService.AssignTransferDriver:input_type -> warehouse.AssignTransferDriverRequest
+	71, // 82: warehouse.WarehouseService.DispatchTransfer:input_type -> warehouse.DispatchTransferRequest
+	73, // 83: warehouse.WarehouseService.ReceiveTransfer:input_type -> warehouse.ReceiveTransferRequest
+	77, // 84: warehouse.WarehouseService.ListReplenishment:input_type -> warehouse.ListReplenishmentRequest
+	80, // 85: warehouse.WarehouseService.ListStockLots:input_type -> warehouse.ListStockLotsRequest
+	83, // 86: warehouse.WarehouseService.ImportStock:input_type -> warehouse.ImportStockRequest
+	88, // 87: warehouse.WarehouseService.ExportStockMovements:input_type -> warehouse.ExportStockMovementsRequest
+	1,  // 88: warehouse.WarehouseService.CheckStockAvailability:output_type -> warehouse.CheckStockResponse
+	2,  // 89: warehouse.WarehouseService.GetWarehouseStock:output_type -> warehouse.GetWarehouseStockResponse
+	4,  // 90: warehouse.WarehouseService.UpdateStock:output_type -> warehouse.UpdateStockResponse
+	6,  // 91: warehouse.WarehouseService.ReturnStock:output_type -> warehouse.ReturnStockResponse
+	8,  // 92: warehouse.WarehouseService.ReserveStock:output_type -> warehouse.ReserveStockResponse
+	12, // 93: warehouse.WarehouseService.CommitReservation:output_type -> warehouse.CommitReservationResponse
+	14, // 94: warehouse.WarehouseService.ReleaseReservation:output_type -> warehouse.ReleaseReservationResponse
+	16, // 95: warehouse.WarehouseService.CreateProduct:output_type -> warehouse.CreateProductResponse
+	18, // 96: warehouse.WarehouseService.GetProduct:output_type -> warehouse.GetProductResponse
+	20, // 97: warehouse.WarehouseService.ListProducts:output_type -> warehouse.ListProductsResponse
+	22, // 98: warehouse.WarehouseService.UpdateProduct:output_type -> warehouse.UpdateProductResponse
+	24, // 99: warehouse.WarehouseService.DeleteProduct:output_type -> warehouse.DeleteProductResponse
+	26, // 100: warehouse.WarehouseService.GetProductPrices:output_type -> warehouse.GetProductPricesResponse
+	30, // 101: warehouse.WarehouseService.CreateReceivingDocument:output_type -> warehouse.CreateReceivingDocumentResponse
+	32, // 102: warehouse.WarehouseService.GetReceivingDocument:output_type -> warehouse.GetReceivingDocumentResponse
+	34, // 103: warehouse.WarehouseService.ListReceivingDocuments:output_type -> warehouse.ListReceivingDocumentsResponse
+	36, // 104: warehouse.WarehouseService.RecordReceivedQuantities:output_type -> warehouse.RecordReceivedQuantitiesResponse
+	38, // 105: warehouse.WarehouseService.PostReceivingDocument:output_type -> warehouse.PostReceivingDocumentResponse
+	42, // 106: warehouse.WarehouseService.ListStockMovements:output_type -> warehouse.ListStockMovementsResponse
+	45, // 107: warehouse.WarehouseService.ReconcileStock:output_type -> warehouse.ReconcileStockResponse
+	53, // 108: warehouse.WarehouseService.CreateWarehouse:output_type -> warehouse.CreateWarehouseResponse
+	55, // 109: warehouse.WarehouseService.GetWarehouse:output_type -> warehouse.GetWarehouseResponse
+	57, // 110: warehouse.WarehouseService.ListWarehouses:output_type -> warehouse.ListWarehousesResponse
+	59, // 111: warehouse.WarehouseService.UpdateWarehouse:output_type -> warehouse.UpdateWarehouseResponse
+	61, // 112: warehouse.WarehouseService.GetWarehouseInventory:output_type -> warehouse.GetWarehouseInventoryResponse
+	64, // 113: warehouse.WarehouseService.CreateTransfer:output_type -> warehouse.CreateTransferResponse
+	66, // 114: warehouse.WarehouseService.GetTransfer:output_type -> warehouse.GetTransferResponse
+	68, // 115: warehouse.WarehouseService.ListTransfers:output_type -> warehouse.ListTransfersResponse
+	70, // 116: warehouse.WarehouseService.AssignTransferDriver:output_type -> warehouse.AssignTransferDriverResponse
+	72, // 117: warehouse.WarehouseService.DispatchTransfer:output_type -> warehouse.DispatchTransferResponse
+	74, // 118: warehouse.WarehouseService.ReceiveTransfer:output_type -> warehouse.ReceiveTransferResponse
+	78, // 119: warehouse.WarehouseService.ListReplenishment:output_type -> warehouse.ListReplenishmentResponse
+	81, // 120: warehouse.WarehouseService.ListStockLots:output_type -> warehouse.ListStockLotsResponse
+	85, // 121: warehouse.WarehouseService.ImportStock:output_type -> warehouse.ImportStockResponse
+	43, // 122: warehouse.WarehouseService.ExportStockMovements:output_type -> warehouse.StockMovement
+	88, // [88:123] is the sub-list for method output_type
+	53, // [53:88] is the sub-list for method input_type
+	53, // [53:53] is the sub-list for extension type_name
+	53, // [53:53] is the sub-list for extension extendee
+	0,  // [0:53] is the sub-list for field type_name
 }
 
 func init() { file_warehouse_service_warehouse_service_proto_init() }
@@ -555,7 +6425,7 @@ func file_warehouse_service_warehouse_service_proto_init() {
 			GoPackagePath: reflect.TypeOf(x{}).PkgPath(),
 			RawDescriptor: unsafe.Slice(unsafe.StringData(file_warehouse_service_warehouse_service_proto_rawDesc), len(file_warehouse_service_warehouse_service_proto_rawDesc)),
 			NumEnums:      0,
-			NumMessages:   8,
+			NumMessages:   89,
 			NumExtensions: 0,
 			NumServices:   1,
 		},
@@ -2,11 +2,14 @@ package main
 
 import (
 	"context"
+	"errors"
 	authservice_config "logistics/configs/auth-service"
 	auth_grpc_server "logistics/internal/services/auth-service/grpc"
 	"logistics/internal/services/auth-service/grpc/app"
 	auth_grpc_repository "logistics/internal/services/auth-service/grpc/repository"
 	"logistics/pkg/database/postgres"
+	"logistics/pkg/lib/accesstoken"
+	"logistics/pkg/lib/hasher"
 	"logistics/pkg/lib/logger/slogger"
 	"os"
 )
@@ -33,8 +36,38 @@ func main() {
 	dbpool := db.GetPool()
 	defer db.Close()
 
+	// SECRET_HASH нужен только для проверки паролей, сохраненных до перехода на argon2id
+	passwordHasher, err := hasher.New(authGRPCServiceConfig.PasswordHashing, os.Getenv("SECRET_HASH"))
+	if err != nil {
+		log.Error("Failed to configure password hashing", slogger.Err(err))
+		os.Exit(1)
+	}
+
+	// Без ключей сервис не стартует: временный ключ в памяти допустим только при явном allow_ephemeral_key
+	var keyset *accesstoken.Keyset
+	switch {
+	case len(authGRPCServiceConfig.TokenSigning.Keys) > 0:
+		keyset, err = accesstoken.NewKeyset(authGRPCServiceConfig.TokenSigning)
+	case authGRPCServiceConfig.TokenSigning.AllowEphemeralKey:
+		log.Warn("No token signing keys configured, using an in-memory key: access tokens will not survive a restart")
+		keyset, err = accesstoken.GenerateKeyset()
+	default:
+		err = errors.New("no token signing keys configured, set token_signing.keys or token_signing.allow_ephemeral_key for local development")
+	}
+	if err != nil {
+		log.Error("Failed to load token signing keys", slogger.Err(err))
+		os.Exit(1)
+	}
+	log.Info("Token signing keys loaded", "active_kid", keyset.ActiveKeyID())
+
 	authGRPCRepository := auth_grpc_repository.NewAuthRepository(dbpool)
-	authGRPCService := auth_grpc_server.NewAuthGRPCService(log, authGRPCRepository)
+	authGRPCService := auth_grpc_server.NewAuthGRPCService(log, authGRPCRepository, passwordHasher, keyset)
+	// BOOTSTRAP_ADMIN_EMAIL делает администратором уже зарегистрированного пользователя: так появляется первый администратор
+	if email := os.Getenv("BOOTSTRAP_ADMIN_EMAIL"); email != "" {
+		if err := authGRPCService.BootstrapAdmin(ctx, email); err != nil {
+			log.Warn("Failed to grant bootstrap admin role, sign up this user and restart the service", slogger.Err(err))
+		}
+	}
 	authGRPCApp := app.NewApp(log, authGRPCService, authGRPCServiceConfig)
 	log.Info("Auth service configuration loaded successfully", "address", authGRPCServiceConfig.Address)
 
@@ -4,7 +4,10 @@ import (
 	"context"
 	"logistics/internal/kafka"
 	"logistics/internal/services/driver-service/grpc/app"
+	"logistics/internal/services/driver-service/matching"
 	"logistics/internal/services/driver-service/repository"
+	"logistics/internal/shared/entity"
+	"logistics/pkg/cache/redis"
 	"logistics/pkg/database/postgres"
 	"logistics/pkg/lib/logger/slogger"
 	"os"
@@ -36,6 +39,13 @@ func main() {
 
 	dbpool := db.GetPool()
 
+	redis, err := redis.NewRedisClient(driverGRPCServiceConfig.RedisConfig)
+	if err != nil {
+		log.Error("Failed to connect to Redis", slogger.Err(err))
+		os.Exit(1)
+	}
+	defer redis.Close()
+
 	kafkaProducer := kafka.NewKafkaProducer(driverGRPCServiceConfig.KafkaConfig, log)
 	if !kafkaProducer.IsHealthy() {
 		log.Error("Kafka is not available. Cannot start service.")
@@ -53,7 +63,24 @@ func main() {
 	log.Info("Kafka producer initialized", "brokers", driverGRPCServiceConfig.KafkaConfig.Brokers, "topic", driverGRPCServiceConfig.KafkaConfig.Topic)
 
 	driverGRPCRepository := repository.NewDriverRepository(dbpool)
-	driverGRPCService := driverservice.NewDriverGRPCService(log, driverGRPCRepository, kafkaProducer)
+	matchingConfig := driverGRPCServiceConfig.Matching
+	strategy, err := matching.NewStrategy(matchingConfig.Strategy)
+	if err != nil {
+		log.Error("Failed to configure driver matching", slogger.Err(err))
+		os.Exit(1)
+	}
+	var pickup *entity.Location
+	if matchingConfig.PickupLatitude != 0 || matchingConfig.PickupLongitude != 0 {
+		pickup = &entity.Location{Latitude: matchingConfig.PickupLatitude, Longitude: matchingConfig.PickupLongitude}
+	}
+	log.Info("Driver matching configured", "strategy", matchingConfig.Strategy)
+
+	driverGRPCService := driverservice.NewDriverGRPCService(log, driverGRPCRepository, strategy, pickup, redis.Client)
+
+	// События водителей публикуются из outbox после фиксации транзакций
+	relayCtx, stopRelay := context.WithCancel(ctx)
+	defer stopRelay()
+	go kafka.NewOutboxRelay(log, dbpool, kafka.DriverOutboxTable, kafkaProducer).Run(relayCtx)
 
 	driverGRPCApp := app.NewApp(log, driverGRPCService, driverGRPCServiceConfig)
 	log.Info("Driver service started successfully", "address", driverGRPCServiceConfig.Address)
@@ -2,15 +2,26 @@ package main
 
 import (
 	"context"
+	driverpb "logistics/api/protobuf/driver_service"
+	warehousepb "logistics/api/protobuf/warehouse_service"
+	driverservice_config "logistics/configs/driver-service"
 	orderservice_config "logistics/configs/order-service"
+	warehouseservice_config "logistics/configs/warehouse-service"
 	"logistics/internal/kafka"
 	orderservice "logistics/internal/services/order-service"
+	"logistics/internal/services/order-service/assignment"
 	"logistics/internal/services/order-service/grpc/app"
 	"logistics/internal/services/order-service/repository"
+	"logistics/internal/services/order-service/saga"
+	"logistics/internal/services/order-service/tracking"
 	"logistics/pkg/cache/redis"
 	"logistics/pkg/database/postgres"
 	"logistics/pkg/lib/logger/slogger"
 	"os"
+	"time"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 func main() {
@@ -53,8 +64,76 @@ func main() {
 		os.Exit(1)
 	}
 
+	// События заказов идут в отдельный топик, чтобы не смешиваться с сообщениями водителей
+	eventsKafkaConfig := orderGRPCServiceConfig.KafkaConfig
+	eventsKafkaConfig.Topic = eventsKafkaConfig.EventsTopic
+	if err := kafka.EnsureTopicExists(ctx, eventsKafkaConfig, log); err != nil {
+		log.Error("Failed to ensure Kafka events topic exists", slogger.Err(err))
+		os.Exit(1)
+	}
+	kafkaProducer := kafka.NewKafkaProducer(eventsKafkaConfig, log)
+	if kafkaProducer == nil {
+		log.Error("Kafka is not available. Cannot start service.")
+		os.Exit(1)
+	}
+	defer kafkaProducer.Close()
+
+	warehouseGRPCServiceConfig, err := warehouseservice_config.LoadWarehouseGRPCServiceConfig("configs/warehouse-service/warehouse_service_config.yaml")
+	if err != nil {
+		log.Error("Failed to load warehouse service configuration", slogger.Err(err))
+		os.Exit(1)
+	}
+	warehouseGRPCConn, err := grpc.NewClient(warehouseGRPCServiceConfig.Address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Error("Failed to create gRPC client for warehouse service", slogger.Err(err))
+		os.Exit(1)
+	}
+	defer warehouseGRPCConn.Close()
+
+	driverGRPCServiceConfig, err := driverservice_config.LoadDriverGRPCServiceConfig("configs/driver-service/driver_service_config.yaml")
+	if err != nil {
+		log.Error("Failed to load driver service configuration", slogger.Err(err))
+		os.Exit(1)
+	}
+	driverGRPCConn, err := grpc.NewClient(driverGRPCServiceConfig.Address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Error("Failed to create gRPC client for driver service", slogger.Err(err))
+		os.Exit(1)
+	}
+	defer driverGRPCConn.Close()
+
+	warehouseGRPCClient := warehousepb.NewWarehouseServiceClient(warehouseGRPCConn)
+	driverGRPCClient := driverpb.NewDriverServiceClient(driverGRPCConn)
+
 	orderGRPCRepository := repository.NewOrderRepository(dbpool)
-	orderGRPCService := orderservice.NewOrderGRPCService(log, orderGRPCRepository, kafkaConsumer, redis.Client)
+	createOrderSaga := saga.NewCreateOrderSaga(log, orderGRPCRepository, orderGRPCRepository, warehouseGRPCClient)
+	// Доводим до конца или компенсируем саги, прерванные предыдущим запуском
+	recoverCtx, recoverCancel := context.WithTimeout(ctx, 30*time.Second)
+	if err := createOrderSaga.Recover(recoverCtx); err != nil {
+		log.Error("Failed to recover order sagas", slogger.Err(err))
+	}
+	recoverCancel()
+
+	workersCtx, stopWorkers := context.WithCancel(ctx)
+	defer stopWorkers()
+	// Компенсации, не прошедшие из-за недоступного склада, повторяются без перезапуска сервиса
+	go createOrderSaga.RetryCompensations(workersCtx)
+	go kafka.NewOutboxRelay(log, dbpool, kafka.OrderOutboxTable, kafkaProducer).ForEventTypes(kafka.EventOrderStatusChanged).Run(workersCtx)
+	// Команды складу и driver-service из того же outbox исполняются по gRPC с повторами
+	commands := orderservice.NewCommandHandler(log, warehouseGRPCClient, driverGRPCClient)
+	go kafka.NewOutboxDispatcher(log, dbpool, kafka.OrderOutboxTable, commands.Handle).ForEventTypes(orderservice.CommandTypes...).Run(workersCtx)
+
+	// Назначение водителей асинхронное: воркер запрашивает водителей, консьюмер применяет ответы
+	assignments := assignment.NewManager(log, orderGRPCRepository, orderGRPCRepository, driverGRPCClient, redis.Client, assignment.DefaultMaxAge)
+	go assignments.RunWorker(workersCtx)
+	go assignment.NewConsumer(log, kafkaConsumer, assignments).Run(workersCtx)
+
+	// Переходы статуса для WatchOrder приходят через LISTEN/NOTIFY, без опроса базы
+	tracker := tracking.NewHub(log, dbpool)
+	go tracker.Run(workersCtx)
+
+	orderGRPCService := orderservice.NewOrderGRPCService(log, orderGRPCRepository, assignments, redis.Client, warehouseGRPCClient, driverGRPCClient, createOrderSaga, tracker)
+
 	orderGRPCApp := app.NewApp(log, orderGRPCService, orderGRPCServiceConfig)
 	log.Info("Auth service configuration loaded successfully", "address", orderGRPCServiceConfig.Address)
 	log.Info("KafkaConfigGroup", "group", orderGRPCServiceConfig.KafkaConfig.Group_id)
@@ -2,13 +2,21 @@ package main
 
 import (
 	"context"
+	driverpb "logistics/api/protobuf/driver_service"
+	driverservice_config "logistics/configs/driver-service"
 	warehouseservice_config "logistics/configs/warehouse-service"
+	"logistics/internal/kafka"
 	warehouseservice "logistics/internal/services/warehouse-service"
 	"logistics/internal/services/warehouse-service/grpc/app"
+	"logistics/internal/services/warehouse-service/replenishment"
 	"logistics/internal/services/warehouse-service/repository"
+	"logistics/internal/services/warehouse-service/reservation"
 	"logistics/pkg/database/postgres"
 	"logistics/pkg/lib/logger/slogger"
 	"os"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
 )
 
 func main() {
@@ -34,9 +42,43 @@ func main() {
 	}
 	defer db.Close()
 
+	// Водители для перемещений между складами берутся из driver-service
+	driverGRPCServiceConfig, err := driverservice_config.LoadDriverGRPCServiceConfig("configs/driver-service/driver_service_config.yaml")
+	if err != nil {
+		log.Error("Failed to load driver service configuration", slogger.Err(err))
+		os.Exit(1)
+	}
+	driverGRPCConn, err := grpc.NewClient(driverGRPCServiceConfig.Address, grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		log.Error("Failed to create gRPC client for driver service", slogger.Err(err))
+		os.Exit(1)
+	}
+	defer driverGRPCConn.Close()
+	driverGRPCClient := driverpb.NewDriverServiceClient(driverGRPCConn)
+
+	// События склада (stock.low) публикуются из outbox
+	if err := kafka.EnsureTopicExists(ctx, warehouseGRPCServiceConfig.KafkaConfig, log); err != nil {
+		log.Error("Failed to ensure Kafka topic exists", slogger.Err(err))
+		os.Exit(1)
+	}
+	kafkaProducer := kafka.NewKafkaProducer(warehouseGRPCServiceConfig.KafkaConfig, log)
+	if kafkaProducer == nil {
+		log.Error("Kafka is not available. Cannot start service.")
+		os.Exit(1)
+	}
+	defer kafkaProducer.Close()
+
 	dbpool := db.GetPool()
 	warehouseGRPCRepository := repository.NewWarehouseRepository(dbpool)
-	warehouseGRPCService := warehouseservice.NewWarehouseGRPCService(log, warehouseGRPCRepository)
+	lowStockMonitor := replenishment.NewMonitor(log, warehouseGRPCRepository, replenishment.DefaultCheckInterval)
+	warehouseGRPCService := warehouseservice.NewWarehouseGRPCService(log, warehouseGRPCRepository, driverGRPCClient, lowStockMonitor)
+
+	// Фоновые задачи: снятие истекших резервов, поиск товаров ниже точки заказа и отправка событий
+	workersCtx, stopWorkers := context.WithCancel(ctx)
+	defer stopWorkers()
+	go reservation.NewSweeper(log, warehouseGRPCRepository, reservation.DefaultSweepInterval).Run(workersCtx)
+	go lowStockMonitor.Run(workersCtx)
+	go kafka.NewOutboxRelay(log, dbpool, kafka.WarehouseOutboxTable, kafkaProducer).Run(workersCtx)
 
 	warehouseGRPCApp := app.NewApp(log, warehouseGRPCService, warehouseGRPCServiceConfig)
 	log.Info("Warehouse service configuration loaded successfully", "address", warehouseGRPCServiceConfig.Address)
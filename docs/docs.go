@@ -24,33 +24,106 @@ const docTemplate = `{
     "host": "{{.Host}}",
     "basePath": "{{.BasePath}}",
     "paths": {
-        "/auth/logout": {
-            "post": {
+        "/admin/drivers": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Выполняет выход пользователя и удаляет refresh token",
+                "description": "Возвращает страницу водителей с фильтром по статусу. Уволенные водители скрыты, пока не передан include_inactive",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "admin"
+                ],
+                "summary": "Список водителей",
+                "parameters": [
+                    {
+                        "enum": [
+                            "offline",
+                            "available",
+                            "busy",
+                            "break",
+                            "unavailable"
+                        ],
+                        "type": "string",
+                        "description": "Статус водителя",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Показывать уволенных водителей",
+                        "name": "include_inactive",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Номер страницы",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Размер страницы (до 100)",
+                        "name": "page_size",
+                        "in": "query"
+                    }
                 ],
-                "summary": "Выход из системы",
                 "responses": {
                     "200": {
-                        "description": "OK",
+                        "description": "Страница водителей",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "drivers": {
+                                    "type": "array",
+                                    "items": {
+                                        "$ref": "#/definitions/entity.Driver"
+                                    }
+                                },
+                                "page": {
+                                    "type": "integer"
+                                },
+                                "page_size": {
+                                    "type": "integer"
+                                },
+                                "total": {
+                                    "type": "integer",
+                                    "format": "int64"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректные параметры",
                         "schema": {
                             "type": "object",
                             "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
                                 "message": {
                                     "type": "string"
                                 }
                             }
                         }
                     },
+                    "403": {
+                        "description": "Нужна роль администратора или диспетчера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
                     "500": {
                         "description": "Ошибка сервера",
                         "schema": {
@@ -58,16 +131,22 @@ const docTemplate = `{
                             "properties": {
                                 "error": {
                                     "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
                                 }
                             }
                         }
                     }
                 }
-            }
-        },
-        "/auth/sign-in": {
+            },
             "post": {
-                "description": "Выполняет вход пользователя и возвращает токены",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Регистрирует нового водителя. Телефон и номер удостоверения должны быть уникальными",
                 "consumes": [
                     "application/json"
                 ],
@@ -75,25 +154,30 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "admin"
                 ],
-                "summary": "Аутентификация пользователя",
+                "summary": "Создание водителя",
                 "parameters": [
                     {
-                        "description": "Данные для входа",
+                        "description": "Данные водителя",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.LoginRequest"
+                            "$ref": "#/definitions/dto.CreateDriverRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "OK",
+                    "201": {
+                        "description": "Водитель создан",
                         "schema": {
-                            "$ref": "#/definitions/dto.AuthResponse"
+                            "type": "object",
+                            "properties": {
+                                "driver": {
+                                    "$ref": "#/definitions/entity.Driver"
+                                }
+                            }
                         }
                     },
                     "400": {
@@ -103,17 +187,34 @@ const docTemplate = `{
                             "properties": {
                                 "error": {
                                     "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
                                 }
                             }
                         }
                     },
-                    "401": {
-                        "description": "Неверные учетные данные",
+                    "403": {
+                        "description": "Нужна роль администратора или диспетчера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Телефон или удостоверение уже заняты",
                         "schema": {
                             "type": "object",
                             "properties": {
                                 "error": {
                                     "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
                                 }
                             }
                         }
@@ -125,6 +226,9 @@ const docTemplate = `{
                             "properties": {
                                 "error": {
                                     "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
                                 }
                             }
                         }
@@ -132,54 +236,44 @@ const docTemplate = `{
                 }
             }
         },
-        "/auth/sign-up": {
-            "post": {
-                "description": "Создает нового пользователя в системе",
-                "consumes": [
-                    "application/json"
+        "/admin/drivers/{driver_id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
                 ],
+                "description": "Возвращает карточку водителя по ID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "auth"
+                    "admin"
                 ],
-                "summary": "Регистрация пользователя",
+                "summary": "Получение водителя",
                 "parameters": [
                     {
-                        "description": "Данные для регистрации",
-                        "name": "request",
-                        "in": "body",
-                        "required": true,
-                        "schema": {
-                            "$ref": "#/definitions/dto.RegisterRequest"
-                        }
+                        "type": "integer",
+                        "description": "ID водителя",
+                        "name": "driver_id",
+                        "in": "path",
+                        "required": true
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Успешная регистрация",
+                    "200": {
+                        "description": "Водитель",
                         "schema": {
                             "type": "object",
                             "properties": {
-                                "email": {
-                                    "type": "string"
-                                },
-                                "first_name": {
-                                    "type": "string"
-                                },
-                                "last_name": {
-                                    "type": "string"
-                                },
-                                "user_id": {
-                                    "type": "integer",
-                                    "format": "int64"
+                                "driver": {
+                                    "$ref": "#/definitions/entity.Driver"
                                 }
                             }
                         }
                     },
                     "400": {
-                        "description": "Некорректные данные",
+                        "description": "Неверный ID водителя",
                         "schema": {
                             "type": "object",
                             "properties": {
@@ -189,8 +283,8 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "500": {
-                        "description": "Ошибка сервера",
+                    "403": {
+                        "description": "Нужна роль администратора или диспетчера",
                         "schema": {
                             "type": "object",
                             "properties": {
@@ -199,36 +293,17 @@ const docTemplate = `{
                                 }
                             }
                         }
-                    }
-                }
-            }
-        },
-        "/orders": {
-            "get": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Возвращает все заказы текущего авторизованного пользователя",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "orders"
-                ],
-                "summary": "Получение списка заказов пользователя",
-                "responses": {
-                    "200": {
-                        "description": "Успешный ответ",
+                    },
+                    "404": {
+                        "description": "Водитель не найден",
                         "schema": {
                             "type": "object",
                             "properties": {
-                                "orders": {
-                                    "type": "array",
-                                    "items": {
-                                        "$ref": "#/definitions/entity.Order"
-                                    }
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
                                 }
                             }
                         }
@@ -249,13 +324,13 @@ const docTemplate = `{
                     }
                 }
             },
-            "post": {
+            "put": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Создает новый заказ после проверки наличия товаров на складе",
+                "description": "Заменяет анкетные данные водителя. Статус меняется отдельно",
                 "consumes": [
                     "application/json"
                 ],
@@ -263,29 +338,41 @@ const docTemplate = `{
                     "application/json"
                 ],
                 "tags": [
-                    "orders"
+                    "admin"
                 ],
-                "summary": "Создание нового заказа",
+                "summary": "Изменение водителя",
                 "parameters": [
                     {
-                        "description": "Данные для создания заказа",
+                        "type": "integer",
+                        "description": "ID водителя",
+                        "name": "driver_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Данные водителя",
                         "name": "request",
                         "in": "body",
                         "required": true,
                         "schema": {
-                            "$ref": "#/definitions/dto.CreateOrderRequest"
+                            "$ref": "#/definitions/dto.DriverRequest"
                         }
                     }
                 ],
                 "responses": {
-                    "201": {
-                        "description": "Created",
+                    "200": {
+                        "description": "Водитель изменен",
                         "schema": {
-                            "$ref": "#/definitions/dto.CreateOrderResponse"
+                            "type": "object",
+                            "properties": {
+                                "driver": {
+                                    "$ref": "#/definitions/entity.Driver"
+                                }
+                            }
                         }
                     },
                     "400": {
-                        "description": "Некорректные данные или товара нет в наличии",
+                        "description": "Некорректные данные",
                         "schema": {
                             "type": "object",
                             "properties": {
@@ -298,8 +385,19 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "500": {
-                        "description": "Ошибка сервера",
+                    "403": {
+                        "description": "Нужна роль администратора или диспетчера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Водитель не найден",
                         "schema": {
                             "type": "object",
                             "properties": {
@@ -311,31 +409,15 @@ const docTemplate = `{
                                 }
                             }
                         }
-                    }
-                }
-            }
-        },
-        "/orders/deliveries": {
-            "get": {
-                "security": [
-                    {
-                        "ApiKeyAuth": []
-                    }
-                ],
-                "description": "Возвращает все доставки текущего авторизованного пользователя",
-                "produces": [
-                    "application/json"
-                ],
-                "tags": [
-                    "deliveries"
-                ],
-                "summary": "Получение списка доставок",
-                "responses": {
-                    "200": {
-                        "description": "Если доставок нет",
+                    },
+                    "409": {
+                        "description": "Телефон или удостоверение уже заняты",
                         "schema": {
                             "type": "object",
                             "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
                                 "message": {
                                     "type": "string"
                                 }
@@ -359,51 +441,44 @@ const docTemplate = `{
                 }
             }
         },
-        "/orders/deliveries/{order_id}/complete_delivery": {
+        "/admin/drivers/{driver_id}/deactivate": {
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Отмечает доставку как завершенную и обновляет статус водителя",
+                "description": "Снимает водителя с линии: он переводится в offline и больше не назначается на заказы. Водителя на заказе деактивировать нельзя",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "deliveries"
+                    "admin"
                 ],
-                "summary": "Завершение доставки",
+                "summary": "Деактивация водителя",
                 "parameters": [
                     {
                         "type": "integer",
-                        "description": "ID заказа",
-                        "name": "order_id",
+                        "description": "ID водителя",
+                        "name": "driver_id",
                         "in": "path",
                         "required": true
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Успешное завершение",
+                        "description": "Водитель деактивирован",
                         "schema": {
                             "type": "object",
                             "properties": {
-                                "driver_id": {
-                                    "type": "integer",
-                                    "format": "int64"
-                                },
-                                "message": {
-                                    "type": "string"
-                                },
-                                "success": {
-                                    "type": "boolean"
+                                "driver": {
+                                    "$ref": "#/definitions/entity.Driver"
                                 }
                             }
                         }
                     },
                     "400": {
-                        "description": "Неверный ID заказа",
+                        "description": "Неверный ID водителя",
                         "schema": {
                             "type": "object",
                             "properties": {
@@ -413,8 +488,19 @@ const docTemplate = `{
                             }
                         }
                     },
-                    "500": {
-                        "description": "Ошибка сервера",
+                    "403": {
+                        "description": "Нужна роль администратора или диспетчера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Водитель не найден",
                         "schema": {
                             "type": "object",
                             "properties": {
@@ -426,43 +512,113 @@ const docTemplate = `{
                                 }
                             }
                         }
-                    }
-                }
-            }
-        },
-        "/orders/{order_id}": {
-            "get": {
+                    },
+                    "409": {
+                        "description": "Водитель выполняет заказ",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/products": {
+            "get": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Возвращает детальную информацию о конкретном заказе пользователя",
+                "description": "Возвращает страницу каталога. Снятые с продажи товары скрыты, пока не передан include_inactive",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "orders"
+                    "admin"
                 ],
-                "summary": "Получение деталей заказа",
+                "summary": "Каталог товаров",
                 "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Показывать снятые с продажи товары",
+                        "name": "include_inactive",
+                        "in": "query"
+                    },
                     {
                         "type": "integer",
-                        "description": "ID заказа",
-                        "name": "order_id",
-                        "in": "path",
-                        "required": true
+                        "default": 1,
+                        "description": "Номер страницы",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Размер страницы (до 100)",
+                        "name": "page_size",
+                        "in": "query"
                     }
                 ],
                 "responses": {
                     "200": {
-                        "description": "Детали заказа",
+                        "description": "Страница каталога",
                         "schema": {
-                            "$ref": "#/definitions/entity.Order"
+                            "type": "object",
+                            "properties": {
+                                "page": {
+                                    "type": "integer"
+                                },
+                                "page_size": {
+                                    "type": "integer"
+                                },
+                                "products": {
+                                    "type": "array",
+                                    "items": {
+                                        "$ref": "#/definitions/entity.Product"
+                                    }
+                                },
+                                "total": {
+                                    "type": "integer",
+                                    "format": "int64"
+                                }
+                            }
                         }
                     },
                     "400": {
-                        "description": "Неверный ID заказа",
+                        "description": "Некорректные параметры",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Нужна роль администратора или диспетчера",
                         "schema": {
                             "type": "object",
                             "properties": {
@@ -487,57 +643,74 @@ const docTemplate = `{
                         }
                     }
                 }
-            }
-        },
-        "/orders/{order_id}/assign-driver": {
+            },
             "post": {
                 "security": [
                     {
                         "ApiKeyAuth": []
                     }
                 ],
-                "description": "Назначает подходящего водителя на заказ и обновляет его статус",
+                "description": "Добавляет товар в каталог. Артикул и наименование должны быть уникальными, остаток нового товара - 0",
+                "consumes": [
+                    "application/json"
+                ],
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "orders"
+                    "admin"
                 ],
-                "summary": "Назначение водителя на заказ",
+                "summary": "Создание товара",
                 "parameters": [
                     {
-                        "type": "integer",
-                        "description": "ID заказа",
-                        "name": "order_id",
-                        "in": "path",
-                        "required": true
+                        "description": "Карточка товара",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.ProductRequest"
+                        }
                     }
                 ],
                 "responses": {
-                    "200": {
-                        "description": "Успешное назначение",
+                    "201": {
+                        "description": "Товар создан",
                         "schema": {
                             "type": "object",
                             "properties": {
-                                "driver_id": {
-                                    "type": "integer",
-                                    "format": "int64"
+                                "product": {
+                                    "$ref": "#/definitions/entity.Product"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректные данные",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
                                 },
                                 "message": {
                                     "type": "string"
-                                },
-                                "order_id": {
-                                    "type": "integer",
-                                    "format": "int64"
-                                },
-                                "success": {
-                                    "type": "boolean"
                                 }
                             }
                         }
                     },
-                    "400": {
-                        "description": "Заказ не в pending статусе",
+                    "403": {
+                        "description": "Нужна роль администратора или диспетчера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Артикул или наименование уже заняты",
                         "schema": {
                             "type": "object",
                             "properties": {
@@ -567,30 +740,74 @@ const docTemplate = `{
                 }
             }
         },
-        "/store/products": {
+        "/admin/products/{product_id}": {
             "get": {
-                "description": "Возвращает список всех товаров доступных на складе",
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает карточку товара по ID",
                 "produces": [
                     "application/json"
                 ],
                 "tags": [
-                    "warehouse"
+                    "admin"
+                ],
+                "summary": "Получение товара",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID товара",
+                        "name": "product_id",
+                        "in": "path",
+                        "required": true
+                    }
                 ],
-                "summary": "Получение доступных товаров",
                 "responses": {
                     "200": {
-                        "description": "Список товаров",
+                        "description": "Товар",
                         "schema": {
                             "type": "object",
                             "properties": {
-                                "message": {
+                                "product": {
+                                    "$ref": "#/definitions/entity.Product"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный ID товара",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Нужна роль администратора или диспетчера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Товар не найден",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
                                     "type": "string"
                                 },
-                                "products": {
-                                    "type": "array",
-                                    "items": {
-                                        "$ref": "#/definitions/entity.GoodsItem"
-                                    }
+                                "message": {
+                                    "type": "string"
                                 }
                             }
                         }
@@ -602,279 +819,5557 @@ const docTemplate = `{
                             "properties": {
                                 "error": {
                                     "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
                                 }
                             }
                         }
                     }
                 }
-            }
-        }
-    },
-    "definitions": {
-        "dto.AuthResponse": {
-            "description": "Ответ с токеном доступа и информацией о пользователе",
-            "type": "object",
-            "properties": {
-                "access_token": {
-                    "type": "string",
-                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
-                },
-                "user": {
-                    "$ref": "#/definitions/dto.UserInfo"
-                }
-            }
-        },
-        "dto.CreateOrderItem": {
-            "type": "object",
-            "required": [
-                "product_name",
-                "quantity"
-            ],
-            "properties": {
-                "product_name": {
-                    "type": "string",
-                    "example": "Ноутбук"
-                },
-                "quantity": {
-                    "type": "integer",
-                    "minimum": 1,
-                    "example": 1
-                }
-            }
-        },
-        "dto.CreateOrderRequest": {
-            "description": "Запрос на создание нового заказа",
-            "type": "object",
-            "required": [
-                "delivery_address",
-                "items",
-                "user_id"
-            ],
-            "properties": {
-                "delivery_address": {
-                    "type": "string",
-                    "example": "ул. Пушкина, д. 10"
-                },
-                "items": {
-                    "type": "array",
-                    "minItems": 1,
-                    "items": {
-                        "$ref": "#/definitions/dto.CreateOrderItem"
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
                     }
-                },
-                "user_id": {
-                    "type": "integer",
-                    "example": 123
-                }
-            }
-        },
-        "dto.CreateOrderResponse": {
-            "description": "Ответ после успешного создания заказа",
-            "type": "object",
-            "properties": {
-                "message": {
-                    "type": "string",
-                    "example": "Order created successfully"
-                },
-                "order": {
-                    "$ref": "#/definitions/entity.Order"
-                }
-            }
-        },
-        "dto.LoginRequest": {
-            "description": "Запрос на аутентификацию пользователя",
-            "type": "object",
-            "required": [
+                ],
+                "description": "Заменяет карточку товара, в том числе цену. Новая цена действует для заказов, созданных после изменения",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Изменение товара",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID товара",
+                        "name": "product_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Карточка товара",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateProductRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Товар изменен",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "product": {
+                                    "$ref": "#/definitions/entity.Product"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректные данные",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Нужна роль администратора или диспетчера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Товар не найден",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Артикул или наименование уже заняты",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Снимает товар с продажи: он пропадает с витрины и недоступен для новых заказов. Карточка сохраняется для истории",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Удаление товара",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID товара",
+                        "name": "product_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Товар снят с продажи",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "product": {
+                                    "$ref": "#/definitions/entity.Product"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный ID товара",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Нужна роль администратора или диспетчера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Товар не найден",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/users/{user_id}/roles/{role}": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Выдает пользователю роль admin, dispatcher, driver или customer. Новая роль попадет в access токен пользователя после обновления сессии",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Выдача роли",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID пользователя",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "admin",
+                            "dispatcher",
+                            "driver",
+                            "customer"
+                        ],
+                        "type": "string",
+                        "description": "Роль",
+                        "name": "role",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Роли пользователя",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "roles": {
+                                    "type": "array",
+                                    "items": {
+                                        "type": "string"
+                                    }
+                                },
+                                "user_id": {
+                                    "type": "integer",
+                                    "format": "int64"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный ID пользователя или роль",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Нужна роль администратора",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Пользователь не найден",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                }
+            },
+            "delete": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Отзывает роль у пользователя. Последнего администратора роли лишить нельзя",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Отзыв роли",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID пользователя",
+                        "name": "user_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "admin",
+                            "dispatcher",
+                            "driver",
+                            "customer"
+                        ],
+                        "type": "string",
+                        "description": "Роль",
+                        "name": "role",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Роли пользователя",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "roles": {
+                                    "type": "array",
+                                    "items": {
+                                        "type": "string"
+                                    }
+                                },
+                                "user_id": {
+                                    "type": "integer",
+                                    "format": "int64"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный ID пользователя или роль",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Нужна роль администратора",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Это последний администратор",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/warehouses": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает склады. Закрытые склады скрыты, пока не передан include_inactive",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Список складов",
+                "parameters": [
+                    {
+                        "type": "boolean",
+                        "description": "Показывать закрытые склады",
+                        "name": "include_inactive",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Склады",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "warehouses": {
+                                    "type": "array",
+                                    "items": {
+                                        "$ref": "#/definitions/entity.Warehouse"
+                                    }
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректные параметры",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Нужна роль администратора или диспетчера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Добавляет склад. Координаты нужны, чтобы заказы отгружались с ближайшего склада",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Создание склада",
+                "parameters": [
+                    {
+                        "description": "Данные склада",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.WarehouseRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Склад создан",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "warehouse": {
+                                    "$ref": "#/definitions/entity.Warehouse"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректные данные",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Нужна роль администратора или диспетчера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Название уже занято",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/warehouses/{warehouse_id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает склад по ID",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Получение склада",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID склада",
+                        "name": "warehouse_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Склад",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "warehouse": {
+                                    "$ref": "#/definitions/entity.Warehouse"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный ID склада",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Нужна роль администратора или диспетчера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Склад не найден",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                }
+            },
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Заменяет данные склада. Закрытый склад (active=false) не участвует в резервировании и не принимает поставки",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Изменение склада",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID склада",
+                        "name": "warehouse_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Данные склада",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.UpdateWarehouseRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Склад изменен",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "warehouse": {
+                                    "$ref": "#/definitions/entity.Warehouse"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректные данные",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Нужна роль администратора или диспетчера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Склад не найден",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Название уже занято",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/admin/warehouses/{warehouse_id}/stock": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает остатки одного склада: количество, резерв и доступное к заказу",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Остатки склада",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID склада",
+                        "name": "warehouse_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Остатки склада",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "items": {
+                                    "type": "array",
+                                    "items": {
+                                        "$ref": "#/definitions/entity.WarehouseStockItem"
+                                    }
+                                },
+                                "warehouse": {
+                                    "$ref": "#/definitions/entity.Warehouse"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный ID склада",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Нужна роль администратора или диспетчера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Склад не найден",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/logout": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Выполняет выход пользователя и удаляет refresh token",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Выход из системы",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/refresh": {
+            "post": {
+                "description": "Обменивает refresh token из cookie на новую пару токенов. Старый refresh token гасится;\nповторное его предъявление завершает все сессии, начатые тем же входом",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Обновление сессии",
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.RefreshResponse"
+                        }
+                    },
+                    "401": {
+                        "description": "Refresh token отсутствует, истек или отозван",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/sign-in": {
+            "post": {
+                "description": "Выполняет вход пользователя и возвращает токены",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Аутентификация пользователя",
+                "parameters": [
+                    {
+                        "description": "Данные для входа",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.LoginRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "OK",
+                        "schema": {
+                            "$ref": "#/definitions/dto.AuthResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректные данные",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "401": {
+                        "description": "Неверные учетные данные",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/auth/sign-up": {
+            "post": {
+                "description": "Создает нового пользователя в системе",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "auth"
+                ],
+                "summary": "Регистрация пользователя",
+                "parameters": [
+                    {
+                        "description": "Данные для регистрации",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.RegisterRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Успешная регистрация",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "email": {
+                                    "type": "string"
+                                },
+                                "first_name": {
+                                    "type": "string"
+                                },
+                                "last_name": {
+                                    "type": "string"
+                                },
+                                "user_id": {
+                                    "type": "integer",
+                                    "format": "int64"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректные данные",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/orders": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает все заказы текущего авторизованного пользователя",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Получение списка заказов пользователя",
+                "responses": {
+                    "200": {
+                        "description": "Успешный ответ",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "orders": {
+                                    "type": "array",
+                                    "items": {
+                                        "$ref": "#/definitions/entity.Order"
+                                    }
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Создает новый заказ: проверяет наличие товаров, списывает их со склада и подтверждает заказ",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Создание нового заказа",
+                "parameters": [
+                    {
+                        "description": "Данные для создания заказа",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateOrderRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Created",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateOrderResponse"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректные данные или товара нет в наличии",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/assignments/{job_id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает состояние задачи назначения водителя",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Статус назначения водителя",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID задачи назначения",
+                        "name": "job_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Успешный ответ",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "job": {
+                                    "$ref": "#/definitions/entity.AssignmentJob"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный ID задачи",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Задача не найдена",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/deliveries": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает все доставки текущего авторизованного пользователя",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "deliveries"
+                ],
+                "summary": "Получение списка доставок",
+                "responses": {
+                    "200": {
+                        "description": "Если доставок нет",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/deliveries/{order_id}/complete_delivery": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Отмечает доставку как завершенную и обновляет статус водителя",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "deliveries"
+                ],
+                "summary": "Завершение доставки",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID заказа",
+                        "name": "order_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Успешное завершение",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "driver_id": {
+                                    "type": "integer",
+                                    "format": "int64"
+                                },
+                                "message": {
+                                    "type": "string"
+                                },
+                                "success": {
+                                    "type": "boolean"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный ID заказа",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Заказ не в статусе in_progress",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{order_id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает детальную информацию о конкретном заказе пользователя",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Получение деталей заказа",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID заказа",
+                        "name": "order_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Детали заказа",
+                        "schema": {
+                            "$ref": "#/definitions/entity.Order"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный ID заказа",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{order_id}/assign-driver": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Ставит назначение водителя в очередь и сразу возвращает задачу; результат доступен по status_url",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Назначение водителя на заказ",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID заказа",
+                        "name": "order_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "202": {
+                        "description": "Назначение поставлено в очередь",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "job": {
+                                    "$ref": "#/definitions/entity.AssignmentJob"
+                                },
+                                "status_url": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректный ID заказа",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Заказ не найден",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Статус заказа не допускает назначение водителя",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{order_id}/cancel": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Отменяет заказ, возвращает товар на склад и освобождает назначенного водителя. Доставленный заказ отменить нельзя.\nВозврат товара и освобождение водителя выполняются сразу после отмены и повторяются до успеха: stock_returned и released_driver_id сообщают, что они запланированы",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Отмена заказа",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID заказа",
+                        "name": "order_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Причина отмены",
+                        "name": "request",
+                        "in": "body",
+                        "schema": {
+                            "$ref": "#/definitions/dto.CancelOrderRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Заказ отменен",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "message": {
+                                    "type": "string"
+                                },
+                                "previous_status": {
+                                    "type": "string"
+                                },
+                                "released_driver_id": {
+                                    "type": "integer",
+                                    "format": "int64"
+                                },
+                                "stock_returned": {
+                                    "type": "boolean"
+                                },
+                                "success": {
+                                    "type": "boolean"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный ID заказа",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Заказ не найден",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Заказ уже доставлен или отменен",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{order_id}/driver-location": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает последнюю известную позицию водителя, который везет заказ текущего пользователя. Доступно, пока заказ назначен или в пути",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Текущая позиция водителя",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID заказа",
+                        "name": "order_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Позиция водителя",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "location": {
+                                    "$ref": "#/definitions/entity.DriverLocation"
+                                },
+                                "order_id": {
+                                    "type": "integer",
+                                    "format": "int64"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный ID заказа",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Заказ не найден или позиция водителя неизвестна",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Заказ сейчас не в доставке",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "status": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{order_id}/events": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Server-Sent Events поток по заказу текущего пользователя. Первым приходит событие snapshot с заказом, затем status (переход статуса) и driver_position (позиция водителя). Событие end закрывает поток после конечного статуса, error - при обрыве (нужно переподключиться)",
+                "produces": [
+                    "text/event-stream"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "Отслеживание заказа в реальном времени",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID заказа",
+                        "name": "order_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Поток событий",
+                        "schema": {
+                            "type": "string"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный ID заказа",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Заказ не найден",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/orders/{order_id}/timeline": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает все переходы статуса заказа текущего пользователя в хронологическом порядке",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "orders"
+                ],
+                "summary": "История статусов заказа",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID заказа",
+                        "name": "order_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "История статусов",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "order_id": {
+                                    "type": "integer",
+                                    "format": "int64"
+                                },
+                                "timeline": {
+                                    "type": "array",
+                                    "items": {
+                                        "$ref": "#/definitions/entity.OrderStatusChange"
+                                    }
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный ID заказа",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Заказ не найден",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/store/lots": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает партии с остатком, ближайшие к истечению срока годности первыми. С expiring_within_days - только партии, срок которых истекает в эти дни или уже истек",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Партии товара и сроки годности",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID склада; без параметра - все склады",
+                        "name": "warehouse_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID товара; без параметра - все товары",
+                        "name": "product_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Горизонт отчета в днях (до 365)",
+                        "name": "expiring_within_days",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Партии",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "lots": {
+                                    "type": "array",
+                                    "items": {
+                                        "$ref": "#/definitions/entity.StockLot"
+                                    }
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректные параметры",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Нужна роль администратора или диспетчера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/store/movements/export": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает журнал движения товара в CSV в порядке записи. Без фильтров выгружается весь журнал",
+                "produces": [
+                    "text/csv"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Выгрузка журнала движения в CSV",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID склада",
+                        "name": "warehouse_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID товара",
+                        "name": "product_id",
+                        "in": "query"
+                    },
+                    {
+                        "enum": [
+                            "order",
+                            "return",
+                            "receipt",
+                            "adjustment",
+                            "transfer"
+                        ],
+                        "type": "string",
+                        "description": "Причина движения",
+                        "name": "reason",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Начало периода, unix-время",
+                        "name": "since",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Конец периода (не включая), unix-время",
+                        "name": "until",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "CSV с движениями",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректные параметры",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Нужна роль администратора или диспетчера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/store/products": {
+            "get": {
+                "description": "Возвращает список всех товаров доступных на складе",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "warehouse"
+                ],
+                "summary": "Получение доступных товаров",
+                "responses": {
+                    "200": {
+                        "description": "Список товаров",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "message": {
+                                    "type": "string"
+                                },
+                                "products": {
+                                    "type": "array",
+                                    "items": {
+                                        "$ref": "#/definitions/entity.GoodsItem"
+                                    }
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/store/products/{product_id}/movements": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает изменения остатка товара, новые сначала: списания и возвраты по заказам, приемки, корректировки и перемещения",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Журнал движения товара",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID товара",
+                        "name": "product_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "enum": [
+                            "order",
+                            "return",
+                            "receipt",
+                            "adjustment",
+                            "transfer"
+                        ],
+                        "type": "string",
+                        "description": "Причина движения",
+                        "name": "reason",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID склада; без параметра - движения по всем складам",
+                        "name": "warehouse_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Начало периода, unix-время",
+                        "name": "since",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "Конец периода (не включая), unix-время",
+                        "name": "until",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Номер страницы",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 50,
+                        "description": "Размер страницы (до 500)",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Страница журнала",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "movements": {
+                                    "type": "array",
+                                    "items": {
+                                        "$ref": "#/definitions/entity.StockMovement"
+                                    }
+                                },
+                                "page": {
+                                    "type": "integer"
+                                },
+                                "page_size": {
+                                    "type": "integer"
+                                },
+                                "total": {
+                                    "type": "integer",
+                                    "format": "int64"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректные параметры",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Нужна роль администратора или диспетчера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/store/receivings": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает страницу документов приемки без строк, новые сначала",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Документы приемки",
+                "parameters": [
+                    {
+                        "enum": [
+                            "draft",
+                            "posted"
+                        ],
+                        "type": "string",
+                        "description": "Статус документа",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Номер страницы",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Размер страницы (до 100)",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Страница документов",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "documents": {
+                                    "type": "array",
+                                    "items": {
+                                        "$ref": "#/definitions/entity.ReceivingDocument"
+                                    }
+                                },
+                                "page": {
+                                    "type": "integer"
+                                },
+                                "page_size": {
+                                    "type": "integer"
+                                },
+                                "total": {
+                                    "type": "integer",
+                                    "format": "int64"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректные параметры",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Нужна роль администратора или диспетчера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Создает черновик приемки с ожидаемыми строками поставки. Остатки не меняются до проведения документа",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Создание документа приемки",
+                "parameters": [
+                    {
+                        "description": "Поставщик и строки",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateReceivingRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Документ создан",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "document": {
+                                    "$ref": "#/definitions/entity.ReceivingDocument"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректные данные",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Нужна роль администратора или диспетчера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Склад или товар не найден",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Склад закрыт",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/store/receivings/{document_id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает документ приемки со строками",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Получение документа приемки",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID документа",
+                        "name": "document_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Документ",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "document": {
+                                    "$ref": "#/definitions/entity.ReceivingDocument"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный ID документа",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Нужна роль администратора или диспетчера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Документ не найден",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/store/receivings/{document_id}/post": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Приходует принятый товар на склад и закрывает документ. Каждая строка с принятым товаром сохраняется как поступление",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Проведение документа приемки",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID документа",
+                        "name": "document_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Документ проведен",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "document": {
+                                    "$ref": "#/definitions/entity.ReceivingDocument"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный ID документа",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Нужна роль администратора или диспетчера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Документ не найден",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Документ уже проведен или товар не принят",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/store/receivings/{document_id}/received": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Задает фактически принятое количество по строкам черновика. Товар, которого не ждали, добавляется строкой с ожидаемым количеством 0",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Отметка принятого товара",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID документа",
+                        "name": "document_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Принятые количества",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.RecordReceivedRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Документ обновлен",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "document": {
+                                    "$ref": "#/definitions/entity.ReceivingDocument"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректные данные",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Нужна роль администратора или диспетчера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Документ или товар не найден",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Документ уже проведен",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/store/reconciliation": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Проверяет, что остаток каждого товара на каждом складе равен сумме его движений. Возвращает только расхождения",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Сверка остатков с журналом",
+                "parameters": [
+                    {
+                        "type": "array",
+                        "items": {
+                            "type": "integer"
+                        },
+                        "collectionFormat": "multi",
+                        "description": "ID товаров; без параметра проверяются все",
+                        "name": "product_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Результат сверки",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "checked": {
+                                    "type": "integer",
+                                    "format": "int64"
+                                },
+                                "discrepancies": {
+                                    "type": "array",
+                                    "items": {
+                                        "$ref": "#/definitions/entity.StockDiscrepancy"
+                                    }
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный ID товара",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Нужна роль администратора или диспетчера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/store/replenishment": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает товары, доступный остаток которых (за вычетом резервов) ниже точки заказа, и рекомендуемое количество к заказу",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Товары к пополнению",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID склада; без параметра - все активные склады",
+                        "name": "warehouse_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Список к пополнению",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "items": {
+                                    "type": "array",
+                                    "items": {
+                                        "$ref": "#/definitions/entity.ReplenishmentItem"
+                                    }
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректные параметры",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Нужна роль администратора или диспетчера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Склад не найден",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/store/stock/export": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает остатки склада или всех складов в CSV: количество, резерв, доступное к заказу и цену. Выгрузку можно исправить и загрузить обратно",
+                "produces": [
+                    "text/csv"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Выгрузка остатков в CSV",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID склада; без параметра - все склады, включая закрытые",
+                        "name": "warehouse_id",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "CSV с остатками",
+                        "schema": {
+                            "type": "file"
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный ID склада",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Нужна роль администратора или диспетчера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Склад не найден",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/store/stock/import": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Приводит остатки складов к фактическим из CSV и обновляет цены. Первая строка - заголовок с колонками sku и/или product_name, quantity, необязательными warehouse_id и unit_price; остальные колонки игнорируются, разделитель - запятая или точка с запятой.\nСклад строки берется из колонки warehouse_id, а если ее нет - из параметра warehouse_id. Если заданы оба, строки другого склада отклоняются. Один товар одного склада может встречаться в файле только один раз.\nquantity - фактический остаток: излишек приходуется, недостача списывается корректировкой. Файл применяется целиком или не применяется вовсе; при ошибках возвращается отчет по всем строкам.",
+                "consumes": [
+                    "multipart/form-data"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Загрузка остатков из CSV",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID склада; обязателен, если в файле нет колонки warehouse_id",
+                        "name": "warehouse_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "boolean",
+                        "description": "Только проверить файл и показать изменения",
+                        "name": "dry_run",
+                        "in": "query"
+                    },
+                    {
+                        "type": "file",
+                        "description": "CSV с остатками",
+                        "name": "file",
+                        "in": "formData",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Файл проверен или применен",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "applied": {
+                                    "type": "boolean"
+                                },
+                                "errors": {
+                                    "type": "array",
+                                    "items": {
+                                        "$ref": "#/definitions/entity.ImportRowError"
+                                    }
+                                },
+                                "results": {
+                                    "type": "array",
+                                    "items": {
+                                        "$ref": "#/definitions/entity.StockImportResult"
+                                    }
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректные параметры или файл",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Нужна роль администратора или диспетчера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Склад не найден",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Склад закрыт",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "413": {
+                        "description": "Файл слишком большой",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "422": {
+                        "description": "В строках файла есть ошибки, ничего не изменено",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "applied": {
+                                    "type": "boolean"
+                                },
+                                "errors": {
+                                    "type": "array",
+                                    "items": {
+                                        "$ref": "#/definitions/entity.ImportRowError"
+                                    }
+                                },
+                                "results": {
+                                    "type": "array",
+                                    "items": {
+                                        "$ref": "#/definitions/entity.StockImportResult"
+                                    }
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/store/transfers": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает страницу перемещений без строк, новые сначала",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Перемещения",
+                "parameters": [
+                    {
+                        "enum": [
+                            "draft",
+                            "in_transit",
+                            "received"
+                        ],
+                        "type": "string",
+                        "description": "Статус перемещения",
+                        "name": "status",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "description": "ID склада-источника или получателя",
+                        "name": "warehouse_id",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 1,
+                        "description": "Номер страницы",
+                        "name": "page",
+                        "in": "query"
+                    },
+                    {
+                        "type": "integer",
+                        "default": 20,
+                        "description": "Размер страницы (до 100)",
+                        "name": "page_size",
+                        "in": "query"
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Страница перемещений",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "page": {
+                                    "type": "integer"
+                                },
+                                "page_size": {
+                                    "type": "integer"
+                                },
+                                "total": {
+                                    "type": "integer",
+                                    "format": "int64"
+                                },
+                                "transfers": {
+                                    "type": "array",
+                                    "items": {
+                                        "$ref": "#/definitions/entity.StockTransfer"
+                                    }
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректные параметры",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Нужна роль администратора или диспетчера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                }
+            },
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Создает черновик перемещения товара между складами. Остатки не меняются до отправки",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Создание перемещения",
+                "parameters": [
+                    {
+                        "description": "Склады, строки и водитель",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.CreateTransferRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "201": {
+                        "description": "Перемещение создано",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "transfer": {
+                                    "$ref": "#/definitions/entity.StockTransfer"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректные данные",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Нужна роль администратора или диспетчера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Склад, товар или водитель не найден",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Склад-получатель закрыт или водитель уволен",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/store/transfers/{transfer_id}": {
+            "get": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Возвращает перемещение со строками",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Получение перемещения",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID перемещения",
+                        "name": "transfer_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Перемещение",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "transfer": {
+                                    "$ref": "#/definitions/entity.StockTransfer"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный ID перемещения",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Нужна роль администратора или диспетчера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Перемещение не найдено",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/store/transfers/{transfer_id}/dispatch": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Списывает товар со склада-источника и переводит перемещение в in_transit. Зарезервированный под заказы товар не перемещается",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Отправка перемещения",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID перемещения",
+                        "name": "transfer_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Перемещение отправлено",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "transfer": {
+                                    "$ref": "#/definitions/entity.StockTransfer"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный ID перемещения",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Нужна роль администратора или диспетчера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Перемещение не найдено",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Перемещение уже отправлено, не хватает товара или водитель занят",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/store/transfers/{transfer_id}/driver": {
+            "put": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Назначает водителя, который повезет товар. Водителя можно сменить или снять, пока перемещение не отправлено",
+                "consumes": [
+                    "application/json"
+                ],
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Назначение водителя на перемещение",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID перемещения",
+                        "name": "transfer_id",
+                        "in": "path",
+                        "required": true
+                    },
+                    {
+                        "description": "Водитель",
+                        "name": "request",
+                        "in": "body",
+                        "required": true,
+                        "schema": {
+                            "$ref": "#/definitions/dto.AssignTransferDriverRequest"
+                        }
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Водитель назначен",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "transfer": {
+                                    "$ref": "#/definitions/entity.StockTransfer"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Некорректные данные",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Нужна роль администратора или диспетчера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Перемещение или водитель не найдены",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Перемещение уже отправлено или водитель уволен",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        },
+        "/store/transfers/{transfer_id}/receive": {
+            "post": {
+                "security": [
+                    {
+                        "ApiKeyAuth": []
+                    }
+                ],
+                "description": "Приходует товар в пути на склад-получатель и закрывает перемещение",
+                "produces": [
+                    "application/json"
+                ],
+                "tags": [
+                    "admin"
+                ],
+                "summary": "Приемка перемещения",
+                "parameters": [
+                    {
+                        "type": "integer",
+                        "description": "ID перемещения",
+                        "name": "transfer_id",
+                        "in": "path",
+                        "required": true
+                    }
+                ],
+                "responses": {
+                    "200": {
+                        "description": "Перемещение принято",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "transfer": {
+                                    "$ref": "#/definitions/entity.StockTransfer"
+                                }
+                            }
+                        }
+                    },
+                    "400": {
+                        "description": "Неверный ID перемещения",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "403": {
+                        "description": "Нужна роль администратора или диспетчера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "404": {
+                        "description": "Перемещение не найдено",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "409": {
+                        "description": "Перемещение не в пути",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    },
+                    "500": {
+                        "description": "Ошибка сервера",
+                        "schema": {
+                            "type": "object",
+                            "properties": {
+                                "error": {
+                                    "type": "string"
+                                },
+                                "message": {
+                                    "type": "string"
+                                }
+                            }
+                        }
+                    }
+                }
+            }
+        }
+    },
+    "definitions": {
+        "dto.AssignTransferDriverRequest": {
+            "description": "driver_id 0 снимает водителя с перемещения",
+            "type": "object",
+            "properties": {
+                "driver_id": {
+                    "type": "integer",
+                    "example": 3
+                }
+            }
+        },
+        "dto.AuthResponse": {
+            "description": "Ответ с токеном доступа и информацией о пользователе",
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string",
+                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
+                },
+                "user": {
+                    "$ref": "#/definitions/dto.UserInfo"
+                }
+            }
+        },
+        "dto.CancelOrderRequest": {
+            "description": "Причина отмены заказа",
+            "type": "object",
+            "properties": {
+                "reason": {
+                    "type": "string",
+                    "example": "Передумал покупать"
+                }
+            }
+        },
+        "dto.CreateDriverRequest": {
+            "description": "Новый водитель; статус по умолчанию offline",
+            "type": "object",
+            "required": [
+                "car",
+                "license_number",
+                "name",
+                "phone"
+            ],
+            "properties": {
+                "car": {
+                    "type": "string",
+                    "example": "Toyota Camry 2020, гос.номер А123АА777"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "ivanov@mail.ru"
+                },
+                "license_number": {
+                    "type": "string",
+                    "example": "AB123456"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Иванов Петр Сергеевич"
+                },
+                "phone": {
+                    "type": "string",
+                    "example": "+79161234567"
+                },
+                "status": {
+                    "type": "string",
+                    "example": "offline"
+                }
+            }
+        },
+        "dto.CreateOrderItem": {
+            "type": "object",
+            "required": [
+                "product_name",
+                "quantity"
+            ],
+            "properties": {
+                "product_name": {
+                    "type": "string",
+                    "example": "Ноутбук"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 1
+                }
+            }
+        },
+        "dto.CreateOrderRequest": {
+            "description": "Запрос на создание нового заказа",
+            "type": "object",
+            "required": [
+                "delivery_address",
+                "items",
+                "user_id"
+            ],
+            "properties": {
+                "delivery_address": {
+                    "type": "string",
+                    "example": "ул. Пушкина, д. 10"
+                },
+                "delivery_location": {
+                    "description": "DeliveryLocation - координаты адреса доставки, используются при подборе ближайшего водителя",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.Location"
+                        }
+                    ]
+                },
+                "items": {
+                    "type": "array",
+                    "minItems": 1,
+                    "items": {
+                        "$ref": "#/definitions/dto.CreateOrderItem"
+                    }
+                },
+                "user_id": {
+                    "type": "integer",
+                    "example": 123
+                }
+            }
+        },
+        "dto.CreateOrderResponse": {
+            "description": "Ответ после успешного создания заказа",
+            "type": "object",
+            "properties": {
+                "message": {
+                    "type": "string",
+                    "example": "Order created successfully"
+                },
+                "order": {
+                    "$ref": "#/definitions/entity.Order"
+                }
+            }
+        },
+        "dto.CreateReceivingRequest": {
+            "description": "Склад, поставщик и ожидаемые строки поставки",
+            "type": "object",
+            "required": [
+                "lines",
+                "supplier",
+                "warehouse_id"
+            ],
+            "properties": {
+                "lines": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ReceivingLineRequest"
+                    }
+                },
+                "note": {
+                    "type": "string",
+                    "example": "Поставка по договору 17"
+                },
+                "reference": {
+                    "type": "string",
+                    "example": "ТН-2025-0142"
+                },
+                "supplier": {
+                    "type": "string",
+                    "example": "ООО Поставщик"
+                },
+                "warehouse_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "dto.CreateTransferRequest": {
+            "description": "Склад-источник, склад-получатель и строки; водителя можно назначить сразу",
+            "type": "object",
+            "required": [
+                "destination_warehouse_id",
+                "lines",
+                "source_warehouse_id"
+            ],
+            "properties": {
+                "destination_warehouse_id": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "driver_id": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "lines": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.TransferLineRequest"
+                    }
+                },
+                "note": {
+                    "type": "string",
+                    "example": "Пополнение склада Север"
+                },
+                "source_warehouse_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "dto.DriverRequest": {
+            "description": "Данные водителя для создания и изменения",
+            "type": "object",
+            "required": [
+                "car",
+                "license_number",
+                "name",
+                "phone"
+            ],
+            "properties": {
+                "car": {
+                    "type": "string",
+                    "example": "Toyota Camry 2020, гос.номер А123АА777"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "ivanov@mail.ru"
+                },
+                "license_number": {
+                    "type": "string",
+                    "example": "AB123456"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Иванов Петр Сергеевич"
+                },
+                "phone": {
+                    "type": "string",
+                    "example": "+79161234567"
+                }
+            }
+        },
+        "dto.LoginRequest": {
+            "description": "Запрос на аутентификацию пользователя",
+            "type": "object",
+            "required": [
+                "email",
+                "password"
+            ],
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "user@example.com"
+                },
+                "password": {
+                    "type": "string",
+                    "example": "password123"
+                }
+            }
+        },
+        "dto.ProductRequest": {
+            "description": "Данные товара для создания и изменения",
+            "type": "object",
+            "required": [
+                "name",
+                "sku"
+            ],
+            "properties": {
+                "description": {
+                    "type": "string",
+                    "example": "Игровой ноутбук 15.6\""
+                },
+                "dimensions": {
+                    "$ref": "#/definitions/entity.Dimensions"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Ноутбук ASUS ROG"
+                },
+                "reorder_point": {
+                    "description": "ReorderPoint - порог доступного остатка на складе, ниже которого товар нужно дозаказать; 0 - не отслеживать",
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 5
+                },
+                "reorder_quantity": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 20
+                },
+                "sku": {
+                    "type": "string",
+                    "example": "SKU-000001"
+                },
+                "unit_price": {
+                    "type": "number",
+                    "minimum": 0,
+                    "example": 899.99
+                },
+                "weight_grams": {
+                    "type": "integer",
+                    "example": 2300
+                }
+            }
+        },
+        "dto.ReceivedLineRequest": {
+            "type": "object",
+            "required": [
+                "product_id"
+            ],
+            "properties": {
+                "expires_at": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 1760659200
+                },
+                "lot_number": {
+                    "description": "LotNumber и ExpiresAt не переданы - остается партия, указанная раньше",
+                    "type": "string",
+                    "example": "L2025-0917"
+                },
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "received_quantity": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 9
+                }
+            }
+        },
+        "dto.ReceivingLineRequest": {
+            "description": "Товар и ожидаемое количество; принятое можно указать сразу",
+            "type": "object",
+            "required": [
+                "product_id"
+            ],
+            "properties": {
+                "expected_quantity": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 10
+                },
+                "expires_at": {
+                    "description": "ExpiresAt - срок годности партии, unix-время; 0 - товар не портится",
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 1760659200
+                },
+                "lot_number": {
+                    "description": "LotNumber - номер партии поставщика; пустой - партия по номеру документа",
+                    "type": "string",
+                    "example": "L2025-0917"
+                },
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "received_quantity": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 0
+                }
+            }
+        },
+        "dto.RecordReceivedRequest": {
+            "description": "Принятые количества; товар вне ожидаемых строк добавляется в документ",
+            "type": "object",
+            "required": [
+                "lines"
+            ],
+            "properties": {
+                "lines": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/dto.ReceivedLineRequest"
+                    }
+                }
+            }
+        },
+        "dto.RefreshResponse": {
+            "description": "Новый access token; новый refresh token приходит в cookie",
+            "type": "object",
+            "properties": {
+                "access_token": {
+                    "type": "string",
+                    "example": "eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."
+                },
+                "roles": {
+                    "type": "array",
+                    "items": {
+                        "type": "string"
+                    },
+                    "example": [
+                        "customer"
+                    ]
+                },
+                "user_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "dto.RegisterRequest": {
+            "description": "Запрос на регистрацию нового пользователя",
+            "type": "object",
+            "required": [
+                "confirm_password",
                 "email",
+                "first_name",
+                "last_name",
                 "password"
             ],
             "properties": {
-                "email": {
+                "confirm_password": {
+                    "type": "string",
+                    "example": "password123"
+                },
+                "email": {
+                    "type": "string",
+                    "example": "user@example.com"
+                },
+                "first_name": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "minLength": 2,
+                    "example": "John"
+                },
+                "last_name": {
+                    "type": "string",
+                    "maxLength": 50,
+                    "minLength": 2,
+                    "example": "Doe"
+                },
+                "password": {
+                    "type": "string",
+                    "maxLength": 100,
+                    "minLength": 8,
+                    "example": "password123"
+                }
+            }
+        },
+        "dto.TransferLineRequest": {
+            "type": "object",
+            "required": [
+                "product_id",
+                "quantity"
+            ],
+            "properties": {
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "quantity": {
+                    "type": "integer",
+                    "minimum": 1,
+                    "example": 5
+                }
+            }
+        },
+        "dto.UpdateProductRequest": {
+            "description": "Карточка товара целиком; active не передан - признак не меняется",
+            "type": "object",
+            "required": [
+                "name",
+                "sku"
+            ],
+            "properties": {
+                "active": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Игровой ноутбук 15.6\""
+                },
+                "dimensions": {
+                    "$ref": "#/definitions/entity.Dimensions"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Ноутбук ASUS ROG"
+                },
+                "reorder_point": {
+                    "description": "ReorderPoint - порог доступного остатка на складе, ниже которого товар нужно дозаказать; 0 - не отслеживать",
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 5
+                },
+                "reorder_quantity": {
+                    "type": "integer",
+                    "minimum": 0,
+                    "example": 20
+                },
+                "sku": {
+                    "type": "string",
+                    "example": "SKU-000001"
+                },
+                "unit_price": {
+                    "type": "number",
+                    "minimum": 0,
+                    "example": 899.99
+                },
+                "weight_grams": {
+                    "type": "integer",
+                    "example": 2300
+                }
+            }
+        },
+        "dto.UpdateWarehouseRequest": {
+            "description": "Данные склада целиком; active не передан - признак не меняется",
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "active": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "address": {
+                    "type": "string",
+                    "example": "г. Москва, ул. Складская, д. 1"
+                },
+                "location": {
+                    "$ref": "#/definitions/entity.Location"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Склад Север"
+                }
+            }
+        },
+        "dto.UserInfo": {
+            "type": "object",
+            "properties": {
+                "email": {
+                    "type": "string",
+                    "example": "user@example.com"
+                },
+                "first_name": {
+                    "type": "string",
+                    "example": "John"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "last_name": {
+                    "type": "string",
+                    "example": "Doe"
+                }
+            }
+        },
+        "dto.WarehouseRequest": {
+            "description": "Название, адрес и координаты склада; без координат склад выбирается последним",
+            "type": "object",
+            "required": [
+                "name"
+            ],
+            "properties": {
+                "address": {
+                    "type": "string",
+                    "example": "г. Москва, ул. Складская, д. 1"
+                },
+                "location": {
+                    "$ref": "#/definitions/entity.Location"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Склад Север"
+                }
+            }
+        },
+        "entity.ActorType": {
+            "type": "string",
+            "enum": [
+                "user",
+                "driver",
+                "system"
+            ],
+            "x-enum-comments": {
+                "ActorDriver": "действие водителя",
+                "ActorSystem": "автоматическое действие сервиса",
+                "ActorUser": "действие пользователя"
+            },
+            "x-enum-descriptions": [
+                "действие пользователя",
+                "действие водителя",
+                "автоматическое действие сервиса"
+            ],
+            "x-enum-varnames": [
+                "ActorUser",
+                "ActorDriver",
+                "ActorSystem"
+            ]
+        },
+        "entity.AssignmentJob": {
+            "description": "Задача назначения водителя",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "integer",
+                    "example": 1694966400
+                },
+                "driver_id": {
+                    "type": "integer",
+                    "example": 456
+                },
+                "error": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "order_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.AssignmentJobStatus"
+                        }
+                    ],
+                    "example": "queued"
+                },
+                "updated_at": {
+                    "type": "integer",
+                    "example": 1694966400
+                },
+                "user_id": {
+                    "type": "integer",
+                    "example": 123
+                }
+            }
+        },
+        "entity.AssignmentJobStatus": {
+            "type": "string",
+            "enum": [
+                "queued",
+                "searching",
+                "assigned",
+                "failed"
+            ],
+            "x-enum-comments": {
+                "AssignmentAssigned": "водитель назначен на заказ",
+                "AssignmentFailed": "назначить водителя не удалось",
+                "AssignmentQueued": "ждет обработки воркером",
+                "AssignmentSearching": "водитель запрошен, ждем событие из Kafka"
+            },
+            "x-enum-descriptions": [
+                "ждет обработки воркером",
+                "водитель запрошен, ждем событие из Kafka",
+                "водитель назначен на заказ",
+                "назначить водителя не удалось"
+            ],
+            "x-enum-varnames": [
+                "AssignmentQueued",
+                "AssignmentSearching",
+                "AssignmentAssigned",
+                "AssignmentFailed"
+            ]
+        },
+        "entity.Dimensions": {
+            "type": "object",
+            "properties": {
+                "height_mm": {
+                    "type": "integer",
+                    "example": 30
+                },
+                "length_mm": {
+                    "type": "integer",
+                    "example": 360
+                },
+                "width_mm": {
+                    "type": "integer",
+                    "example": 260
+                }
+            }
+        },
+        "entity.Driver": {
+            "type": "object",
+            "properties": {
+                "active": {
+                    "description": "Active - false у уволенных водителей: их не назначают на заказы",
+                    "type": "boolean"
+                },
+                "car": {
+                    "type": "string"
+                },
+                "email": {
+                    "type": "string"
+                },
+                "id": {
+                    "type": "integer"
+                },
+                "last_assigned_at": {
+                    "type": "integer"
+                },
+                "license_number": {
+                    "type": "string"
+                },
+                "location": {
+                    "description": "Location - последняя известная позиция водителя",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.Location"
+                        }
+                    ]
+                },
+                "location_updated_at": {
+                    "type": "integer"
+                },
+                "name": {
+                    "type": "string"
+                },
+                "phone": {
+                    "type": "string"
+                },
+                "status": {
+                    "$ref": "#/definitions/entity.DriverStatus"
+                }
+            }
+        },
+        "entity.DriverLocation": {
+            "description": "Позиция водителя на момент замера",
+            "type": "object",
+            "properties": {
+                "driver_id": {
+                    "type": "integer",
+                    "example": 7
+                },
+                "location": {
+                    "$ref": "#/definitions/entity.Location"
+                },
+                "recorded_at": {
+                    "type": "integer",
+                    "example": 1718000000
+                }
+            }
+        },
+        "entity.DriverStatus": {
+            "type": "string",
+            "enum": [
+                "offline",
+                "available",
+                "busy",
+                "break",
+                "unavailable"
+            ],
+            "x-enum-comments": {
+                "DriverStatusAvailable": "свободен, может взять заказ",
+                "DriverStatusBreak": "на перерыве",
+                "DriverStatusBusy": "выполняет заказ",
+                "DriverStatusUnavailable": "недоступен (болеет, отпуск)"
+            },
+            "x-enum-descriptions": [
+                "",
+                "свободен, может взять заказ",
+                "выполняет заказ",
+                "на перерыве",
+                "недоступен (болеет, отпуск)"
+            ],
+            "x-enum-varnames": [
+                "DriverStatusOffline",
+                "DriverStatusAvailable",
+                "DriverStatusBusy",
+                "DriverStatusBreak",
+                "DriverStatusUnavailable"
+            ]
+        },
+        "entity.GoodsItem": {
+            "description": "Товар в составе заказа",
+            "type": "object",
+            "properties": {
+                "last_updated": {
+                    "type": "integer",
+                    "example": 1694966400
+                },
+                "price": {
+                    "type": "number",
+                    "example": 15000
+                },
+                "product_id": {
+                    "type": "integer",
+                    "example": 789
+                },
+                "product_name": {
+                    "type": "string",
+                    "example": "Ноутбук"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "total_price": {
+                    "type": "number",
+                    "example": 15000
+                }
+            }
+        },
+        "entity.ImportRowError": {
+            "description": "Ошибка в строке файла загрузки",
+            "type": "object",
+            "properties": {
+                "line": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "message": {
+                    "type": "string",
+                    "example": "product not found"
+                }
+            }
+        },
+        "entity.Location": {
+            "description": "Координаты точки",
+            "type": "object",
+            "properties": {
+                "latitude": {
+                    "type": "number",
+                    "example": 55.7558
+                },
+                "longitude": {
+                    "type": "number",
+                    "example": 37.6173
+                }
+            }
+        },
+        "entity.LotAllocation": {
+            "description": "Часть позиции, собранная из партии",
+            "type": "object",
+            "properties": {
+                "expires_at": {
+                    "type": "integer",
+                    "example": 1760659200
+                },
+                "lot_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "lot_number": {
+                    "type": "string",
+                    "example": "L2025-0917"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 2
+                }
+            }
+        },
+        "entity.MovementReason": {
+            "type": "string",
+            "enum": [
+                "order",
+                "return",
+                "receipt",
+                "adjustment",
+                "transfer"
+            ],
+            "x-enum-comments": {
+                "MovementAdjustment": "ручная корректировка и начальные остатки",
+                "MovementOrder": "списание по заказу, reference - заказ",
+                "MovementReceipt": "приемка, reference - документ приемки",
+                "MovementReturn": "возврат по заказу, reference - заказ",
+                "MovementTransfer": "перемещение, reference - документ перемещения"
+            },
+            "x-enum-descriptions": [
+                "списание по заказу, reference - заказ",
+                "возврат по заказу, reference - заказ",
+                "приемка, reference - документ приемки",
+                "ручная корректировка и начальные остатки",
+                "перемещение, reference - документ перемещения"
+            ],
+            "x-enum-varnames": [
+                "MovementOrder",
+                "MovementReturn",
+                "MovementReceipt",
+                "MovementAdjustment",
+                "MovementTransfer"
+            ]
+        },
+        "entity.Order": {
+            "description": "Информация о заказе",
+            "type": "object",
+            "properties": {
+                "allocations": {
+                    "description": "Allocations - с каких складов отгружается заказ; несколько складов - заказ разделен",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.StockAllocation"
+                    }
+                },
+                "created_at": {
+                    "type": "integer",
+                    "example": 1694966400
+                },
+                "delivery_address": {
+                    "type": "string",
+                    "example": "ул. Пушкина, д. 10"
+                },
+                "delivery_location": {
+                    "description": "DeliveryLocation - геокодированный адрес доставки",
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.Location"
+                        }
+                    ]
+                },
+                "driver_id": {
+                    "type": "integer",
+                    "example": 456
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "items": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.GoodsItem"
+                    }
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.OrderStatus"
+                        }
+                    ],
+                    "example": "pending"
+                },
+                "total_amount": {
+                    "type": "number",
+                    "example": 15000.5
+                },
+                "user_id": {
+                    "type": "integer",
+                    "example": 123
+                }
+            }
+        },
+        "entity.OrderStatus": {
+            "type": "string",
+            "enum": [
+                "pending",
+                "confirmed",
+                "route_ready",
+                "assigned",
+                "in_progress",
+                "delivered",
+                "cancelled",
+                "failed"
+            ],
+            "x-enum-comments": {
+                "StatusAssigned": "водитель назначен",
+                "StatusCancelled": "отменен",
+                "StatusConfirmed": "товар зарезервирован",
+                "StatusDelivered": "доставлен",
+                "StatusFailed": "ошибка",
+                "StatusInProgress": "в пути",
+                "StatusPending": "создан, ждет проверки склада",
+                "StatusRouteReady": "маршрут построен"
+            },
+            "x-enum-descriptions": [
+                "создан, ждет проверки склада",
+                "товар зарезервирован",
+                "маршрут построен",
+                "водитель назначен",
+                "в пути",
+                "доставлен",
+                "отменен",
+                "ошибка"
+            ],
+            "x-enum-varnames": [
+                "StatusPending",
+                "StatusConfirmed",
+                "StatusRouteReady",
+                "StatusAssigned",
+                "StatusInProgress",
+                "StatusDelivered",
+                "StatusCancelled",
+                "StatusFailed"
+            ]
+        },
+        "entity.OrderStatusChange": {
+            "description": "Переход статуса заказа",
+            "type": "object",
+            "properties": {
+                "actor_id": {
+                    "type": "integer",
+                    "example": 123
+                },
+                "actor_type": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ActorType"
+                        }
+                    ],
+                    "example": "user"
+                },
+                "changed_at": {
+                    "type": "integer",
+                    "example": 1694966400
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "new_status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.OrderStatus"
+                        }
+                    ],
+                    "example": "in_progress"
+                },
+                "old_status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.OrderStatus"
+                        }
+                    ],
+                    "example": "pending"
+                },
+                "order_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "reason": {
                     "type": "string",
-                    "example": "user@example.com"
+                    "example": "driver assigned"
+                }
+            }
+        },
+        "entity.Product": {
+            "description": "Товар каталога",
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "created_at": {
+                    "type": "integer",
+                    "example": 1757808000
+                },
+                "description": {
+                    "type": "string",
+                    "example": "Игровой ноутбук 15.6\""
+                },
+                "dimensions": {
+                    "$ref": "#/definitions/entity.Dimensions"
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Ноутбук ASUS ROG"
+                },
+                "reorder_point": {
+                    "description": "ReorderPoint - доступный остаток на складе, ниже которого товар пора пополнять; 0 - без контроля",
+                    "type": "integer",
+                    "example": 5
+                },
+                "reorder_quantity": {
+                    "description": "ReorderQuantity - сколько обычно заказывается у поставщика за раз",
+                    "type": "integer",
+                    "example": 20
+                },
+                "sku": {
+                    "type": "string",
+                    "example": "SKU-000001"
+                },
+                "unit_price": {
+                    "type": "number",
+                    "example": 899.99
+                },
+                "updated_at": {
+                    "type": "integer",
+                    "example": 1757808000
+                },
+                "weight_grams": {
+                    "type": "integer",
+                    "example": 2300
+                }
+            }
+        },
+        "entity.ReceivingDocument": {
+            "description": "Документ приемки",
+            "type": "object",
+            "properties": {
+                "created_at": {
+                    "type": "integer",
+                    "example": 1757808000
+                },
+                "created_by": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "lines": {
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.ReceivingLine"
+                    }
+                },
+                "note": {
+                    "type": "string"
+                },
+                "posted_at": {
+                    "type": "integer",
+                    "example": 1757894400
+                },
+                "posted_by": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "reference": {
+                    "type": "string",
+                    "example": "ТН-2025-0142"
+                },
+                "status": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.ReceivingStatus"
+                        }
+                    ],
+                    "example": "draft"
+                },
+                "supplier": {
+                    "type": "string",
+                    "example": "ООО Поставщик"
+                },
+                "updated_at": {
+                    "type": "integer",
+                    "example": 1757808000
+                },
+                "warehouse_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "entity.ReceivingLine": {
+            "type": "object",
+            "properties": {
+                "expected_quantity": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "expires_at": {
+                    "description": "ExpiresAt - срок годности партии, unix-время; 0 - товар не портится",
+                    "type": "integer",
+                    "example": 1760659200
+                },
+                "lot_number": {
+                    "description": "LotNumber - партия, в которую приходуется товар; пустой - партия по номеру документа",
+                    "type": "string",
+                    "example": "L2025-0917"
+                },
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "product_name": {
+                    "type": "string",
+                    "example": "Ноутбук ASUS ROG"
+                },
+                "received_quantity": {
+                    "type": "integer",
+                    "example": 9
+                }
+            }
+        },
+        "entity.ReceivingStatus": {
+            "type": "string",
+            "enum": [
+                "draft",
+                "posted"
+            ],
+            "x-enum-comments": {
+                "ReceivingDraft": "товар принимается, количества можно менять",
+                "ReceivingPosted": "принятое оприходовано на склад"
+            },
+            "x-enum-descriptions": [
+                "товар принимается, количества можно менять",
+                "принятое оприходовано на склад"
+            ],
+            "x-enum-varnames": [
+                "ReceivingDraft",
+                "ReceivingPosted"
+            ]
+        },
+        "entity.ReplenishmentItem": {
+            "description": "Товар, который нужно пополнить, и рекомендуемое количество к заказу",
+            "type": "object",
+            "properties": {
+                "available": {
+                    "type": "integer",
+                    "example": 3
+                },
+                "detected_at": {
+                    "description": "DetectedAt - когда отправлено событие stock.low; 0 - фоновая проверка еще не прошла",
+                    "type": "integer",
+                    "example": 1757808000
+                },
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "product_name": {
+                    "type": "string",
+                    "example": "Ноутбук ASUS ROG"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 4
+                },
+                "reorder_point": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "reorder_quantity": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "reserved": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "sku": {
+                    "type": "string",
+                    "example": "SKU-000001"
+                },
+                "suggested_quantity": {
+                    "type": "integer",
+                    "example": 20
+                },
+                "warehouse_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "entity.StockAllocation": {
+            "description": "Количество товара заказа, отгружаемое со склада",
+            "type": "object",
+            "properties": {
+                "lots": {
+                    "description": "Lots - партии, из которых собирается количество, по FEFO",
+                    "type": "array",
+                    "items": {
+                        "$ref": "#/definitions/entity.LotAllocation"
+                    }
+                },
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "password": {
-                    "type": "string",
-                    "example": "password123"
+                "quantity": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "warehouse_id": {
+                    "type": "integer",
+                    "example": 1
                 }
             }
         },
-        "dto.RegisterRequest": {
-            "description": "Запрос на регистрацию нового пользователя",
+        "entity.StockDiscrepancy": {
+            "description": "Товар, у которого остаток не совпадает с журналом",
             "type": "object",
-            "required": [
-                "confirm_password",
-                "email",
-                "first_name",
-                "last_name",
-                "password"
-            ],
             "properties": {
-                "confirm_password": {
-                    "type": "string",
-                    "example": "password123"
+                "ledger_total": {
+                    "type": "integer",
+                    "example": 12
                 },
-                "email": {
-                    "type": "string",
-                    "example": "user@example.com"
+                "on_hand": {
+                    "type": "integer",
+                    "example": 10
                 },
-                "first_name": {
-                    "type": "string",
-                    "maxLength": 50,
-                    "minLength": 2,
-                    "example": "John"
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "last_name": {
+                "product_name": {
                     "type": "string",
-                    "maxLength": 50,
-                    "minLength": 2,
-                    "example": "Doe"
+                    "example": "Ноутбук ASUS ROG"
                 },
-                "password": {
-                    "type": "string",
-                    "maxLength": 100,
-                    "minLength": 8,
-                    "example": "password123"
+                "warehouse_id": {
+                    "type": "integer",
+                    "example": 1
                 }
             }
         },
-        "dto.UserInfo": {
+        "entity.StockImportResult": {
+            "description": "Остаток и цена товара до и после загрузки",
             "type": "object",
             "properties": {
-                "email": {
-                    "type": "string",
-                    "example": "user@example.com"
+                "line": {
+                    "type": "integer",
+                    "example": 2
                 },
-                "first_name": {
-                    "type": "string",
-                    "example": "John"
+                "new_quantity": {
+                    "type": "integer",
+                    "example": 12
                 },
-                "id": {
+                "new_unit_price": {
+                    "type": "number",
+                    "example": 1899.99
+                },
+                "old_quantity": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "old_unit_price": {
+                    "type": "number",
+                    "example": 1999.99
+                },
+                "product_id": {
                     "type": "integer",
                     "example": 1
                 },
-                "last_name": {
+                "product_name": {
                     "type": "string",
-                    "example": "Doe"
+                    "example": "Ноутбук ASUS ROG"
+                },
+                "sku": {
+                    "type": "string",
+                    "example": "SKU-000001"
+                },
+                "warehouse_id": {
+                    "type": "integer",
+                    "example": 1
                 }
             }
         },
-        "entity.GoodsItem": {
-            "description": "Товар в составе заказа",
+        "entity.StockLot": {
+            "description": "Партия товара: номер, срок годности, дата поступления и остаток",
             "type": "object",
             "properties": {
-                "last_updated": {
+                "expires_at": {
+                    "description": "ExpiresAt - срок годности, unix-время; 0 - товар не портится",
                     "type": "integer",
-                    "example": 1694966400
+                    "example": 1760659200
                 },
-                "price": {
-                    "type": "number",
-                    "example": 15000
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "lot_number": {
+                    "type": "string",
+                    "example": "L2025-0917"
                 },
                 "product_id": {
                     "type": "integer",
-                    "example": 789
+                    "example": 1
                 },
                 "product_name": {
                     "type": "string",
-                    "example": "Ноутбук"
+                    "example": "Молоко 3,2%"
                 },
                 "quantity": {
+                    "type": "integer",
+                    "example": 40
+                },
+                "received_at": {
+                    "type": "integer",
+                    "example": 1758067200
+                },
+                "reserved": {
+                    "type": "integer",
+                    "example": 5
+                },
+                "warehouse_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
+        },
+        "entity.StockMovement": {
+            "description": "Изменение остатка товара с причиной и ссылкой на документ",
+            "type": "object",
+            "properties": {
+                "actor_id": {
                     "type": "integer",
                     "example": 1
                 },
-                "total_price": {
-                    "type": "number",
-                    "example": 15000
+                "created_at": {
+                    "type": "integer",
+                    "example": 1757808000
+                },
+                "delta": {
+                    "type": "integer",
+                    "example": -2
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "lot_id": {
+                    "description": "LotID - партия, остаток которой изменился; 0 - запись до учета партий",
+                    "type": "integer",
+                    "example": 1
+                },
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "reason": {
+                    "allOf": [
+                        {
+                            "$ref": "#/definitions/entity.MovementReason"
+                        }
+                    ],
+                    "example": "order"
+                },
+                "reference_id": {
+                    "type": "integer",
+                    "example": 42
+                },
+                "warehouse_id": {
+                    "type": "integer",
+                    "example": 1
                 }
             }
         },
-        "entity.Order": {
-            "description": "Информация о заказе",
+        "entity.StockTransfer": {
+            "description": "Документ перемещения",
             "type": "object",
             "properties": {
                 "created_at": {
                     "type": "integer",
-                    "example": 1694966400
+                    "example": 1757808000
                 },
-                "delivery_address": {
-                    "type": "string",
-                    "example": "ул. Пушкина, д. 10"
+                "created_by": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "destination_warehouse_id": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "dispatched_at": {
+                    "type": "integer",
+                    "example": 1757811600
+                },
+                "dispatched_by": {
+                    "type": "integer",
+                    "example": 1
                 },
                 "driver_id": {
                     "type": "integer",
-                    "example": 456
+                    "example": 3
                 },
                 "id": {
                     "type": "integer",
                     "example": 1
                 },
-                "items": {
+                "lines": {
                     "type": "array",
                     "items": {
-                        "$ref": "#/definitions/entity.GoodsItem"
+                        "$ref": "#/definitions/entity.TransferLine"
                     }
                 },
+                "note": {
+                    "type": "string"
+                },
+                "received_at": {
+                    "type": "integer",
+                    "example": 1757822400
+                },
+                "received_by": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "source_warehouse_id": {
+                    "type": "integer",
+                    "example": 1
+                },
                 "status": {
                     "allOf": [
                         {
-                            "$ref": "#/definitions/entity.OrderStatus"
+                            "$ref": "#/definitions/entity.TransferStatus"
                         }
                     ],
-                    "example": "pending"
+                    "example": "draft"
                 },
-                "total_amount": {
-                    "type": "number",
-                    "example": 15000.5
+                "updated_at": {
+                    "type": "integer",
+                    "example": 1757808000
+                }
+            }
+        },
+        "entity.TransferLine": {
+            "type": "object",
+            "properties": {
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
                 },
-                "user_id": {
+                "product_name": {
+                    "type": "string",
+                    "example": "Ноутбук ASUS ROG"
+                },
+                "quantity": {
                     "type": "integer",
-                    "example": 123
+                    "example": 5
                 }
             }
         },
-        "entity.OrderStatus": {
+        "entity.TransferStatus": {
             "type": "string",
             "enum": [
-                "pending",
-                "confirmed",
-                "route_ready",
-                "assigned",
-                "in_progress",
-                "delivered",
-                "cancelled",
-                "failed"
+                "draft",
+                "in_transit",
+                "received"
             ],
             "x-enum-comments": {
-                "StatusAssigned": "водитель назначен",
-                "StatusCancelled": "отменен",
-                "StatusConfirmed": "товар зарезервирован",
-                "StatusDelivered": "доставлен",
-                "StatusFailed": "ошибка",
-                "StatusInProgress": "в пути",
-                "StatusPending": "создан, ждет проверки склада",
-                "StatusRouteReady": "маршрут построен"
+                "TransferDraft": "состав и водитель еще меняются, остатки не тронуты",
+                "TransferInTransit": "товар списан со склада-источника и в пути",
+                "TransferReceived": "товар оприходован на складе-получателе"
             },
             "x-enum-descriptions": [
-                "создан, ждет проверки склада",
-                "товар зарезервирован",
-                "маршрут построен",
-                "водитель назначен",
-                "в пути",
-                "доставлен",
-                "отменен",
-                "ошибка"
+                "состав и водитель еще меняются, остатки не тронуты",
+                "товар списан со склада-источника и в пути",
+                "товар оприходован на складе-получателе"
             ],
             "x-enum-varnames": [
-                "StatusPending",
-                "StatusConfirmed",
-                "StatusRouteReady",
-                "StatusAssigned",
-                "StatusInProgress",
-                "StatusDelivered",
-                "StatusCancelled",
-                "StatusFailed"
+                "TransferDraft",
+                "TransferInTransit",
+                "TransferReceived"
             ]
+        },
+        "entity.Warehouse": {
+            "description": "Склад",
+            "type": "object",
+            "properties": {
+                "active": {
+                    "type": "boolean",
+                    "example": true
+                },
+                "address": {
+                    "type": "string",
+                    "example": "г. Москва, ул. Складская, д. 1"
+                },
+                "created_at": {
+                    "type": "integer",
+                    "example": 1757808000
+                },
+                "id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "location": {
+                    "$ref": "#/definitions/entity.Location"
+                },
+                "name": {
+                    "type": "string",
+                    "example": "Склад Север"
+                },
+                "updated_at": {
+                    "type": "integer",
+                    "example": 1757808000
+                }
+            }
+        },
+        "entity.WarehouseStockItem": {
+            "description": "Остаток товара на складе",
+            "type": "object",
+            "properties": {
+                "available": {
+                    "type": "integer",
+                    "example": 10
+                },
+                "last_updated": {
+                    "type": "integer",
+                    "example": 1757808000
+                },
+                "product_id": {
+                    "type": "integer",
+                    "example": 1
+                },
+                "product_name": {
+                    "type": "string",
+                    "example": "Ноутбук ASUS ROG"
+                },
+                "quantity": {
+                    "type": "integer",
+                    "example": 12
+                },
+                "reserved": {
+                    "type": "integer",
+                    "example": 2
+                },
+                "sku": {
+                    "type": "string",
+                    "example": "SKU-000001"
+                },
+                "unit_price": {
+                    "type": "number",
+                    "example": 1999.99
+                },
+                "warehouse_id": {
+                    "type": "integer",
+                    "example": 1
+                }
+            }
         }
     },
     "securityDefinitions": {
@@ -14,6 +14,8 @@ type KafkaConfig struct {
 	Brokers  []string
 	Topic    string
 	Group_id string
+	// EventsTopic - топик для событий сервиса, публикуемых через outbox
+	EventsTopic string `mapstructure:"events_topic"`
 }
 
 type Msg struct {
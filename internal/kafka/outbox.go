@@ -0,0 +1,213 @@
+package kafka
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgxpool"
+	"github.com/segmentio/kafka-go"
+)
+
+// Таблицы outbox по сервисам
+const (
+	OrderOutboxTable     = "order_outbox"
+	DriverOutboxTable    = "driver_outbox"
+	WarehouseOutboxTable = "warehouse_outbox"
+)
+
+// Типы событий, публикуемых через outbox
+const (
+	EventOrderStatusChanged = "order.status_changed"
+	EventDriverFound        = "driver.found"
+	EventStockLow           = "stock.low"
+)
+
+// Команды другим сервисам: пишутся в outbox вместе с изменением состояния и исполняются
+// по gRPC диспетчером outbox, а не публикуются в Kafka
+const (
+	CommandReturnStock   = "order.return_stock"
+	CommandReleaseDriver = "order.release_driver"
+)
+
+const (
+	defaultRelayInterval  = time.Second
+	defaultRelayBatchSize = 100
+	maxRelayBackoff       = 5 * time.Minute
+)
+
+// OutboxMessage - событие, ожидающее публикации в Kafka
+type OutboxMessage struct {
+	ID        int64
+	EventType string
+	Key       string
+	Payload   []byte
+	Attempts  int
+	CreatedAt int64
+}
+
+// EnqueueOutbox записывает событие в outbox в той же транзакции, что и изменение состояния
+func EnqueueOutbox(ctx context.Context, tx pgx.Tx, table string, msg OutboxMessage) error {
+	if msg.CreatedAt == 0 {
+		msg.CreatedAt = time.Now().Unix()
+	}
+	query := `INSERT INTO ` + table + ` (event_type, message_key, payload, created_at, next_attempt_at) VALUES ($1, $2, $3, $4, $4)`
+	_, err := tx.Exec(ctx, query, msg.EventType, msg.Key, msg.Payload, msg.CreatedAt)
+	if err != nil {
+		return fmt.Errorf("failed to enqueue %s event: %w", msg.EventType, err)
+	}
+	return nil
+}
+
+// OutboxHandler доставляет сообщение outbox; ошибка откладывает повтор с экспоненциальной задержкой
+type OutboxHandler func(ctx context.Context, msg OutboxMessage) error
+
+// OutboxRelay доставляет неотправленные сообщения из outbox и отмечает их отправленными.
+// Доставка at-least-once: сообщение может уйти повторно, если отметка не успела сохраниться.
+type OutboxRelay struct {
+	pool      *pgxpool.Pool
+	table     string
+	deliver   OutboxHandler
+	logger    *slog.Logger
+	interval  time.Duration
+	batchSize int
+	// eventTypes - какие сообщения забирает relay; пусто - все
+	eventTypes []string
+	// ordered - после ошибки остальные сообщения батча ждут следующего прохода, чтобы не нарушить порядок
+	ordered bool
+}
+
+// NewOutboxRelay публикует события outbox в Kafka в порядке записи
+func NewOutboxRelay(logger *slog.Logger, pool *pgxpool.Pool, table string, producer *KafkaProducer) *OutboxRelay {
+	return &OutboxRelay{
+		pool:  pool,
+		table: table,
+		deliver: func(ctx context.Context, msg OutboxMessage) error {
+			return producer.SendMessage(ctx, kafka.Message{
+				Key:     []byte(msg.Key),
+				Value:   msg.Payload,
+				Headers: []kafka.Header{{Key: "event_type", Value: []byte(msg.EventType)}},
+			})
+		},
+		logger:    logger,
+		interval:  defaultRelayInterval,
+		batchSize: defaultRelayBatchSize,
+		ordered:   true,
+	}
+}
+
+// NewOutboxDispatcher исполняет команды из outbox обработчиком handler. Команды независимы,
+// поэтому ошибка одной откладывает только ее
+func NewOutboxDispatcher(logger *slog.Logger, pool *pgxpool.Pool, table string, handler OutboxHandler) *OutboxRelay {
+	return &OutboxRelay{
+		pool:      pool,
+		table:     table,
+		deliver:   handler,
+		logger:    logger,
+		interval:  defaultRelayInterval,
+		batchSize: defaultRelayBatchSize,
+	}
+}
+
+// ForEventTypes ограничивает relay сообщениями перечисленных типов, чтобы события и команды
+// одного outbox доставлялись разными relay
+func (r *OutboxRelay) ForEventTypes(eventTypes ...string) *OutboxRelay {
+	r.eventTypes = eventTypes
+	return r
+}
+
+// Run работает до отмены контекста
+func (r *OutboxRelay) Run(ctx context.Context) {
+	ticker := time.NewTicker(r.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			// Выбираем батчи, пока outbox не опустеет
+			for {
+				sent, err := r.relayBatch(ctx)
+				if err != nil {
+					r.logger.Error("Failed to relay outbox batch", slog.String("table", r.table), slog.String("error", err.Error()))
+					break
+				}
+				if sent < r.batchSize {
+					break
+				}
+			}
+		}
+	}
+}
+
+// relayBatch отправляет один батч; строки заблокированы на время отправки,
+// поэтому несколько экземпляров сервиса не публикуют одно событие параллельно
+func (r *OutboxRelay) relayBatch(ctx context.Context) (int, error) {
+	tx, err := r.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	now := time.Now().Unix()
+	query := `SELECT id, event_type, message_key, payload, attempts, created_at FROM ` + r.table + `
+		WHERE sent_at IS NULL AND next_attempt_at <= $1 AND ($3::text[] IS NULL OR event_type = ANY($3))
+		ORDER BY id LIMIT $2 FOR UPDATE SKIP LOCKED`
+	rows, err := tx.Query(ctx, query, now, r.batchSize, r.eventTypes)
+	if err != nil {
+		return 0, fmt.Errorf("failed to fetch outbox messages: %w", err)
+	}
+	var messages []OutboxMessage
+	for rows.Next() {
+		var msg OutboxMessage
+		if err := rows.Scan(&msg.ID, &msg.EventType, &msg.Key, &msg.Payload, &msg.Attempts, &msg.CreatedAt); err != nil {
+			rows.Close()
+			return 0, fmt.Errorf("failed to scan outbox message: %w", err)
+		}
+		messages = append(messages, msg)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return 0, fmt.Errorf("error iterating outbox messages: %w", err)
+	}
+
+	sent := 0
+	for _, msg := range messages {
+		if err := r.deliver(ctx, msg); err != nil {
+			// Откладываем сообщение с экспоненциальной задержкой
+			nextAttempt := now + int64(relayBackoff(msg.Attempts+1).Seconds())
+			_, updErr := tx.Exec(ctx, `UPDATE `+r.table+` SET attempts = attempts + 1, last_error = $2, next_attempt_at = $3 WHERE id = $1`, msg.ID, err.Error(), nextAttempt)
+			if updErr != nil {
+				return sent, fmt.Errorf("failed to record outbox failure: %w", updErr)
+			}
+			r.logger.Warn("Outbox message delivery failed", slog.String("table", r.table), slog.Int64("id", msg.ID), slog.String("event_type", msg.EventType), slog.String("error", err.Error()))
+			if r.ordered {
+				break
+			}
+			continue
+		}
+		if _, err := tx.Exec(ctx, `UPDATE `+r.table+` SET sent_at = $2, attempts = attempts + 1 WHERE id = $1`, msg.ID, now); err != nil {
+			return sent, fmt.Errorf("failed to mark outbox message as sent: %w", err)
+		}
+		sent++
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	if sent > 0 {
+		r.logger.Info("Outbox messages relayed", slog.String("table", r.table), slog.Int("count", sent))
+	}
+	return sent, nil
+}
+
+func relayBackoff(attempt int) time.Duration {
+	backoff := time.Second << min(attempt, 10)
+	if backoff > maxRelayBackoff {
+		return maxRelayBackoff
+	}
+	return backoff
+}
@@ -9,9 +9,11 @@ import (
 	"logistics/internal/shared/models/dto"
 	"logistics/pkg/lib/logger/slogger"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/gin-gonic/gin"
+	"google.golang.org/grpc"
 )
 
 type AuthHandler struct {
@@ -117,6 +119,50 @@ func (h *AuthHandler) SignIn(c *gin.Context) {
 	})
 }
 
+// @Summary Обновление сессии
+// @Description Обменивает refresh token из cookie на новую пару токенов. Старый refresh token гасится;
+// @Description повторное его предъявление завершает все сессии, начатые тем же входом
+// @Tags auth
+// @Produce  json
+// @Success 200 {object} dto.RefreshResponse
+// @Failure 401 {object} object{error=string} "Refresh token отсутствует, истек или отозван"
+// @Failure 500 {object} object{error=string} "Ошибка сервера"
+// @Router /auth/refresh [post]
+func (h *AuthHandler) Refresh(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+	refreshToken, err := c.Cookie("refresh_token")
+	if err != nil || refreshToken == "" {
+		h.logger.Error("Refresh token is required", slogger.Err(err), slog.String("status", fmt.Sprintf("%d", http.StatusUnauthorized)))
+		c.JSON(http.StatusUnauthorized, gin.H{"error": "Refresh token is required"})
+		return
+	}
+	session, err := h.authGRPCClient.RefreshSession(ctx, &authpb.RefreshSessionRequest{
+		RefreshToken: refreshToken,
+		ExpiresAt:    time.Now().Add(middleware.RefreshTokenTTL).Unix(),
+	})
+	if err != nil {
+		code := httpStatusFromGRPC(err)
+		h.logger.Error("Failed to refresh session", slogger.Err(err), slog.String("status", fmt.Sprintf("%d", code)))
+		if code == http.StatusUnauthorized {
+			middleware.ClearRefreshTokenCookie(c)
+			c.JSON(code, gin.H{"error": "Invalid refresh token"})
+			return
+		}
+		c.JSON(code, gin.H{"error": err.Error()})
+		return
+	}
+	middleware.SetRefreshTokenCookie(c, session.RefreshToken)
+	c.Header("Authorization", "Bearer "+session.AccessToken)
+
+	h.logger.Info("Session refreshed", slog.Int64("user_id", session.UserId), slog.String("status", fmt.Sprintf("%d", http.StatusOK)))
+	c.JSON(http.StatusOK, dto.RefreshResponse{
+		AccessToken: session.AccessToken,
+		UserID:      uint(session.UserId),
+		Roles:       session.Roles,
+	})
+}
+
 // @Summary Выход из системы
 // @Description Выполняет выход пользователя и удаляет refresh token
 // @Tags auth
@@ -148,3 +194,60 @@ func (h *AuthHandler) Logout(c *gin.Context) {
 	}
 	c.JSON(http.StatusOK, gin.H{"message": "Logged out successfully"})
 }
+
+// @Summary Выдача роли
+// @Description Выдает пользователю роль admin, dispatcher, driver или customer. Новая роль попадет в access токен пользователя после обновления сессии
+// @Tags admin
+// @Produce  json
+// @Param   user_id path int true "ID пользователя"
+// @Param   role path string true "Роль" Enums(admin, dispatcher, driver, customer)
+// @Success 200 {object} object{user_id=int64,roles=[]string} "Роли пользователя"
+// @Failure 400 {object} object{error=string,message=string} "Неверный ID пользователя или роль"
+// @Failure 403 {object} object{error=string} "Нужна роль администратора"
+// @Failure 404 {object} object{error=string,message=string} "Пользователь не найден"
+// @Failure 500 {object} object{error=string,message=string} "Ошибка сервера"
+// @Security ApiKeyAuth
+// @Router /admin/users/{user_id}/roles/{role} [put]
+func (h *AuthHandler) GrantRole(c *gin.Context) {
+	h.changeRole(c, "grant", h.authGRPCClient.GrantRole)
+}
+
+// @Summary Отзыв роли
+// @Description Отзывает роль у пользователя. Последнего администратора роли лишить нельзя
+// @Tags admin
+// @Produce  json
+// @Param   user_id path int true "ID пользователя"
+// @Param   role path string true "Роль" Enums(admin, dispatcher, driver, customer)
+// @Success 200 {object} object{user_id=int64,roles=[]string} "Роли пользователя"
+// @Failure 400 {object} object{error=string,message=string} "Неверный ID пользователя или роль"
+// @Failure 403 {object} object{error=string} "Нужна роль администратора"
+// @Failure 409 {object} object{error=string,message=string} "Это последний администратор"
+// @Failure 500 {object} object{error=string,message=string} "Ошибка сервера"
+// @Security ApiKeyAuth
+// @Router /admin/users/{user_id}/roles/{role} [delete]
+func (h *AuthHandler) RevokeRole(c *gin.Context) {
+	h.changeRole(c, "revoke", h.authGRPCClient.RevokeRole)
+}
+
+type changeRoleFunc func(ctx context.Context, req *authpb.ChangeUserRoleRequest, opts ...grpc.CallOption) (*authpb.GetUserRolesResponse, error)
+
+func (h *AuthHandler) changeRole(c *gin.Context, action string, change changeRoleFunc) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+	userID, err := strconv.ParseInt(c.Param("user_id"), 10, 64)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid user_id"})
+		return
+	}
+
+	resp, err := change(ctx, &authpb.ChangeUserRoleRequest{UserId: userID, Role: c.Param("role")})
+	if err != nil {
+		h.logger.Error("Failed to "+action+" role", slogger.Err(err), slog.Int64("user_id", userID))
+		c.JSON(httpStatusFromGRPC(err), gin.H{
+			"error":   "Failed to " + action + " role",
+			"message": err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{"user_id": userID, "roles": resp.Roles})
+}
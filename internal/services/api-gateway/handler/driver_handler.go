@@ -0,0 +1,269 @@
+package handler
+
+import (
+	"context"
+	"log/slog"
+	driverpb "logistics/api/protobuf/driver_service"
+	"logistics/internal/shared/entity"
+	"logistics/internal/shared/models/dto"
+	"logistics/pkg/lib/logger/slogger"
+	"logistics/pkg/lib/utils"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+type DriverHandler struct {
+	logger           *slog.Logger
+	driverGRPCClient driverpb.DriverServiceClient
+}
+
+func NewDriverHandler(logger *slog.Logger, driverClient driverpb.DriverServiceClient) *DriverHandler {
+	return &DriverHandler{
+		logger:           logger,
+		driverGRPCClient: driverClient,
+	}
+}
+
+// @Summary Создание водителя
+// @Description Регистрирует нового водителя. Телефон и номер удостоверения должны быть уникальными
+// @Tags admin
+// @Accept  json
+// @Produce  json
+// @Param   request body dto.CreateDriverRequest true "Данные водителя"
+// @Success 201 {object} object{driver=entity.Driver} "Водитель создан"
+// @Failure 400 {object} object{error=string,message=string} "Некорректные данные"
+// @Failure 403 {object} object{error=string} "Нужна роль администратора или диспетчера"
+// @Failure 409 {object} object{error=string,message=string} "Телефон или удостоверение уже заняты"
+// @Failure 500 {object} object{error=string,message=string} "Ошибка сервера"
+// @Security ApiKeyAuth
+// @Router /admin/drivers [post]
+func (d *DriverHandler) CreateDriver(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var req dto.CreateDriverRequest
+	if err := c.BindJSON(&req); err != nil {
+		d.logger.Error("Failed to bind JSON", slogger.Err(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := d.driverGRPCClient.CreateDriver(ctx, &driverpb.CreateDriverRequest{
+		Name:          req.Name,
+		Phone:         req.Phone,
+		Email:         req.Email,
+		LicenseNumber: req.LicenseNumber,
+		Car:           req.Car,
+		Status:        req.Status,
+	})
+	if err != nil {
+		d.logger.Error("Failed to create driver", "error", slogger.Err(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{
+			"error":   "Failed to create driver",
+			"message": err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusCreated, gin.H{
+		"driver": utils.ConvertProtoToDriver(resp.Driver),
+	})
+}
+
+// @Summary Список водителей
+// @Description Возвращает страницу водителей с фильтром по статусу. Уволенные водители скрыты, пока не передан include_inactive
+// @Tags admin
+// @Produce  json
+// @Param   status query string false "Статус водителя" Enums(offline, available, busy, break, unavailable)
+// @Param   include_inactive query bool false "Показывать уволенных водителей"
+// @Param   page query int false "Номер страницы" default(1)
+// @Param   page_size query int false "Размер страницы (до 100)" default(20)
+// @Success 200 {object} object{drivers=[]entity.Driver,total=int64,page=int,page_size=int} "Страница водителей"
+// @Failure 400 {object} object{error=string,message=string} "Некорректные параметры"
+// @Failure 403 {object} object{error=string} "Нужна роль администратора или диспетчера"
+// @Failure 500 {object} object{error=string,message=string} "Ошибка сервера"
+// @Security ApiKeyAuth
+// @Router /admin/drivers [get]
+func (d *DriverHandler) ListDrivers(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	req := &driverpb.ListDriversRequest{
+		Status: c.Query("status"),
+	}
+	var err error
+	if value := c.Query("include_inactive"); value != "" {
+		if req.IncludeInactive, err = strconv.ParseBool(value); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid include_inactive"})
+			return
+		}
+	}
+	if req.Page, err = queryInt32(c, "page"); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid page"})
+		return
+	}
+	if req.PageSize, err = queryInt32(c, "page_size"); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid page_size"})
+		return
+	}
+
+	resp, err := d.driverGRPCClient.ListDrivers(ctx, req)
+	if err != nil {
+		d.logger.Error("Failed to list drivers", "error", slogger.Err(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{
+			"error":   "Failed to list drivers",
+			"message": err.Error(),
+		})
+		return
+	}
+	drivers := make([]*entity.Driver, 0, len(resp.Drivers))
+	for _, driver := range resp.Drivers {
+		drivers = append(drivers, utils.ConvertProtoToDriver(driver))
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"drivers":   drivers,
+		"total":     resp.Total,
+		"page":      resp.Page,
+		"page_size": resp.PageSize,
+	})
+}
+
+// @Summary Получение водителя
+// @Description Возвращает карточку водителя по ID
+// @Tags admin
+// @Produce  json
+// @Param   driver_id path int true "ID водителя"
+// @Success 200 {object} object{driver=entity.Driver} "Водитель"
+// @Failure 400 {object} object{error=string} "Неверный ID водителя"
+// @Failure 403 {object} object{error=string} "Нужна роль администратора или диспетчера"
+// @Failure 404 {object} object{error=string,message=string} "Водитель не найден"
+// @Failure 500 {object} object{error=string,message=string} "Ошибка сервера"
+// @Security ApiKeyAuth
+// @Router /admin/drivers/{driver_id} [get]
+func (d *DriverHandler) GetDriver(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	driverID, err := strconv.Atoi(c.Param("driver_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid driver_id"})
+		return
+	}
+
+	resp, err := d.driverGRPCClient.GetDriver(ctx, &driverpb.GetDriverRequest{DriverId: int64(driverID)})
+	if err != nil {
+		d.logger.Error("Failed to get driver", "error", slogger.Err(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{
+			"error":   "Failed to get driver",
+			"message": err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"driver": utils.ConvertProtoToDriver(resp.Driver),
+	})
+}
+
+// @Summary Изменение водителя
+// @Description Заменяет анкетные данные водителя. Статус меняется отдельно
+// @Tags admin
+// @Accept  json
+// @Produce  json
+// @Param   driver_id path int true "ID водителя"
+// @Param   request body dto.DriverRequest true "Данные водителя"
+// @Success 200 {object} object{driver=entity.Driver} "Водитель изменен"
+// @Failure 400 {object} object{error=string,message=string} "Некорректные данные"
+// @Failure 403 {object} object{error=string} "Нужна роль администратора или диспетчера"
+// @Failure 404 {object} object{error=string,message=string} "Водитель не найден"
+// @Failure 409 {object} object{error=string,message=string} "Телефон или удостоверение уже заняты"
+// @Failure 500 {object} object{error=string,message=string} "Ошибка сервера"
+// @Security ApiKeyAuth
+// @Router /admin/drivers/{driver_id} [put]
+func (d *DriverHandler) UpdateDriver(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	driverID, err := strconv.Atoi(c.Param("driver_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid driver_id"})
+		return
+	}
+	var req dto.DriverRequest
+	if err := c.BindJSON(&req); err != nil {
+		d.logger.Error("Failed to bind JSON", slogger.Err(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := d.driverGRPCClient.UpdateDriver(ctx, &driverpb.UpdateDriverRequest{
+		DriverId:      int64(driverID),
+		Name:          req.Name,
+		Phone:         req.Phone,
+		Email:         req.Email,
+		LicenseNumber: req.LicenseNumber,
+		Car:           req.Car,
+	})
+	if err != nil {
+		d.logger.Error("Failed to update driver", "error", slogger.Err(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{
+			"error":   "Failed to update driver",
+			"message": err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"driver": utils.ConvertProtoToDriver(resp.Driver),
+	})
+}
+
+// @Summary Деактивация водителя
+// @Description Снимает водителя с линии: он переводится в offline и больше не назначается на заказы. Водителя на заказе деактивировать нельзя
+// @Tags admin
+// @Produce  json
+// @Param   driver_id path int true "ID водителя"
+// @Success 200 {object} object{driver=entity.Driver} "Водитель деактивирован"
+// @Failure 400 {object} object{error=string} "Неверный ID водителя"
+// @Failure 403 {object} object{error=string} "Нужна роль администратора или диспетчера"
+// @Failure 404 {object} object{error=string,message=string} "Водитель не найден"
+// @Failure 409 {object} object{error=string,message=string} "Водитель выполняет заказ"
+// @Failure 500 {object} object{error=string,message=string} "Ошибка сервера"
+// @Security ApiKeyAuth
+// @Router /admin/drivers/{driver_id}/deactivate [post]
+func (d *DriverHandler) DeactivateDriver(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	driverID, err := strconv.Atoi(c.Param("driver_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid driver_id"})
+		return
+	}
+
+	resp, err := d.driverGRPCClient.DeactivateDriver(ctx, &driverpb.DeactivateDriverRequest{DriverId: int64(driverID)})
+	if err != nil {
+		d.logger.Error("Failed to deactivate driver", "error", slogger.Err(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{
+			"error":   "Failed to deactivate driver",
+			"message": err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"driver": utils.ConvertProtoToDriver(resp.Driver),
+	})
+}
+
+// queryInt32 читает необязательный числовой query-параметр; отсутствие - 0
+func queryInt32(c *gin.Context, key string) (int32, error) {
+	value := c.Query(key)
+	if value == "" {
+		return 0, nil
+	}
+	n, err := strconv.ParseInt(value, 10, 32)
+	return int32(n), err
+}
+
+func queryInt64(c *gin.Context, key string) (int64, error) {
+	value := c.Query(key)
+	if value == "" {
+		return 0, nil
+	}
+	return strconv.ParseInt(value, 10, 64)
+}
@@ -6,18 +6,23 @@ import (
 	driverpb "logistics/api/protobuf/driver_service"
 	orderpb "logistics/api/protobuf/order_service"
 	warehousepb "logistics/api/protobuf/warehouse_service"
+	"logistics/pkg/lib/accesstoken"
 )
 
 type Handlers struct {
 	AuthHandlerInterface
+	JWKSHandlerInterface
 	OrderHandlerInterface
 	WarehouseHandlerInterface
+	DriverHandlerInterface
 }
 
-func NewHandlers(logger *slog.Logger, authGRPCClient authpb.AuthServiceClient, orderGRPCClient orderpb.OrderServiceClient, driverGRPCClient driverpb.DriverServiceClient, warehouseGRPCClient warehousepb.WarehouseServiceClient) *Handlers {
+func NewHandlers(logger *slog.Logger, authGRPCClient authpb.AuthServiceClient, orderGRPCClient orderpb.OrderServiceClient, driverGRPCClient driverpb.DriverServiceClient, warehouseGRPCClient warehousepb.WarehouseServiceClient, verifier *accesstoken.Verifier) *Handlers {
 	return &Handlers{
 		AuthHandlerInterface:      NewAuthHandler(logger, authGRPCClient),
+		JWKSHandlerInterface:      NewJWKSHandler(logger, verifier),
 		OrderHandlerInterface:     NewOrderHandler(logger, orderGRPCClient, driverGRPCClient, warehouseGRPCClient),
 		WarehouseHandlerInterface: NewWarehouseHandler(logger, warehouseGRPCClient),
+		DriverHandlerInterface:    NewDriverHandler(logger, driverGRPCClient),
 	}
 }
@@ -5,7 +5,14 @@ import "github.com/gin-gonic/gin"
 type AuthHandlerInterface interface {
 	SignUp(c *gin.Context)
 	SignIn(c *gin.Context)
+	Refresh(c *gin.Context)
 	Logout(c *gin.Context)
+	GrantRole(c *gin.Context)
+	RevokeRole(c *gin.Context)
+}
+
+type JWKSHandlerInterface interface {
+	GetJWKS(c *gin.Context)
 }
 
 type OrderHandlerInterface interface {
@@ -13,10 +20,51 @@ type OrderHandlerInterface interface {
 	GetOrders(c *gin.Context)
 	GetOrderByID(c *gin.Context)
 	AssignDriver(c *gin.Context)
+	GetAssignmentJob(c *gin.Context)
+	GetDriverLocation(c *gin.Context)
+	WatchOrder(c *gin.Context)
 	CompleteOrder(c *gin.Context)
 	GetDeliveries(c *gin.Context)
+	GetOrderTimeline(c *gin.Context)
+	CancelOrder(c *gin.Context)
 }
 
 type WarehouseHandlerInterface interface {
 	GetAvailableProducts(c *gin.Context)
+	CreateProduct(c *gin.Context)
+	ListProducts(c *gin.Context)
+	GetProduct(c *gin.Context)
+	UpdateProduct(c *gin.Context)
+	DeleteProduct(c *gin.Context)
+	CreateReceivingDocument(c *gin.Context)
+	ListReceivingDocuments(c *gin.Context)
+	GetReceivingDocument(c *gin.Context)
+	RecordReceivedQuantities(c *gin.Context)
+	PostReceivingDocument(c *gin.Context)
+	ListStockMovements(c *gin.Context)
+	ReconcileStock(c *gin.Context)
+	ListReplenishment(c *gin.Context)
+	ListStockLots(c *gin.Context)
+	ImportStock(c *gin.Context)
+	ExportStock(c *gin.Context)
+	ExportStockMovements(c *gin.Context)
+	CreateWarehouse(c *gin.Context)
+	ListWarehouses(c *gin.Context)
+	GetWarehouse(c *gin.Context)
+	UpdateWarehouse(c *gin.Context)
+	GetWarehouseInventory(c *gin.Context)
+	CreateTransfer(c *gin.Context)
+	ListTransfers(c *gin.Context)
+	GetTransfer(c *gin.Context)
+	AssignTransferDriver(c *gin.Context)
+	DispatchTransfer(c *gin.Context)
+	ReceiveTransfer(c *gin.Context)
+}
+
+type DriverHandlerInterface interface {
+	CreateDriver(c *gin.Context)
+	ListDrivers(c *gin.Context)
+	GetDriver(c *gin.Context)
+	UpdateDriver(c *gin.Context)
+	DeactivateDriver(c *gin.Context)
 }
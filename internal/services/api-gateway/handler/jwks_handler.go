@@ -0,0 +1,43 @@
+package handler
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"logistics/pkg/lib/accesstoken"
+	"logistics/pkg/lib/logger/slogger"
+	"net/http"
+	"time"
+
+	"github.com/gin-gonic/gin"
+)
+
+// jwksMaxAge - сколько внешние проверяющие могут кешировать набор ключей
+const jwksMaxAge = 5 * time.Minute
+
+type JWKSHandler struct {
+	logger   *slog.Logger
+	verifier *accesstoken.Verifier
+}
+
+func NewJWKSHandler(logger *slog.Logger, verifier *accesstoken.Verifier) *JWKSHandler {
+	return &JWKSHandler{
+		logger:   logger,
+		verifier: verifier,
+	}
+}
+
+// GetJWKS отдает набор JWK (RFC 7517), которыми проверяются access токены: активный ключ и ключи,
+// выводимые из оборота. Путь стандартный и лежит вне /api/v1, поэтому в swagger не описан
+func (h *JWKSHandler) GetJWKS(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
+	defer cancel()
+	jwks, err := h.verifier.JWKS(ctx)
+	if err != nil {
+		h.logger.Error("Failed to get signing keys", slogger.Err(err), slog.String("status", fmt.Sprintf("%d", http.StatusServiceUnavailable)))
+		c.JSON(http.StatusServiceUnavailable, gin.H{"error": "signing keys are unavailable"})
+		return
+	}
+	c.Header("Cache-Control", fmt.Sprintf("public, max-age=%d", int(jwksMaxAge.Seconds())))
+	c.JSON(http.StatusOK, jwks)
+}
@@ -2,7 +2,9 @@ package handler
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"io"
 	"log/slog"
 	driverpb "logistics/api/protobuf/driver_service"
 	orderpb "logistics/api/protobuf/order_service"
@@ -14,11 +16,12 @@ import (
 	"logistics/pkg/lib/utils"
 	"net/http"
 	"strconv"
-	"sync"
 	"time"
 
 	"github.com/gin-gonic/gin"
-	"golang.org/x/sync/errgroup"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type OrderHandler struct {
@@ -26,7 +29,6 @@ type OrderHandler struct {
 	orderGRPCClient     orderpb.OrderServiceClient
 	driverGRPCClient    driverpb.DriverServiceClient
 	warehouseGRPCClient warehousepb.WarehouseServiceClient
-	stockCheckWorkers   chan struct{}
 }
 
 func NewOrderHandler(logger *slog.Logger, orderClient orderpb.OrderServiceClient, driverClient driverpb.DriverServiceClient, warehouseClient warehousepb.WarehouseServiceClient) *OrderHandler {
@@ -35,12 +37,11 @@ func NewOrderHandler(logger *slog.Logger, orderClient orderpb.OrderServiceClient
 		orderGRPCClient:     orderClient,
 		driverGRPCClient:    driverClient,
 		warehouseGRPCClient: warehouseClient,
-		stockCheckWorkers:   make(chan struct{}, 10),
 	}
 }
 
 // @Summary Создание нового заказа
-// @Description Создает новый заказ после проверки наличия товаров на складе
+// @Description Создает новый заказ: проверяет наличие товаров, списывает их со склада и подтверждает заказ
 // @Tags orders
 // @Accept  json
 // @Produce  json
@@ -68,126 +69,50 @@ func (o *OrderHandler) CreateOrder(c *gin.Context) {
 		return
 	}
 
-	g, gctx := errgroup.WithContext(ctx)
-
-	var (
-		available bool
-		mu        sync.RWMutex
-	)
-
-	// Проверка наличия товаров на складе
-	g.Go(func() error {
-		o.stockCheckWorkers <- struct{}{}
-		defer func() { <-o.stockCheckWorkers }()
-
-		stockReq := &warehousepb.CheckStockRequest{
-			Items: make([]*warehousepb.StockItem, len(req.Items)),
-		}
-		for i, item := range req.Items {
-			stockReq.Items[i] = &warehousepb.StockItem{
-				ProductName: item.ProductName,
-				Quantity:    int32(item.Quantity),
-			}
-		}
-
-		result, err := o.warehouseGRPCClient.CheckStockAvailability(gctx, stockReq)
-		if err != nil {
-			return fmt.Errorf("stock check failed: %w", err)
-		}
-		o.logger.Info("Stock check completed", slog.String("status", "success"), slog.Bool("available", result.Available))
-
-		mu.Lock()
-		available = result.Available
-		mu.Unlock()
-
-		return nil
-	})
-
-	// Ждем завершения всех параллельных операций
-	if err := g.Wait(); err != nil {
-		o.logger.Error("Parallel operations failed", "error", slogger.Err(err))
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error": err.Error(),
-		})
-		return
-	}
-
-	// Проверяем результаты (с защитой мьютексом)
-	mu.RLock()
-	if !available {
-		mu.RUnlock()
-		c.JSON(http.StatusBadRequest, gin.H{
-			"error":   "Stock not available",
-			"message": "Some items are out of stock",
-		})
-		return
-	}
-	mu.RUnlock()
-	o.logger.Info("All parallel operations completed successfully", slog.String("status", "success"), slog.Bool("stock_available", available))
-
-	// Создаем заказ
+	// Проверка склада, расчет цен и списание товара выполняются сагой в order-service
 	orderItems := make([]*orderpb.OrderItem, len(req.Items))
 	for i, item := range req.Items {
-		info, err := o.orderGRPCClient.GetOrderItemInfo(ctx, &orderpb.GetOrderItemInfoRequest{
-			ProductName: item.ProductName,
-		})
-		if err != nil {
-			o.logger.Error("Failed to get item price", "error", slogger.Err(err))
-			c.JSON(http.StatusInternalServerError, gin.H{
-				"error":   "Failed to get item price",
-				"message": err.Error(),
-			})
-			return
-		}
-
-		totalPrice := info.Price * float64(item.Quantity)
-
 		orderItems[i] = &orderpb.OrderItem{
-			ProductId:   info.ProductId,
 			ProductName: item.ProductName,
 			Quantity:    int32(item.Quantity),
-			Price:       info.Price,
-			TotalPrice:  totalPrice,
 		}
 	}
 	orderReq := &orderpb.CreateOrderRequest{
-		UserId:          int64(userID),
-		Items:           orderItems, // Используем уже заполненный слайс
-		DeliveryAddress: req.DeliveryAddress,
-		Time:            time.Now().Unix(),
+		UserId:           int64(userID),
+		Items:            orderItems,
+		DeliveryAddress:  req.DeliveryAddress,
+		Time:             time.Now().Unix(),
+		DeliveryLocation: utils.ConvertEntityLocationToOrder(req.DeliveryLocation),
 	}
 
 	orderResp, err := o.orderGRPCClient.CreateOrder(ctx, orderReq)
 	if err != nil {
 		o.logger.Error("Failed to create order", "error", slogger.Err(err))
-		c.JSON(http.StatusInternalServerError, gin.H{
+		if status.Code(err) == codes.FailedPrecondition {
+			c.JSON(http.StatusBadRequest, gin.H{
+				"error":   "Stock not available",
+				"message": err.Error(),
+			})
+			return
+		}
+		c.JSON(httpStatusFromGRPC(err), gin.H{
 			"error":   "Failed to create order",
 			"message": err.Error(),
 		})
 		return
 	}
-	_, err = o.warehouseGRPCClient.UpdateStock(ctx, &warehousepb.UpdateStockRequest{
-		Items: utils.ConvertOrderItemToWarehouseStockItem(orderItems, orderReq.Time),
-	})
-	if err != nil {
-		o.logger.Error("Failed to update stock after order creation", "error", slogger.Err(err))
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error":   "Failed to update stock after order creation",
-			"message": "Stock update failed",
-		})
-		return
-	}
 
 	// Возвращаем ответ
 	c.JSON(http.StatusCreated, dto.CreateOrderResponse{
 		Order: &entity.Order{
-			ID:              orderResp.Order.Id,
-			UserID:          orderReq.UserId,
-			Status:          entity.OrderStatus(orderResp.Order.Status),
-			Items:           utils.ConvertOrderItemToGoodsItem(orderResp.Order.Items),
-			TotalAmount:     orderResp.Order.TotalAmount,
-			DeliveryAddress: orderReq.DeliveryAddress,
-			CreatedAt:       orderResp.Order.CreatedAt.AsTime().Unix(),
+			ID:               orderResp.Order.Id,
+			UserID:           orderReq.UserId,
+			Status:           entity.OrderStatus(orderResp.Order.Status),
+			Items:            utils.ConvertOrderItemToGoodsItem(orderResp.Order.Items),
+			TotalAmount:      orderResp.Order.TotalAmount,
+			DeliveryAddress:  orderReq.DeliveryAddress,
+			CreatedAt:        orderResp.Order.CreatedAt.AsTime().Unix(),
+			DeliveryLocation: utils.ConvertOrderLocationToEntity(orderResp.Order.DeliveryLocation),
 		},
 		Message: "Order created successfully",
 	})
@@ -273,18 +198,69 @@ func (o *OrderHandler) GetOrderByID(c *gin.Context) {
 	c.JSON(http.StatusOK, order)
 }
 
+// @Summary История статусов заказа
+// @Description Возвращает все переходы статуса заказа текущего пользователя в хронологическом порядке
+// @Tags orders
+// @Produce  json
+// @Param   order_id path int true "ID заказа"
+// @Success 200 {object} object{order_id=int64,timeline=[]entity.OrderStatusChange} "История статусов"
+// @Failure 400 {object} object{error=string} "Неверный ID заказа"
+// @Failure 404 {object} object{error=string,message=string} "Заказ не найден"
+// @Failure 500 {object} object{error=string,message=string} "Ошибка сервера"
+// @Security ApiKeyAuth
+// @Router /orders/{order_id}/timeline [get]
+func (o *OrderHandler) GetOrderTimeline(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	userID, err := middleware.GetUserId(c)
+	if err != nil {
+		o.logger.Error("getting user_id failed", slog.String("status", fmt.Sprintf("%d", http.StatusInternalServerError)), slogger.Err(err))
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	orderID, err := strconv.Atoi(c.Param("order_id"))
+	if err != nil {
+		o.logger.Error("Invalid order_id", slog.String("status", fmt.Sprintf("%d", http.StatusBadRequest)), slogger.Err(err))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid order_id",
+		})
+		return
+	}
+
+	timeline, err := o.orderGRPCClient.GetOrderTimeline(ctx, &orderpb.GetOrderTimelineRequest{
+		UserId:  int64(userID),
+		OrderId: int64(orderID),
+	})
+	if err != nil {
+		o.logger.Error("Failed to get order timeline", "error", slogger.Err(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{
+			"error":   "Failed to get order timeline",
+			"message": err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"order_id": orderID,
+		"timeline": utils.ConvertOrderStatusChanges(timeline.Changes),
+	})
+}
+
 // @Summary Назначение водителя на заказ
-// @Description Назначает подходящего водителя на заказ и обновляет его статус
+// @Description Ставит назначение водителя в очередь и сразу возвращает задачу; результат доступен по status_url
 // @Tags orders
 // @Produce  json
 // @Param   order_id path int true "ID заказа"
-// @Success 200 {object} object{driver_id=int64,order_id=int64,success=bool,message=string} "Успешное назначение"
-// @Failure 400 {object} object{error=string,message=string} "Заказ не в pending статусе"
+// @Success 202 {object} object{job=entity.AssignmentJob,status_url=string} "Назначение поставлено в очередь"
+// @Failure 400 {object} object{error=string,message=string} "Некорректный ID заказа"
+// @Failure 404 {object} object{error=string,message=string} "Заказ не найден"
+// @Failure 409 {object} object{error=string,message=string} "Статус заказа не допускает назначение водителя"
 // @Failure 500 {object} object{error=string,message=string} "Ошибка сервера"
 // @Security ApiKeyAuth
 // @Router /orders/{order_id}/assign-driver [post]
 func (o *OrderHandler) AssignDriver(c *gin.Context) {
-	ctx, cancel := context.WithTimeout(context.Background(), 60*time.Second)
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
 	defer cancel()
 	userID, err := middleware.GetUserId(c)
 	if err != nil {
@@ -302,71 +278,334 @@ func (o *OrderHandler) AssignDriver(c *gin.Context) {
 		})
 		return
 	}
-	orderStatus, err := o.orderGRPCClient.CheckOrderStatus(ctx, &orderpb.CheckOrderStatusRequest{
+
+	resp, err := o.orderGRPCClient.RequestDriverAssignment(ctx, &orderpb.RequestDriverAssignmentRequest{
 		UserId:  int64(userID),
 		OrderId: int64(orderID),
 	})
 	if err != nil {
-		o.logger.Error("Failed to check order status", "error", slogger.Err(err))
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error":   "Failed to check order status",
+		o.logger.Error("Failed to request driver assignment", "error", slogger.Err(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{
+			"error":   "Failed to request driver assignment",
 			"message": err.Error(),
 		})
 		return
 	}
-	if orderStatus.Status != string(entity.StatusPending) {
-		o.logger.Error("Order is not in pending status, other driver assignment is not possible", slog.String("status", orderStatus.Status))
+
+	c.JSON(http.StatusAccepted, gin.H{
+		"job":        utils.ConvertProtoToAssignmentJob(resp.Job),
+		"status_url": fmt.Sprintf("/api/v1/orders/assignments/%d", resp.Job.Id),
+	})
+}
+
+// @Summary Статус назначения водителя
+// @Description Возвращает состояние задачи назначения водителя
+// @Tags orders
+// @Produce  json
+// @Param   job_id path int true "ID задачи назначения"
+// @Success 200 {object} object{job=entity.AssignmentJob} "Успешный ответ"
+// @Failure 400 {object} object{error=string,message=string} "Некорректный ID задачи"
+// @Failure 404 {object} object{error=string,message=string} "Задача не найдена"
+// @Failure 500 {object} object{error=string,message=string} "Ошибка сервера"
+// @Security ApiKeyAuth
+// @Router /orders/assignments/{job_id} [get]
+func (o *OrderHandler) GetAssignmentJob(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	userID, err := middleware.GetUserId(c)
+	if err != nil {
+		o.logger.Error("getting user_id failed", slog.String("status", fmt.Sprintf("%d", http.StatusInternalServerError)), slogger.Err(err))
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	jobID, err := strconv.Atoi(c.Param("job_id"))
+	if err != nil {
 		c.JSON(http.StatusBadRequest, gin.H{
-			"error":   "Order is not in pending status, other driver assignment is not possible",
-			"message": fmt.Sprintf("Current order status: %s", orderStatus.Status),
+			"error": "Invalid job_id",
 		})
 		return
 	}
 
-	_, err = o.driverGRPCClient.FindSuitableDriver(ctx, &driverpb.FindDriverRequest{
-		OrderId: int64(orderID),
+	resp, err := o.orderGRPCClient.GetAssignmentJob(ctx, &orderpb.GetAssignmentJobRequest{
+		UserId: int64(userID),
+		JobId:  int64(jobID),
 	})
 	if err != nil {
-		o.logger.Error("Failed to find suitable driver", "error", slogger.Err(err))
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error":   "Failed to find suitable driver",
+		o.logger.Error("Failed to get assignment job", "error", slogger.Err(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{
+			"error":   "Failed to get assignment job",
 			"message": err.Error(),
 		})
 		return
 	}
-	assignReq := &orderpb.AssignDriverRequest{
+
+	c.JSON(http.StatusOK, gin.H{
+		"job": utils.ConvertProtoToAssignmentJob(resp.Job),
+	})
+}
+
+// @Summary Текущая позиция водителя
+// @Description Возвращает последнюю известную позицию водителя, который везет заказ текущего пользователя. Доступно, пока заказ назначен или в пути
+// @Tags orders
+// @Produce  json
+// @Param   order_id path int true "ID заказа"
+// @Success 200 {object} object{order_id=int64,location=entity.DriverLocation} "Позиция водителя"
+// @Failure 400 {object} object{error=string} "Неверный ID заказа"
+// @Failure 404 {object} object{error=string,message=string} "Заказ не найден или позиция водителя неизвестна"
+// @Failure 409 {object} object{error=string,status=string} "Заказ сейчас не в доставке"
+// @Failure 500 {object} object{error=string,message=string} "Ошибка сервера"
+// @Security ApiKeyAuth
+// @Router /orders/{order_id}/driver-location [get]
+func (o *OrderHandler) GetDriverLocation(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	userID, err := middleware.GetUserId(c)
+	if err != nil {
+		o.logger.Error("getting user_id failed", slog.String("status", fmt.Sprintf("%d", http.StatusInternalServerError)), slogger.Err(err))
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	orderID, err := strconv.Atoi(c.Param("order_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid order_id",
+		})
+		return
+	}
+
+	orderResp, err := o.orderGRPCClient.GetOrderDetails(ctx, &orderpb.GetOrderDetailsRequest{
 		UserId:  int64(userID),
 		OrderId: int64(orderID),
+	})
+	if err != nil {
+		o.logger.Error("Failed to get order details", "error", slogger.Err(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{
+			"error":   "Failed to get order details",
+			"message": err.Error(),
+		})
+		return
 	}
-	assignResp, err := o.orderGRPCClient.AssignDriver(ctx, assignReq)
+	// Позицию водителя видит только владелец заказа
+	order := orderResp.Order
+	if order == nil || order.UserId != int64(userID) {
+		c.JSON(http.StatusNotFound, gin.H{
+			"error":   "Order not found",
+			"message": fmt.Sprintf("order %d not found", orderID),
+		})
+		return
+	}
+	orderStatus := entity.OrderStatus(order.Status)
+	if order.DriverId == 0 || (orderStatus != entity.StatusAssigned && orderStatus != entity.StatusInProgress) {
+		c.JSON(http.StatusConflict, gin.H{
+			"error":  "Order is not being delivered",
+			"status": order.Status,
+		})
+		return
+	}
+
+	locationResp, err := o.driverGRPCClient.GetDriverLocation(ctx, &driverpb.GetDriverLocationRequest{
+		DriverId: order.DriverId,
+	})
 	if err != nil {
-		o.logger.Error("Failed to assign driver", "error", slogger.Err(err))
-		c.JSON(http.StatusInternalServerError, gin.H{
-			"error":   "Failed to assign driver",
+		o.logger.Error("Failed to get driver location", "error", slogger.Err(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{
+			"error":   "Failed to get driver location",
 			"message": err.Error(),
 		})
 		return
 	}
-	resp, err := o.driverGRPCClient.UpdateDriverStatus(ctx, &driverpb.UpdateDriverStatusRequest{
-		DriverId: assignResp.DriverId,
-		Status:   string(entity.DriverStatusBusy),
+
+	c.JSON(http.StatusOK, gin.H{
+		"order_id": order.Id,
+		"location": utils.ConvertProtoToDriverLocation(locationResp),
 	})
+}
 
-	if !resp.Success {
-		o.logger.Error("Failed to update driver status", slog.String("status", "error"), slogger.Err(err))
+// orderEventsKeepAlive - период пустых событий, чтобы прокси не закрывали простаивающее соединение
+const orderEventsKeepAlive = 15 * time.Second
+
+// @Summary Отслеживание заказа в реальном времени
+// @Description Server-Sent Events поток по заказу текущего пользователя. Первым приходит событие snapshot с заказом, затем status (переход статуса) и driver_position (позиция водителя). Событие end закрывает поток после конечного статуса, error - при обрыве (нужно переподключиться)
+// @Tags orders
+// @Produce  text/event-stream
+// @Param   order_id path int true "ID заказа"
+// @Success 200 {string} string "Поток событий"
+// @Failure 400 {object} object{error=string} "Неверный ID заказа"
+// @Failure 404 {object} object{error=string,message=string} "Заказ не найден"
+// @Failure 500 {object} object{error=string,message=string} "Ошибка сервера"
+// @Security ApiKeyAuth
+// @Router /orders/{order_id}/events [get]
+func (o *OrderHandler) WatchOrder(c *gin.Context) {
+	// Поток живет, пока клиент держит соединение
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	defer cancel()
+	userID, err := middleware.GetUserId(c)
+	if err != nil {
+		o.logger.Error("getting user_id failed", slog.String("status", fmt.Sprintf("%d", http.StatusInternalServerError)), slogger.Err(err))
 		c.JSON(http.StatusInternalServerError, gin.H{
-			"error":   "Failed to update driver status",
+			"error": err.Error(),
+		})
+		return
+	}
+	orderID, err := strconv.Atoi(c.Param("order_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid order_id",
+		})
+		return
+	}
+
+	stream, err := o.orderGRPCClient.WatchOrder(ctx, &orderpb.WatchOrderRequest{
+		UserId:  int64(userID),
+		OrderId: int64(orderID),
+	})
+	if err == nil {
+		// Ошибки доступа приходят с первым сообщением, до него можно ответить обычным JSON
+		var first *orderpb.OrderEvent
+		if first, err = stream.Recv(); err == nil {
+			o.streamOrderEvents(ctx, c, first, stream)
+			return
+		}
+	}
+	o.logger.Error("Failed to watch order", "error", slogger.Err(err))
+	c.JSON(httpStatusFromGRPC(err), gin.H{
+		"error":   "Failed to watch order",
+		"message": err.Error(),
+	})
+}
+
+func (o *OrderHandler) streamOrderEvents(ctx context.Context, c *gin.Context, first *orderpb.OrderEvent, stream grpc.ServerStreamingClient[orderpb.OrderEvent]) {
+	events := make(chan *orderpb.OrderEvent, 1)
+	events <- first
+	var streamErr error
+	go func() {
+		defer close(events)
+		for {
+			event, err := stream.Recv()
+			if err != nil {
+				streamErr = err
+				return
+			}
+			select {
+			case events <- event:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	c.Header("Cache-Control", "no-cache")
+	c.Header("Connection", "keep-alive")
+	c.Header("X-Accel-Buffering", "no")
+
+	keepAlive := time.NewTicker(orderEventsKeepAlive)
+	defer keepAlive.Stop()
+
+	c.Stream(func(w io.Writer) bool {
+		select {
+		case <-ctx.Done():
+			return false
+		case <-keepAlive.C:
+			c.SSEvent("ping", gin.H{"time": time.Now().Unix()})
+			return true
+		case event, ok := <-events:
+			if !ok {
+				// streamErr записан до закрытия канала
+				if errors.Is(streamErr, io.EOF) {
+					c.SSEvent("end", gin.H{"order_id": first.OrderId})
+				} else {
+					o.logger.Error("Order watch stream failed", "error", slogger.Err(streamErr))
+					c.SSEvent("error", gin.H{"error": "Order watch interrupted", "message": streamErr.Error()})
+				}
+				return false
+			}
+			switch e := event.Event.(type) {
+			case *orderpb.OrderEvent_Snapshot:
+				c.SSEvent("snapshot", utils.ConvertProtoToOrder(e.Snapshot))
+			case *orderpb.OrderEvent_StatusChange:
+				c.SSEvent("status", utils.ConvertOrderStatusChange(e.StatusChange))
+			case *orderpb.OrderEvent_DriverPosition:
+				c.SSEvent("driver_position", entity.DriverLocation{
+					DriverID: e.DriverPosition.DriverId,
+					Location: entity.Location{
+						Latitude:  e.DriverPosition.Location.GetLatitude(),
+						Longitude: e.DriverPosition.Location.GetLongitude(),
+					},
+					RecordedAt: e.DriverPosition.RecordedAt,
+				})
+			}
+			return true
+		}
+	})
+}
+
+// @Summary Отмена заказа
+// @Description Отменяет заказ, возвращает товар на склад и освобождает назначенного водителя. Доставленный заказ отменить нельзя.
+// @Description Возврат товара и освобождение водителя выполняются сразу после отмены и повторяются до успеха: stock_returned и released_driver_id сообщают, что они запланированы
+// @Tags orders
+// @Accept  json
+// @Produce  json
+// @Param   order_id path int true "ID заказа"
+// @Param   request body dto.CancelOrderRequest false "Причина отмены"
+// @Success 200 {object} object{success=bool,previous_status=string,stock_returned=bool,released_driver_id=int64,message=string} "Заказ отменен"
+// @Failure 400 {object} object{error=string} "Неверный ID заказа"
+// @Failure 404 {object} object{error=string,message=string} "Заказ не найден"
+// @Failure 409 {object} object{error=string,message=string} "Заказ уже доставлен или отменен"
+// @Failure 500 {object} object{error=string,message=string} "Ошибка сервера"
+// @Security ApiKeyAuth
+// @Router /orders/{order_id}/cancel [post]
+func (o *OrderHandler) CancelOrder(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), 15*time.Second)
+	defer cancel()
+	userID, err := middleware.GetUserId(c)
+	if err != nil {
+		o.logger.Error("getting user_id failed", slog.String("status", fmt.Sprintf("%d", http.StatusInternalServerError)), slogger.Err(err))
+		c.JSON(http.StatusInternalServerError, gin.H{
+			"error": err.Error(),
+		})
+		return
+	}
+	orderID, err := strconv.Atoi(c.Param("order_id"))
+	if err != nil {
+		o.logger.Error("Invalid order_id", slog.String("status", fmt.Sprintf("%d", http.StatusBadRequest)), slogger.Err(err))
+		c.JSON(http.StatusBadRequest, gin.H{
+			"error": "Invalid order_id",
+		})
+		return
+	}
+	var req dto.CancelOrderRequest
+	if c.Request.ContentLength > 0 {
+		if err := c.BindJSON(&req); err != nil {
+			o.logger.Error("Failed to bind JSON", slog.String("status", fmt.Sprintf("%d", http.StatusBadRequest)), slogger.Err(err))
+			c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+			return
+		}
+	}
+
+	cancelResp, err := o.orderGRPCClient.CancelOrder(ctx, &orderpb.CancelOrderRequest{
+		UserId:  int64(userID),
+		OrderId: int64(orderID),
+		Reason:  req.Reason,
+	})
+	if err != nil {
+		o.logger.Error("Failed to cancel order", "error", slogger.Err(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{
+			"error":   "Failed to cancel order",
 			"message": err.Error(),
 		})
 		return
 	}
 	c.JSON(http.StatusOK, gin.H{
-		"driver_id": assignResp.DriverId,
-		"order_id":  assignResp.OrderId,
-		"success":   true,
-		"message":   assignResp.Message,
+		"success":            cancelResp.Success,
+		"previous_status":    cancelResp.PreviousStatus,
+		"stock_returned":     cancelResp.StockReturned,
+		"released_driver_id": cancelResp.ReleasedDriverId,
+		"message":            cancelResp.Message,
 	})
-
 }
 
 // @Summary Получение списка доставок
@@ -416,6 +655,7 @@ func (o *OrderHandler) GetDeliveries(c *gin.Context) {
 // @Param   order_id path int true "ID заказа"
 // @Success 200 {object} object{success=bool,driver_id=int64,message=string} "Успешное завершение"
 // @Failure 400 {object} object{error=string} "Неверный ID заказа"
+// @Failure 409 {object} object{error=string,message=string} "Заказ не в статусе in_progress"
 // @Failure 500 {object} object{error=string,message=string} "Ошибка сервера"
 // @Security ApiKeyAuth
 // @Router /orders/deliveries/{order_id}/complete_delivery [post]
@@ -445,7 +685,7 @@ func (o *OrderHandler) CompleteOrder(c *gin.Context) {
 	completeResp, err := o.orderGRPCClient.CompleteDelivery(ctx, completeReq)
 	if err != nil {
 		o.logger.Error("Failed to complete order", "error", slogger.Err(err))
-		c.JSON(http.StatusInternalServerError, gin.H{
+		c.JSON(httpStatusFromGRPC(err), gin.H{
 			"error":   "Failed to complete order",
 			"message": err.Error(),
 		})
@@ -0,0 +1,413 @@
+package handler
+
+import (
+	"bytes"
+	"cmp"
+	"context"
+	"encoding/csv"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	warehousepb "logistics/api/protobuf/warehouse_service"
+	"logistics/internal/services/api-gateway/middleware"
+	"logistics/internal/shared/entity"
+	"logistics/pkg/lib/logger/slogger"
+	"logistics/pkg/lib/utils"
+
+	"github.com/gin-gonic/gin"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+const (
+	// maxStockImportSize - предел размера файла загрузки остатков
+	maxStockImportSize = 5 << 20
+	// stockImportTimeout - пакет применяется одной транзакцией, на большой файл нужно больше обычных 5 секунд
+	stockImportTimeout = 60 * time.Second
+)
+
+// stockExportHeader - колонки выгрузки остатков; warehouse_id, sku, quantity и unit_price понимает загрузка,
+// поэтому исправленную выгрузку, в том числе по всем складам, можно загрузить обратно
+var stockExportHeader = []string{"warehouse_id", "product_id", "sku", "product_name", "quantity", "reserved", "available", "unit_price", "last_updated"}
+
+var movementExportHeader = []string{"id", "created_at", "warehouse_id", "product_id", "lot_id", "delta", "reason", "reference_id", "actor_id"}
+
+// @Summary Загрузка остатков из CSV
+// @Description Приводит остатки складов к фактическим из CSV и обновляет цены. Первая строка - заголовок с колонками sku и/или product_name, quantity, необязательными warehouse_id и unit_price; остальные колонки игнорируются, разделитель - запятая или точка с запятой.
+// @Description Склад строки берется из колонки warehouse_id, а если ее нет - из параметра warehouse_id. Если заданы оба, строки другого склада отклоняются. Один товар одного склада может встречаться в файле только один раз.
+// @Description quantity - фактический остаток: излишек приходуется, недостача списывается корректировкой. Файл применяется целиком или не применяется вовсе; при ошибках возвращается отчет по всем строкам.
+// @Tags admin
+// @Accept  multipart/form-data
+// @Produce  json
+// @Param   warehouse_id query int false "ID склада; обязателен, если в файле нет колонки warehouse_id"
+// @Param   dry_run query bool false "Только проверить файл и показать изменения"
+// @Param   file formData file true "CSV с остатками"
+// @Success 200 {object} object{applied=bool,results=[]entity.StockImportResult,errors=[]entity.ImportRowError} "Файл проверен или применен"
+// @Failure 400 {object} object{error=string,message=string} "Некорректные параметры или файл"
+// @Failure 403 {object} object{error=string} "Нужна роль администратора или диспетчера"
+// @Failure 404 {object} object{error=string,message=string} "Склад не найден"
+// @Failure 409 {object} object{error=string,message=string} "Склад закрыт"
+// @Failure 413 {object} object{error=string} "Файл слишком большой"
+// @Failure 422 {object} object{applied=bool,results=[]entity.StockImportResult,errors=[]entity.ImportRowError} "В строках файла есть ошибки, ничего не изменено"
+// @Failure 500 {object} object{error=string,message=string} "Ошибка сервера"
+// @Security ApiKeyAuth
+// @Router /store/stock/import [post]
+func (w *WarehouseHandler) ImportStock(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), stockImportTimeout)
+	defer cancel()
+	warehouseID, err := queryInt64(c, "warehouse_id")
+	if err != nil || warehouseID < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid warehouse_id"})
+		return
+	}
+	dryRun := false
+	if value := c.Query("dry_run"); value != "" {
+		if dryRun, err = strconv.ParseBool(value); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid dry_run"})
+			return
+		}
+	}
+	userID, err := middleware.GetUserId(c)
+	if err != nil {
+		w.logger.Error("getting user_id failed", slogger.Err(err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	header, err := c.FormFile("file")
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "File is required", "message": err.Error()})
+		return
+	}
+	if header.Size > maxStockImportSize {
+		c.JSON(http.StatusRequestEntityTooLarge, gin.H{"error": fmt.Sprintf("File is larger than %d bytes", maxStockImportSize)})
+		return
+	}
+	file, err := header.Open()
+	if err != nil {
+		w.logger.Error("Failed to open uploaded file", "error", slogger.Err(err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to read file", "message": err.Error()})
+		return
+	}
+	defer file.Close()
+	data, err := io.ReadAll(io.LimitReader(file, maxStockImportSize))
+	if err != nil {
+		w.logger.Error("Failed to read uploaded file", "error", slogger.Err(err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to read file", "message": err.Error()})
+		return
+	}
+
+	rows, rowErrors, err := parseStockCSV(data, warehouseID)
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid CSV", "message": err.Error()})
+		return
+	}
+	results := []entity.StockImportResult{}
+	applied := false
+	if len(rows) > 0 {
+		resp, err := w.warehouseGRPCClient.ImportStock(ctx, &warehousepb.ImportStockRequest{
+			WarehouseId: warehouseID,
+			Rows:        rows,
+			// Строки, которые не удалось разобрать, не применяются, поэтому и остальные только проверяются
+			DryRun: dryRun || len(rowErrors) > 0,
+			UserId: int64(userID),
+		})
+		if err != nil {
+			w.logger.Error("Failed to import stock", "error", slogger.Err(err))
+			c.JSON(httpStatusFromGRPC(err), gin.H{
+				"error":   "Failed to import stock",
+				"message": err.Error(),
+			})
+			return
+		}
+		applied = resp.Applied
+		for _, result := range resp.Results {
+			results = append(results, utils.ConvertProtoToStockImportResult(result))
+		}
+		for _, rowErr := range resp.Errors {
+			rowErrors = append(rowErrors, entity.ImportRowError{Line: rowErr.Line, Message: rowErr.Message})
+		}
+	} else if len(rowErrors) == 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid CSV", "message": "no rows after header"})
+		return
+	}
+	slices.SortStableFunc(rowErrors, func(a, b entity.ImportRowError) int { return cmp.Compare(a.Line, b.Line) })
+
+	code := http.StatusOK
+	if len(rowErrors) > 0 {
+		code = http.StatusUnprocessableEntity
+	}
+	c.JSON(code, gin.H{
+		"applied": applied,
+		"results": results,
+		"errors":  rowErrors,
+	})
+}
+
+// parseStockCSV разбирает файл загрузки остатков. Ошибка возвращается, если файл не читается как CSV
+// или в заголовке нет нужных колонок; ошибки в значениях отдельных строк попадают в отчет.
+// Без warehouseID склад каждой строки должен быть указан в колонке warehouse_id
+func parseStockCSV(data []byte, warehouseID int64) ([]*warehousepb.ImportStockRow, []entity.ImportRowError, error) {
+	// Excel сохраняет UTF-8 с BOM, а в русской локали разделяет колонки точкой с запятой
+	data = bytes.TrimPrefix(data, []byte("\ufeff"))
+	reader := csv.NewReader(bytes.NewReader(data))
+	firstLine, _, _ := bytes.Cut(data, []byte("\n"))
+	if bytes.Count(firstLine, []byte(";")) > bytes.Count(firstLine, []byte(",")) {
+		reader.Comma = ';'
+	}
+	reader.FieldsPerRecord = -1
+	reader.TrimLeadingSpace = true
+
+	header, err := reader.Read()
+	if err != nil {
+		if errors.Is(err, io.EOF) {
+			return nil, nil, errors.New("file is empty")
+		}
+		return nil, nil, err
+	}
+	columns := map[string]int{"warehouse_id": -1, "sku": -1, "product_name": -1, "quantity": -1, "unit_price": -1}
+	for i, name := range header {
+		name = strings.ToLower(strings.TrimSpace(name))
+		switch name {
+		case "name":
+			name = "product_name"
+		case "price":
+			name = "unit_price"
+		}
+		if index, ok := columns[name]; ok && index < 0 {
+			columns[name] = i
+		}
+	}
+	if columns["quantity"] < 0 || (columns["sku"] < 0 && columns["product_name"] < 0) {
+		return nil, nil, errors.New("header must contain quantity and sku or product_name columns")
+	}
+	if warehouseID == 0 && columns["warehouse_id"] < 0 {
+		return nil, nil, errors.New("header must contain warehouse_id column when warehouse_id parameter is not set")
+	}
+	field := func(record []string, column string) string {
+		if i := columns[column]; i >= 0 && i < len(record) {
+			return strings.TrimSpace(record[i])
+		}
+		return ""
+	}
+
+	var rows []*warehousepb.ImportStockRow
+	var rowErrors []entity.ImportRowError
+	for {
+		record, err := reader.Read()
+		if errors.Is(err, io.EOF) {
+			break
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+		line, _ := reader.FieldPos(0)
+		if strings.TrimSpace(strings.Join(record, "")) == "" {
+			continue
+		}
+
+		row := &warehousepb.ImportStockRow{
+			Line:        int32(line),
+			Sku:         field(record, "sku"),
+			ProductName: field(record, "product_name"),
+		}
+		if value := field(record, "warehouse_id"); value != "" {
+			id, err := strconv.ParseInt(value, 10, 64)
+			if err != nil || id <= 0 {
+				rowErrors = append(rowErrors, entity.ImportRowError{Line: row.Line, Message: fmt.Sprintf("invalid warehouse_id %q", value)})
+				continue
+			}
+			row.WarehouseId = id
+		}
+		quantity, err := strconv.ParseInt(field(record, "quantity"), 10, 32)
+		if err != nil {
+			rowErrors = append(rowErrors, entity.ImportRowError{Line: row.Line, Message: fmt.Sprintf("invalid quantity %q", field(record, "quantity"))})
+			continue
+		}
+		row.Quantity = int32(quantity)
+		if value := field(record, "unit_price"); value != "" {
+			price, err := strconv.ParseFloat(strings.Replace(value, ",", ".", 1), 64)
+			if err != nil {
+				rowErrors = append(rowErrors, entity.ImportRowError{Line: row.Line, Message: fmt.Sprintf("invalid unit_price %q", value)})
+				continue
+			}
+			row.UnitPrice = wrapperspb.Double(price)
+		}
+		rows = append(rows, row)
+	}
+	return rows, rowErrors, nil
+}
+
+// @Summary Выгрузка остатков в CSV
+// @Description Возвращает остатки склада или всех складов в CSV: количество, резерв, доступное к заказу и цену. Выгрузку можно исправить и загрузить обратно
+// @Tags admin
+// @Produce  text/csv
+// @Param   warehouse_id query int false "ID склада; без параметра - все склады, включая закрытые"
+// @Success 200 {file} file "CSV с остатками"
+// @Failure 400 {object} object{error=string} "Неверный ID склада"
+// @Failure 403 {object} object{error=string} "Нужна роль администратора или диспетчера"
+// @Failure 404 {object} object{error=string,message=string} "Склад не найден"
+// @Failure 500 {object} object{error=string,message=string} "Ошибка сервера"
+// @Security ApiKeyAuth
+// @Router /store/stock/export [get]
+func (w *WarehouseHandler) ExportStock(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	warehouseID, err := queryInt64(c, "warehouse_id")
+	if err != nil || warehouseID < 0 {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid warehouse_id"})
+		return
+	}
+
+	warehouseIDs := []int64{warehouseID}
+	if warehouseID == 0 {
+		resp, err := w.warehouseGRPCClient.ListWarehouses(ctx, &warehousepb.ListWarehousesRequest{IncludeInactive: true})
+		if err != nil {
+			w.logger.Error("Failed to list warehouses", "error", slogger.Err(err))
+			c.JSON(httpStatusFromGRPC(err), gin.H{
+				"error":   "Failed to export stock",
+				"message": err.Error(),
+			})
+			return
+		}
+		warehouseIDs = warehouseIDs[:0]
+		for _, warehouse := range resp.Warehouses {
+			warehouseIDs = append(warehouseIDs, warehouse.WarehouseId)
+		}
+	}
+
+	// Все склады читаются до начала ответа, чтобы ошибка не оборвала файл на середине
+	var records [][]string
+	for _, id := range warehouseIDs {
+		resp, err := w.warehouseGRPCClient.GetWarehouseInventory(ctx, &warehousepb.GetWarehouseInventoryRequest{WarehouseId: id})
+		if err != nil {
+			w.logger.Error("Failed to get warehouse inventory", "error", slogger.Err(err))
+			c.JSON(httpStatusFromGRPC(err), gin.H{
+				"error":   "Failed to export stock",
+				"message": err.Error(),
+			})
+			return
+		}
+		for _, item := range resp.Items {
+			records = append(records, []string{
+				strconv.FormatInt(id, 10),
+				strconv.FormatInt(item.ProductId, 10),
+				item.Sku,
+				item.ProductName,
+				strconv.Itoa(int(item.Quantity)),
+				strconv.Itoa(int(item.Reserved)),
+				strconv.Itoa(int(item.Available)),
+				strconv.FormatFloat(item.UnitPrice, 'f', 2, 64),
+				formatCSVTime(item.LastUpdated),
+			})
+		}
+	}
+
+	writeCSVHeaders(c, fmt.Sprintf("stock-%s.csv", time.Now().UTC().Format("20060102-150405")))
+	writer := csv.NewWriter(c.Writer)
+	writer.Write(stockExportHeader)
+	writer.WriteAll(records)
+	if err := writer.Error(); err != nil {
+		w.logger.Error("Failed to write stock export", "error", slogger.Err(err))
+	}
+}
+
+// @Summary Выгрузка журнала движения в CSV
+// @Description Возвращает журнал движения товара в CSV в порядке записи. Без фильтров выгружается весь журнал
+// @Tags admin
+// @Produce  text/csv
+// @Param   warehouse_id query int false "ID склада"
+// @Param   product_id query int false "ID товара"
+// @Param   reason query string false "Причина движения" Enums(order, return, receipt, adjustment, transfer)
+// @Param   since query int false "Начало периода, unix-время"
+// @Param   until query int false "Конец периода (не включая), unix-время"
+// @Success 200 {file} file "CSV с движениями"
+// @Failure 400 {object} object{error=string,message=string} "Некорректные параметры"
+// @Failure 403 {object} object{error=string} "Нужна роль администратора или диспетчера"
+// @Failure 500 {object} object{error=string,message=string} "Ошибка сервера"
+// @Security ApiKeyAuth
+// @Router /store/movements/export [get]
+func (w *WarehouseHandler) ExportStockMovements(c *gin.Context) {
+	// Выгрузка идет, пока клиент ее читает
+	ctx, cancel := context.WithCancel(c.Request.Context())
+	defer cancel()
+	req := &warehousepb.ExportStockMovementsRequest{Reason: c.Query("reason")}
+	var err error
+	if req.WarehouseId, err = queryInt64(c, "warehouse_id"); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid warehouse_id"})
+		return
+	}
+	if req.ProductId, err = queryInt64(c, "product_id"); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid product_id"})
+		return
+	}
+	if req.Since, err = queryInt64(c, "since"); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid since"})
+		return
+	}
+	if req.Until, err = queryInt64(c, "until"); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid until"})
+		return
+	}
+
+	stream, err := w.warehouseGRPCClient.ExportStockMovements(ctx, req)
+	var first *warehousepb.StockMovement
+	if err == nil {
+		// Ошибки проверки параметров приходят с первым сообщением, до него можно ответить обычным JSON
+		first, err = stream.Recv()
+	}
+	if err != nil && !errors.Is(err, io.EOF) {
+		w.logger.Error("Failed to export stock movements", "error", slogger.Err(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{
+			"error":   "Failed to export stock movements",
+			"message": err.Error(),
+		})
+		return
+	}
+
+	writeCSVHeaders(c, fmt.Sprintf("movements-%s.csv", time.Now().UTC().Format("20060102-150405")))
+	writer := csv.NewWriter(c.Writer)
+	writer.Write(movementExportHeader)
+	for movement := first; movement != nil; {
+		writer.Write([]string{
+			strconv.FormatInt(movement.Id, 10),
+			formatCSVTime(movement.CreatedAt),
+			strconv.FormatInt(movement.WarehouseId, 10),
+			strconv.FormatInt(movement.ProductId, 10),
+			strconv.FormatInt(movement.LotId, 10),
+			strconv.Itoa(int(movement.Delta)),
+			movement.Reason,
+			strconv.FormatInt(movement.ReferenceId, 10),
+			strconv.FormatInt(movement.ActorId, 10),
+		})
+		if movement, err = stream.Recv(); err != nil {
+			break
+		}
+	}
+	writer.Flush()
+	// Заголовки ответа уже отправлены, оборванную выгрузку остается только залогировать
+	if err != nil && !errors.Is(err, io.EOF) {
+		w.logger.Error("Stock movements export interrupted", "error", slogger.Err(err))
+	} else if err := writer.Error(); err != nil {
+		w.logger.Error("Failed to write stock movements export", "error", slogger.Err(err))
+	}
+}
+
+func writeCSVHeaders(c *gin.Context, filename string) {
+	c.Header("Content-Type", "text/csv; charset=utf-8")
+	c.Header("Content-Disposition", fmt.Sprintf(`attachment; filename="%s"`, filename))
+	c.Status(http.StatusOK)
+}
+
+// formatCSVTime выводит unix-время в RFC 3339, нулевое - пустой ячейкой
+func formatCSVTime(unix int64) string {
+	if unix == 0 {
+		return ""
+	}
+	return time.Unix(unix, 0).UTC().Format(time.RFC3339)
+}
@@ -4,11 +4,18 @@ import (
 	"context"
 	"log/slog"
 	warehousepb "logistics/api/protobuf/warehouse_service"
+	"logistics/internal/services/api-gateway/middleware"
+	"logistics/internal/shared/entity"
+	"logistics/internal/shared/models/dto"
+	"logistics/pkg/lib/logger/slogger"
+	"logistics/pkg/lib/utils"
 	"net/http"
+	"strconv"
 	"time"
 
 	"github.com/gin-gonic/gin"
 	"google.golang.org/protobuf/types/known/emptypb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
 type WarehouseHandler struct {
@@ -47,3 +54,1204 @@ func (w *WarehouseHandler) GetAvailableProducts(c *gin.Context) {
 		"products": products.Stocks},
 	)
 }
+
+// @Summary Создание товара
+// @Description Добавляет товар в каталог. Артикул и наименование должны быть уникальными, остаток нового товара - 0
+// @Tags admin
+// @Accept  json
+// @Produce  json
+// @Param   request body dto.ProductRequest true "Карточка товара"
+// @Success 201 {object} object{product=entity.Product} "Товар создан"
+// @Failure 400 {object} object{error=string,message=string} "Некорректные данные"
+// @Failure 403 {object} object{error=string} "Нужна роль администратора или диспетчера"
+// @Failure 409 {object} object{error=string,message=string} "Артикул или наименование уже заняты"
+// @Failure 500 {object} object{error=string,message=string} "Ошибка сервера"
+// @Security ApiKeyAuth
+// @Router /admin/products [post]
+func (w *WarehouseHandler) CreateProduct(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var req dto.ProductRequest
+	if err := c.BindJSON(&req); err != nil {
+		w.logger.Error("Failed to bind JSON", slogger.Err(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := w.warehouseGRPCClient.CreateProduct(ctx, &warehousepb.CreateProductRequest{
+		Sku:             req.SKU,
+		Name:            req.Name,
+		Description:     req.Description,
+		UnitPrice:       req.UnitPrice,
+		WeightGrams:     req.WeightGrams,
+		Dimensions:      convertDimensions(req.Dimensions),
+		ReorderPoint:    req.ReorderPoint,
+		ReorderQuantity: req.ReorderQuantity,
+	})
+	if err != nil {
+		w.logger.Error("Failed to create product", "error", slogger.Err(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{
+			"error":   "Failed to create product",
+			"message": err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusCreated, gin.H{
+		"product": utils.ConvertProtoToProduct(resp.Product),
+	})
+}
+
+// @Summary Каталог товаров
+// @Description Возвращает страницу каталога. Снятые с продажи товары скрыты, пока не передан include_inactive
+// @Tags admin
+// @Produce  json
+// @Param   include_inactive query bool false "Показывать снятые с продажи товары"
+// @Param   page query int false "Номер страницы" default(1)
+// @Param   page_size query int false "Размер страницы (до 100)" default(20)
+// @Success 200 {object} object{products=[]entity.Product,total=int64,page=int,page_size=int} "Страница каталога"
+// @Failure 400 {object} object{error=string} "Некорректные параметры"
+// @Failure 403 {object} object{error=string} "Нужна роль администратора или диспетчера"
+// @Failure 500 {object} object{error=string,message=string} "Ошибка сервера"
+// @Security ApiKeyAuth
+// @Router /admin/products [get]
+func (w *WarehouseHandler) ListProducts(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	req := &warehousepb.ListProductsRequest{}
+	var err error
+	if value := c.Query("include_inactive"); value != "" {
+		if req.IncludeInactive, err = strconv.ParseBool(value); err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid include_inactive"})
+			return
+		}
+	}
+	if req.Page, err = queryInt32(c, "page"); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid page"})
+		return
+	}
+	if req.PageSize, err = queryInt32(c, "page_size"); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid page_size"})
+		return
+	}
+
+	resp, err := w.warehouseGRPCClient.ListProducts(ctx, req)
+	if err != nil {
+		w.logger.Error("Failed to list products", "error", slogger.Err(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{
+			"error":   "Failed to list products",
+			"message": err.Error(),
+		})
+		return
+	}
+	products := make([]*entity.Product, 0, len(resp.Products))
+	for _, product := range resp.Products {
+		products = append(products, utils.ConvertProtoToProduct(product))
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"products":  products,
+		"total":     resp.Total,
+		"page":      resp.Page,
+		"page_size": resp.PageSize,
+	})
+}
+
+// @Summary Получение товара
+// @Description Возвращает карточку товара по ID
+// @Tags admin
+// @Produce  json
+// @Param   product_id path int true "ID товара"
+// @Success 200 {object} object{product=entity.Product} "Товар"
+// @Failure 400 {object} object{error=string} "Неверный ID товара"
+// @Failure 403 {object} object{error=string} "Нужна роль администратора или диспетчера"
+// @Failure 404 {object} object{error=string,message=string} "Товар не найден"
+// @Failure 500 {object} object{error=string,message=string} "Ошибка сервера"
+// @Security ApiKeyAuth
+// @Router /admin/products/{product_id} [get]
+func (w *WarehouseHandler) GetProduct(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	productID, err := strconv.Atoi(c.Param("product_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid product_id"})
+		return
+	}
+
+	resp, err := w.warehouseGRPCClient.GetProduct(ctx, &warehousepb.GetProductRequest{ProductId: int64(productID)})
+	if err != nil {
+		w.logger.Error("Failed to get product", "error", slogger.Err(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{
+			"error":   "Failed to get product",
+			"message": err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"product": utils.ConvertProtoToProduct(resp.Product),
+	})
+}
+
+// @Summary Изменение товара
+// @Description Заменяет карточку товара, в том числе цену. Новая цена действует для заказов, созданных после изменения
+// @Tags admin
+// @Accept  json
+// @Produce  json
+// @Param   product_id path int true "ID товара"
+// @Param   request body dto.UpdateProductRequest true "Карточка товара"
+// @Success 200 {object} object{product=entity.Product} "Товар изменен"
+// @Failure 400 {object} object{error=string,message=string} "Некорректные данные"
+// @Failure 403 {object} object{error=string} "Нужна роль администратора или диспетчера"
+// @Failure 404 {object} object{error=string,message=string} "Товар не найден"
+// @Failure 409 {object} object{error=string,message=string} "Артикул или наименование уже заняты"
+// @Failure 500 {object} object{error=string,message=string} "Ошибка сервера"
+// @Security ApiKeyAuth
+// @Router /admin/products/{product_id} [put]
+func (w *WarehouseHandler) UpdateProduct(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	productID, err := strconv.Atoi(c.Param("product_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid product_id"})
+		return
+	}
+	var req dto.UpdateProductRequest
+	if err := c.BindJSON(&req); err != nil {
+		w.logger.Error("Failed to bind JSON", slogger.Err(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	updateReq := &warehousepb.UpdateProductRequest{
+		ProductId:       int64(productID),
+		Sku:             req.SKU,
+		Name:            req.Name,
+		Description:     req.Description,
+		UnitPrice:       req.UnitPrice,
+		WeightGrams:     req.WeightGrams,
+		Dimensions:      convertDimensions(req.Dimensions),
+		ReorderPoint:    req.ReorderPoint,
+		ReorderQuantity: req.ReorderQuantity,
+	}
+	if req.Active != nil {
+		updateReq.Active = wrapperspb.Bool(*req.Active)
+	}
+	resp, err := w.warehouseGRPCClient.UpdateProduct(ctx, updateReq)
+	if err != nil {
+		w.logger.Error("Failed to update product", "error", slogger.Err(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{
+			"error":   "Failed to update product",
+			"message": err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"product": utils.ConvertProtoToProduct(resp.Product),
+	})
+}
+
+// @Summary Удаление товара
+// @Description Снимает товар с продажи: он пропадает с витрины и недоступен для новых заказов. Карточка сохраняется для истории
+// @Tags admin
+// @Produce  json
+// @Param   product_id path int true "ID товара"
+// @Success 200 {object} object{product=entity.Product} "Товар снят с продажи"
+// @Failure 400 {object} object{error=string} "Неверный ID товара"
+// @Failure 403 {object} object{error=string} "Нужна роль администратора или диспетчера"
+// @Failure 404 {object} object{error=string,message=string} "Товар не найден"
+// @Failure 500 {object} object{error=string,message=string} "Ошибка сервера"
+// @Security ApiKeyAuth
+// @Router /admin/products/{product_id} [delete]
+func (w *WarehouseHandler) DeleteProduct(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	productID, err := strconv.Atoi(c.Param("product_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid product_id"})
+		return
+	}
+
+	resp, err := w.warehouseGRPCClient.DeleteProduct(ctx, &warehousepb.DeleteProductRequest{ProductId: int64(productID)})
+	if err != nil {
+		w.logger.Error("Failed to delete product", "error", slogger.Err(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{
+			"error":   "Failed to delete product",
+			"message": err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"product": utils.ConvertProtoToProduct(resp.Product),
+	})
+}
+
+func convertDimensions(dimensions entity.Dimensions) *warehousepb.Dimensions {
+	return &warehousepb.Dimensions{
+		LengthMm: dimensions.LengthMM,
+		WidthMm:  dimensions.WidthMM,
+		HeightMm: dimensions.HeightMM,
+	}
+}
+
+// @Summary Создание документа приемки
+// @Description Создает черновик приемки с ожидаемыми строками поставки. Остатки не меняются до проведения документа
+// @Tags admin
+// @Accept  json
+// @Produce  json
+// @Param   request body dto.CreateReceivingRequest true "Поставщик и строки"
+// @Success 201 {object} object{document=entity.ReceivingDocument} "Документ создан"
+// @Failure 400 {object} object{error=string,message=string} "Некорректные данные"
+// @Failure 403 {object} object{error=string} "Нужна роль администратора или диспетчера"
+// @Failure 404 {object} object{error=string,message=string} "Склад или товар не найден"
+// @Failure 409 {object} object{error=string,message=string} "Склад закрыт"
+// @Failure 500 {object} object{error=string,message=string} "Ошибка сервера"
+// @Security ApiKeyAuth
+// @Router /store/receivings [post]
+func (w *WarehouseHandler) CreateReceivingDocument(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	userID, err := middleware.GetUserId(c)
+	if err != nil {
+		w.logger.Error("getting user_id failed", slogger.Err(err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	var req dto.CreateReceivingRequest
+	if err := c.BindJSON(&req); err != nil {
+		w.logger.Error("Failed to bind JSON", slogger.Err(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	lines := make([]*warehousepb.ReceivingLine, 0, len(req.Lines))
+	for _, line := range req.Lines {
+		lines = append(lines, &warehousepb.ReceivingLine{
+			ProductId:        line.ProductID,
+			ExpectedQuantity: line.ExpectedQuantity,
+			ReceivedQuantity: line.ReceivedQuantity,
+			LotNumber:        line.LotNumber,
+			ExpiresAt:        line.ExpiresAt,
+		})
+	}
+	resp, err := w.warehouseGRPCClient.CreateReceivingDocument(ctx, &warehousepb.CreateReceivingDocumentRequest{
+		WarehouseId: req.WarehouseID,
+		Supplier:    req.Supplier,
+		Reference:   req.Reference,
+		Note:        req.Note,
+		Lines:       lines,
+		UserId:      int64(userID),
+	})
+	if err != nil {
+		w.logger.Error("Failed to create receiving document", "error", slogger.Err(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{
+			"error":   "Failed to create receiving document",
+			"message": err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusCreated, gin.H{
+		"document": utils.ConvertProtoToReceivingDocument(resp.Document),
+	})
+}
+
+// @Summary Документы приемки
+// @Description Возвращает страницу документов приемки без строк, новые сначала
+// @Tags admin
+// @Produce  json
+// @Param   status query string false "Статус документа" Enums(draft, posted)
+// @Param   page query int false "Номер страницы" default(1)
+// @Param   page_size query int false "Размер страницы (до 100)" default(20)
+// @Success 200 {object} object{documents=[]entity.ReceivingDocument,total=int64,page=int,page_size=int} "Страница документов"
+// @Failure 400 {object} object{error=string,message=string} "Некорректные параметры"
+// @Failure 403 {object} object{error=string} "Нужна роль администратора или диспетчера"
+// @Failure 500 {object} object{error=string,message=string} "Ошибка сервера"
+// @Security ApiKeyAuth
+// @Router /store/receivings [get]
+func (w *WarehouseHandler) ListReceivingDocuments(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	req := &warehousepb.ListReceivingDocumentsRequest{Status: c.Query("status")}
+	var err error
+	if req.Page, err = queryInt32(c, "page"); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid page"})
+		return
+	}
+	if req.PageSize, err = queryInt32(c, "page_size"); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid page_size"})
+		return
+	}
+
+	resp, err := w.warehouseGRPCClient.ListReceivingDocuments(ctx, req)
+	if err != nil {
+		w.logger.Error("Failed to list receiving documents", "error", slogger.Err(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{
+			"error":   "Failed to list receiving documents",
+			"message": err.Error(),
+		})
+		return
+	}
+	documents := make([]*entity.ReceivingDocument, 0, len(resp.Documents))
+	for _, document := range resp.Documents {
+		documents = append(documents, utils.ConvertProtoToReceivingDocument(document))
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"documents": documents,
+		"total":     resp.Total,
+		"page":      resp.Page,
+		"page_size": resp.PageSize,
+	})
+}
+
+// @Summary Получение документа приемки
+// @Description Возвращает документ приемки со строками
+// @Tags admin
+// @Produce  json
+// @Param   document_id path int true "ID документа"
+// @Success 200 {object} object{document=entity.ReceivingDocument} "Документ"
+// @Failure 400 {object} object{error=string} "Неверный ID документа"
+// @Failure 403 {object} object{error=string} "Нужна роль администратора или диспетчера"
+// @Failure 404 {object} object{error=string,message=string} "Документ не найден"
+// @Failure 500 {object} object{error=string,message=string} "Ошибка сервера"
+// @Security ApiKeyAuth
+// @Router /store/receivings/{document_id} [get]
+func (w *WarehouseHandler) GetReceivingDocument(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	documentID, err := strconv.Atoi(c.Param("document_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid document_id"})
+		return
+	}
+
+	resp, err := w.warehouseGRPCClient.GetReceivingDocument(ctx, &warehousepb.GetReceivingDocumentRequest{DocumentId: int64(documentID)})
+	if err != nil {
+		w.logger.Error("Failed to get receiving document", "error", slogger.Err(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{
+			"error":   "Failed to get receiving document",
+			"message": err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"document": utils.ConvertProtoToReceivingDocument(resp.Document),
+	})
+}
+
+// @Summary Отметка принятого товара
+// @Description Задает фактически принятое количество по строкам черновика. Товар, которого не ждали, добавляется строкой с ожидаемым количеством 0
+// @Tags admin
+// @Accept  json
+// @Produce  json
+// @Param   document_id path int true "ID документа"
+// @Param   request body dto.RecordReceivedRequest true "Принятые количества"
+// @Success 200 {object} object{document=entity.ReceivingDocument} "Документ обновлен"
+// @Failure 400 {object} object{error=string,message=string} "Некорректные данные"
+// @Failure 403 {object} object{error=string} "Нужна роль администратора или диспетчера"
+// @Failure 404 {object} object{error=string,message=string} "Документ или товар не найден"
+// @Failure 409 {object} object{error=string,message=string} "Документ уже проведен"
+// @Failure 500 {object} object{error=string,message=string} "Ошибка сервера"
+// @Security ApiKeyAuth
+// @Router /store/receivings/{document_id}/received [put]
+func (w *WarehouseHandler) RecordReceivedQuantities(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	documentID, err := strconv.Atoi(c.Param("document_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid document_id"})
+		return
+	}
+	var req dto.RecordReceivedRequest
+	if err := c.BindJSON(&req); err != nil {
+		w.logger.Error("Failed to bind JSON", slogger.Err(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	lines := make([]*warehousepb.ReceivingLine, 0, len(req.Lines))
+	for _, line := range req.Lines {
+		lines = append(lines, &warehousepb.ReceivingLine{
+			ProductId:        line.ProductID,
+			ReceivedQuantity: line.ReceivedQuantity,
+			LotNumber:        line.LotNumber,
+			ExpiresAt:        line.ExpiresAt,
+		})
+	}
+	resp, err := w.warehouseGRPCClient.RecordReceivedQuantities(ctx, &warehousepb.RecordReceivedQuantitiesRequest{
+		DocumentId: int64(documentID),
+		Lines:      lines,
+	})
+	if err != nil {
+		w.logger.Error("Failed to record received quantities", "error", slogger.Err(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{
+			"error":   "Failed to record received quantities",
+			"message": err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"document": utils.ConvertProtoToReceivingDocument(resp.Document),
+	})
+}
+
+// @Summary Проведение документа приемки
+// @Description Приходует принятый товар на склад и закрывает документ. Каждая строка с принятым товаром сохраняется как поступление
+// @Tags admin
+// @Produce  json
+// @Param   document_id path int true "ID документа"
+// @Success 200 {object} object{document=entity.ReceivingDocument} "Документ проведен"
+// @Failure 400 {object} object{error=string} "Неверный ID документа"
+// @Failure 403 {object} object{error=string} "Нужна роль администратора или диспетчера"
+// @Failure 404 {object} object{error=string,message=string} "Документ не найден"
+// @Failure 409 {object} object{error=string,message=string} "Документ уже проведен или товар не принят"
+// @Failure 500 {object} object{error=string,message=string} "Ошибка сервера"
+// @Security ApiKeyAuth
+// @Router /store/receivings/{document_id}/post [post]
+func (w *WarehouseHandler) PostReceivingDocument(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	documentID, err := strconv.Atoi(c.Param("document_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid document_id"})
+		return
+	}
+	userID, err := middleware.GetUserId(c)
+	if err != nil {
+		w.logger.Error("getting user_id failed", slogger.Err(err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := w.warehouseGRPCClient.PostReceivingDocument(ctx, &warehousepb.PostReceivingDocumentRequest{
+		DocumentId: int64(documentID),
+		UserId:     int64(userID),
+	})
+	if err != nil {
+		w.logger.Error("Failed to post receiving document", "error", slogger.Err(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{
+			"error":   "Failed to post receiving document",
+			"message": err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"document": utils.ConvertProtoToReceivingDocument(resp.Document),
+	})
+}
+
+// @Summary Журнал движения товара
+// @Description Возвращает изменения остатка товара, новые сначала: списания и возвраты по заказам, приемки, корректировки и перемещения
+// @Tags admin
+// @Produce  json
+// @Param   product_id path int true "ID товара"
+// @Param   reason query string false "Причина движения" Enums(order, return, receipt, adjustment, transfer)
+// @Param   warehouse_id query int false "ID склада; без параметра - движения по всем складам"
+// @Param   since query int false "Начало периода, unix-время"
+// @Param   until query int false "Конец периода (не включая), unix-время"
+// @Param   page query int false "Номер страницы" default(1)
+// @Param   page_size query int false "Размер страницы (до 500)" default(50)
+// @Success 200 {object} object{movements=[]entity.StockMovement,total=int64,page=int,page_size=int} "Страница журнала"
+// @Failure 400 {object} object{error=string,message=string} "Некорректные параметры"
+// @Failure 403 {object} object{error=string} "Нужна роль администратора или диспетчера"
+// @Failure 500 {object} object{error=string,message=string} "Ошибка сервера"
+// @Security ApiKeyAuth
+// @Router /store/products/{product_id}/movements [get]
+func (w *WarehouseHandler) ListStockMovements(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	productID, err := strconv.Atoi(c.Param("product_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid product_id"})
+		return
+	}
+	req := &warehousepb.ListStockMovementsRequest{
+		ProductId: int64(productID),
+		Reason:    c.Query("reason"),
+	}
+	if req.WarehouseId, err = queryInt64(c, "warehouse_id"); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid warehouse_id"})
+		return
+	}
+	if req.Since, err = queryInt64(c, "since"); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid since"})
+		return
+	}
+	if req.Until, err = queryInt64(c, "until"); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid until"})
+		return
+	}
+	if req.Page, err = queryInt32(c, "page"); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid page"})
+		return
+	}
+	if req.PageSize, err = queryInt32(c, "page_size"); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid page_size"})
+		return
+	}
+
+	resp, err := w.warehouseGRPCClient.ListStockMovements(ctx, req)
+	if err != nil {
+		w.logger.Error("Failed to list stock movements", "error", slogger.Err(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{
+			"error":   "Failed to list stock movements",
+			"message": err.Error(),
+		})
+		return
+	}
+	movements := make([]*entity.StockMovement, 0, len(resp.Movements))
+	for _, movement := range resp.Movements {
+		movements = append(movements, utils.ConvertProtoToStockMovement(movement))
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"movements": movements,
+		"total":     resp.Total,
+		"page":      resp.Page,
+		"page_size": resp.PageSize,
+	})
+}
+
+// @Summary Сверка остатков с журналом
+// @Description Проверяет, что остаток каждого товара на каждом складе равен сумме его движений. Возвращает только расхождения
+// @Tags admin
+// @Produce  json
+// @Param   product_id query []int false "ID товаров; без параметра проверяются все" collectionFormat(multi)
+// @Success 200 {object} object{checked=int64,discrepancies=[]entity.StockDiscrepancy} "Результат сверки"
+// @Failure 400 {object} object{error=string} "Неверный ID товара"
+// @Failure 403 {object} object{error=string} "Нужна роль администратора или диспетчера"
+// @Failure 500 {object} object{error=string,message=string} "Ошибка сервера"
+// @Security ApiKeyAuth
+// @Router /store/reconciliation [get]
+func (w *WarehouseHandler) ReconcileStock(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+	defer cancel()
+	req := &warehousepb.ReconcileStockRequest{}
+	for _, value := range c.QueryArray("product_id") {
+		productID, err := strconv.ParseInt(value, 10, 64)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid product_id"})
+			return
+		}
+		req.ProductIds = append(req.ProductIds, productID)
+	}
+
+	resp, err := w.warehouseGRPCClient.ReconcileStock(ctx, req)
+	if err != nil {
+		w.logger.Error("Failed to reconcile stock", "error", slogger.Err(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{
+			"error":   "Failed to reconcile stock",
+			"message": err.Error(),
+		})
+		return
+	}
+	discrepancies := make([]entity.StockDiscrepancy, 0, len(resp.Discrepancies))
+	for _, d := range resp.Discrepancies {
+		discrepancies = append(discrepancies, entity.StockDiscrepancy{
+			ProductID:   d.ProductId,
+			ProductName: d.ProductName,
+			OnHand:      d.OnHand,
+			LedgerTotal: d.LedgerTotal,
+			WarehouseID: d.WarehouseId,
+		})
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"checked":       resp.Checked,
+		"discrepancies": discrepancies,
+	})
+}
+
+// @Summary Товары к пополнению
+// @Description Возвращает товары, доступный остаток которых (за вычетом резервов) ниже точки заказа, и рекомендуемое количество к заказу
+// @Tags admin
+// @Produce  json
+// @Param   warehouse_id query int false "ID склада; без параметра - все активные склады"
+// @Success 200 {object} object{items=[]entity.ReplenishmentItem} "Список к пополнению"
+// @Failure 400 {object} object{error=string,message=string} "Некорректные параметры"
+// @Failure 403 {object} object{error=string} "Нужна роль администратора или диспетчера"
+// @Failure 404 {object} object{error=string,message=string} "Склад не найден"
+// @Failure 500 {object} object{error=string,message=string} "Ошибка сервера"
+// @Security ApiKeyAuth
+// @Router /store/replenishment [get]
+func (w *WarehouseHandler) ListReplenishment(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	req := &warehousepb.ListReplenishmentRequest{}
+	var err error
+	if req.WarehouseId, err = queryInt64(c, "warehouse_id"); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid warehouse_id"})
+		return
+	}
+
+	resp, err := w.warehouseGRPCClient.ListReplenishment(ctx, req)
+	if err != nil {
+		w.logger.Error("Failed to list replenishment", "error", slogger.Err(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{
+			"error":   "Failed to list replenishment",
+			"message": err.Error(),
+		})
+		return
+	}
+	items := make([]*entity.ReplenishmentItem, 0, len(resp.Items))
+	for _, item := range resp.Items {
+		items = append(items, utils.ConvertProtoToReplenishmentItem(item))
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"items": items,
+	})
+}
+
+// @Summary Партии товара и сроки годности
+// @Description Возвращает партии с остатком, ближайшие к истечению срока годности первыми. С expiring_within_days - только партии, срок которых истекает в эти дни или уже истек
+// @Tags admin
+// @Produce  json
+// @Param   warehouse_id query int false "ID склада; без параметра - все склады"
+// @Param   product_id query int false "ID товара; без параметра - все товары"
+// @Param   expiring_within_days query int false "Горизонт отчета в днях (до 365)"
+// @Success 200 {object} object{lots=[]entity.StockLot} "Партии"
+// @Failure 400 {object} object{error=string,message=string} "Некорректные параметры"
+// @Failure 403 {object} object{error=string} "Нужна роль администратора или диспетчера"
+// @Failure 500 {object} object{error=string,message=string} "Ошибка сервера"
+// @Security ApiKeyAuth
+// @Router /store/lots [get]
+func (w *WarehouseHandler) ListStockLots(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	req := &warehousepb.ListStockLotsRequest{}
+	var err error
+	if req.WarehouseId, err = queryInt64(c, "warehouse_id"); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid warehouse_id"})
+		return
+	}
+	if req.ProductId, err = queryInt64(c, "product_id"); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid product_id"})
+		return
+	}
+	if req.ExpiringWithinDays, err = queryInt32(c, "expiring_within_days"); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid expiring_within_days"})
+		return
+	}
+
+	resp, err := w.warehouseGRPCClient.ListStockLots(ctx, req)
+	if err != nil {
+		w.logger.Error("Failed to list stock lots", "error", slogger.Err(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{
+			"error":   "Failed to list stock lots",
+			"message": err.Error(),
+		})
+		return
+	}
+	lots := make([]*entity.StockLot, 0, len(resp.Lots))
+	for _, lot := range resp.Lots {
+		lots = append(lots, utils.ConvertProtoToStockLot(lot))
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"lots": lots,
+	})
+}
+
+// @Summary Создание склада
+// @Description Добавляет склад. Координаты нужны, чтобы заказы отгружались с ближайшего склада
+// @Tags admin
+// @Accept  json
+// @Produce  json
+// @Param   request body dto.WarehouseRequest true "Данные склада"
+// @Success 201 {object} object{warehouse=entity.Warehouse} "Склад создан"
+// @Failure 400 {object} object{error=string,message=string} "Некорректные данные"
+// @Failure 403 {object} object{error=string} "Нужна роль администратора или диспетчера"
+// @Failure 409 {object} object{error=string,message=string} "Название уже занято"
+// @Failure 500 {object} object{error=string,message=string} "Ошибка сервера"
+// @Security ApiKeyAuth
+// @Router /admin/warehouses [post]
+func (w *WarehouseHandler) CreateWarehouse(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	var req dto.WarehouseRequest
+	if err := c.BindJSON(&req); err != nil {
+		w.logger.Error("Failed to bind JSON", slogger.Err(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := w.warehouseGRPCClient.CreateWarehouse(ctx, &warehousepb.CreateWarehouseRequest{
+		Name:     req.Name,
+		Address:  req.Address,
+		Location: convertWarehouseLocation(req.Location),
+	})
+	if err != nil {
+		w.logger.Error("Failed to create warehouse", "error", slogger.Err(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{
+			"error":   "Failed to create warehouse",
+			"message": err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusCreated, gin.H{
+		"warehouse": utils.ConvertProtoToWarehouse(resp.Warehouse),
+	})
+}
+
+// @Summary Список складов
+// @Description Возвращает склады. Закрытые склады скрыты, пока не передан include_inactive
+// @Tags admin
+// @Produce  json
+// @Param   include_inactive query bool false "Показывать закрытые склады"
+// @Success 200 {object} object{warehouses=[]entity.Warehouse} "Склады"
+// @Failure 400 {object} object{error=string} "Некорректные параметры"
+// @Failure 403 {object} object{error=string} "Нужна роль администратора или диспетчера"
+// @Failure 500 {object} object{error=string,message=string} "Ошибка сервера"
+// @Security ApiKeyAuth
+// @Router /admin/warehouses [get]
+func (w *WarehouseHandler) ListWarehouses(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	req := &warehousepb.ListWarehousesRequest{}
+	if value := c.Query("include_inactive"); value != "" {
+		includeInactive, err := strconv.ParseBool(value)
+		if err != nil {
+			c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid include_inactive"})
+			return
+		}
+		req.IncludeInactive = includeInactive
+	}
+
+	resp, err := w.warehouseGRPCClient.ListWarehouses(ctx, req)
+	if err != nil {
+		w.logger.Error("Failed to list warehouses", "error", slogger.Err(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{
+			"error":   "Failed to list warehouses",
+			"message": err.Error(),
+		})
+		return
+	}
+	warehouses := make([]*entity.Warehouse, 0, len(resp.Warehouses))
+	for _, warehouse := range resp.Warehouses {
+		warehouses = append(warehouses, utils.ConvertProtoToWarehouse(warehouse))
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"warehouses": warehouses,
+	})
+}
+
+// @Summary Получение склада
+// @Description Возвращает склад по ID
+// @Tags admin
+// @Produce  json
+// @Param   warehouse_id path int true "ID склада"
+// @Success 200 {object} object{warehouse=entity.Warehouse} "Склад"
+// @Failure 400 {object} object{error=string} "Неверный ID склада"
+// @Failure 403 {object} object{error=string} "Нужна роль администратора или диспетчера"
+// @Failure 404 {object} object{error=string,message=string} "Склад не найден"
+// @Failure 500 {object} object{error=string,message=string} "Ошибка сервера"
+// @Security ApiKeyAuth
+// @Router /admin/warehouses/{warehouse_id} [get]
+func (w *WarehouseHandler) GetWarehouse(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	warehouseID, err := strconv.Atoi(c.Param("warehouse_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid warehouse_id"})
+		return
+	}
+
+	resp, err := w.warehouseGRPCClient.GetWarehouse(ctx, &warehousepb.GetWarehouseRequest{WarehouseId: int64(warehouseID)})
+	if err != nil {
+		w.logger.Error("Failed to get warehouse", "error", slogger.Err(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{
+			"error":   "Failed to get warehouse",
+			"message": err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"warehouse": utils.ConvertProtoToWarehouse(resp.Warehouse),
+	})
+}
+
+// @Summary Изменение склада
+// @Description Заменяет данные склада. Закрытый склад (active=false) не участвует в резервировании и не принимает поставки
+// @Tags admin
+// @Accept  json
+// @Produce  json
+// @Param   warehouse_id path int true "ID склада"
+// @Param   request body dto.UpdateWarehouseRequest true "Данные склада"
+// @Success 200 {object} object{warehouse=entity.Warehouse} "Склад изменен"
+// @Failure 400 {object} object{error=string,message=string} "Некорректные данные"
+// @Failure 403 {object} object{error=string} "Нужна роль администратора или диспетчера"
+// @Failure 404 {object} object{error=string,message=string} "Склад не найден"
+// @Failure 409 {object} object{error=string,message=string} "Название уже занято"
+// @Failure 500 {object} object{error=string,message=string} "Ошибка сервера"
+// @Security ApiKeyAuth
+// @Router /admin/warehouses/{warehouse_id} [put]
+func (w *WarehouseHandler) UpdateWarehouse(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	warehouseID, err := strconv.Atoi(c.Param("warehouse_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid warehouse_id"})
+		return
+	}
+	var req dto.UpdateWarehouseRequest
+	if err := c.BindJSON(&req); err != nil {
+		w.logger.Error("Failed to bind JSON", slogger.Err(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	updateReq := &warehousepb.UpdateWarehouseRequest{
+		WarehouseId: int64(warehouseID),
+		Name:        req.Name,
+		Address:     req.Address,
+		Location:    convertWarehouseLocation(req.Location),
+	}
+	if req.Active != nil {
+		updateReq.Active = wrapperspb.Bool(*req.Active)
+	}
+	resp, err := w.warehouseGRPCClient.UpdateWarehouse(ctx, updateReq)
+	if err != nil {
+		w.logger.Error("Failed to update warehouse", "error", slogger.Err(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{
+			"error":   "Failed to update warehouse",
+			"message": err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"warehouse": utils.ConvertProtoToWarehouse(resp.Warehouse),
+	})
+}
+
+// @Summary Остатки склада
+// @Description Возвращает остатки одного склада: количество, резерв и доступное к заказу
+// @Tags admin
+// @Produce  json
+// @Param   warehouse_id path int true "ID склада"
+// @Success 200 {object} object{warehouse=entity.Warehouse,items=[]entity.WarehouseStockItem} "Остатки склада"
+// @Failure 400 {object} object{error=string} "Неверный ID склада"
+// @Failure 403 {object} object{error=string} "Нужна роль администратора или диспетчера"
+// @Failure 404 {object} object{error=string,message=string} "Склад не найден"
+// @Failure 500 {object} object{error=string,message=string} "Ошибка сервера"
+// @Security ApiKeyAuth
+// @Router /admin/warehouses/{warehouse_id}/stock [get]
+func (w *WarehouseHandler) GetWarehouseInventory(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	warehouseID, err := strconv.Atoi(c.Param("warehouse_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid warehouse_id"})
+		return
+	}
+
+	resp, err := w.warehouseGRPCClient.GetWarehouseInventory(ctx, &warehousepb.GetWarehouseInventoryRequest{WarehouseId: int64(warehouseID)})
+	if err != nil {
+		w.logger.Error("Failed to get warehouse inventory", "error", slogger.Err(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{
+			"error":   "Failed to get warehouse inventory",
+			"message": err.Error(),
+		})
+		return
+	}
+	items := make([]entity.WarehouseStockItem, 0, len(resp.Items))
+	for _, item := range resp.Items {
+		items = append(items, entity.WarehouseStockItem{
+			WarehouseID: resp.Warehouse.GetWarehouseId(),
+			ProductID:   item.ProductId,
+			ProductName: item.ProductName,
+			Quantity:    item.Quantity,
+			Reserved:    item.Reserved,
+			Available:   item.Available,
+			LastUpdated: item.LastUpdated,
+			SKU:         item.Sku,
+			UnitPrice:   item.UnitPrice,
+		})
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"warehouse": utils.ConvertProtoToWarehouse(resp.Warehouse),
+		"items":     items,
+	})
+}
+
+func convertWarehouseLocation(location *entity.Location) *warehousepb.Location {
+	if location == nil {
+		return nil
+	}
+	return &warehousepb.Location{Latitude: location.Latitude, Longitude: location.Longitude}
+}
+
+// @Summary Создание перемещения
+// @Description Создает черновик перемещения товара между складами. Остатки не меняются до отправки
+// @Tags admin
+// @Accept  json
+// @Produce  json
+// @Param   request body dto.CreateTransferRequest true "Склады, строки и водитель"
+// @Success 201 {object} object{transfer=entity.StockTransfer} "Перемещение создано"
+// @Failure 400 {object} object{error=string,message=string} "Некорректные данные"
+// @Failure 403 {object} object{error=string} "Нужна роль администратора или диспетчера"
+// @Failure 404 {object} object{error=string,message=string} "Склад, товар или водитель не найден"
+// @Failure 409 {object} object{error=string,message=string} "Склад-получатель закрыт или водитель уволен"
+// @Failure 500 {object} object{error=string,message=string} "Ошибка сервера"
+// @Security ApiKeyAuth
+// @Router /store/transfers [post]
+func (w *WarehouseHandler) CreateTransfer(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	userID, err := middleware.GetUserId(c)
+	if err != nil {
+		w.logger.Error("getting user_id failed", slogger.Err(err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+	var req dto.CreateTransferRequest
+	if err := c.BindJSON(&req); err != nil {
+		w.logger.Error("Failed to bind JSON", slogger.Err(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	lines := make([]*warehousepb.TransferLine, 0, len(req.Lines))
+	for _, line := range req.Lines {
+		lines = append(lines, &warehousepb.TransferLine{
+			ProductId: line.ProductID,
+			Quantity:  line.Quantity,
+		})
+	}
+	resp, err := w.warehouseGRPCClient.CreateTransfer(ctx, &warehousepb.CreateTransferRequest{
+		SourceWarehouseId:      req.SourceWarehouseID,
+		DestinationWarehouseId: req.DestinationWarehouseID,
+		Lines:                  lines,
+		Note:                   req.Note,
+		DriverId:               req.DriverID,
+		UserId:                 int64(userID),
+	})
+	if err != nil {
+		w.logger.Error("Failed to create stock transfer", "error", slogger.Err(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{
+			"error":   "Failed to create stock transfer",
+			"message": err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusCreated, gin.H{
+		"transfer": utils.ConvertProtoToTransfer(resp.Transfer),
+	})
+}
+
+// @Summary Перемещения
+// @Description Возвращает страницу перемещений без строк, новые сначала
+// @Tags admin
+// @Produce  json
+// @Param   status query string false "Статус перемещения" Enums(draft, in_transit, received)
+// @Param   warehouse_id query int false "ID склада-источника или получателя"
+// @Param   page query int false "Номер страницы" default(1)
+// @Param   page_size query int false "Размер страницы (до 100)" default(20)
+// @Success 200 {object} object{transfers=[]entity.StockTransfer,total=int64,page=int,page_size=int} "Страница перемещений"
+// @Failure 400 {object} object{error=string,message=string} "Некорректные параметры"
+// @Failure 403 {object} object{error=string} "Нужна роль администратора или диспетчера"
+// @Failure 500 {object} object{error=string,message=string} "Ошибка сервера"
+// @Security ApiKeyAuth
+// @Router /store/transfers [get]
+func (w *WarehouseHandler) ListTransfers(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	req := &warehousepb.ListTransfersRequest{Status: c.Query("status")}
+	var err error
+	if req.WarehouseId, err = queryInt64(c, "warehouse_id"); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid warehouse_id"})
+		return
+	}
+	if req.Page, err = queryInt32(c, "page"); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid page"})
+		return
+	}
+	if req.PageSize, err = queryInt32(c, "page_size"); err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid page_size"})
+		return
+	}
+
+	resp, err := w.warehouseGRPCClient.ListTransfers(ctx, req)
+	if err != nil {
+		w.logger.Error("Failed to list stock transfers", "error", slogger.Err(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{
+			"error":   "Failed to list stock transfers",
+			"message": err.Error(),
+		})
+		return
+	}
+	transfers := make([]*entity.StockTransfer, 0, len(resp.Transfers))
+	for _, transfer := range resp.Transfers {
+		transfers = append(transfers, utils.ConvertProtoToTransfer(transfer))
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"transfers": transfers,
+		"total":     resp.Total,
+		"page":      resp.Page,
+		"page_size": resp.PageSize,
+	})
+}
+
+// @Summary Получение перемещения
+// @Description Возвращает перемещение со строками
+// @Tags admin
+// @Produce  json
+// @Param   transfer_id path int true "ID перемещения"
+// @Success 200 {object} object{transfer=entity.StockTransfer} "Перемещение"
+// @Failure 400 {object} object{error=string} "Неверный ID перемещения"
+// @Failure 403 {object} object{error=string} "Нужна роль администратора или диспетчера"
+// @Failure 404 {object} object{error=string,message=string} "Перемещение не найдено"
+// @Failure 500 {object} object{error=string,message=string} "Ошибка сервера"
+// @Security ApiKeyAuth
+// @Router /store/transfers/{transfer_id} [get]
+func (w *WarehouseHandler) GetTransfer(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	transferID, err := strconv.Atoi(c.Param("transfer_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid transfer_id"})
+		return
+	}
+
+	resp, err := w.warehouseGRPCClient.GetTransfer(ctx, &warehousepb.GetTransferRequest{TransferId: int64(transferID)})
+	if err != nil {
+		w.logger.Error("Failed to get stock transfer", "error", slogger.Err(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{
+			"error":   "Failed to get stock transfer",
+			"message": err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"transfer": utils.ConvertProtoToTransfer(resp.Transfer),
+	})
+}
+
+// @Summary Назначение водителя на перемещение
+// @Description Назначает водителя, который повезет товар. Водителя можно сменить или снять, пока перемещение не отправлено
+// @Tags admin
+// @Accept  json
+// @Produce  json
+// @Param   transfer_id path int true "ID перемещения"
+// @Param   request body dto.AssignTransferDriverRequest true "Водитель"
+// @Success 200 {object} object{transfer=entity.StockTransfer} "Водитель назначен"
+// @Failure 400 {object} object{error=string,message=string} "Некорректные данные"
+// @Failure 403 {object} object{error=string} "Нужна роль администратора или диспетчера"
+// @Failure 404 {object} object{error=string,message=string} "Перемещение или водитель не найдены"
+// @Failure 409 {object} object{error=string,message=string} "Перемещение уже отправлено или водитель уволен"
+// @Failure 500 {object} object{error=string,message=string} "Ошибка сервера"
+// @Security ApiKeyAuth
+// @Router /store/transfers/{transfer_id}/driver [put]
+func (w *WarehouseHandler) AssignTransferDriver(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	transferID, err := strconv.Atoi(c.Param("transfer_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid transfer_id"})
+		return
+	}
+	var req dto.AssignTransferDriverRequest
+	if err := c.BindJSON(&req); err != nil {
+		w.logger.Error("Failed to bind JSON", slogger.Err(err))
+		c.JSON(http.StatusBadRequest, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := w.warehouseGRPCClient.AssignTransferDriver(ctx, &warehousepb.AssignTransferDriverRequest{
+		TransferId: int64(transferID),
+		DriverId:   req.DriverID,
+	})
+	if err != nil {
+		w.logger.Error("Failed to assign driver to stock transfer", "error", slogger.Err(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{
+			"error":   "Failed to assign driver to stock transfer",
+			"message": err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"transfer": utils.ConvertProtoToTransfer(resp.Transfer),
+	})
+}
+
+// @Summary Отправка перемещения
+// @Description Списывает товар со склада-источника и переводит перемещение в in_transit. Зарезервированный под заказы товар не перемещается
+// @Tags admin
+// @Produce  json
+// @Param   transfer_id path int true "ID перемещения"
+// @Success 200 {object} object{transfer=entity.StockTransfer} "Перемещение отправлено"
+// @Failure 400 {object} object{error=string} "Неверный ID перемещения"
+// @Failure 403 {object} object{error=string} "Нужна роль администратора или диспетчера"
+// @Failure 404 {object} object{error=string,message=string} "Перемещение не найдено"
+// @Failure 409 {object} object{error=string,message=string} "Перемещение уже отправлено, не хватает товара или водитель занят"
+// @Failure 500 {object} object{error=string,message=string} "Ошибка сервера"
+// @Security ApiKeyAuth
+// @Router /store/transfers/{transfer_id}/dispatch [post]
+func (w *WarehouseHandler) DispatchTransfer(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	transferID, err := strconv.Atoi(c.Param("transfer_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid transfer_id"})
+		return
+	}
+	userID, err := middleware.GetUserId(c)
+	if err != nil {
+		w.logger.Error("getting user_id failed", slogger.Err(err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := w.warehouseGRPCClient.DispatchTransfer(ctx, &warehousepb.DispatchTransferRequest{
+		TransferId: int64(transferID),
+		UserId:     int64(userID),
+	})
+	if err != nil {
+		w.logger.Error("Failed to dispatch stock transfer", "error", slogger.Err(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{
+			"error":   "Failed to dispatch stock transfer",
+			"message": err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"transfer": utils.ConvertProtoToTransfer(resp.Transfer),
+	})
+}
+
+// @Summary Приемка перемещения
+// @Description Приходует товар в пути на склад-получатель и закрывает перемещение
+// @Tags admin
+// @Produce  json
+// @Param   transfer_id path int true "ID перемещения"
+// @Success 200 {object} object{transfer=entity.StockTransfer} "Перемещение принято"
+// @Failure 400 {object} object{error=string} "Неверный ID перемещения"
+// @Failure 403 {object} object{error=string} "Нужна роль администратора или диспетчера"
+// @Failure 404 {object} object{error=string,message=string} "Перемещение не найдено"
+// @Failure 409 {object} object{error=string,message=string} "Перемещение не в пути"
+// @Failure 500 {object} object{error=string,message=string} "Ошибка сервера"
+// @Security ApiKeyAuth
+// @Router /store/transfers/{transfer_id}/receive [post]
+func (w *WarehouseHandler) ReceiveTransfer(c *gin.Context) {
+	ctx, cancel := context.WithTimeout(context.Background(), 5*time.Second)
+	defer cancel()
+	transferID, err := strconv.Atoi(c.Param("transfer_id"))
+	if err != nil {
+		c.JSON(http.StatusBadRequest, gin.H{"error": "Invalid transfer_id"})
+		return
+	}
+	userID, err := middleware.GetUserId(c)
+	if err != nil {
+		w.logger.Error("getting user_id failed", slogger.Err(err))
+		c.JSON(http.StatusInternalServerError, gin.H{"error": err.Error()})
+		return
+	}
+
+	resp, err := w.warehouseGRPCClient.ReceiveTransfer(ctx, &warehousepb.ReceiveTransferRequest{
+		TransferId: int64(transferID),
+		UserId:     int64(userID),
+	})
+	if err != nil {
+		w.logger.Error("Failed to receive stock transfer", "error", slogger.Err(err))
+		c.JSON(httpStatusFromGRPC(err), gin.H{
+			"error":   "Failed to receive stock transfer",
+			"message": err.Error(),
+		})
+		return
+	}
+	c.JSON(http.StatusOK, gin.H{
+		"transfer": utils.ConvertProtoToTransfer(resp.Transfer),
+	})
+}
@@ -12,7 +12,10 @@ import (
 	"logistics/internal/services/api-gateway/handler"
 	"logistics/internal/services/api-gateway/middleware"
 	"logistics/internal/services/api-gateway/routes"
+	"logistics/internal/shared/entity"
+	"logistics/pkg/lib/accesstoken"
 	"logistics/pkg/lib/logger/slogger"
+	"logistics/pkg/lib/utils"
 	"net/http"
 	"os"
 	"os/signal"
@@ -24,13 +27,20 @@ import (
 	ginSwagger "github.com/swaggo/gin-swagger"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/emptypb"
 )
 
+// jwksRefreshInterval - как часто шлюз перечитывает открытые ключи auth-service
+const jwksRefreshInterval = 10 * time.Minute
+
 type Server struct {
 	router *gin.Engine
 
 	authGRPCClient authpb.AuthServiceClient
 
+	// verifier проверяет access токены по открытым ключам auth-service
+	verifier *accesstoken.Verifier
+
 	handlers *handler.Handlers // Хендлеры, которые используют gRPC-клиенты.
 
 	microservices_config *configs.MicroservicesConfig
@@ -68,10 +78,19 @@ func NewServer(logger *slog.Logger, microservices_config *configs.MicroservicesC
 	driverGRPCClient := driverpb.NewDriverServiceClient(driverGRPCConn)
 	warehouseGRPCClient := warehousepb.NewWarehouseServiceClient(warehouseGRPCConn)
 
-	handlers := handler.NewHandlers(logger, authGRPCClient, orderGRPCClient, driverGRPCClient, warehouseGRPCClient)
+	verifier := accesstoken.NewVerifier(logger, func(ctx context.Context) (accesstoken.JWKS, error) {
+		resp, err := authGRPCClient.GetJWKS(ctx, &emptypb.Empty{})
+		if err != nil {
+			return accesstoken.JWKS{}, err
+		}
+		return utils.ConvertProtoToJWKS(resp), nil
+	})
+
+	handlers := handler.NewHandlers(logger, authGRPCClient, orderGRPCClient, driverGRPCClient, warehouseGRPCClient, verifier)
 	return &Server{
 		router:               router,
 		authGRPCClient:       authGRPCClient,
+		verifier:             verifier,
 		handlers:             handlers,
 		microservices_config: microservices_config,
 		logger:               logger,
@@ -82,6 +101,12 @@ func (s *Server) Run() error {
 
 	s.setupRoutes()
 
+	// Ключи подгружаются сразу и дальше обновляются по расписанию, чтобы ротация ключей auth-service
+	// подхватывалась и без токенов с новым kid
+	keysCtx, stopKeys := context.WithCancel(context.Background())
+	defer stopKeys()
+	go s.verifier.Run(keysCtx, jwksRefreshInterval)
+
 	// Канал для ошибок сервера
 	serverErr := make(chan error, 1)
 	go func() {
@@ -123,6 +148,7 @@ func (s *Server) Run() error {
 
 func (s *Server) setupRoutes() {
 	s.router.GET("/swagger/*any", ginSwagger.WrapHandler(swaggerFiles.Handler))
+	routes.SetupWellKnownRoutes(s.router, s.handlers.JWKSHandlerInterface)
 	api := s.router.Group("/api/v1")
 
 	// Public routes
@@ -130,10 +156,25 @@ func (s *Server) setupRoutes() {
 
 	// Protected routes
 	protected := api.Group("")
-	protected.Use(middleware.AuthMiddleware(s.authGRPCClient))
+	protected.Use(middleware.AuthMiddleware(s.verifier))
 	{
 		routes.SetupLogoutRoute(protected, s.handlers.AuthHandlerInterface)
 		routes.SetupOrderRoutes(protected, s.handlers.OrderHandlerInterface)
 		routes.SetupWarehouseRoutes(protected, s.handlers.WarehouseHandlerInterface)
 	}
+
+	// Управление водителями, каталогом и складами - для администраторов и диспетчеров
+	admin := protected.Group("")
+	admin.Use(middleware.RequireRoles(entity.RoleAdmin, entity.RoleDispatcher))
+	{
+		routes.SetupAdminRoutes(admin, s.handlers.DriverHandlerInterface, s.handlers.WarehouseHandlerInterface)
+		routes.SetupStoreAdminRoutes(admin, s.handlers.WarehouseHandlerInterface)
+	}
+
+	// Роли раздают только администраторы
+	rolesAdmin := protected.Group("")
+	rolesAdmin.Use(middleware.RequireRoles(entity.RoleAdmin))
+	{
+		routes.SetupRoleRoutes(rolesAdmin, s.handlers.AuthHandlerInterface)
+	}
 }
@@ -5,10 +5,12 @@ import (
 	"errors"
 	"fmt"
 	"log/slog"
-	authpb "logistics/api/protobuf/auth_service"
+	"logistics/internal/shared/entity"
 	"logistics/internal/shared/models/dto"
+	"logistics/pkg/lib/accesstoken"
 	"logistics/pkg/lib/logger/slogger"
 	"net/http"
+	"slices"
 	"strings"
 	"time"
 
@@ -19,122 +21,81 @@ const (
 	RefreshTokenTTL = 24 * time.Hour
 )
 
-func AuthMiddleware(authGRPCService authpb.AuthServiceClient) gin.HandlerFunc {
+// AuthMiddleware пропускает запросы только с действующим access токеном. Подпись проверяется локально
+// по открытым ключам auth-service, без запроса на каждый вызов. Просроченный токен дает 401:
+// клиент сам обновляет сессию через POST /auth/refresh и повторяет запрос
+func AuthMiddleware(verifier *accesstoken.Verifier) gin.HandlerFunc {
 	return gin.HandlerFunc(func(c *gin.Context) {
 		ctx, cancel := context.WithTimeout(c.Request.Context(), 5*time.Second)
 		defer cancel()
 		authHeader := c.GetHeader("Authorization")
-		if authHeader != "" {
-			// Извлекаем токен из заголовка "Bearer TOKEN"
-			tokenParts := strings.Split(authHeader, " ")
-			if len(tokenParts) != 2 || tokenParts[0] != "Bearer" {
-				slog.Error("Invalid authorization header format")
-				c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization header format"})
-				c.Abort()
-				return
-			} else {
-				req := dto.AccessTokenRequest{
-					AccessToken: tokenParts[1],
-				}
-
-				// Валидация токена через сервис
-				userID, err := authGRPCService.ValidateToken(ctx, &authpb.ValidateTokenRequest{
-					AccessToken: req.AccessToken,
-				})
-				if err != nil {
-					refresh_token, err := c.Cookie("refresh_token")
-					if err != nil {
-						slog.Error("Refresh token is required", slogger.Err(err), slog.String("status", fmt.Sprintf("%d", http.StatusUnauthorized)))
-						c.JSON(http.StatusUnauthorized, gin.H{"error": "Refresh token is required"})
-						c.Abort()
-						return
-					}
-					if refresh_token != "" {
-						userID, err := authGRPCService.GetUserIDbyRefreshToken(ctx, &authpb.GetUserIDbyRefreshTokenRequest{
-							RefreshToken: refresh_token,
-						})
-						if err != nil {
-							slog.Error("Invalid refresh token", slogger.Err(err), slog.String("status", fmt.Sprintf("%d", http.StatusUnauthorized)))
-							c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid refresh token"})
-							c.Abort()
-							return
-						}
-						if userID.UserId != 0 {
-							_, err = authGRPCService.RemoveOldRefreshToken(ctx, &authpb.RemoveOldRefreshTokenRequest{
-								UserId:       userID.UserId,
-								RefreshToken: refresh_token,
-							})
-							if err != nil {
-								slog.Error("Failed to remove old refresh token", slogger.Err(err), slog.String("status", fmt.Sprintf("%d", http.StatusInternalServerError)))
-								c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to remove old refresh token"})
-								c.Abort()
-								return
-							}
-							new_access_token, err := authGRPCService.GenerateAccessToken(ctx, &authpb.GenerateAccessTokenRequest{
-								UserId: userID.UserId,
-							})
-							if err != nil {
-								slog.Error("Failed to generate new access token", slogger.Err(err), slog.String("status", fmt.Sprintf("%d", http.StatusInternalServerError)))
-								c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate new access token"})
-								c.Abort()
-								return
-							}
-							new_refresh_token, err := authGRPCService.GenerateRefreshToken(ctx, &authpb.GenerateRefreshTokenRequest{
-								UserId: userID.UserId,
-							})
-							if err != nil {
-								slog.Error("Failed to generate new refresh token", slogger.Err(err), slog.String("status", fmt.Sprintf("%d", http.StatusInternalServerError)))
-								c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to generate new refresh token"})
-								c.Abort()
-								return
-							}
-
-							_, err = authGRPCService.SaveNewRefreshToken(ctx, &authpb.SaveNewRefreshTokenRequest{
-								UserId:       userID.UserId,
-								RefreshToken: new_refresh_token.RefreshToken,
-								ExpiresAt:    time.Now().Add(RefreshTokenTTL).Unix(),
-							})
-							if err != nil {
-								slog.Error("Failed to save new refresh token", slogger.Err(err), slog.String("status", fmt.Sprintf("%d", http.StatusInternalServerError)))
-								c.JSON(http.StatusInternalServerError, gin.H{"error": "Failed to save new refresh token"})
-								c.Abort()
-								return
-							}
-							c.Header("Authorization", "Bearer "+new_access_token.AccessToken)
-							SetRefreshTokenCookie(c, new_refresh_token.RefreshToken)
-							c.Set("user_id", uint(userID.UserId))
-							c.Next()
-							return
-
-						} else {
-							slog.Error("Authorization is required", slog.String("status", fmt.Sprintf("%d", http.StatusUnauthorized)))
-							c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization is required"})
-							c.Abort()
-							return
-						}
-
-					} else {
-						slog.Error("Authorization is required", slog.String("status", fmt.Sprintf("%d", http.StatusUnauthorized)))
-						c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization is required"})
-						c.Abort()
-						return
-
-					}
-				}
-				c.Set("user_id", uint(userID.UserId))
-				c.Next()
-				return
-			}
-		} else {
+		if authHeader == "" {
 			slog.Error("Authorization is required, Token is empty", slog.String("status", fmt.Sprintf("%d", http.StatusUnauthorized)))
 			c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization is required, Token is empty"})
 			c.Abort()
 			return
 		}
+		// Извлекаем токен из заголовка "Bearer TOKEN"
+		tokenParts := strings.Split(authHeader, " ")
+		if len(tokenParts) != 2 || tokenParts[0] != "Bearer" {
+			slog.Error("Invalid authorization header format")
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid authorization header format"})
+			c.Abort()
+			return
+		}
+		req := dto.AccessTokenRequest{
+			AccessToken: tokenParts[1],
+		}
 
+		claims, err := verifier.Verify(ctx, req.AccessToken)
+		if err != nil {
+			slog.Error("Invalid access token", slogger.Err(err), slog.String("status", fmt.Sprintf("%d", http.StatusUnauthorized)))
+			c.Header("WWW-Authenticate", `Bearer error="invalid_token"`)
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Invalid or expired access token"})
+			c.Abort()
+			return
+		}
+		userID, _ := claims.UserID()
+		c.Set("user_id", uint(userID))
+		c.Set("roles", claims.Roles)
+		c.Next()
 	})
 }
 
+// RequireRoles пропускает дальше пользователей, у которых есть хотя бы одна из ролей; ставится после AuthMiddleware.
+// Роли берутся из access токена, поэтому запрос в auth-service не нужен
+func RequireRoles(roles ...entity.Role) gin.HandlerFunc {
+	return func(c *gin.Context) {
+		userID, err := GetUserId(c)
+		if err != nil {
+			slog.Error("getting user_id failed", slogger.Err(err), slog.String("status", fmt.Sprintf("%d", http.StatusUnauthorized)))
+			c.JSON(http.StatusUnauthorized, gin.H{"error": "Authorization is required"})
+			c.Abort()
+			return
+		}
+		for _, role := range GetUserRoles(c) {
+			if slices.Contains(roles, role) {
+				c.Next()
+				return
+			}
+		}
+		slog.Warn("Role required", slog.Uint64("user_id", uint64(userID)), slog.Any("roles", roles), slog.String("status", fmt.Sprintf("%d", http.StatusForbidden)))
+		c.JSON(http.StatusForbidden, gin.H{"error": "Insufficient role", "required_roles": roles})
+		c.Abort()
+	}
+}
+
+// GetUserRoles возвращает роли пользователя, сохраненные AuthMiddleware
+func GetUserRoles(c *gin.Context) []entity.Role {
+	value, _ := c.Get("roles")
+	names, _ := value.([]string)
+	roles := make([]entity.Role, 0, len(names))
+	for _, name := range names {
+		roles = append(roles, entity.Role(name))
+	}
+	return roles
+}
+
 func GetUserId(c *gin.Context) (uint, error) {
 	userID, ok := c.Get("user_id")
 	if !ok {
@@ -156,3 +117,9 @@ func SetRefreshTokenCookie(c *gin.Context, refreshToken string) {
 	c.SetSameSite(http.SameSiteLaxMode)
 	c.SetCookie("refresh_token", refreshToken, int(RefreshTokenTTL.Seconds()), "/", "", false, true)
 }
+
+// ClearRefreshTokenCookie удаляет refresh токен у клиента
+func ClearRefreshTokenCookie(c *gin.Context) {
+	c.SetSameSite(http.SameSiteLaxMode)
+	c.SetCookie("refresh_token", "", -1, "/", "", false, true)
+}
@@ -11,19 +11,40 @@ func SetupAuthRoutes(router *gin.RouterGroup, authHandler handler.AuthHandlerInt
 	{
 		auth.POST("/sign-up", authHandler.SignUp)
 		auth.POST("/sign-in", authHandler.SignIn)
+		auth.POST("/refresh", authHandler.Refresh)
 	}
 }
 
+// SetupWellKnownRoutes - служебные пути из /.well-known, они не входят в версионированное API
+func SetupWellKnownRoutes(router *gin.Engine, jwksHandler handler.JWKSHandlerInterface) {
+	router.GET("/.well-known/jwks.json", jwksHandler.GetJWKS)
+}
+
 func SetupLogoutRoute(router *gin.RouterGroup, authHandler handler.AuthHandlerInterface) {
 	router.POST("/logout", authHandler.Logout)
 }
+
+// SetupRoleRoutes - выдача и отзыв ролей пользователей, только для администраторов
+func SetupRoleRoutes(router *gin.RouterGroup, authHandler handler.AuthHandlerInterface) {
+	users := router.Group("/admin/users")
+	{
+		users.PUT("/:user_id/roles/:role", authHandler.GrantRole)
+		users.DELETE("/:user_id/roles/:role", authHandler.RevokeRole)
+	}
+}
+
 func SetupOrderRoutes(router *gin.RouterGroup, orderHandler handler.OrderHandlerInterface) {
 	orders := router.Group("/orders")
 	{
 		orders.POST("", orderHandler.CreateOrder)
 		orders.GET("", orderHandler.GetOrders)
 		orders.GET("/:order_id", orderHandler.GetOrderByID)
+		orders.GET("/:order_id/timeline", orderHandler.GetOrderTimeline)
 		orders.POST("/:order_id/assign-driver", orderHandler.AssignDriver)
+		orders.GET("/assignments/:job_id", orderHandler.GetAssignmentJob)
+		orders.GET("/:order_id/driver-location", orderHandler.GetDriverLocation)
+		orders.GET("/:order_id/events", orderHandler.WatchOrder)
+		orders.POST("/:order_id/cancel", orderHandler.CancelOrder)
 		orders.GET("/delivery", orderHandler.GetDeliveries)
 		orders.POST("/:order_id/complete_delivery", orderHandler.CompleteOrder)
 	}
@@ -35,3 +56,54 @@ func SetupWarehouseRoutes(router *gin.RouterGroup, warehouseHandler handler.Ware
 		warehouse.GET("/products", warehouseHandler.GetAvailableProducts)
 	}
 }
+
+func SetupAdminRoutes(router *gin.RouterGroup, driverHandler handler.DriverHandlerInterface, warehouseHandler handler.WarehouseHandlerInterface) {
+	admin := router.Group("/admin")
+	{
+		admin.POST("/drivers", driverHandler.CreateDriver)
+		admin.GET("/drivers", driverHandler.ListDrivers)
+		admin.GET("/drivers/:driver_id", driverHandler.GetDriver)
+		admin.PUT("/drivers/:driver_id", driverHandler.UpdateDriver)
+		admin.POST("/drivers/:driver_id/deactivate", driverHandler.DeactivateDriver)
+
+		admin.POST("/products", warehouseHandler.CreateProduct)
+		admin.GET("/products", warehouseHandler.ListProducts)
+		admin.GET("/products/:product_id", warehouseHandler.GetProduct)
+		admin.PUT("/products/:product_id", warehouseHandler.UpdateProduct)
+		admin.DELETE("/products/:product_id", warehouseHandler.DeleteProduct)
+
+		admin.POST("/warehouses", warehouseHandler.CreateWarehouse)
+		admin.GET("/warehouses", warehouseHandler.ListWarehouses)
+		admin.GET("/warehouses/:warehouse_id", warehouseHandler.GetWarehouse)
+		admin.PUT("/warehouses/:warehouse_id", warehouseHandler.UpdateWarehouse)
+		admin.GET("/warehouses/:warehouse_id/stock", warehouseHandler.GetWarehouseInventory)
+	}
+}
+
+// SetupStoreAdminRoutes - складские операции, доступные администраторам и диспетчерам
+func SetupStoreAdminRoutes(router *gin.RouterGroup, warehouseHandler handler.WarehouseHandlerInterface) {
+	store := router.Group("/store")
+	{
+		store.POST("/receivings", warehouseHandler.CreateReceivingDocument)
+		store.GET("/receivings", warehouseHandler.ListReceivingDocuments)
+		store.GET("/receivings/:document_id", warehouseHandler.GetReceivingDocument)
+		store.PUT("/receivings/:document_id/received", warehouseHandler.RecordReceivedQuantities)
+		store.POST("/receivings/:document_id/post", warehouseHandler.PostReceivingDocument)
+
+		store.POST("/transfers", warehouseHandler.CreateTransfer)
+		store.GET("/transfers", warehouseHandler.ListTransfers)
+		store.GET("/transfers/:transfer_id", warehouseHandler.GetTransfer)
+		store.PUT("/transfers/:transfer_id/driver", warehouseHandler.AssignTransferDriver)
+		store.POST("/transfers/:transfer_id/dispatch", warehouseHandler.DispatchTransfer)
+		store.POST("/transfers/:transfer_id/receive", warehouseHandler.ReceiveTransfer)
+
+		store.GET("/products/:product_id/movements", warehouseHandler.ListStockMovements)
+		store.GET("/reconciliation", warehouseHandler.ReconcileStock)
+		store.GET("/replenishment", warehouseHandler.ListReplenishment)
+		store.GET("/lots", warehouseHandler.ListStockLots)
+
+		store.POST("/stock/import", warehouseHandler.ImportStock)
+		store.GET("/stock/export", warehouseHandler.ExportStock)
+		store.GET("/movements/export", warehouseHandler.ExportStockMovements)
+	}
+}
@@ -2,17 +2,45 @@ package domain
 
 import (
 	"context"
+	"errors"
 	"logistics/internal/shared/entity"
 )
 
+var (
+	// ErrUserNotFound - пользователя с таким email нет
+	ErrUserNotFound = errors.New("user not found")
+	// ErrInvalidCredentials - неверный email или пароль; клиенту не сообщается, что именно
+	ErrInvalidCredentials = errors.New("invalid email or password")
+	// ErrLastAdmin - отзыв роли оставил бы систему без администраторов
+	ErrLastAdmin = errors.New("cannot revoke the role of the last admin")
+)
+
+var (
+	// ErrRefreshTokenInvalid - refresh токена нет, он истек или его семейство отозвано
+	ErrRefreshTokenInvalid = errors.New("refresh token is invalid or expired")
+	// ErrRefreshTokenReused - предъявлен уже погашенный refresh токен; семейство отозвано
+	ErrRefreshTokenReused = errors.New("refresh token reuse detected")
+)
+
+// RotatedRefreshToken - результат ротации: владелец и семейство предъявленного токена
+type RotatedRefreshToken struct {
+	UserID   int64
+	FamilyID int64
+}
+
 type AuthRepositoryInterface interface {
 	IsUserExists(ctx context.Context, email string) (bool, error)
 	CreateUser(ctx context.Context, user *entity.User) (int64, error)
-	CheckUserVerification(ctx context.Context, email string, hashpassword string) (entity.User, error)
+	GetUserByEmail(ctx context.Context, email string) (entity.User, error)
+	UpdatePasswordHash(ctx context.Context, userID int64, oldHash, newHash string) error
 	SaveNewRefreshToken(ctx context.Context, userID int64, refreshToken string, expires_at int64) error
 	RemoveRefreshToken(ctx context.Context, userID int64, refreshToken string) error
+	RotateRefreshToken(ctx context.Context, refreshToken, newRefreshToken string, expiresAt, now int64, issue func(userID int64) error) (RotatedRefreshToken, error)
 	GetUserIDbyRefreshToken(ctx context.Context, refreshToken string) (int64, error)
 	Logout(ctx context.Context, userID int64) error
+	GetUserRoles(ctx context.Context, userID int64) ([]entity.Role, error)
+	GrantRole(ctx context.Context, userID int64, role entity.Role) error
+	RevokeRole(ctx context.Context, userID int64, role entity.Role) error
 	// SignUp creates a new user in the database.
 	// SignUp(email, password, firstName, lastName string) (uint, error)
 	// // SignIn checks user credentials and returns user ID if valid.
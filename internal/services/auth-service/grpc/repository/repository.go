@@ -2,8 +2,15 @@ package auth_grpc_repository
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"logistics/internal/services/auth-service/domain"
 	"logistics/internal/shared/entity"
+	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -17,13 +24,26 @@ func NewAuthRepository(pool *pgxpool.Pool) *AuthRepository {
 	}
 }
 
+// CreateUser создает пользователя с ролью покупателя
 func (a *AuthRepository) CreateUser(ctx context.Context, user *entity.User) (int64, error) {
+	tx, err := a.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
 	query := `INSERT INTO users (first_name, last_name, email, password, time_of_registration) VALUES ($1, $2, $3, $4, $5) RETURNING id`
 	var userID int64
-	err := a.pool.QueryRow(ctx, query, user.FirstName, user.LastName, user.Email, user.Password, user.TimeOfRegistration).Scan(&userID)
+	err = tx.QueryRow(ctx, query, user.FirstName, user.LastName, user.Email, user.Password, user.TimeOfRegistration).Scan(&userID)
 	if err != nil {
 		return 0, err
 	}
+	if _, err := tx.Exec(ctx, `INSERT INTO user_roles (user_id, role) VALUES ($1, $2)`, userID, entity.RoleCustomer); err != nil {
+		return 0, fmt.Errorf("failed to grant customer role to user %d: %w", userID, err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
 	return userID, nil
 }
 
@@ -39,50 +59,230 @@ func (a *AuthRepository) IsUserExists(ctx context.Context, email string) (bool,
 	return exists, nil
 }
 
-func (a *AuthRepository) CheckUserVerification(ctx context.Context, email string, hashPassword string) (entity.User, error) {
-	query := `SELECT id, email, first_name, last_name, password FROM users WHERE email = $1 AND password = $2`
+// GetUserByEmail возвращает пользователя вместе с хешем пароля; пароль проверяет сервис
+func (a *AuthRepository) GetUserByEmail(ctx context.Context, email string) (entity.User, error) {
+	query := `SELECT id, email, first_name, last_name, password FROM users WHERE email = $1`
 	var user entity.User
-	err := a.pool.QueryRow(ctx, query, email, hashPassword).Scan(&user.ID, &user.Email, &user.FirstName, &user.LastName, &user.Password)
+	err := a.pool.QueryRow(ctx, query, email).Scan(&user.ID, &user.Email, &user.FirstName, &user.LastName, &user.Password)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return entity.User{}, domain.ErrUserNotFound
+	}
 	if err != nil {
 		return entity.User{}, err
 	}
 	return user, nil
+}
+
+// UpdatePasswordHash заменяет хеш пароля, только если он не менялся с момента проверки
+func (a *AuthRepository) UpdatePasswordHash(ctx context.Context, userID int64, oldHash, newHash string) error {
+	query := `UPDATE users SET password = $3 WHERE id = $1 AND password = $2`
+	_, err := a.pool.Exec(ctx, query, userID, oldHash, newHash)
+	if err != nil {
+		return fmt.Errorf("failed to update password hash of user %d: %w", userID, err)
+	}
+	return nil
+}
 
+// hashRefreshToken - в базе хранится только SHA-256 токена. Токен - 32 случайных байта,
+// подбирать его по хешу бессмысленно, поэтому медленный хеш не нужен
+func hashRefreshToken(refreshToken string) string {
+	sum := sha256.Sum256([]byte(refreshToken))
+	return hex.EncodeToString(sum[:])
 }
 
+// SaveNewRefreshToken сохраняет токен нового входа: он начинает новое семейство
 func (a *AuthRepository) SaveNewRefreshToken(ctx context.Context, userID int64, refreshToken string, expires_at int64) error {
-	query := `INSERT INTO refresh_tokens (user_id, token, expires_at) VALUES ($1, $2, $3)`
-	_, err := a.pool.Exec(ctx, query, userID, refreshToken, expires_at)
+	tx, err := a.pool.Begin(ctx)
 	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	now := time.Now().Unix()
+	var familyID int64
+	err = tx.QueryRow(ctx, `INSERT INTO refresh_token_families (user_id, created_at) VALUES ($1, $2) RETURNING id`, userID, now).Scan(&familyID)
+	if err != nil {
+		return fmt.Errorf("failed to create refresh token family for user %d: %w", userID, err)
+	}
+	if err := insertRefreshToken(ctx, tx, userID, familyID, refreshToken, expires_at, now); err != nil {
 		return err
 	}
+	// Истекшие токены больше не нужны даже для обнаружения повторов
+	_, err = tx.Exec(ctx, `DELETE FROM refresh_token_families f WHERE f.user_id = $1
+		AND NOT EXISTS (SELECT 1 FROM refresh_tokens t WHERE t.family_id = f.id AND t.expires_at > $2)`, userID, now)
+	if err != nil {
+		return fmt.Errorf("failed to delete expired refresh token families of user %d: %w", userID, err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func insertRefreshToken(ctx context.Context, tx pgx.Tx, userID, familyID int64, refreshToken string, expiresAt, now int64) error {
+	query := `INSERT INTO refresh_tokens (user_id, family_id, token_hash, expires_at, created_at) VALUES ($1, $2, $3, $4, $5)`
+	if _, err := tx.Exec(ctx, query, userID, familyID, hashRefreshToken(refreshToken), expiresAt, now); err != nil {
+		return fmt.Errorf("failed to save refresh token of user %d: %w", userID, err)
+	}
 	return nil
 }
 
 func (a *AuthRepository) RemoveRefreshToken(ctx context.Context, userID int64, refreshToken string) error {
-	query := `DELETE FROM refresh_tokens WHERE user_id = $1 AND token = $2`
-	_, err := a.pool.Exec(ctx, query, userID, refreshToken)
+	query := `DELETE FROM refresh_tokens WHERE user_id = $1 AND token_hash = $2`
+	_, err := a.pool.Exec(ctx, query, userID, hashRefreshToken(refreshToken))
 	if err != nil {
 		return err
 	}
 	return nil
 }
 
+// RotateRefreshToken гасит действующий токен и выпускает newRefreshToken в том же семействе.
+// Погашенный токен остается в базе до истечения: если его предъявят снова, семейство отзывается,
+// а вызывающий получает ErrRefreshTokenReused вместе с владельцем и семейством для журнала.
+// issue выпускает access токен до фиксации: при его ошибке ротация откатывается и старый токен остается действующим
+func (a *AuthRepository) RotateRefreshToken(ctx context.Context, refreshToken, newRefreshToken string, expiresAt, now int64, issue func(userID int64) error) (domain.RotatedRefreshToken, error) {
+	tx, err := a.pool.Begin(ctx)
+	if err != nil {
+		return domain.RotatedRefreshToken{}, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Блокировка строки токена не дает двум параллельным ротациям погасить его дважды
+	query := `SELECT t.id, t.user_id, t.family_id, t.expires_at, t.rotated_at IS NOT NULL, f.revoked_at IS NOT NULL
+		FROM refresh_tokens t JOIN refresh_token_families f ON f.id = t.family_id
+		WHERE t.token_hash = $1
+		FOR UPDATE OF t, f`
+	var tokenID, tokenExpiresAt int64
+	var rotated, revoked bool
+	var result domain.RotatedRefreshToken
+	err = tx.QueryRow(ctx, query, hashRefreshToken(refreshToken)).Scan(&tokenID, &result.UserID, &result.FamilyID, &tokenExpiresAt, &rotated, &revoked)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return domain.RotatedRefreshToken{}, domain.ErrRefreshTokenInvalid
+	}
+	if err != nil {
+		return domain.RotatedRefreshToken{}, fmt.Errorf("failed to get refresh token: %w", err)
+	}
+	if revoked || tokenExpiresAt <= now {
+		return result, domain.ErrRefreshTokenInvalid
+	}
+	if rotated {
+		_, err := tx.Exec(ctx, `UPDATE refresh_token_families SET revoked_at = $2, revoke_reason = 'reuse' WHERE id = $1`, result.FamilyID, now)
+		if err != nil {
+			return result, fmt.Errorf("failed to revoke refresh token family %d: %w", result.FamilyID, err)
+		}
+		if err := tx.Commit(ctx); err != nil {
+			return result, fmt.Errorf("failed to commit transaction: %w", err)
+		}
+		return result, domain.ErrRefreshTokenReused
+	}
+
+	if _, err := tx.Exec(ctx, `UPDATE refresh_tokens SET rotated_at = $2 WHERE id = $1`, tokenID, now); err != nil {
+		return result, fmt.Errorf("failed to rotate refresh token: %w", err)
+	}
+	if err := insertRefreshToken(ctx, tx, result.UserID, result.FamilyID, newRefreshToken, expiresAt, now); err != nil {
+		return result, err
+	}
+	if err := issue(result.UserID); err != nil {
+		return result, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return result, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return result, nil
+}
+
+// GetUserIDbyRefreshToken возвращает владельца действующего токена: не погашенного, не истекшего и из неотозванного семейства
 func (a *AuthRepository) GetUserIDbyRefreshToken(ctx context.Context, refreshToken string) (int64, error) {
-	query := `SELECT user_id FROM refresh_tokens WHERE token = $1 AND expires_at > EXTRACT(EPOCH FROM NOW())`
+	query := `SELECT t.user_id FROM refresh_tokens t JOIN refresh_token_families f ON f.id = t.family_id
+		WHERE t.token_hash = $1 AND t.rotated_at IS NULL AND f.revoked_at IS NULL AND t.expires_at > EXTRACT(EPOCH FROM NOW())`
 	var userID int64
-	err := a.pool.QueryRow(ctx, query, refreshToken).Scan(&userID)
+	err := a.pool.QueryRow(ctx, query, hashRefreshToken(refreshToken)).Scan(&userID)
 	if err != nil {
 		return 0, err
 	}
 	return userID, nil
 }
 
+// Logout завершает все сессии пользователя
 func (a *AuthRepository) Logout(ctx context.Context, userID int64) error {
-	query := `DELETE FROM refresh_tokens WHERE user_id = $1`
+	query := `DELETE FROM refresh_token_families WHERE user_id = $1`
 	_, err := a.pool.Exec(ctx, query, userID)
 	if err != nil {
 		return err
 	}
 	return nil
 }
+
+// GetUserRoles возвращает роли пользователя; у неизвестного пользователя ролей нет
+func (a *AuthRepository) GetUserRoles(ctx context.Context, userID int64) ([]entity.Role, error) {
+	rows, err := a.pool.Query(ctx, `SELECT role FROM user_roles WHERE user_id = $1 ORDER BY role`, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query roles of user %d: %w", userID, err)
+	}
+	defer rows.Close()
+
+	var roles []entity.Role
+	for rows.Next() {
+		var role entity.Role
+		if err := rows.Scan(&role); err != nil {
+			return nil, fmt.Errorf("failed to scan role: %w", err)
+		}
+		roles = append(roles, role)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating roles: %w", err)
+	}
+	return roles, nil
+}
+
+// GrantRole выдает роль пользователю; уже выданная роль не дублируется
+func (a *AuthRepository) GrantRole(ctx context.Context, userID int64, role entity.Role) error {
+	var exists bool
+	if err := a.pool.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM users WHERE id = $1)`, userID).Scan(&exists); err != nil {
+		return fmt.Errorf("failed to check user %d: %w", userID, err)
+	}
+	if !exists {
+		return domain.ErrUserNotFound
+	}
+	query := `INSERT INTO user_roles (user_id, role) VALUES ($1, $2) ON CONFLICT DO NOTHING`
+	if _, err := a.pool.Exec(ctx, query, userID, role); err != nil {
+		return fmt.Errorf("failed to grant role %s to user %d: %w", role, userID, err)
+	}
+	return nil
+}
+
+// RevokeRole отзывает роль у пользователя. Администраторы блокируются на время отзыва,
+// чтобы два параллельных отзыва не оставили систему без них
+func (a *AuthRepository) RevokeRole(ctx context.Context, userID int64, role entity.Role) error {
+	tx, err := a.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if role == entity.RoleAdmin {
+		var admins int
+		err := tx.QueryRow(ctx, `SELECT COUNT(*) FROM (SELECT 1 FROM user_roles WHERE role = $1 FOR UPDATE) a`, entity.RoleAdmin).Scan(&admins)
+		if err != nil {
+			return fmt.Errorf("failed to lock admins: %w", err)
+		}
+		if admins <= 1 {
+			var isAdmin bool
+			err := tx.QueryRow(ctx, `SELECT EXISTS (SELECT 1 FROM user_roles WHERE user_id = $1 AND role = $2)`, userID, entity.RoleAdmin).Scan(&isAdmin)
+			if err != nil {
+				return fmt.Errorf("failed to check roles of user %d: %w", userID, err)
+			}
+			if isAdmin {
+				return domain.ErrLastAdmin
+			}
+		}
+	}
+
+	if _, err := tx.Exec(ctx, `DELETE FROM user_roles WHERE user_id = $1 AND role = $2`, userID, role); err != nil {
+		return fmt.Errorf("failed to revoke role %s from user %d: %w", role, userID, err)
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
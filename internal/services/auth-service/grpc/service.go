@@ -6,19 +6,20 @@ import (
 	"encoding/base64"
 	"errors"
 	"fmt"
-	"log"
 	"log/slog"
 	authpb "logistics/api/protobuf/auth_service"
 	"logistics/internal/services/auth-service/domain"
 	"logistics/internal/shared/entity"
+	"logistics/pkg/lib/accesstoken"
+	"logistics/pkg/lib/hasher"
+	"logistics/pkg/lib/logger/slogger"
 	"logistics/pkg/lib/utils"
-	"os"
-	"strconv"
+	"slices"
 	"time"
 
-	"github.com/golang-jwt/jwt/v5"
-	"github.com/joho/godotenv"
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
@@ -30,12 +31,24 @@ type AuthGRPCService struct {
 	authpb.UnimplementedAuthServiceServer
 	log            *slog.Logger
 	authrepository domain.AuthRepositoryInterface
+	passwordHasher *hasher.Hasher
+	// keyset подписывает access токены; открытые ключи публикуются через GetJWKS
+	keyset *accesstoken.Keyset
+	// dummyHash проверяется, когда пользователя нет, чтобы по времени ответа нельзя было узнать, зарегистрирован ли email
+	dummyHash string
 }
 
-func NewAuthGRPCService(log *slog.Logger, repository domain.AuthRepositoryInterface) *AuthGRPCService {
+func NewAuthGRPCService(log *slog.Logger, repository domain.AuthRepositoryInterface, passwordHasher *hasher.Hasher, keyset *accesstoken.Keyset) *AuthGRPCService {
+	dummyHash, err := passwordHasher.Hash("dummy password")
+	if err != nil {
+		log.Error("failed to prepare dummy password hash", slogger.Err(err))
+	}
 	return &AuthGRPCService{
 		log:            log,
 		authrepository: repository,
+		passwordHasher: passwordHasher,
+		keyset:         keyset,
+		dummyHash:      dummyHash,
 	}
 }
 
@@ -53,7 +66,7 @@ func (s *AuthGRPCService) SignUp(ctx context.Context, req *authpb.SignUpRequest)
 	if exists {
 		return nil, errors.New("user with this email already exists")
 	}
-	hashedPassword, err := utils.HashPassword(req.Password)
+	hashedPassword, err := s.passwordHasher.Hash(req.Password)
 	if err != nil {
 		return nil, fmt.Errorf("failed to hash password: %w", err)
 	}
@@ -79,13 +92,23 @@ func (s *AuthGRPCService) SignUp(ctx context.Context, req *authpb.SignUpRequest)
 }
 
 func (s *AuthGRPCService) SignIn(ctx context.Context, req *authpb.SignInRequest) (*authpb.SignInResponse, error) {
-	hashPassword, err := utils.HashPassword(req.Password)
+	user, err := s.authrepository.GetUserByEmail(ctx, req.Email)
+	if errors.Is(err, domain.ErrUserNotFound) {
+		s.passwordHasher.Verify(req.Password, s.dummyHash)
+		return nil, status.Error(codes.Unauthenticated, domain.ErrInvalidCredentials.Error())
+	}
 	if err != nil {
-		return nil, fmt.Errorf("failed to hash password: %w", err)
+		return nil, fmt.Errorf("failed to get user: %w", err)
 	}
-	user, err := s.authrepository.CheckUserVerification(ctx, req.Email, hashPassword)
+	rehash, err := s.passwordHasher.Verify(req.Password, user.Password)
 	if err != nil {
-		return nil, fmt.Errorf("user not found: %w", err)
+		if !errors.Is(err, hasher.ErrMismatch) {
+			s.log.Error("failed to verify password", slog.Int("user_id", user.ID), slogger.Err(err))
+		}
+		return nil, status.Error(codes.Unauthenticated, domain.ErrInvalidCredentials.Error())
+	}
+	if rehash {
+		s.upgradePasswordHash(ctx, user, req.Password)
 	}
 	accessToken, err := s.GenerateAccessToken(ctx, &authpb.GenerateAccessTokenRequest{
 		UserId: int64(user.ID),
@@ -112,6 +135,20 @@ func (s *AuthGRPCService) SignIn(ctx context.Context, req *authpb.SignInRequest)
 	//ДОБАВИТЬ КЭШИРОВАНИЕ
 }
 
+// upgradePasswordHash пересчитывает хеш, записанный устаревшим алгоритмом или с прежними параметрами.
+// Пароль уже проверен, поэтому сбой только логируется: пересчет повторится при следующем входе
+func (s *AuthGRPCService) upgradePasswordHash(ctx context.Context, user entity.User, password string) {
+	newHash, err := s.passwordHasher.Hash(password)
+	if err == nil {
+		err = s.authrepository.UpdatePasswordHash(ctx, int64(user.ID), user.Password, newHash)
+	}
+	if err != nil {
+		s.log.Error("failed to upgrade password hash", slog.Int("user_id", user.ID), slogger.Err(err))
+		return
+	}
+	s.log.Info("password hash upgraded", slog.Int("user_id", user.ID))
+}
+
 func (s *AuthGRPCService) Logout(ctx context.Context, req *authpb.LogoutRequest) (*emptypb.Empty, error) {
 	err := s.authrepository.Logout(ctx, req.UserId)
 	if err != nil {
@@ -120,47 +157,91 @@ func (s *AuthGRPCService) Logout(ctx context.Context, req *authpb.LogoutRequest)
 	return &emptypb.Empty{}, nil
 }
 
-func (s *AuthGRPCService) ValidateToken(ctx context.Context, req *authpb.ValidateTokenRequest) (*authpb.ValidateTokenResponse, error) {
-	err := godotenv.Load(".env")
+func (s *AuthGRPCService) IsAdmin(ctx context.Context, req *authpb.IsAdminRequest) (*authpb.IsAdminResponse, error) {
+	roles, err := s.authrepository.GetUserRoles(ctx, req.UserId)
 	if err != nil {
-		return &authpb.ValidateTokenResponse{}, fmt.Errorf("failed to load environment file: %w", err)
+		return nil, fmt.Errorf("failed to check admin rights: %w", err)
 	}
+	return &authpb.IsAdminResponse{IsAdmin: slices.Contains(roles, entity.RoleAdmin)}, nil
+}
 
-	secretSignInKey := os.Getenv("SECRET_SIGNINKEY")
-	if secretSignInKey == "" {
-		return &authpb.ValidateTokenResponse{}, fmt.Errorf("SECRET_SIGNINKEY environment variable is not set")
+func (s *AuthGRPCService) GetUserRoles(ctx context.Context, req *authpb.GetUserRolesRequest) (*authpb.GetUserRolesResponse, error) {
+	roles, err := s.authrepository.GetUserRoles(ctx, req.UserId)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user roles: %w", err)
 	}
+	return &authpb.GetUserRolesResponse{Roles: rolesToStrings(roles)}, nil
+}
 
-	token, err := jwt.ParseWithClaims(req.AccessToken, &jwt.RegisteredClaims{}, func(token *jwt.Token) (interface{}, error) {
-		// Проверяем метод подписи
-		if _, ok := token.Method.(*jwt.SigningMethodHMAC); !ok {
-			return nil, fmt.Errorf("unexpected signing method: %v", token.Header["alg"])
-		}
-		return []byte(secretSignInKey), nil
-	})
+func (s *AuthGRPCService) GrantRole(ctx context.Context, req *authpb.ChangeUserRoleRequest) (*authpb.GetUserRolesResponse, error) {
+	role := entity.Role(req.Role)
+	if req.UserId <= 0 || !role.IsValid() {
+		return nil, status.Error(codes.InvalidArgument, "user_id and a valid role are required")
+	}
+	err := s.authrepository.GrantRole(ctx, req.UserId, role)
+	if errors.Is(err, domain.ErrUserNotFound) {
+		return nil, status.Errorf(codes.NotFound, "user %d not found", req.UserId)
+	}
+	if err != nil {
+		s.log.Error("failed to grant role", slog.Int64("user_id", req.UserId), slog.String("role", req.Role), slogger.Err(err))
+		return nil, status.Errorf(codes.Internal, "failed to grant role: %v", err)
+	}
+	s.log.Info("role granted", slog.Int64("user_id", req.UserId), slog.String("role", req.Role))
+	return s.GetUserRoles(ctx, &authpb.GetUserRolesRequest{UserId: req.UserId})
+}
 
+func (s *AuthGRPCService) RevokeRole(ctx context.Context, req *authpb.ChangeUserRoleRequest) (*authpb.GetUserRolesResponse, error) {
+	role := entity.Role(req.Role)
+	if req.UserId <= 0 || !role.IsValid() {
+		return nil, status.Error(codes.InvalidArgument, "user_id and a valid role are required")
+	}
+	err := s.authrepository.RevokeRole(ctx, req.UserId, role)
+	if errors.Is(err, domain.ErrLastAdmin) {
+		return nil, status.Error(codes.FailedPrecondition, err.Error())
+	}
 	if err != nil {
-		return &authpb.ValidateTokenResponse{}, fmt.Errorf("invalid token: %w", err)
+		s.log.Error("failed to revoke role", slog.Int64("user_id", req.UserId), slog.String("role", req.Role), slogger.Err(err))
+		return nil, status.Errorf(codes.Internal, "failed to revoke role: %v", err)
 	}
+	s.log.Info("role revoked", slog.Int64("user_id", req.UserId), slog.String("role", req.Role))
+	return s.GetUserRoles(ctx, &authpb.GetUserRolesRequest{UserId: req.UserId})
+}
 
-	// Проверяем валидность claims
-	if claims, ok := token.Claims.(*jwt.RegisteredClaims); ok && token.Valid {
-		if claims.ExpiresAt != nil && claims.ExpiresAt.Time.Before(time.Now()) {
-			return &authpb.ValidateTokenResponse{}, err
-		}
+// BootstrapAdmin выдает роль администратора зарегистрированному пользователю с этим email.
+// Так появляется первый администратор, который дальше раздает роли через GrantRole
+func (s *AuthGRPCService) BootstrapAdmin(ctx context.Context, email string) error {
+	user, err := s.authrepository.GetUserByEmail(ctx, email)
+	if err != nil {
+		return fmt.Errorf("failed to find bootstrap admin %s: %w", email, err)
+	}
+	if err := s.authrepository.GrantRole(ctx, int64(user.ID), entity.RoleAdmin); err != nil {
+		return err
+	}
+	s.log.Info("bootstrap admin granted", slog.Int("user_id", user.ID))
+	return nil
+}
 
-		userID, err := strconv.Atoi(claims.Subject)
-		if err != nil {
-			return &authpb.ValidateTokenResponse{
-				UserId: int64(userID),
-			}, fmt.Errorf("invalid user ID in access token: %w", err)
-		}
-		return &authpb.ValidateTokenResponse{
-			UserId: int64(userID),
-		}, nil
+func rolesToStrings(roles []entity.Role) []string {
+	result := make([]string, 0, len(roles))
+	for _, role := range roles {
+		result = append(result, string(role))
 	}
+	return result
+}
 
-	return nil, fmt.Errorf("invalid token claims")
+func (s *AuthGRPCService) ValidateToken(ctx context.Context, req *authpb.ValidateTokenRequest) (*authpb.ValidateTokenResponse, error) {
+	claims, err := s.keyset.Parse(req.AccessToken)
+	if err != nil {
+		return &authpb.ValidateTokenResponse{}, status.Error(codes.Unauthenticated, err.Error())
+	}
+	userID, err := claims.UserID()
+	if err != nil {
+		return &authpb.ValidateTokenResponse{}, status.Error(codes.Unauthenticated, err.Error())
+	}
+	return &authpb.ValidateTokenResponse{
+		UserId: userID,
+		Roles:  claims.Roles,
+	}, nil
 }
 
 func (s *AuthGRPCService) GetUserIDbyRefreshToken(ctx context.Context, req *authpb.GetUserIDbyRefreshTokenRequest) (*authpb.GetUserIDbyRefreshTokenResponse, error) {
@@ -174,33 +255,31 @@ func (s *AuthGRPCService) GetUserIDbyRefreshToken(ctx context.Context, req *auth
 }
 
 func (s *AuthGRPCService) GenerateAccessToken(ctx context.Context, req *authpb.GenerateAccessTokenRequest) (*authpb.GenerateAccessTokenResponse, error) {
-	token := jwt.NewWithClaims(jwt.SigningMethodHS256, jwt.RegisteredClaims{
-		Subject:   strconv.Itoa(int(req.UserId)),
-		IssuedAt:  jwt.NewNumericDate(time.Now()),
-		ExpiresAt: jwt.NewNumericDate(time.Now().Add(AccessTokenTTL)),
-	})
-	err := godotenv.Load(".env")
+	accessToken, _, err := s.issueAccessToken(ctx, req.UserId)
 	if err != nil {
-		log.Fatal(err)
 		return &authpb.GenerateAccessTokenResponse{
 			AccessToken: "",
-		}, fmt.Errorf("failed to load environment file: %w", err)
+		}, err
 	}
-	secretSignInKey := os.Getenv("SECRET_SIGNINKEY")
-	if secretSignInKey == "" {
-		return &authpb.GenerateAccessTokenResponse{
-			AccessToken: "",
-		}, fmt.Errorf("SECRET_SIGNINKEY environment variable is not set")
+	return &authpb.GenerateAccessTokenResponse{
+		AccessToken: accessToken,
+	}, nil
+}
+
+// issueAccessToken подписывает access токен пользователя и возвращает его вместе с ролями, вошедшими в токен
+func (s *AuthGRPCService) issueAccessToken(ctx context.Context, userID int64) (string, []string, error) {
+	// Роли попадают в токен, чтобы шлюз проверял доступ без запроса в auth-service.
+	// Изменение ролей вступает в силу со следующим токеном
+	userRoles, err := s.authrepository.GetUserRoles(ctx, userID)
+	if err != nil {
+		return "", nil, fmt.Errorf("failed to get user roles: %w", err)
 	}
-	tokenSignedString, err := token.SignedString([]byte(secretSignInKey))
+	roles := rolesToStrings(userRoles)
+	tokenSignedString, err := s.keyset.Sign(accesstoken.NewClaims(userID, roles, time.Now(), AccessTokenTTL))
 	if err != nil {
-		return &authpb.GenerateAccessTokenResponse{
-			AccessToken: "",
-		}, fmt.Errorf("failed to sign token: %w", err)
+		return "", nil, err
 	}
-	return &authpb.GenerateAccessTokenResponse{
-		AccessToken: tokenSignedString,
-	}, nil
+	return tokenSignedString, roles, nil
 }
 
 func (s *AuthGRPCService) GenerateRefreshToken(ctx context.Context, req *authpb.GenerateRefreshTokenRequest) (*authpb.GenerateRefreshTokenResponse, error) {
@@ -235,3 +314,53 @@ func (s *AuthGRPCService) SaveNewRefreshToken(ctx context.Context, req *authpb.S
 	return &emptypb.Empty{}, nil
 
 }
+
+// RefreshSession обменивает refresh токен на новую пару токенов. Проверка, ротация и выпуск access токена
+// идут в одной транзакции: клиент либо получает обе новые части, либо его старый токен остается действующим
+func (s *AuthGRPCService) RefreshSession(ctx context.Context, req *authpb.RefreshSessionRequest) (*authpb.RefreshSessionResponse, error) {
+	if req.RefreshToken == "" {
+		return nil, status.Error(codes.InvalidArgument, "refresh_token is required")
+	}
+	if req.ExpiresAt <= time.Now().Unix() {
+		return nil, status.Error(codes.InvalidArgument, "expires_at must be in the future")
+	}
+	newToken, err := s.GenerateRefreshToken(ctx, &authpb.GenerateRefreshTokenRequest{})
+	if err != nil {
+		return nil, status.Errorf(codes.Internal, "failed to generate refresh token: %v", err)
+	}
+	var accessToken string
+	var roles []string
+	rotated, err := s.authrepository.RotateRefreshToken(ctx, req.RefreshToken, newToken.RefreshToken, req.ExpiresAt, time.Now().Unix(),
+		func(userID int64) error {
+			var err error
+			accessToken, roles, err = s.issueAccessToken(ctx, userID)
+			return err
+		})
+	switch {
+	case errors.Is(err, domain.ErrRefreshTokenReused):
+		// Погашенный токен предъявляет либо тот, кто его украл, либо владелец после того, как токен уже
+		// использовал похититель. Различить их нельзя, поэтому завершается вся цепочка сессии
+		s.log.Warn("security event: refresh token reuse detected, token family revoked",
+			slog.String("event", "refresh_token_reuse"),
+			slog.Int64("user_id", rotated.UserID),
+			slog.Int64("family_id", rotated.FamilyID),
+		)
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	case errors.Is(err, domain.ErrRefreshTokenInvalid):
+		return nil, status.Error(codes.Unauthenticated, err.Error())
+	case err != nil:
+		s.log.Error("failed to refresh session", slog.Int64("user_id", rotated.UserID), slogger.Err(err))
+		return nil, status.Errorf(codes.Internal, "failed to refresh session: %v", err)
+	}
+	return &authpb.RefreshSessionResponse{
+		UserId:       rotated.UserID,
+		AccessToken:  accessToken,
+		RefreshToken: newToken.RefreshToken,
+		Roles:        roles,
+	}, nil
+}
+
+// GetJWKS отдает открытые ключи набора, чтобы шлюз и другие сервисы проверяли access токены сами
+func (s *AuthGRPCService) GetJWKS(ctx context.Context, req *emptypb.Empty) (*authpb.GetJWKSResponse, error) {
+	return utils.ConvertJWKSToProto(s.keyset.JWKS()), nil
+}
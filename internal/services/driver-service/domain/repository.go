@@ -2,11 +2,46 @@ package domain
 
 import (
 	"context"
+	"errors"
+	kfk "logistics/internal/kafka"
 	"logistics/internal/shared/entity"
 )
 
+// ErrDriverNotAvailable - водителя уже занял другой заказ
+var ErrDriverNotAvailable = errors.New("driver is not available")
+
+var (
+	// ErrDriverNotFound - водителя с таким ID нет
+	ErrDriverNotFound = errors.New("driver not found")
+	// ErrLocationUnknown - водитель еще не сообщал свою позицию
+	ErrLocationUnknown = errors.New("driver location unknown")
+	// ErrDuplicatePhone - телефон уже закреплен за другим водителем
+	ErrDuplicatePhone = errors.New("phone is already used by another driver")
+	// ErrDuplicateLicense - номер удостоверения уже закреплен за другим водителем
+	ErrDuplicateLicense = errors.New("license number is already used by another driver")
+	// ErrDriverBusy - водитель выполняет заказ
+	ErrDriverBusy = errors.New("driver is on a delivery")
+)
+
+// DriverFilter - условия выборки списка водителей
+type DriverFilter struct {
+	Status          entity.DriverStatus
+	IncludeInactive bool
+	Limit           int
+	Offset          int
+}
+
 type DriverRepositoryInterface interface {
 	// FindSuitableDriver(location string) ([]*entity.Driver, error)
 	GetAvailableDrivers(ctx context.Context) ([]*entity.Driver, error)
 	UpdateDriverStatus(ctx context.Context, driverID int, status string) error
+	ReserveDriver(ctx context.Context, driverID, reservedAt int64, events ...kfk.OutboxMessage) error
+	ReleaseDriver(ctx context.Context, driverID, reservedAt int64) (bool, error)
+	SaveLocation(ctx context.Context, location entity.DriverLocation, historyLimit int) (bool, error)
+	GetDriverLocation(ctx context.Context, driverID int64) (*entity.DriverLocation, error)
+	CreateDriver(ctx context.Context, driver *entity.Driver) (*entity.Driver, error)
+	GetDriver(ctx context.Context, driverID int64) (*entity.Driver, error)
+	ListDrivers(ctx context.Context, filter DriverFilter) ([]*entity.Driver, int64, error)
+	UpdateDriver(ctx context.Context, driver *entity.Driver) (*entity.Driver, error)
+	DeactivateDriver(ctx context.Context, driverID int64) (*entity.Driver, error)
 }
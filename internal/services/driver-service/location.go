@@ -0,0 +1,158 @@
+package driverservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"log/slog"
+	driverpb "logistics/api/protobuf/driver_service"
+	"logistics/internal/services/driver-service/domain"
+	"logistics/internal/shared/entity"
+	rediscache "logistics/pkg/cache/redis"
+	"logistics/pkg/lib/logger/slogger"
+	"logistics/pkg/lib/utils"
+	"time"
+
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// locationHistoryLimit - сколько последних замеров храним в Postgres на водителя
+	locationHistoryLimit = 500
+	// locationCacheTTL - время жизни текущей позиции в Redis; после него читаем из Postgres
+	locationCacheTTL = time.Hour
+)
+
+func driverLocationKey(driverID int64) string {
+	return fmt.Sprintf("driver:%d_location", driverID)
+}
+
+func (d *DriverGRPCService) ReportLocation(ctx context.Context, req *driverpb.ReportLocationRequest) (*driverpb.ReportLocationResponse, error) {
+	accepted, err := d.saveLocation(ctx, req)
+	if err != nil {
+		return nil, err
+	}
+	return &driverpb.ReportLocationResponse{Accepted: accepted}, nil
+}
+
+// StreamLocation принимает поток замеров от приложения водителя; ошибочный замер прерывает поток
+func (d *DriverGRPCService) StreamLocation(stream grpc.ClientStreamingServer[driverpb.ReportLocationRequest, driverpb.StreamLocationResponse]) error {
+	resp := &driverpb.StreamLocationResponse{}
+	for {
+		req, err := stream.Recv()
+		if errors.Is(err, io.EOF) {
+			return stream.SendAndClose(resp)
+		}
+		if err != nil {
+			return err
+		}
+		resp.Received++
+		accepted, err := d.saveLocation(stream.Context(), req)
+		if err != nil {
+			return err
+		}
+		if accepted {
+			resp.Accepted++
+		}
+	}
+}
+
+func (d *DriverGRPCService) GetDriverLocation(ctx context.Context, req *driverpb.GetDriverLocationRequest) (*driverpb.GetDriverLocationResponse, error) {
+	if req.DriverId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "driver_id is required")
+	}
+
+	locationJSON, err := d.redisClient.Get(ctx, driverLocationKey(req.DriverId)).Result()
+	if err != nil && err != redis.Nil {
+		d.logger.Error("failed to get driver location from redis", slogger.Err(err))
+	} else if err == nil {
+		var location entity.DriverLocation
+		if err := json.Unmarshal([]byte(locationJSON), &location); err != nil {
+			d.logger.Error("failed to unmarshal driver location from redis", slogger.Err(err))
+		} else {
+			return utils.ConvertDriverLocationToProto(&location), nil
+		}
+	}
+
+	location, err := d.driverRepo.GetDriverLocation(ctx, req.DriverId)
+	if errors.Is(err, domain.ErrDriverNotFound) || errors.Is(err, domain.ErrLocationUnknown) {
+		return nil, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		d.logger.Error("failed to get driver location", slog.Int64("driver_id", req.DriverId), slogger.Err(err))
+		return nil, status.Errorf(codes.Internal, "failed to get driver location: %v", err)
+	}
+	d.cacheLocation(ctx, location)
+	return utils.ConvertDriverLocationToProto(location), nil
+}
+
+// saveLocation проверяет замер, сохраняет его в Postgres и обновляет текущую позицию в Redis
+func (d *DriverGRPCService) saveLocation(ctx context.Context, req *driverpb.ReportLocationRequest) (bool, error) {
+	if req.DriverId <= 0 {
+		return false, status.Error(codes.InvalidArgument, "driver_id is required")
+	}
+	if req.Location == nil {
+		return false, status.Error(codes.InvalidArgument, "location is required")
+	}
+	if req.Location.Latitude < -90 || req.Location.Latitude > 90 || req.Location.Longitude < -180 || req.Location.Longitude > 180 {
+		return false, status.Errorf(codes.InvalidArgument, "invalid coordinates %f, %f", req.Location.Latitude, req.Location.Longitude)
+	}
+
+	now := time.Now().Unix()
+	recordedAt := req.RecordedAt
+	if recordedAt == 0 {
+		recordedAt = now
+	}
+	// Часы устройства могут спешить: замер из будущего закрепил бы позицию навсегда
+	if recordedAt > now {
+		recordedAt = now
+	}
+
+	location := entity.DriverLocation{
+		DriverID:   req.DriverId,
+		Location:   entity.Location{Latitude: req.Location.Latitude, Longitude: req.Location.Longitude},
+		RecordedAt: recordedAt,
+	}
+	latest, err := d.driverRepo.SaveLocation(ctx, location, locationHistoryLimit)
+	if errors.Is(err, domain.ErrDriverNotFound) {
+		return false, status.Error(codes.NotFound, err.Error())
+	}
+	if err != nil {
+		d.logger.Error("failed to save driver location", slog.Int64("driver_id", req.DriverId), slogger.Err(err))
+		return false, status.Errorf(codes.Internal, "failed to save driver location: %v", err)
+	}
+	if latest {
+		d.cacheLocation(ctx, &location)
+		d.publishLocation(ctx, &location)
+	}
+	return latest, nil
+}
+
+// cacheLocation кладет позицию в Redis; при ошибке позиция останется доступна из Postgres
+func (d *DriverGRPCService) cacheLocation(ctx context.Context, location *entity.DriverLocation) {
+	locationJSON, err := json.Marshal(location)
+	if err != nil {
+		d.logger.Error("failed to marshal driver location", slogger.Err(err))
+		return
+	}
+	if err := d.redisClient.Set(ctx, driverLocationKey(location.DriverID), locationJSON, locationCacheTTL).Err(); err != nil {
+		d.logger.Error("failed to cache driver location", slog.Int64("driver_id", location.DriverID), slogger.Err(err))
+	}
+}
+
+// publishLocation рассылает новую позицию тем, кто отслеживает заказ водителя
+func (d *DriverGRPCService) publishLocation(ctx context.Context, location *entity.DriverLocation) {
+	locationJSON, err := json.Marshal(location)
+	if err != nil {
+		d.logger.Error("failed to marshal driver location", slogger.Err(err))
+		return
+	}
+	if err := d.redisClient.Publish(ctx, rediscache.DriverLocationChannel(location.DriverID), locationJSON).Err(); err != nil {
+		d.logger.Error("failed to publish driver location", slog.Int64("driver_id", location.DriverID), slogger.Err(err))
+	}
+}
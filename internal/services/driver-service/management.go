@@ -0,0 +1,174 @@
+package driverservice
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	driverpb "logistics/api/protobuf/driver_service"
+	"logistics/internal/services/driver-service/domain"
+	"logistics/internal/shared/entity"
+	"logistics/pkg/lib/logger/slogger"
+	"logistics/pkg/lib/utils"
+	"net/mail"
+	"regexp"
+	"strings"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultDriversPageSize = 20
+	maxDriversPageSize     = 100
+)
+
+var (
+	// phonePattern - международный формат: необязательный +, затем 10-15 цифр
+	phonePattern = regexp.MustCompile(`^\+?[0-9]{10,15}$`)
+	// licensePattern - латинские буквы и цифры, как в серии и номере удостоверения
+	licensePattern = regexp.MustCompile(`^[A-Z0-9]{6,20}$`)
+)
+
+func (d *DriverGRPCService) CreateDriver(ctx context.Context, req *driverpb.CreateDriverRequest) (*driverpb.CreateDriverResponse, error) {
+	driver, err := validateDriverProfile(req.Name, req.Phone, req.Email, req.LicenseNumber, req.Car)
+	if err != nil {
+		return nil, err
+	}
+	driver.Status = entity.DriverStatusOffline
+	if req.Status != "" {
+		driver.Status = entity.DriverStatus(req.Status)
+	}
+	// Новый водитель не может сразу оказаться на заказе
+	if !driver.Status.IsValid() || driver.Status == entity.DriverStatusBusy {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid initial status %q", req.Status)
+	}
+
+	created, err := d.driverRepo.CreateDriver(ctx, driver)
+	if err != nil {
+		return nil, d.driverError("failed to create driver", err)
+	}
+	d.logger.Info("driver created", slog.Int64("driver_id", created.ID))
+	return &driverpb.CreateDriverResponse{Driver: utils.ConvertDriverToProto(created)}, nil
+}
+
+func (d *DriverGRPCService) GetDriver(ctx context.Context, req *driverpb.GetDriverRequest) (*driverpb.GetDriverResponse, error) {
+	if req.DriverId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "driver_id is required")
+	}
+	driver, err := d.driverRepo.GetDriver(ctx, req.DriverId)
+	if err != nil {
+		return nil, d.driverError("failed to get driver", err)
+	}
+	return &driverpb.GetDriverResponse{Driver: utils.ConvertDriverToProto(driver)}, nil
+}
+
+func (d *DriverGRPCService) ListDrivers(ctx context.Context, req *driverpb.ListDriversRequest) (*driverpb.ListDriversResponse, error) {
+	filter := domain.DriverFilter{
+		Status:          entity.DriverStatus(req.Status),
+		IncludeInactive: req.IncludeInactive,
+	}
+	if filter.Status != "" && !filter.Status.IsValid() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid status %q", req.Status)
+	}
+
+	page, pageSize := req.Page, req.PageSize
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = defaultDriversPageSize
+	}
+	if pageSize > maxDriversPageSize {
+		pageSize = maxDriversPageSize
+	}
+	filter.Limit = int(pageSize)
+	filter.Offset = int(page-1) * int(pageSize)
+
+	drivers, total, err := d.driverRepo.ListDrivers(ctx, filter)
+	if err != nil {
+		return nil, d.driverError("failed to list drivers", err)
+	}
+	resp := &driverpb.ListDriversResponse{
+		Drivers:  make([]*driverpb.Driver, 0, len(drivers)),
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	}
+	for _, driver := range drivers {
+		resp.Drivers = append(resp.Drivers, utils.ConvertDriverToProto(driver))
+	}
+	return resp, nil
+}
+
+func (d *DriverGRPCService) UpdateDriver(ctx context.Context, req *driverpb.UpdateDriverRequest) (*driverpb.UpdateDriverResponse, error) {
+	if req.DriverId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "driver_id is required")
+	}
+	driver, err := validateDriverProfile(req.Name, req.Phone, req.Email, req.LicenseNumber, req.Car)
+	if err != nil {
+		return nil, err
+	}
+	driver.ID = req.DriverId
+
+	updated, err := d.driverRepo.UpdateDriver(ctx, driver)
+	if err != nil {
+		return nil, d.driverError("failed to update driver", err)
+	}
+	d.logger.Info("driver updated", slog.Int64("driver_id", updated.ID))
+	return &driverpb.UpdateDriverResponse{Driver: utils.ConvertDriverToProto(updated)}, nil
+}
+
+func (d *DriverGRPCService) DeactivateDriver(ctx context.Context, req *driverpb.DeactivateDriverRequest) (*driverpb.DeactivateDriverResponse, error) {
+	if req.DriverId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "driver_id is required")
+	}
+	driver, err := d.driverRepo.DeactivateDriver(ctx, req.DriverId)
+	if err != nil {
+		return nil, d.driverError("failed to deactivate driver", err)
+	}
+	d.logger.Info("driver deactivated", slog.Int64("driver_id", driver.ID))
+	return &driverpb.DeactivateDriverResponse{Driver: utils.ConvertDriverToProto(driver)}, nil
+}
+
+// validateDriverProfile проверяет и нормализует анкетные данные водителя
+func validateDriverProfile(name, phone, email, licenseNumber, car string) (*entity.Driver, error) {
+	driver := &entity.Driver{
+		Name:          strings.TrimSpace(name),
+		Phone:         strings.ReplaceAll(strings.TrimSpace(phone), " ", ""),
+		Email:         strings.TrimSpace(email),
+		LicenseNumber: strings.ToUpper(strings.ReplaceAll(strings.TrimSpace(licenseNumber), " ", "")),
+		Car:           strings.TrimSpace(car),
+	}
+	if driver.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if !phonePattern.MatchString(driver.Phone) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid phone %q", phone)
+	}
+	if driver.Email != "" {
+		if _, err := mail.ParseAddress(driver.Email); err != nil {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid email %q", email)
+		}
+	}
+	if !licensePattern.MatchString(driver.LicenseNumber) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid license_number %q", licenseNumber)
+	}
+	if driver.Car == "" {
+		return nil, status.Error(codes.InvalidArgument, "car is required")
+	}
+	return driver, nil
+}
+
+// driverError переводит ошибку репозитория в gRPC-статус
+func (d *DriverGRPCService) driverError(msg string, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrDriverNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrDuplicatePhone), errors.Is(err, domain.ErrDuplicateLicense):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case errors.Is(err, domain.ErrDriverBusy):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	d.logger.Error(msg, slog.String("status", "error"), slogger.Err(err))
+	return status.Errorf(codes.Internal, "%s: %v", msg, err)
+}
@@ -0,0 +1,84 @@
+package matching
+
+import (
+	"fmt"
+	"math/rand"
+	"sort"
+
+	"logistics/internal/shared/entity"
+	"logistics/pkg/lib/utils"
+)
+
+// Названия стратегий подбора водителя
+const (
+	StrategyRandom            = "random"
+	StrategyNearest           = "nearest"
+	StrategyLeastRecentlyUsed = "least_recently_used"
+)
+
+// Strategy упорядочивает свободных водителей: первый в списке - лучший кандидат.
+// Следующие нужны, если первого успел занять параллельный запрос.
+type Strategy interface {
+	Rank(target *entity.Location, drivers []*entity.Driver) []*entity.Driver
+}
+
+// NewStrategy возвращает стратегию по названию из конфигурации
+func NewStrategy(name string) (Strategy, error) {
+	switch name {
+	case StrategyRandom:
+		return RandomStrategy{}, nil
+	case StrategyNearest, "":
+		return NearestStrategy{fallback: LeastRecentlyUsedStrategy{}}, nil
+	case StrategyLeastRecentlyUsed:
+		return LeastRecentlyUsedStrategy{}, nil
+	default:
+		return nil, fmt.Errorf("unknown matching strategy %q", name)
+	}
+}
+
+// RandomStrategy - случайный порядок
+type RandomStrategy struct{}
+
+func (RandomStrategy) Rank(_ *entity.Location, drivers []*entity.Driver) []*entity.Driver {
+	ranked := clone(drivers)
+	rand.Shuffle(len(ranked), func(i, j int) { ranked[i], ranked[j] = ranked[j], ranked[i] })
+	return ranked
+}
+
+// NearestStrategy - ближайшие к точке по расстоянию большого круга; водители без координат идут в конце.
+// Без целевой точки порядок определяет fallback.
+type NearestStrategy struct {
+	fallback Strategy
+}
+
+func (s NearestStrategy) Rank(target *entity.Location, drivers []*entity.Driver) []*entity.Driver {
+	if target == nil {
+		return s.fallback.Rank(nil, drivers)
+	}
+	ranked := clone(drivers)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		a, b := ranked[i].Location, ranked[j].Location
+		if a == nil || b == nil {
+			return a != nil
+		}
+		return utils.DistanceKm(*target, *a) < utils.DistanceKm(*target, *b)
+	})
+	return ranked
+}
+
+// LeastRecentlyUsedStrategy - дольше всех не получавшие заказ идут первыми
+type LeastRecentlyUsedStrategy struct{}
+
+func (LeastRecentlyUsedStrategy) Rank(_ *entity.Location, drivers []*entity.Driver) []*entity.Driver {
+	ranked := clone(drivers)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		return ranked[i].LastAssignedAt < ranked[j].LastAssignedAt
+	})
+	return ranked
+}
+
+func clone(drivers []*entity.Driver) []*entity.Driver {
+	ranked := make([]*entity.Driver, len(drivers))
+	copy(ranked, drivers)
+	return ranked
+}
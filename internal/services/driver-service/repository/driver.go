@@ -0,0 +1,146 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"logistics/internal/services/driver-service/domain"
+	"logistics/internal/shared/entity"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const driverColumns = `id, name, phone, COALESCE(email, ''), license_number, car, status, is_active, latitude, longitude, location_updated_at, last_assigned_at`
+
+// scanDriver читает строку, выбранную по driverColumns
+func scanDriver(row pgx.Row) (*entity.Driver, error) {
+	var driver entity.Driver
+	var latitude, longitude *float64
+	err := row.Scan(
+		&driver.ID,
+		&driver.Name,
+		&driver.Phone,
+		&driver.Email,
+		&driver.LicenseNumber,
+		&driver.Car,
+		&driver.Status,
+		&driver.Active,
+		&latitude,
+		&longitude,
+		&driver.LocationUpdatedAt,
+		&driver.LastAssignedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if latitude != nil && longitude != nil {
+		driver.Location = &entity.Location{Latitude: *latitude, Longitude: *longitude}
+	}
+	return &driver, nil
+}
+
+// uniqueViolation переводит нарушение уникальности телефона или удостоверения в доменную ошибку
+func uniqueViolation(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) || pgErr.Code != "23505" {
+		return nil
+	}
+	switch pgErr.ConstraintName {
+	case "drivers_phone_key":
+		return domain.ErrDuplicatePhone
+	case "drivers_license_number_key":
+		return domain.ErrDuplicateLicense
+	}
+	return nil
+}
+
+func (d *DriverRepository) CreateDriver(ctx context.Context, driver *entity.Driver) (*entity.Driver, error) {
+	query := `INSERT INTO drivers (name, phone, email, license_number, car, status) VALUES ($1, $2, NULLIF($3, ''), $4, $5, $6)
+		RETURNING ` + driverColumns
+	created, err := scanDriver(d.pool.QueryRow(ctx, query, driver.Name, driver.Phone, driver.Email, driver.LicenseNumber, driver.Car, driver.Status))
+	if err != nil {
+		if domainErr := uniqueViolation(err); domainErr != nil {
+			return nil, domainErr
+		}
+		return nil, fmt.Errorf("failed to create driver: %w", err)
+	}
+	return created, nil
+}
+
+func (d *DriverRepository) GetDriver(ctx context.Context, driverID int64) (*entity.Driver, error) {
+	query := `SELECT ` + driverColumns + ` FROM drivers WHERE id = $1`
+	driver, err := scanDriver(d.pool.QueryRow(ctx, query, driverID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("driver %d: %w", driverID, domain.ErrDriverNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get driver %d: %w", driverID, err)
+	}
+	return driver, nil
+}
+
+// ListDrivers возвращает страницу водителей и общее число подходящих под фильтр
+func (d *DriverRepository) ListDrivers(ctx context.Context, filter domain.DriverFilter) ([]*entity.Driver, int64, error) {
+	where := `($1 = '' OR status = $1) AND ($2 OR is_active)`
+
+	var total int64
+	err := d.pool.QueryRow(ctx, `SELECT COUNT(*) FROM drivers WHERE `+where, string(filter.Status), filter.IncludeInactive).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count drivers: %w", err)
+	}
+
+	query := `SELECT ` + driverColumns + ` FROM drivers WHERE ` + where + ` ORDER BY id LIMIT $3 OFFSET $4`
+	rows, err := d.pool.Query(ctx, query, string(filter.Status), filter.IncludeInactive, filter.Limit, filter.Offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query drivers: %w", err)
+	}
+	defer rows.Close()
+
+	drivers := make([]*entity.Driver, 0, filter.Limit)
+	for rows.Next() {
+		driver, err := scanDriver(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan driver row: %w", err)
+		}
+		drivers = append(drivers, driver)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating through driver rows: %w", err)
+	}
+	return drivers, total, nil
+}
+
+func (d *DriverRepository) UpdateDriver(ctx context.Context, driver *entity.Driver) (*entity.Driver, error) {
+	query := `UPDATE drivers SET name = $1, phone = $2, email = NULLIF($3, ''), license_number = $4, car = $5 WHERE id = $6
+		RETURNING ` + driverColumns
+	updated, err := scanDriver(d.pool.QueryRow(ctx, query, driver.Name, driver.Phone, driver.Email, driver.LicenseNumber, driver.Car, driver.ID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("driver %d: %w", driver.ID, domain.ErrDriverNotFound)
+	}
+	if err != nil {
+		if domainErr := uniqueViolation(err); domainErr != nil {
+			return nil, domainErr
+		}
+		return nil, fmt.Errorf("failed to update driver %d: %w", driver.ID, err)
+	}
+	return updated, nil
+}
+
+// DeactivateDriver снимает водителя с линии; водителя на заказе деактивировать нельзя
+func (d *DriverRepository) DeactivateDriver(ctx context.Context, driverID int64) (*entity.Driver, error) {
+	query := `UPDATE drivers SET is_active = FALSE, status = $1 WHERE id = $2 AND status <> $3
+		RETURNING ` + driverColumns
+	driver, err := scanDriver(d.pool.QueryRow(ctx, query, entity.DriverStatusOffline, driverID, entity.DriverStatusBusy))
+	if errors.Is(err, pgx.ErrNoRows) {
+		// Отличаем отсутствующего водителя от занятого
+		if _, getErr := d.GetDriver(ctx, driverID); getErr != nil {
+			return nil, getErr
+		}
+		return nil, fmt.Errorf("driver %d: %w", driverID, domain.ErrDriverBusy)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to deactivate driver %d: %w", driverID, err)
+	}
+	return driver, nil
+}
@@ -0,0 +1,78 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"logistics/internal/services/driver-service/domain"
+	"logistics/internal/shared/entity"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// SaveLocation пишет замер в историю водителя и обновляет его текущую позицию.
+// Возвращает false, если замер старше уже сохраненной позиции: такой замер попадает только в историю.
+// В истории остаются последние historyLimit замеров.
+func (d *DriverRepository) SaveLocation(ctx context.Context, location entity.DriverLocation, historyLimit int) (bool, error) {
+	tx, err := d.pool.Begin(ctx)
+	if err != nil {
+		return false, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Блокируем строку водителя, чтобы параллельные замеры не перепутали порядок
+	var updatedAt int64
+	err = tx.QueryRow(ctx, `SELECT location_updated_at FROM drivers WHERE id = $1 FOR UPDATE`, location.DriverID).Scan(&updatedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return false, fmt.Errorf("driver %d: %w", location.DriverID, domain.ErrDriverNotFound)
+	}
+	if err != nil {
+		return false, fmt.Errorf("failed to lock driver %d: %w", location.DriverID, err)
+	}
+
+	latest := location.RecordedAt >= updatedAt
+	if latest {
+		query := `UPDATE drivers SET latitude = $1, longitude = $2, location_updated_at = $3 WHERE id = $4`
+		_, err = tx.Exec(ctx, query, location.Location.Latitude, location.Location.Longitude, location.RecordedAt, location.DriverID)
+		if err != nil {
+			return false, fmt.Errorf("failed to update location of driver %d: %w", location.DriverID, err)
+		}
+	}
+
+	insertQuery := `INSERT INTO driver_location_history (driver_id, latitude, longitude, recorded_at) VALUES ($1, $2, $3, $4)`
+	_, err = tx.Exec(ctx, insertQuery, location.DriverID, location.Location.Latitude, location.Location.Longitude, location.RecordedAt)
+	if err != nil {
+		return false, fmt.Errorf("failed to insert location history of driver %d: %w", location.DriverID, err)
+	}
+
+	trimQuery := `DELETE FROM driver_location_history WHERE driver_id = $1 AND id NOT IN (
+		SELECT id FROM driver_location_history WHERE driver_id = $1 ORDER BY recorded_at DESC, id DESC LIMIT $2)`
+	if _, err := tx.Exec(ctx, trimQuery, location.DriverID, historyLimit); err != nil {
+		return false, fmt.Errorf("failed to trim location history of driver %d: %w", location.DriverID, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return false, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return latest, nil
+}
+
+// GetDriverLocation возвращает последнюю сохраненную позицию водителя
+func (d *DriverRepository) GetDriverLocation(ctx context.Context, driverID int64) (*entity.DriverLocation, error) {
+	query := `SELECT latitude, longitude, location_updated_at FROM drivers WHERE id = $1`
+
+	var latitude, longitude *float64
+	location := &entity.DriverLocation{DriverID: driverID}
+	err := d.pool.QueryRow(ctx, query, driverID).Scan(&latitude, &longitude, &location.RecordedAt)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("driver %d: %w", driverID, domain.ErrDriverNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get location of driver %d: %w", driverID, err)
+	}
+	if latitude == nil || longitude == nil {
+		return nil, fmt.Errorf("driver %d: %w", driverID, domain.ErrLocationUnknown)
+	}
+	location.Location = entity.Location{Latitude: *latitude, Longitude: *longitude}
+	return location, nil
+}
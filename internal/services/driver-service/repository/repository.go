@@ -3,6 +3,8 @@ package repository
 import (
 	"context"
 	"fmt"
+	kfk "logistics/internal/kafka"
+	"logistics/internal/services/driver-service/domain"
 	"logistics/internal/shared/entity"
 
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -19,7 +21,7 @@ func NewDriverRepository(pool *pgxpool.Pool) *DriverRepository {
 }
 
 func (d *DriverRepository) GetAvailableDrivers(ctx context.Context) ([]*entity.Driver, error) {
-	query := `SELECT id, name, phone, license_number, car, status FROM drivers WHERE status = 'available' ORDER BY id`
+	query := `SELECT ` + driverColumns + ` FROM drivers WHERE status = 'available' AND is_active ORDER BY id`
 	rows, err := d.pool.Query(ctx, query)
 	if err != nil {
 		return nil, fmt.Errorf("failed to query available drivers: %w", err)
@@ -28,19 +30,11 @@ func (d *DriverRepository) GetAvailableDrivers(ctx context.Context) ([]*entity.D
 
 	var drivers []*entity.Driver
 	for rows.Next() {
-		var driver entity.Driver
-		err := rows.Scan(
-			&driver.ID,
-			&driver.Name,
-			&driver.Phone,
-			&driver.LicenseNumber,
-			&driver.Car,
-			&driver.Status,
-		)
+		driver, err := scanDriver(rows)
 		if err != nil {
 			return nil, fmt.Errorf("failed to scan driver row: %w", err)
 		}
-		drivers = append(drivers, &driver)
+		drivers = append(drivers, driver)
 	}
 
 	if err := rows.Err(); err != nil {
@@ -58,3 +52,43 @@ func (d *DriverRepository) UpdateDriverStatus(ctx context.Context, driverID int,
 	}
 	return nil
 }
+
+// ReleaseDriver возвращает занятого водителя в available. С reservedAt освобождается только резерв,
+// сделанный в это время: запоздалый повтор не снимет более новый резерв того же водителя
+func (d *DriverRepository) ReleaseDriver(ctx context.Context, driverID, reservedAt int64) (bool, error) {
+	query := `UPDATE drivers SET status = $1 WHERE id = $2 AND status = $3 AND ($4 = 0 OR last_assigned_at = $4)`
+	tag, err := d.pool.Exec(ctx, query, entity.DriverStatusAvailable, driverID, entity.DriverStatusBusy, reservedAt)
+	if err != nil {
+		return false, fmt.Errorf("failed to release driver %d: %w", driverID, err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+// ReserveDriver переводит свободного водителя в busy и кладет события в outbox одной транзакцией
+func (d *DriverRepository) ReserveDriver(ctx context.Context, driverID, reservedAt int64, events ...kfk.OutboxMessage) error {
+	tx, err := d.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `UPDATE drivers SET status = $1, last_assigned_at = $2 WHERE id = $3 AND status = $4 AND is_active`
+	tag, err := tx.Exec(ctx, query, entity.DriverStatusBusy, reservedAt, driverID, entity.DriverStatusAvailable)
+	if err != nil {
+		return fmt.Errorf("failed to reserve driver %d: %w", driverID, err)
+	}
+	if tag.RowsAffected() == 0 {
+		return fmt.Errorf("driver %d: %w", driverID, domain.ErrDriverNotAvailable)
+	}
+
+	for _, event := range events {
+		if err := kfk.EnqueueOutbox(ctx, tx, kfk.DriverOutboxTable, event); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
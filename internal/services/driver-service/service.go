@@ -3,18 +3,19 @@ package driverservice
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"log/slog"
 	driverpb "logistics/api/protobuf/driver_service"
 	kfk "logistics/internal/kafka"
 	"logistics/internal/services/driver-service/domain"
+	"logistics/internal/services/driver-service/matching"
 	"logistics/internal/shared/entity"
 	"logistics/pkg/lib/logger/slogger"
-	"math/rand"
+	"logistics/pkg/lib/utils"
 	"strconv"
 	"time"
 
-	"github.com/segmentio/kafka-go"
-
+	"github.com/redis/go-redis/v9"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/codes"
 	"google.golang.org/grpc/status"
@@ -23,16 +24,21 @@ import (
 
 type DriverGRPCService struct {
 	driverpb.UnimplementedDriverServiceServer
-	driverRepo    domain.DriverRepositoryInterface
-	logger        *slog.Logger
-	kafkaProducer *kfk.KafkaProducer
+	driverRepo domain.DriverRepositoryInterface
+	logger     *slog.Logger
+	strategy   matching.Strategy
+	// pickup - точка склада, используется, если у заказа нет координат доставки
+	pickup      *entity.Location
+	redisClient *redis.Client
 }
 
-func NewDriverGRPCService(logger *slog.Logger, driverRepo domain.DriverRepositoryInterface, kafkaProducer *kfk.KafkaProducer) *DriverGRPCService {
+func NewDriverGRPCService(logger *slog.Logger, driverRepo domain.DriverRepositoryInterface, strategy matching.Strategy, pickup *entity.Location, redisClient *redis.Client) *DriverGRPCService {
 	return &DriverGRPCService{
-		driverRepo:    driverRepo,
-		logger:        logger,
-		kafkaProducer: kafkaProducer,
+		driverRepo:  driverRepo,
+		logger:      logger,
+		strategy:    strategy,
+		pickup:      pickup,
+		redisClient: redisClient,
 	}
 }
 
@@ -41,7 +47,11 @@ func RegisterDriverServiceServer(s *grpc.Server, srv *DriverGRPCService) {
 }
 
 func (d *DriverGRPCService) FindSuitableDriver(ctx context.Context, req *driverpb.FindDriverRequest) (*driverpb.FindDriverResponse, error) {
-	availableDriversResp, err := d.GetAvailableDrivers(ctx, &emptypb.Empty{})
+	// Без заказа order-service не сможет сопоставить ответ с ожидающим запросом
+	if req.OrderId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "order_id is required")
+	}
+	availableDrivers, err := d.driverRepo.GetAvailableDrivers(ctx)
 	if err != nil {
 		d.logger.Error("failed to get available drivers for finding suitable driver",
 			slog.String("status", "error"), slogger.Err(err))
@@ -49,7 +59,7 @@ func (d *DriverGRPCService) FindSuitableDriver(ctx context.Context, req *driverp
 	}
 
 	// Проверяем, есть ли доступные водители
-	if len(availableDriversResp.Drivers) == 0 {
+	if len(availableDrivers) == 0 {
 		d.logger.Warn("no available drivers found", slog.String("status", "warning"))
 		return &driverpb.FindDriverResponse{
 			Driver:  nil,
@@ -58,42 +68,79 @@ func (d *DriverGRPCService) FindSuitableDriver(ctx context.Context, req *driverp
 		}, nil
 	}
 
-	// Выбираем случайного водителя из списка
-	rand.NewSource(time.Now().UnixNano())
-	randomIndex := rand.Intn(len(availableDriversResp.Drivers))
-	selectedDriver := availableDriversResp.Drivers[randomIndex]
-
-	d.logger.Info("suitable driver found",
-		slog.String("driver_id", strconv.Itoa(int(selectedDriver.DriverId))),
-		slog.String("driver_name", selectedDriver.Name))
+	target := d.pickup
+	if req.Target != nil {
+		target = &entity.Location{Latitude: req.Target.Latitude, Longitude: req.Target.Longitude}
+	}
 
-	msg := entity.DriverKafka{
-		ID:            selectedDriver.DriverId,
-		Name:          selectedDriver.Name,
-		Phone:         selectedDriver.Phone,
-		LicenseNumber: selectedDriver.Vehicle.LicensePlate,
-		Car:           selectedDriver.Vehicle.Model,
+	// Перебираем водителей в порядке стратегии: лучшего мог занять параллельный запрос
+	drivers := d.strategy.Rank(target, availableDrivers)
+
+	var selectedDriver *entity.Driver
+	var reservedAt int64
+	for _, candidate := range drivers {
+		msg := entity.Msg{
+			OrderId: req.OrderId,
+			Driver: entity.DriverKafka{
+				ID:            candidate.ID,
+				Name:          candidate.Name,
+				Phone:         candidate.Phone,
+				LicenseNumber: candidate.LicenseNumber,
+				Car:           candidate.Car,
+			},
+			Timestamp: time.Now().Unix(),
+		}
+
+		messageBytes, err := json.Marshal(msg)
+		if err != nil {
+			d.logger.Error("Failed to marshal data", "error", err.Error())
+			return &driverpb.FindDriverResponse{}, err
+		}
+
+		// Сообщение уйдет в Kafka через outbox после фиксации резерва водителя
+		err = d.driverRepo.ReserveDriver(ctx, candidate.ID, msg.Timestamp, kfk.OutboxMessage{
+			EventType: kfk.EventDriverFound,
+			Key:       strconv.FormatInt(req.OrderId, 10),
+			Payload:   messageBytes,
+			CreatedAt: msg.Timestamp,
+		})
+		if errors.Is(err, domain.ErrDriverNotAvailable) {
+			continue
+		}
+		if err != nil {
+			d.logger.Error("Failed to reserve driver", slog.Int64("driver_id", candidate.ID), slogger.Err(err))
+			return &driverpb.FindDriverResponse{}, err
+		}
+		selectedDriver = candidate
+		reservedAt = msg.Timestamp
+		break
 	}
 
-	messageBytes, err := json.Marshal(msg)
-	if err != nil {
-		d.logger.Error("Failed to marshal data", "error", err.Error())
-		return &driverpb.FindDriverResponse{}, err
+	if selectedDriver == nil {
+		d.logger.Warn("all available drivers were taken", slog.String("status", "warning"))
+		return &driverpb.FindDriverResponse{
+			Driver:  nil,
+			Success: false,
+			Message: "No available drivers found",
+		}, nil
 	}
-	d.logger.Info("Sending Kafka message", slog.String("message", string(messageBytes)))
 
-	err = d.kafkaProducer.SendMessage(ctx, kafka.Message{
-		Value: messageBytes,
-	})
-	if err != nil {
-		d.logger.Error("Failed to send message - Kafka", "error", err.Error())
-		return &driverpb.FindDriverResponse{}, err
+	var distanceKm float64
+	if target != nil && selectedDriver.Location != nil {
+		distanceKm = utils.DistanceKm(*target, *selectedDriver.Location)
 	}
 
+	d.logger.Info("suitable driver found",
+		slog.String("driver_id", strconv.Itoa(int(selectedDriver.ID))),
+		slog.String("driver_name", selectedDriver.Name),
+		slog.Float64("distance_km", distanceKm))
+
 	return &driverpb.FindDriverResponse{
-		Driver:  selectedDriver,
-		Success: true,
-		Message: "Suitable driver found",
+		Driver:     utils.ConvertDriverToProto(selectedDriver),
+		Success:    true,
+		Message:    "Suitable driver found",
+		DistanceKm: distanceKm,
+		ReservedAt: reservedAt,
 	}, nil
 
 }
@@ -106,16 +153,7 @@ func (d *DriverGRPCService) GetAvailableDrivers(ctx context.Context, req *emptyp
 	}
 	drivers := make([]*driverpb.Driver, 0, len(res))
 	for _, driver := range res {
-		drivers = append(drivers, &driverpb.Driver{
-			DriverId: driver.ID,
-			Name:     driver.Name,
-			Phone:    driver.Phone,
-			Status:   string(driver.Status),
-			Vehicle: &driverpb.Vehicle{
-				Model:        driver.Car,
-				LicensePlate: driver.LicenseNumber,
-			},
-		})
+		drivers = append(drivers, utils.ConvertDriverToProto(driver))
 	}
 	return &driverpb.GetAvailableDriversResponse{
 		Drivers: drivers,
@@ -134,3 +172,36 @@ func (d *DriverGRPCService) UpdateDriverStatus(ctx context.Context, req *driverp
 		Success: true,
 	}, nil
 }
+
+func (d *DriverGRPCService) ReleaseDriver(ctx context.Context, req *driverpb.ReleaseDriverRequest) (*driverpb.ReleaseDriverResponse, error) {
+	if req.DriverId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "driver_id is required")
+	}
+	released, err := d.driverRepo.ReleaseDriver(ctx, req.DriverId, req.ReservedAt)
+	if err != nil {
+		d.logger.Error("failed to release driver", slog.Int64("driver_id", req.DriverId), slogger.Err(err))
+		return nil, status.Errorf(codes.Internal, "failed to release driver: %v", err)
+	}
+	if released {
+		d.logger.Info("driver released", slog.Int64("driver_id", req.DriverId))
+	}
+	return &driverpb.ReleaseDriverResponse{Released: released}, nil
+}
+
+// ReserveDriver занимает водителя под задачу вне заказов, например под перемещение между складами
+func (d *DriverGRPCService) ReserveDriver(ctx context.Context, req *driverpb.ReserveDriverRequest) (*driverpb.ReserveDriverResponse, error) {
+	if req.DriverId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "driver_id is required")
+	}
+	reservedAt := time.Now().Unix()
+	err := d.driverRepo.ReserveDriver(ctx, req.DriverId, reservedAt)
+	if errors.Is(err, domain.ErrDriverNotAvailable) {
+		return nil, status.Errorf(codes.FailedPrecondition, "driver %d is not available", req.DriverId)
+	}
+	if err != nil {
+		d.logger.Error("failed to reserve driver", slog.Int64("driver_id", req.DriverId), slogger.Err(err))
+		return nil, status.Errorf(codes.Internal, "failed to reserve driver: %v", err)
+	}
+	d.logger.Info("driver reserved", slog.Int64("driver_id", req.DriverId))
+	return &driverpb.ReserveDriverResponse{ReservedAt: reservedAt}, nil
+}
@@ -0,0 +1,73 @@
+package assignment
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	kfk "logistics/internal/kafka"
+	"logistics/internal/shared/entity"
+	"logistics/pkg/lib/logger/slogger"
+	"strconv"
+	"time"
+)
+
+// DefaultMaxAge - сколько ответ водителя считается актуальным
+const DefaultMaxAge = 2 * time.Minute
+
+// Consumer читает события driver-service из Kafka и применяет их к задачам назначения
+type Consumer struct {
+	logger   *slog.Logger
+	consumer *kfk.KafkaConsumer
+	manager  *Manager
+}
+
+func NewConsumer(logger *slog.Logger, consumer *kfk.KafkaConsumer, manager *Manager) *Consumer {
+	return &Consumer{
+		logger:   logger,
+		consumer: consumer,
+		manager:  manager,
+	}
+}
+
+// Run работает до отмены контекста
+func (c *Consumer) Run(ctx context.Context) {
+	for {
+		kafkaMessage, err := c.consumer.ReadMessage(ctx)
+		if err != nil {
+			if ctx.Err() != nil {
+				return
+			}
+			c.logger.Error("Failed to read driver event", slogger.Err(err))
+			time.Sleep(time.Second)
+			continue
+		}
+
+		msg, err := c.decode(kafkaMessage.Key, kafkaMessage.Value)
+		if err == nil {
+			err = c.manager.ApplyDriverFound(ctx, msg)
+		}
+		if err != nil {
+			c.logger.Warn("Driver event rejected", slog.String("key", string(kafkaMessage.Key)), slogger.Err(err))
+		}
+
+		if err := c.consumer.CommitMessage(ctx, kafkaMessage); err != nil {
+			c.logger.Error("Failed to commit driver event", slogger.Err(err))
+		}
+	}
+}
+
+func (c *Consumer) decode(key, value []byte) (entity.Msg, error) {
+	var msg entity.Msg
+	if err := json.Unmarshal(value, &msg); err != nil {
+		return msg, err
+	}
+	if msg.OrderId <= 0 {
+		return msg, errors.New("driver event without order id")
+	}
+	// Ключ сообщения и заказ в теле должны совпадать
+	if keyOrderID, err := strconv.ParseInt(string(key), 10, 64); err != nil || keyOrderID != msg.OrderId {
+		return msg, errors.New("driver event key does not match order id")
+	}
+	return msg, nil
+}
@@ -0,0 +1,222 @@
+package assignment
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	driverpb "logistics/api/protobuf/driver_service"
+	"logistics/internal/services/order-service/domain"
+	"logistics/internal/services/order-service/statemachine"
+	"logistics/internal/shared/entity"
+	"logistics/pkg/lib/logger/slogger"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/redis/go-redis/v9"
+)
+
+const (
+	workerInterval = time.Second
+	workerBatch    = 10
+)
+
+// Manager ведет асинхронные назначения водителей: ставит задачи в очередь,
+// запрашивает водителей у driver-service и применяет пришедшие из Kafka ответы
+type Manager struct {
+	logger           *slog.Logger
+	orderRepo        domain.OrderRepositoryInterface
+	assignmentRepo   domain.AssignmentRepositoryInterface
+	driverGRPCClient driverpb.DriverServiceClient
+	redisClient      *redis.Client
+	stateMachine     *statemachine.OrderStateMachine
+	// maxAge - сколько задача может ждать ответ водителя
+	maxAge time.Duration
+}
+
+func NewManager(logger *slog.Logger, orderRepo domain.OrderRepositoryInterface, assignmentRepo domain.AssignmentRepositoryInterface, driverClient driverpb.DriverServiceClient, redisClient *redis.Client, maxAge time.Duration) *Manager {
+	if maxAge <= 0 {
+		maxAge = DefaultMaxAge
+	}
+	return &Manager{
+		logger:           logger,
+		orderRepo:        orderRepo,
+		assignmentRepo:   assignmentRepo,
+		driverGRPCClient: driverClient,
+		redisClient:      redisClient,
+		stateMachine:     statemachine.NewOrderStateMachine(),
+		maxAge:           maxAge,
+	}
+}
+
+// Enqueue ставит назначение водителя в очередь; повторный вызов возвращает уже активную задачу
+func (m *Manager) Enqueue(ctx context.Context, userID, orderID int64) (*entity.AssignmentJob, error) {
+	currentStatus, err := m.orderRepo.CheckDeliveryStatus(ctx, userID, orderID)
+	if err != nil {
+		return nil, err
+	}
+	if err := m.stateMachine.Transition(entity.OrderStatus(currentStatus), entity.StatusInProgress); err != nil {
+		return nil, err
+	}
+	return m.assignmentRepo.CreateAssignmentJob(ctx, &entity.AssignmentJob{
+		OrderID:   orderID,
+		UserID:    userID,
+		CreatedAt: time.Now().Unix(),
+	})
+}
+
+func (m *Manager) Get(ctx context.Context, userID, jobID int64) (*entity.AssignmentJob, error) {
+	return m.assignmentRepo.GetAssignmentJob(ctx, userID, jobID)
+}
+
+// RunWorker обрабатывает очередь назначений до отмены контекста
+func (m *Manager) RunWorker(ctx context.Context) {
+	ticker := time.NewTicker(workerInterval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.processQueued(ctx)
+			m.expireStale(ctx)
+		}
+	}
+}
+
+func (m *Manager) processQueued(ctx context.Context) {
+	jobs, err := m.assignmentRepo.ClaimQueuedAssignmentJobs(ctx, workerBatch, time.Now().Unix())
+	if err != nil {
+		m.logger.Error("failed to claim assignment jobs", slogger.Err(err))
+		return
+	}
+	for _, job := range jobs {
+		findReq := &driverpb.FindDriverRequest{OrderId: job.OrderID}
+		order, err := m.orderRepo.GetOrderDetails(ctx, job.UserID, job.OrderID)
+		if err != nil {
+			m.fail(ctx, job, fmt.Sprintf("failed to get order: %v", err))
+			continue
+		}
+		// Без координат доставки driver-service ищет водителя у склада
+		if order.DeliveryLocation != nil {
+			findReq.Target = &driverpb.Location{
+				Latitude:  order.DeliveryLocation.Latitude,
+				Longitude: order.DeliveryLocation.Longitude,
+			}
+		}
+
+		// Водитель резервируется в driver-service, а ответ придет событием из Kafka
+		resp, err := m.driverGRPCClient.FindSuitableDriver(ctx, findReq)
+		if err != nil {
+			m.fail(ctx, job, fmt.Sprintf("failed to find suitable driver: %v", err))
+			continue
+		}
+		if !resp.Success || resp.Driver == nil {
+			m.fail(ctx, job, resp.Message)
+			continue
+		}
+		if err := m.assignmentRepo.SetAssignmentJobDriver(ctx, job.ID, resp.Driver.DriverId, time.Now().Unix()); err != nil {
+			// Без записанного водителя задачу не закрыть по таймауту с его освобождением
+			m.fail(ctx, job, fmt.Sprintf("failed to record reserved driver: %v", err))
+			m.releaseDriver(ctx, job.OrderID, resp.Driver.DriverId, resp.ReservedAt)
+		}
+	}
+}
+
+// expireStale закрывает задачи, не дождавшиеся ответа, и освобождает зарезервированных водителей
+func (m *Manager) expireStale(ctx context.Context) {
+	jobs, err := m.assignmentRepo.GetStaleAssignmentJobs(ctx, time.Now().Add(-m.maxAge).Unix())
+	if err != nil {
+		m.logger.Error("failed to get stale assignment jobs", slogger.Err(err))
+		return
+	}
+	for _, job := range jobs {
+		m.fail(ctx, job, "driver reply timed out")
+		m.releaseDriver(ctx, job.OrderID, job.DriverID, 0)
+	}
+}
+
+// ApplyDriverFound назначает водителя из события на заказ, который его ждет.
+// Водитель из отклоненного события освобождается: driver-service уже держит его в резерве
+func (m *Manager) ApplyDriverFound(ctx context.Context, msg entity.Msg) error {
+	job, err := m.assignmentRepo.GetLatestAssignmentJob(ctx, msg.OrderId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		m.releaseDriver(ctx, msg.OrderId, msg.Driver.ID, msg.Timestamp)
+		return fmt.Errorf("no assignment requested for order %d", msg.OrderId)
+	}
+	if err != nil {
+		return err
+	}
+	if job.Status == entity.AssignmentAssigned && job.DriverID == msg.Driver.ID {
+		// Повторная доставка уже примененного события: водитель везет этот заказ
+		return fmt.Errorf("assignment job %d for order %d is already assigned to driver %d", job.ID, msg.OrderId, msg.Driver.ID)
+	}
+	if job.Status != entity.AssignmentSearching {
+		// Ответ на закрытую задачу
+		m.releaseDriver(ctx, msg.OrderId, msg.Driver.ID, msg.Timestamp)
+		return fmt.Errorf("assignment job %d for order %d is %s", job.ID, msg.OrderId, job.Status)
+	}
+	if job.DriverID != 0 && job.DriverID != msg.Driver.ID {
+		m.releaseDriver(ctx, msg.OrderId, msg.Driver.ID, msg.Timestamp)
+		return fmt.Errorf("assignment job %d reserved driver %d, got %d", job.ID, job.DriverID, msg.Driver.ID)
+	}
+	if time.Since(time.Unix(msg.Timestamp, 0)) > m.maxAge {
+		m.fail(ctx, job, "driver reply timed out")
+		m.releaseDriver(ctx, msg.OrderId, msg.Driver.ID, msg.Timestamp)
+		return fmt.Errorf("stale driver event for order %d", msg.OrderId)
+	}
+
+	currentStatus, err := m.orderRepo.CheckDeliveryStatus(ctx, job.UserID, job.OrderID)
+	if err != nil {
+		return err
+	}
+	if err := m.stateMachine.Transition(entity.OrderStatus(currentStatus), entity.StatusInProgress); err != nil {
+		// Заказ успели отменить, пока искали водителя
+		m.fail(ctx, job, err.Error())
+		m.releaseDriver(ctx, job.OrderID, msg.Driver.ID, msg.Timestamp)
+		return err
+	}
+
+	err = m.assignmentRepo.CompleteAssignmentJob(ctx, job, msg.Driver.ID, &entity.OrderStatusChange{
+		OldStatus: entity.OrderStatus(currentStatus),
+		NewStatus: entity.StatusInProgress,
+		ActorType: entity.ActorSystem,
+		Reason:    fmt.Sprintf("driver %d assigned", msg.Driver.ID),
+		ChangedAt: time.Now().Unix(),
+	})
+	if err != nil {
+		m.fail(ctx, job, err.Error())
+		m.releaseDriver(ctx, job.OrderID, msg.Driver.ID, msg.Timestamp)
+		return err
+	}
+
+	// Закешированный заказ устарел
+	if err := m.redisClient.Del(ctx, fmt.Sprintf("user:%d_order:%d", job.UserID, job.OrderID)).Err(); err != nil {
+		m.logger.Error("failed to invalidate cached order", slogger.Err(err))
+	}
+	m.logger.Info("driver assigned", slog.Int64("job_id", job.ID), slog.Int64("order_id", job.OrderID), slog.Int64("driver_id", msg.Driver.ID))
+	return nil
+}
+
+func (m *Manager) fail(ctx context.Context, job *entity.AssignmentJob, reason string) {
+	m.logger.Warn("driver assignment failed", slog.Int64("job_id", job.ID), slog.Int64("order_id", job.OrderID), slog.String("reason", reason))
+	if err := m.assignmentRepo.FailAssignmentJob(ctx, job.ID, reason, time.Now().Unix()); err != nil {
+		m.logger.Error("failed to mark assignment job as failed", slog.Int64("job_id", job.ID), slogger.Err(err))
+	}
+}
+
+// releaseDriver ставит освобождение водителя в order_outbox: команда повторяется, пока driver-service
+// ее не примет. reservedAt - время резерва из события; резерв, взятый водителем позже, не снимается
+func (m *Manager) releaseDriver(ctx context.Context, orderID, driverID, reservedAt int64) {
+	if driverID == 0 {
+		return
+	}
+	command, err := domain.NewReleaseDriverCommand(orderID, driverID, reservedAt, time.Now().Unix())
+	if err == nil {
+		err = m.assignmentRepo.EnqueueCommands(ctx, command)
+	}
+	if err != nil {
+		m.logger.Error("failed to schedule driver release", slog.Int64("order_id", orderID), slog.Int64("driver_id", driverID), slogger.Err(err))
+	}
+}
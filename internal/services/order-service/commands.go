@@ -0,0 +1,76 @@
+package orderservice
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"log/slog"
+	driverpb "logistics/api/protobuf/driver_service"
+	warehousepb "logistics/api/protobuf/warehouse_service"
+	kfk "logistics/internal/kafka"
+	"logistics/internal/services/order-service/domain"
+	"logistics/pkg/lib/utils"
+	"time"
+)
+
+// commandTimeout - на одну команду; не выполненная за это время повторится с задержкой
+const commandTimeout = 10 * time.Second
+
+// CommandTypes - команды, которые исполняет CommandHandler
+var CommandTypes = []string{kfk.CommandReturnStock, kfk.CommandReleaseDriver}
+
+// CommandHandler исполняет команды warehouse-service и driver-service, записанные в order_outbox
+// вместе с переходом статуса. Обе команды идемпотентны, поэтому повтор после сбоя безопасен
+type CommandHandler struct {
+	logger              *slog.Logger
+	warehouseGRPCClient warehousepb.WarehouseServiceClient
+	driverGRPCClient    driverpb.DriverServiceClient
+}
+
+func NewCommandHandler(logger *slog.Logger, warehouseClient warehousepb.WarehouseServiceClient, driverClient driverpb.DriverServiceClient) *CommandHandler {
+	return &CommandHandler{
+		logger:              logger,
+		warehouseGRPCClient: warehouseClient,
+		driverGRPCClient:    driverClient,
+	}
+}
+
+// Handle исполняет одну команду; ошибка оставляет ее в outbox для повтора
+func (h *CommandHandler) Handle(ctx context.Context, msg kfk.OutboxMessage) error {
+	ctx, cancel := context.WithTimeout(ctx, commandTimeout)
+	defer cancel()
+
+	switch msg.EventType {
+	case kfk.CommandReturnStock:
+		var cmd domain.ReturnStockCommand
+		if err := json.Unmarshal(msg.Payload, &cmd); err != nil {
+			return fmt.Errorf("failed to unmarshal %s command: %w", msg.EventType, err)
+		}
+		// Склад возвращает товар по заказу не больше одного раза
+		_, err := h.warehouseGRPCClient.ReturnStock(ctx, &warehousepb.ReturnStockRequest{
+			OrderId: cmd.OrderID,
+			Items:   utils.ConvertGoodsItemsToWarehouseStockItems(cmd.Items, msg.CreatedAt),
+			Time:    msg.CreatedAt,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to return stock for order %d: %w", cmd.OrderID, err)
+		}
+		h.logger.Info("stock returned", slog.Int64("order_id", cmd.OrderID))
+	case kfk.CommandReleaseDriver:
+		var cmd domain.ReleaseDriverCommand
+		if err := json.Unmarshal(msg.Payload, &cmd); err != nil {
+			return fmt.Errorf("failed to unmarshal %s command: %w", msg.EventType, err)
+		}
+		resp, err := h.driverGRPCClient.ReleaseDriver(ctx, &driverpb.ReleaseDriverRequest{
+			DriverId:   cmd.DriverID,
+			ReservedAt: cmd.ReservedAt,
+		})
+		if err != nil {
+			return fmt.Errorf("failed to release driver %d: %w", cmd.DriverID, err)
+		}
+		h.logger.Info("driver release processed", slog.Int64("order_id", cmd.OrderID), slog.Int64("driver_id", cmd.DriverID), slog.Bool("released", resp.Released))
+	default:
+		return fmt.Errorf("unknown command %q", msg.EventType)
+	}
+	return nil
+}
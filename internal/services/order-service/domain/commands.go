@@ -0,0 +1,46 @@
+package domain
+
+import (
+	"encoding/json"
+	"fmt"
+	kfk "logistics/internal/kafka"
+	"logistics/internal/shared/entity"
+	"strconv"
+)
+
+// ReturnStockCommand - вернуть на склад товар, списанный по заказу
+type ReturnStockCommand struct {
+	OrderID int64              `json:"order_id"`
+	Items   []entity.GoodsItem `json:"items"`
+}
+
+// ReleaseDriverCommand - снять резерв водителя. ReservedAt - время резерва из события driver-service;
+// 0 - снять любой резерв
+type ReleaseDriverCommand struct {
+	OrderID    int64 `json:"order_id"`
+	DriverID   int64 `json:"driver_id"`
+	ReservedAt int64 `json:"reserved_at,omitempty"`
+}
+
+// NewReturnStockCommand собирает команду возврата товара для записи в order_outbox
+func NewReturnStockCommand(orderID int64, items []entity.GoodsItem, now int64) (kfk.OutboxMessage, error) {
+	return newCommand(kfk.CommandReturnStock, orderID, ReturnStockCommand{OrderID: orderID, Items: items}, now)
+}
+
+// NewReleaseDriverCommand собирает команду освобождения водителя для записи в order_outbox
+func NewReleaseDriverCommand(orderID, driverID, reservedAt, now int64) (kfk.OutboxMessage, error) {
+	return newCommand(kfk.CommandReleaseDriver, orderID, ReleaseDriverCommand{OrderID: orderID, DriverID: driverID, ReservedAt: reservedAt}, now)
+}
+
+func newCommand(commandType string, orderID int64, command any, now int64) (kfk.OutboxMessage, error) {
+	payload, err := json.Marshal(command)
+	if err != nil {
+		return kfk.OutboxMessage{}, fmt.Errorf("failed to marshal %s command: %w", commandType, err)
+	}
+	return kfk.OutboxMessage{
+		EventType: commandType,
+		Key:       strconv.FormatInt(orderID, 10),
+		Payload:   payload,
+		CreatedAt: now,
+	}, nil
+}
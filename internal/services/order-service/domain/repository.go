@@ -2,17 +2,48 @@ package domain
 
 import (
 	"context"
+	"errors"
+	kfk "logistics/internal/kafka"
 	"logistics/internal/shared/entity"
 )
 
+// ErrOrderStatusConflict - статус заказа изменился между проверкой и обновлением
+var ErrOrderStatusConflict = errors.New("order status was changed concurrently")
+
+// OrderStatusChannel - канал pg_notify, в который репозиторий пишет закоммиченные переходы статуса
+const OrderStatusChannel = "order_status_changes"
+
 type OrderRepositoryInterface interface {
 	// Define methods for order repository
 	CreateOrder(ctx context.Context, order *entity.Order) (int64, error)
-	CompleteDelivery(ctx context.Context, userID, orderID int64) (int64, error)
+	CompleteDelivery(ctx context.Context, userID, orderID int64, changedAt int64) (int64, error)
 	GetDeliveriesByUser(ctx context.Context, userID int64) ([]*entity.Order, error)
 	GetOrderDetails(ctx context.Context, userPD int64, orderID int64) (*entity.Order, error)
 	GetOrdersByUser(ctx context.Context, userID int64) ([]*entity.Order, error)
-	UpdateOrderStatus(ctx context.Context, userID, orderID int64, driverID int64, status string) error
+	UpdateOrderStatus(ctx context.Context, userID, orderID int64, driverID int64, change *entity.OrderStatusChange) error
+	// CancelOrder фиксирует отмену и команды возврата товара и освобождения водителя одной транзакцией
+	CancelOrder(ctx context.Context, userID, orderID int64, change *entity.OrderStatusChange, commands []kfk.OutboxMessage) error
 	CheckDeliveryStatus(ctx context.Context, userID, orderID int64) (string, error)
-	GetOrderItemInfo(ctx context.Context, productName string) (int32, float64, error)
+	GetOrderStatusHistory(ctx context.Context, userID, orderID int64) ([]*entity.OrderStatusChange, error)
+	SaveOrderAllocations(ctx context.Context, orderID int64, allocations []entity.StockAllocation) error
+}
+
+type SagaRepositoryInterface interface {
+	CreateSaga(ctx context.Context, saga *entity.OrderSaga) (int64, error)
+	CreateOrderInSaga(ctx context.Context, sagaID int64, order *entity.Order, updatedAt int64) (int64, error)
+	UpdateSagaState(ctx context.Context, sagaID int64, state entity.SagaState, sagaErr string, updatedAt int64) error
+	GetUnfinishedSagas(ctx context.Context) ([]*entity.OrderSaga, error)
+}
+
+type AssignmentRepositoryInterface interface {
+	CreateAssignmentJob(ctx context.Context, job *entity.AssignmentJob) (*entity.AssignmentJob, error)
+	GetAssignmentJob(ctx context.Context, userID, jobID int64) (*entity.AssignmentJob, error)
+	GetLatestAssignmentJob(ctx context.Context, orderID int64) (*entity.AssignmentJob, error)
+	ClaimQueuedAssignmentJobs(ctx context.Context, limit int, now int64) ([]*entity.AssignmentJob, error)
+	GetStaleAssignmentJobs(ctx context.Context, updatedBefore int64) ([]*entity.AssignmentJob, error)
+	SetAssignmentJobDriver(ctx context.Context, jobID, driverID, now int64) error
+	FailAssignmentJob(ctx context.Context, jobID int64, reason string, now int64) error
+	CompleteAssignmentJob(ctx context.Context, job *entity.AssignmentJob, driverID int64, change *entity.OrderStatusChange) error
+	// EnqueueCommands записывает команды в order_outbox для исполнения CommandHandler
+	EnqueueCommands(ctx context.Context, commands ...kfk.OutboxMessage) error
 }
@@ -0,0 +1,151 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	kfk "logistics/internal/kafka"
+	"logistics/internal/shared/entity"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const assignmentJobColumns = `id, order_id, user_id, status, driver_id, error, created_at, updated_at`
+
+func scanAssignmentJob(row pgx.Row) (*entity.AssignmentJob, error) {
+	var job entity.AssignmentJob
+	err := row.Scan(&job.ID, &job.OrderID, &job.UserID, &job.Status, &job.DriverID, &job.Error, &job.CreatedAt, &job.UpdatedAt)
+	if err != nil {
+		return nil, err
+	}
+	return &job, nil
+}
+
+func collectAssignmentJobs(rows pgx.Rows) ([]*entity.AssignmentJob, error) {
+	defer rows.Close()
+	var jobs []*entity.AssignmentJob
+	for rows.Next() {
+		job, err := scanAssignmentJob(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan assignment job: %w", err)
+		}
+		jobs = append(jobs, job)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating assignment jobs: %w", err)
+	}
+	return jobs, nil
+}
+
+// CreateAssignmentJob ставит назначение в очередь; если по заказу уже есть незавершенное, возвращает его
+func (o *OrderRepository) CreateAssignmentJob(ctx context.Context, job *entity.AssignmentJob) (*entity.AssignmentJob, error) {
+	query := `INSERT INTO driver_assignment_jobs (order_id, user_id, status, created_at, updated_at) VALUES ($1, $2, $3, $4, $4)
+		ON CONFLICT (order_id) WHERE status IN ('queued', 'searching') DO NOTHING
+		RETURNING ` + assignmentJobColumns
+	created, err := scanAssignmentJob(o.pool.QueryRow(ctx, query, job.OrderID, job.UserID, entity.AssignmentQueued, job.CreatedAt))
+	if err == nil {
+		return created, nil
+	}
+	if !errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("failed to create assignment job: %w", err)
+	}
+
+	query = `SELECT ` + assignmentJobColumns + ` FROM driver_assignment_jobs WHERE order_id = $1 AND status IN ('queued', 'searching')`
+	existing, err := scanAssignmentJob(o.pool.QueryRow(ctx, query, job.OrderID))
+	if err != nil {
+		return nil, fmt.Errorf("failed to get active assignment job: %w", err)
+	}
+	return existing, nil
+}
+
+func (o *OrderRepository) GetAssignmentJob(ctx context.Context, userID, jobID int64) (*entity.AssignmentJob, error) {
+	query := `SELECT ` + assignmentJobColumns + ` FROM driver_assignment_jobs WHERE id = $1 AND user_id = $2`
+	return scanAssignmentJob(o.pool.QueryRow(ctx, query, jobID, userID))
+}
+
+func (o *OrderRepository) GetLatestAssignmentJob(ctx context.Context, orderID int64) (*entity.AssignmentJob, error) {
+	query := `SELECT ` + assignmentJobColumns + ` FROM driver_assignment_jobs WHERE order_id = $1 ORDER BY id DESC LIMIT 1`
+	return scanAssignmentJob(o.pool.QueryRow(ctx, query, orderID))
+}
+
+// ClaimQueuedAssignmentJobs забирает задачи из очереди, переводя их в searching;
+// SKIP LOCKED не дает двум экземплярам сервиса взять одну задачу
+func (o *OrderRepository) ClaimQueuedAssignmentJobs(ctx context.Context, limit int, now int64) ([]*entity.AssignmentJob, error) {
+	query := `UPDATE driver_assignment_jobs SET status = $1, updated_at = $2
+		WHERE id IN (SELECT id FROM driver_assignment_jobs WHERE status = $3 ORDER BY id LIMIT $4 FOR UPDATE SKIP LOCKED)
+		RETURNING ` + assignmentJobColumns
+	rows, err := o.pool.Query(ctx, query, entity.AssignmentSearching, now, entity.AssignmentQueued, limit)
+	if err != nil {
+		return nil, fmt.Errorf("failed to claim assignment jobs: %w", err)
+	}
+	return collectAssignmentJobs(rows)
+}
+
+func (o *OrderRepository) GetStaleAssignmentJobs(ctx context.Context, updatedBefore int64) ([]*entity.AssignmentJob, error) {
+	query := `SELECT ` + assignmentJobColumns + ` FROM driver_assignment_jobs WHERE status = $1 AND updated_at < $2 ORDER BY id`
+	rows, err := o.pool.Query(ctx, query, entity.AssignmentSearching, updatedBefore)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query stale assignment jobs: %w", err)
+	}
+	return collectAssignmentJobs(rows)
+}
+
+func (o *OrderRepository) SetAssignmentJobDriver(ctx context.Context, jobID, driverID, now int64) error {
+	query := `UPDATE driver_assignment_jobs SET driver_id = $1, updated_at = $2 WHERE id = $3 AND status = $4`
+	_, err := o.pool.Exec(ctx, query, driverID, now, jobID, entity.AssignmentSearching)
+	if err != nil {
+		return fmt.Errorf("failed to set driver for assignment job %d: %w", jobID, err)
+	}
+	return nil
+}
+
+func (o *OrderRepository) FailAssignmentJob(ctx context.Context, jobID int64, reason string, now int64) error {
+	query := `UPDATE driver_assignment_jobs SET status = $1, error = $2, updated_at = $3 WHERE id = $4 AND status IN ($5, $6)`
+	_, err := o.pool.Exec(ctx, query, entity.AssignmentFailed, reason, now, jobID, entity.AssignmentQueued, entity.AssignmentSearching)
+	if err != nil {
+		return fmt.Errorf("failed to fail assignment job %d: %w", jobID, err)
+	}
+	return nil
+}
+
+// CompleteAssignmentJob назначает водителя на заказ и закрывает задачу одной транзакцией
+func (o *OrderRepository) CompleteAssignmentJob(ctx context.Context, job *entity.AssignmentJob, driverID int64, change *entity.OrderStatusChange) error {
+	tx, err := o.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := updateOrderStatusTx(ctx, tx, job.UserID, job.OrderID, driverID, change); err != nil {
+		return err
+	}
+
+	query := `UPDATE driver_assignment_jobs SET status = $1, driver_id = $2, updated_at = $3 WHERE id = $4`
+	if _, err := tx.Exec(ctx, query, entity.AssignmentAssigned, driverID, change.ChangedAt, job.ID); err != nil {
+		return fmt.Errorf("failed to complete assignment job %d: %w", job.ID, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func (o *OrderRepository) EnqueueCommands(ctx context.Context, commands ...kfk.OutboxMessage) error {
+	tx, err := o.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	for _, command := range commands {
+		if err := kfk.EnqueueOutbox(ctx, tx, kfk.OrderOutboxTable, command); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
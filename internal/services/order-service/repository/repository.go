@@ -2,8 +2,13 @@ package repository
 
 import (
 	"context"
+	"encoding/json"
+	"errors"
 	"fmt"
+	kfk "logistics/internal/kafka"
+	"logistics/internal/services/order-service/domain"
 	"logistics/internal/shared/entity"
+	"strconv"
 
 	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
@@ -26,16 +31,38 @@ func (o *OrderRepository) CreateOrder(ctx context.Context, order *entity.Order)
 	}
 	defer tx.Rollback(ctx)
 
-	query := `INSERT INTO orders (user_id, driver_id, status, delivery_address, total_amount, created_at) VALUES ($1, $2, $3, $4, $5, $6) RETURNING id`
+	orderID, err := insertOrder(ctx, tx, order)
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return orderID, nil
+
+}
+
+// insertOrder записывает заказ, его товары и начальный статус в рамках транзакции
+func insertOrder(ctx context.Context, tx pgx.Tx, order *entity.Order) (int64, error) {
+	query := `INSERT INTO orders (user_id, driver_id, status, delivery_address, total_amount, created_at, delivery_latitude, delivery_longitude) VALUES ($1, $2, $3, $4, $5, $6, $7, $8) RETURNING id`
+
+	var latitude, longitude *float64
+	if order.DeliveryLocation != nil {
+		latitude, longitude = &order.DeliveryLocation.Latitude, &order.DeliveryLocation.Longitude
+	}
 
 	var orderID int64
-	err = tx.QueryRow(ctx, query,
+	err := tx.QueryRow(ctx, query,
 		order.UserID,
 		0,
 		order.Status,
 		order.DeliveryAddress,
 		order.TotalAmount,
 		order.CreatedAt,
+		latitude,
+		longitude,
 	).Scan(&orderID)
 	if err != nil {
 		return 0, fmt.Errorf("failed to insert order: %w", err)
@@ -63,21 +90,54 @@ func (o *OrderRepository) CreateOrder(ctx context.Context, order *entity.Order)
 		}
 	}
 
-	if err := tx.Commit(ctx); err != nil {
-		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	err = insertStatusChange(ctx, tx, &entity.OrderStatusChange{
+		OrderID:   orderID,
+		NewStatus: order.Status,
+		ActorType: entity.ActorUser,
+		ActorID:   order.UserID,
+		Reason:    "order created",
+		ChangedAt: order.CreatedAt,
+	})
+	if err != nil {
+		return 0, err
 	}
 
 	return orderID, nil
-
 }
 
-func (o *OrderRepository) CompleteDelivery(ctx context.Context, userID, orderID int64) (int64, error) {
-	query := `UPDATE orders SET status = 'delivered' WHERE id = $1 AND user_id = $2 RETURNING driver_id`
+func (o *OrderRepository) CompleteDelivery(ctx context.Context, userID, orderID int64, changedAt int64) (int64, error) {
+	tx, err := o.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `UPDATE orders SET status = $1 WHERE id = $2 AND user_id = $3 AND status = $4 RETURNING driver_id`
 	var driverID int64
-	err := o.pool.QueryRow(ctx, query, orderID, userID).Scan(&driverID)
+	err = tx.QueryRow(ctx, query, entity.StatusDelivered, orderID, userID, entity.StatusInProgress).Scan(&driverID)
 	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return 0, domain.ErrOrderStatusConflict
+		}
 		return 0, err
 	}
+
+	err = insertStatusChange(ctx, tx, &entity.OrderStatusChange{
+		OrderID:   orderID,
+		OldStatus: entity.StatusInProgress,
+		NewStatus: entity.StatusDelivered,
+		ActorType: entity.ActorUser,
+		ActorID:   userID,
+		Reason:    "delivery completed",
+		ChangedAt: changedAt,
+	})
+	if err != nil {
+		return 0, err
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
 	return driverID, nil
 }
 
@@ -113,26 +173,19 @@ func (o *OrderRepository) GetDeliveriesByUser(ctx context.Context, userID int64)
 	return orders, nil
 }
 
-func (o *OrderRepository) GetOrderItemInfo(ctx context.Context, productName string) (int32, float64, error) {
-	query := `SELECT product_id, price FROM warehouse_stock WHERE product_name = $1`
-	var product_id int32
-	var price float64
-	err := o.pool.QueryRow(ctx, query, productName).Scan(&product_id, &price)
-	if err != nil {
-		return 0, 0, err
-	}
-	return product_id, price, nil
-}
-
 func (o *OrderRepository) GetOrderDetails(ctx context.Context, userID, orderID int64) (*entity.Order, error) {
-	query := `SELECT id, user_id, status, total_amount, delivery_address, created_at, driver_id FROM orders WHERE id = $1`
+	query := `SELECT id, user_id, status, total_amount, delivery_address, created_at, driver_id, delivery_latitude, delivery_longitude FROM orders WHERE id = $1`
 	row := o.pool.QueryRow(ctx, query, orderID)
 
 	var order entity.Order
-	err := row.Scan(&order.ID, &order.UserID, &order.Status, &order.TotalAmount, &order.DeliveryAddress, &order.CreatedAt, &order.DriverID)
+	var latitude, longitude *float64
+	err := row.Scan(&order.ID, &order.UserID, &order.Status, &order.TotalAmount, &order.DeliveryAddress, &order.CreatedAt, &order.DriverID, &latitude, &longitude)
 	if err != nil {
 		return nil, err
 	}
+	if latitude != nil && longitude != nil {
+		order.DeliveryLocation = &entity.Location{Latitude: *latitude, Longitude: *longitude}
+	}
 
 	// Fetch order items
 	itemsQuery := `SELECT product_id, product_name, price, quantity, total_price FROM order_items WHERE order_id = $1`
@@ -156,9 +209,72 @@ func (o *OrderRepository) GetOrderDetails(ctx context.Context, userID, orderID i
 	}
 	order.Items = items
 
+	allocationsQuery := `SELECT warehouse_id, product_id, quantity FROM order_allocations WHERE order_id = $1 ORDER BY warehouse_id, product_id`
+	allocationRows, err := o.pool.Query(ctx, allocationsQuery, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query order allocations: %w", err)
+	}
+	defer allocationRows.Close()
+	for allocationRows.Next() {
+		var allocation entity.StockAllocation
+		if err := allocationRows.Scan(&allocation.WarehouseID, &allocation.ProductID, &allocation.Quantity); err != nil {
+			return nil, fmt.Errorf("failed to scan order allocation: %w", err)
+		}
+		order.Allocations = append(order.Allocations, allocation)
+	}
+	if err := allocationRows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating order allocation rows: %w", err)
+	}
+
+	lotsQuery := `SELECT l.warehouse_id, i.product_id, l.lot_id, l.lot_number, l.expires_at, l.quantity
+		FROM order_item_lots l JOIN order_items i ON i.id = l.order_item_id
+		WHERE i.order_id = $1 ORDER BY l.warehouse_id, i.product_id, l.expires_at = 0, l.expires_at, l.lot_id`
+	lotRows, err := o.pool.Query(ctx, lotsQuery, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query order item lots: %w", err)
+	}
+	defer lotRows.Close()
+	for lotRows.Next() {
+		var warehouseID, productID int64
+		var lot entity.LotAllocation
+		if err := lotRows.Scan(&warehouseID, &productID, &lot.LotID, &lot.LotNumber, &lot.ExpiresAt, &lot.Quantity); err != nil {
+			return nil, fmt.Errorf("failed to scan order item lot: %w", err)
+		}
+		for i := range order.Allocations {
+			if order.Allocations[i].WarehouseID == warehouseID && order.Allocations[i].ProductID == productID {
+				order.Allocations[i].Lots = append(order.Allocations[i].Lots, lot)
+				break
+			}
+		}
+	}
+	if err := lotRows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating order item lot rows: %w", err)
+	}
+
 	return &order, nil
 }
 
+// SaveOrderAllocations запоминает, с каких складов и из каких партий отгружается заказ; повторный вызов ничего не меняет
+func (o *OrderRepository) SaveOrderAllocations(ctx context.Context, orderID int64, allocations []entity.StockAllocation) error {
+	batch := &pgx.Batch{}
+	for _, allocation := range allocations {
+		batch.Queue(`INSERT INTO order_allocations (order_id, warehouse_id, product_id, quantity)
+			VALUES ($1, $2, $3, $4) ON CONFLICT DO NOTHING`,
+			orderID, allocation.WarehouseID, allocation.ProductID, allocation.Quantity)
+		// Партии привязываются к позиции заказа с этим товаром
+		for _, lot := range allocation.Lots {
+			batch.Queue(`INSERT INTO order_item_lots (order_item_id, warehouse_id, lot_id, lot_number, expires_at, quantity)
+				SELECT id, $3, $4, $5, $6, $7 FROM order_items WHERE order_id = $1 AND product_id = $2 ORDER BY id LIMIT 1
+				ON CONFLICT DO NOTHING`,
+				orderID, allocation.ProductID, allocation.WarehouseID, lot.LotID, lot.LotNumber, lot.ExpiresAt, lot.Quantity)
+		}
+	}
+	if err := o.pool.SendBatch(ctx, batch).Close(); err != nil {
+		return fmt.Errorf("failed to save allocations of order %d: %w", orderID, err)
+	}
+	return nil
+}
+
 func (o *OrderRepository) GetOrdersByUser(ctx context.Context, userID int64) ([]*entity.Order, error) {
 	// Начинаем транзакцию
 	tx, err := o.pool.Begin(ctx)
@@ -242,11 +358,150 @@ func (o *OrderRepository) GetOrdersByUser(ctx context.Context, userID int64) ([]
 	return orders, nil
 }
 
-func (o *OrderRepository) UpdateOrderStatus(ctx context.Context, userID, orderID int64, driverID int64, status string) error {
-	query := `UPDATE orders SET status = $1, driver_id = $2 WHERE id = $3 AND user_id = $4`
-	_, err := o.pool.Exec(ctx, query, status, driverID, orderID, userID)
+func (o *OrderRepository) UpdateOrderStatus(ctx context.Context, userID, orderID int64, driverID int64, change *entity.OrderStatusChange) error {
+	tx, err := o.pool.Begin(ctx)
 	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := updateOrderStatusTx(ctx, tx, userID, orderID, driverID, change); err != nil {
 		return err
 	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+func (o *OrderRepository) CancelOrder(ctx context.Context, userID, orderID int64, change *entity.OrderStatusChange, commands []kfk.OutboxMessage) error {
+	tx, err := o.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := updateOrderStatusTx(ctx, tx, userID, orderID, 0, change); err != nil {
+		return err
+	}
+	for _, command := range commands {
+		if err := kfk.EnqueueOutbox(ctx, tx, kfk.OrderOutboxTable, command); err != nil {
+			return err
+		}
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return nil
+}
+
+// GetOrderStatusHistory возвращает переходы статуса заказа пользователя; чужой или несуществующий заказ - pgx.ErrNoRows
+func (o *OrderRepository) GetOrderStatusHistory(ctx context.Context, userID, orderID int64) ([]*entity.OrderStatusChange, error) {
+	var ownerID int64
+	err := o.pool.QueryRow(ctx, `SELECT user_id FROM orders WHERE id = $1`, orderID).Scan(&ownerID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get order %d: %w", orderID, err)
+	}
+	if ownerID != userID {
+		return nil, fmt.Errorf("order %d belongs to another user: %w", orderID, pgx.ErrNoRows)
+	}
+
+	query := `SELECT h.id, h.order_id, h.old_status, h.new_status, h.actor_type, h.actor_id, h.reason, h.changed_at
+		FROM order_status_history h
+		JOIN orders o ON o.id = h.order_id
+		WHERE h.order_id = $1 AND o.user_id = $2
+		ORDER BY h.changed_at, h.id`
+	rows, err := o.pool.Query(ctx, query, orderID, userID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query order status history: %w", err)
+	}
+	defer rows.Close()
+
+	var changes []*entity.OrderStatusChange
+	for rows.Next() {
+		var change entity.OrderStatusChange
+		err := rows.Scan(&change.ID, &change.OrderID, &change.OldStatus, &change.NewStatus, &change.ActorType, &change.ActorID, &change.Reason, &change.ChangedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan order status change: %w", err)
+		}
+		changes = append(changes, &change)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating order status history rows: %w", err)
+	}
+	return changes, nil
+}
+
+// updateOrderStatusTx меняет статус заказа, только если он не изменился с момента проверки перехода
+func updateOrderStatusTx(ctx context.Context, tx pgx.Tx, userID, orderID, driverID int64, change *entity.OrderStatusChange) error {
+	query := `UPDATE orders SET status = $1, driver_id = COALESCE(NULLIF($2, 0), driver_id) WHERE id = $3 AND user_id = $4 AND status = $5`
+	tag, err := tx.Exec(ctx, query, change.NewStatus, driverID, orderID, userID, change.OldStatus)
+	if err != nil {
+		return err
+	}
+	if tag.RowsAffected() == 0 {
+		return domain.ErrOrderStatusConflict
+	}
+
+	change.OrderID = orderID
+	return insertStatusChange(ctx, tx, change)
+}
+
+// insertStatusChange пишет переход в историю в рамках транзакции изменения статуса
+func insertStatusChange(ctx context.Context, tx pgx.Tx, change *entity.OrderStatusChange) error {
+	query := `INSERT INTO order_status_history (order_id, old_status, new_status, actor_type, actor_id, reason, changed_at) VALUES ($1, $2, $3, $4, $5, $6, $7)`
+	_, err := tx.Exec(ctx, query,
+		change.OrderID,
+		change.OldStatus,
+		change.NewStatus,
+		change.ActorType,
+		change.ActorID,
+		change.Reason,
+		change.ChangedAt,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to insert order status change: %w", err)
+	}
+
+	// Событие о смене статуса уходит в Kafka только вместе с закоммиченным переходом
+	payload, err := json.Marshal(change)
+	if err != nil {
+		return fmt.Errorf("failed to marshal order status change: %w", err)
+	}
+	err = kfk.EnqueueOutbox(ctx, tx, kfk.OrderOutboxTable, kfk.OutboxMessage{
+		EventType: kfk.EventOrderStatusChanged,
+		Key:       strconv.FormatInt(change.OrderID, 10),
+		Payload:   payload,
+		CreatedAt: change.ChangedAt,
+	})
+	if err != nil {
+		return err
+	}
+
+	// Подписчики отслеживания получат уведомление только после коммита транзакции
+	return notifyStatusChange(ctx, tx, change)
+}
+
+// notifyLimit - запас до предельного размера payload у pg_notify (8000 байт)
+const notifyLimit = 7000
+
+func notifyStatusChange(ctx context.Context, tx pgx.Tx, change *entity.OrderStatusChange) error {
+	payload, err := json.Marshal(change)
+	if err != nil {
+		return fmt.Errorf("failed to marshal order status change: %w", err)
+	}
+	// Причину отмены вводит пользователь, поэтому длинную причину в уведомление не кладем
+	if len(payload) > notifyLimit {
+		trimmed := *change
+		trimmed.Reason = ""
+		if payload, err = json.Marshal(&trimmed); err != nil {
+			return fmt.Errorf("failed to marshal order status change: %w", err)
+		}
+	}
+	if _, err := tx.Exec(ctx, `SELECT pg_notify($1, $2)`, domain.OrderStatusChannel, string(payload)); err != nil {
+		return fmt.Errorf("failed to notify order status change: %w", err)
+	}
 	return nil
 }
@@ -0,0 +1,89 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"logistics/internal/shared/entity"
+)
+
+func (o *OrderRepository) CreateSaga(ctx context.Context, saga *entity.OrderSaga) (int64, error) {
+	payload, err := json.Marshal(saga.Order)
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal saga payload: %w", err)
+	}
+	query := `INSERT INTO order_sagas (order_id, user_id, state, payload, error, created_at, updated_at) VALUES ($1, $2, $3, $4, $5, $6, $7) RETURNING id`
+	var sagaID int64
+	err = o.pool.QueryRow(ctx, query, saga.OrderID, saga.UserID, saga.State, payload, saga.Error, saga.CreatedAt, saga.UpdatedAt).Scan(&sagaID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to insert saga: %w", err)
+	}
+	return sagaID, nil
+}
+
+// CreateOrderInSaga создает заказ и переводит сагу в order_created одной транзакцией,
+// чтобы после падения сервиса по саге всегда было понятно, существует ли заказ
+func (o *OrderRepository) CreateOrderInSaga(ctx context.Context, sagaID int64, order *entity.Order, updatedAt int64) (int64, error) {
+	tx, err := o.pool.Begin(ctx)
+	if err != nil {
+		return 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	orderID, err := insertOrder(ctx, tx, order)
+	if err != nil {
+		return 0, err
+	}
+	order.ID = orderID
+
+	payload, err := json.Marshal(order)
+	if err != nil {
+		return 0, fmt.Errorf("failed to marshal saga payload: %w", err)
+	}
+	query := `UPDATE order_sagas SET order_id = $1, state = $2, payload = $3, updated_at = $4 WHERE id = $5`
+	_, err = tx.Exec(ctx, query, orderID, entity.SagaOrderCreated, payload, updatedAt, sagaID)
+	if err != nil {
+		return 0, fmt.Errorf("failed to update saga %d: %w", sagaID, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return orderID, nil
+}
+
+func (o *OrderRepository) UpdateSagaState(ctx context.Context, sagaID int64, state entity.SagaState, sagaErr string, updatedAt int64) error {
+	query := `UPDATE order_sagas SET state = $1, error = $2, updated_at = $3 WHERE id = $4`
+	_, err := o.pool.Exec(ctx, query, state, sagaErr, updatedAt, sagaID)
+	if err != nil {
+		return fmt.Errorf("failed to update saga %d: %w", sagaID, err)
+	}
+	return nil
+}
+
+func (o *OrderRepository) GetUnfinishedSagas(ctx context.Context) ([]*entity.OrderSaga, error) {
+	query := `SELECT id, order_id, user_id, state, payload, error, created_at, updated_at FROM order_sagas WHERE state NOT IN ($1, $2, $3) ORDER BY id`
+	rows, err := o.pool.Query(ctx, query, entity.SagaCompleted, entity.SagaCompensated, entity.SagaFailed)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query unfinished sagas: %w", err)
+	}
+	defer rows.Close()
+
+	var sagas []*entity.OrderSaga
+	for rows.Next() {
+		var saga entity.OrderSaga
+		var payload []byte
+		err := rows.Scan(&saga.ID, &saga.OrderID, &saga.UserID, &saga.State, &payload, &saga.Error, &saga.CreatedAt, &saga.UpdatedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan saga: %w", err)
+		}
+		if err := json.Unmarshal(payload, &saga.Order); err != nil {
+			return nil, fmt.Errorf("failed to unmarshal saga %d payload: %w", saga.ID, err)
+		}
+		sagas = append(sagas, &saga)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating saga rows: %w", err)
+	}
+	return sagas, nil
+}
@@ -0,0 +1,330 @@
+package saga
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	warehousepb "logistics/api/protobuf/warehouse_service"
+	"logistics/internal/services/order-service/domain"
+	"logistics/internal/services/order-service/statemachine"
+	"logistics/internal/shared/entity"
+	"logistics/pkg/lib/logger/slogger"
+	"logistics/pkg/lib/utils"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	// compensationRetryInterval - первая задержка перед повтором компенсации и период проверки
+	compensationRetryInterval = 10 * time.Second
+	maxCompensationBackoff    = 5 * time.Minute
+)
+
+// CreateOrderSaga - оркестратор создания заказа: проверка склада, расчет цен, запись заказа,
+// резерв и списание товара, подтверждение. Прогресс сохраняется в order_sagas после каждого шага,
+// поэтому после перезапуска сервиса сага либо доводится до конца, либо компенсируется.
+type CreateOrderSaga struct {
+	logger              *slog.Logger
+	orderRepo           domain.OrderRepositoryInterface
+	sagaRepo            domain.SagaRepositoryInterface
+	warehouseGRPCClient warehousepb.WarehouseServiceClient
+	stateMachine        *statemachine.OrderStateMachine
+}
+
+func NewCreateOrderSaga(logger *slog.Logger, orderRepo domain.OrderRepositoryInterface, sagaRepo domain.SagaRepositoryInterface, warehouseClient warehousepb.WarehouseServiceClient) *CreateOrderSaga {
+	return &CreateOrderSaga{
+		logger:              logger,
+		orderRepo:           orderRepo,
+		sagaRepo:            sagaRepo,
+		warehouseGRPCClient: warehouseClient,
+		stateMachine:        statemachine.NewOrderStateMachine(),
+	}
+}
+
+// Execute проводит новый заказ через все шаги саги и возвращает подтвержденный заказ
+func (s *CreateOrderSaga) Execute(ctx context.Context, order *entity.Order) (*entity.Order, error) {
+	now := time.Now().Unix()
+	saga := &entity.OrderSaga{
+		UserID:    order.UserID,
+		State:     entity.SagaStarted,
+		Order:     *order,
+		CreatedAt: now,
+		UpdatedAt: now,
+	}
+	sagaID, err := s.sagaRepo.CreateSaga(ctx, saga)
+	if err != nil {
+		s.logger.Error("failed to start create order saga", slogger.Err(err))
+		return nil, err
+	}
+	saga.ID = sagaID
+
+	// Шаг 1: проверка наличия товара
+	stockResp, err := s.warehouseGRPCClient.CheckStockAvailability(ctx, &warehousepb.CheckStockRequest{
+		Items: utils.ConvertGoodsItemsToWarehouseStockItems(order.Items, order.CreatedAt),
+	})
+	if err != nil {
+		return nil, s.abort(ctx, saga, fmt.Errorf("stock check failed: %w", err))
+	}
+	if !stockResp.Available {
+		return nil, s.abort(ctx, saga, status.Error(codes.FailedPrecondition, "some items are out of stock"))
+	}
+
+	// Шаг 2: расчет цен по каталогу склада
+	names := make([]string, 0, len(order.Items))
+	for _, item := range order.Items {
+		names = append(names, item.ProductName)
+	}
+	pricesResp, err := s.warehouseGRPCClient.GetProductPrices(ctx, &warehousepb.GetProductPricesRequest{Names: names})
+	if err != nil {
+		return nil, s.abort(ctx, saga, fmt.Errorf("failed to get prices: %w", err))
+	}
+	products := make(map[string]*warehousepb.Product, len(pricesResp.Products))
+	for _, product := range pricesResp.Products {
+		products[product.Name] = product
+	}
+	order.TotalAmount = 0
+	for i := range order.Items {
+		item := &order.Items[i]
+		product, ok := products[item.ProductName]
+		if !ok {
+			return nil, s.abort(ctx, saga, status.Errorf(codes.NotFound, "product %q not found", item.ProductName))
+		}
+		item.ProductID = product.ProductId
+		item.Price = product.UnitPrice
+		item.TotalPrice = product.UnitPrice * float64(item.Quantity)
+		order.TotalAmount += item.TotalPrice
+	}
+
+	// Шаг 3: запись заказа вместе с переводом саги в order_created
+	orderID, err := s.sagaRepo.CreateOrderInSaga(ctx, saga.ID, order, time.Now().Unix())
+	if err != nil {
+		return nil, s.abort(ctx, saga, fmt.Errorf("failed to create order: %w", err))
+	}
+	order.ID = orderID
+	saga.OrderID = orderID
+	saga.Order = *order
+	saga.State = entity.SagaOrderCreated
+
+	if err := s.resume(ctx, saga); err != nil {
+		return nil, err
+	}
+	order.Status = entity.StatusConfirmed
+	order.Allocations = saga.Order.Allocations
+	return order, nil
+}
+
+// Recover доводит до конца или компенсирует саги, прерванные падением сервиса
+func (s *CreateOrderSaga) Recover(ctx context.Context) error {
+	sagas, err := s.sagaRepo.GetUnfinishedSagas(ctx)
+	if err != nil {
+		return err
+	}
+	for _, saga := range sagas {
+		s.logger.Info("recovering create order saga", slog.Int64("saga_id", saga.ID), slog.Int64("order_id", saga.OrderID), slog.String("state", string(saga.State)))
+		var err error
+		switch saga.State {
+		case entity.SagaStarted:
+			// Заказ создается атомарно с переходом в order_created, значит его нет
+			err = s.setState(ctx, saga, entity.SagaFailed, "interrupted before order creation")
+		case entity.SagaCompensating:
+			err = s.compensate(ctx, saga, errors.New(saga.Error))
+		default:
+			err = s.resume(ctx, saga)
+		}
+		if err != nil {
+			s.logger.Error("failed to recover create order saga", slog.Int64("saga_id", saga.ID), slogger.Err(err))
+		}
+	}
+	return nil
+}
+
+// RetryCompensations повторяет компенсации, не завершившиеся из-за сбоя склада, с экспоненциальной
+// задержкой до отмены ctx. Саги в остальных состояниях ведет Execute, а прерванные падением - Recover
+func (s *CreateOrderSaga) RetryCompensations(ctx context.Context) {
+	ticker := time.NewTicker(compensationRetryInterval)
+	defer ticker.Stop()
+
+	// attempts - число неудачных повторов по сагам; теряется при перезапуске, где саги подхватит Recover
+	attempts := make(map[int64]int)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			attempts = s.retryCompensations(ctx, attempts, time.Now())
+		}
+	}
+}
+
+func (s *CreateOrderSaga) retryCompensations(ctx context.Context, attempts map[int64]int, now time.Time) map[int64]int {
+	sagas, err := s.sagaRepo.GetUnfinishedSagas(ctx)
+	if err != nil {
+		s.logger.Error("failed to get unfinished sagas", slogger.Err(err))
+		return attempts
+	}
+	pending := make(map[int64]int)
+	for _, saga := range sagas {
+		if saga.State != entity.SagaCompensating {
+			continue
+		}
+		attempt := attempts[saga.ID]
+		// compensate обновляет updated_at при каждой попытке, поэтому это время последней из них
+		if now.Before(time.Unix(saga.UpdatedAt, 0).Add(compensationBackoff(attempt))) {
+			pending[saga.ID] = attempt
+			continue
+		}
+		s.logger.Info("retrying create order saga compensation", slog.Int64("saga_id", saga.ID), slog.Int64("order_id", saga.OrderID), slog.Int("attempt", attempt+1))
+		// compensate возвращает ошибку и при успехе - с причиной отказа заказа, поэтому исход смотрим по состоянию
+		s.compensate(ctx, saga, errors.New(saga.Error))
+		if saga.State == entity.SagaCompensating {
+			pending[saga.ID] = attempt + 1
+		}
+	}
+	return pending
+}
+
+func compensationBackoff(attempt int) time.Duration {
+	backoff := compensationRetryInterval << min(attempt, 10)
+	if backoff > maxCompensationBackoff {
+		return maxCompensationBackoff
+	}
+	return backoff
+}
+
+// resume выполняет шаги после записи заказа, начиная с сохраненного состояния
+func (s *CreateOrderSaga) resume(ctx context.Context, saga *entity.OrderSaga) error {
+	if saga.State == entity.SagaOrderCreated || saga.State == entity.SagaStockUpdating {
+		// Шаг 4: резерв и списание товара, оба вызова идемпотентны по order_id
+		if err := s.setState(ctx, saga, entity.SagaStockUpdating, ""); err != nil {
+			return err
+		}
+		reserveReq := &warehousepb.ReserveStockRequest{
+			OrderId: saga.OrderID,
+			Items:   utils.ConvertGoodsItemsToWarehouseStockItems(saga.Order.Items, time.Now().Unix()),
+			Time:    time.Now().Unix(),
+		}
+		// Склад выбирается по близости к точке доставки
+		if location := saga.Order.DeliveryLocation; location != nil {
+			reserveReq.DeliveryLocation = &warehousepb.Location{Latitude: location.Latitude, Longitude: location.Longitude}
+		}
+		reserveResp, err := s.warehouseGRPCClient.ReserveStock(ctx, reserveReq)
+		if err != nil {
+			return s.compensate(ctx, saga, fmt.Errorf("stock reservation failed: %w", err))
+		}
+		saga.Order.Allocations = utils.ConvertProtoToStockAllocations(reserveResp.Allocations)
+		if err := s.orderRepo.SaveOrderAllocations(ctx, saga.OrderID, saga.Order.Allocations); err != nil {
+			return s.compensate(ctx, saga, fmt.Errorf("failed to save stock allocations: %w", err))
+		}
+		_, err = s.warehouseGRPCClient.CommitReservation(ctx, &warehousepb.CommitReservationRequest{
+			OrderId: saga.OrderID,
+			Time:    time.Now().Unix(),
+		})
+		if err != nil {
+			return s.compensate(ctx, saga, fmt.Errorf("stock write-off failed: %w", err))
+		}
+		if err := s.setState(ctx, saga, entity.SagaStockUpdated, ""); err != nil {
+			return err
+		}
+	}
+
+	if saga.State == entity.SagaStockUpdated {
+		// Шаг 5: подтверждение заказа - товар зарезервирован
+		if err := s.changeOrderStatus(ctx, saga, entity.StatusConfirmed, "stock reserved"); err != nil {
+			return s.compensate(ctx, saga, fmt.Errorf("order confirmation failed: %w", err))
+		}
+		return s.setState(ctx, saga, entity.SagaCompleted, "")
+	}
+	return nil
+}
+
+// compensate возвращает списанный товар и переводит заказ в failed
+func (s *CreateOrderSaga) compensate(ctx context.Context, saga *entity.OrderSaga, cause error) error {
+	// Компенсация должна завершиться, даже если клиент уже отменил запрос
+	ctx = context.WithoutCancel(ctx)
+	s.logger.Warn("compensating create order saga", slog.Int64("saga_id", saga.ID), slog.Int64("order_id", saga.OrderID), slogger.Err(cause))
+
+	if err := s.setState(ctx, saga, entity.SagaCompensating, cause.Error()); err != nil {
+		return err
+	}
+
+	// Снимаем резерв, если списание до него не дошло
+	_, err := s.warehouseGRPCClient.ReleaseReservation(ctx, &warehousepb.ReleaseReservationRequest{
+		OrderId: saga.OrderID,
+		Time:    time.Now().Unix(),
+	})
+	if err != nil {
+		s.logger.Error("failed to release reservation, saga stays in compensating state", slog.Int64("saga_id", saga.ID), slogger.Err(err))
+		return status.Errorf(codes.Internal, "order %d failed and stock release is pending: %v", saga.OrderID, cause)
+	}
+
+	// Склад вернет товар, только если он действительно был списан по этому заказу
+	_, err = s.warehouseGRPCClient.ReturnStock(ctx, &warehousepb.ReturnStockRequest{
+		OrderId: saga.OrderID,
+		Items:   utils.ConvertGoodsItemsToWarehouseStockItems(saga.Order.Items, time.Now().Unix()),
+		Time:    time.Now().Unix(),
+	})
+	if err != nil {
+		s.logger.Error("failed to restore stock, saga stays in compensating state", slog.Int64("saga_id", saga.ID), slogger.Err(err))
+		return status.Errorf(codes.Internal, "order %d failed and stock restore is pending: %v", saga.OrderID, cause)
+	}
+
+	if err := s.changeOrderStatus(ctx, saga, entity.StatusFailed, cause.Error()); err != nil {
+		s.logger.Error("failed to mark order as failed", slog.Int64("order_id", saga.OrderID), slogger.Err(err))
+		return status.Errorf(codes.Internal, "order %d failed: %v", saga.OrderID, cause)
+	}
+
+	if err := s.setState(ctx, saga, entity.SagaCompensated, cause.Error()); err != nil {
+		return err
+	}
+	if code := status.Code(cause); code != codes.Unknown {
+		return status.Errorf(code, "order %d failed: %v", saga.OrderID, cause)
+	}
+	return status.Errorf(codes.Aborted, "order %d failed: %v", saga.OrderID, cause)
+}
+
+// abort завершает сагу, прерванную до создания заказа
+func (s *CreateOrderSaga) abort(ctx context.Context, saga *entity.OrderSaga, cause error) error {
+	s.logger.Warn("create order saga aborted", slog.Int64("saga_id", saga.ID), slogger.Err(cause))
+	if err := s.setState(context.WithoutCancel(ctx), saga, entity.SagaFailed, cause.Error()); err != nil {
+		s.logger.Error("failed to persist aborted saga", slog.Int64("saga_id", saga.ID), slogger.Err(err))
+	}
+	return cause
+}
+
+// changeOrderStatus переводит заказ саги в новый статус, если переход еще возможен
+func (s *CreateOrderSaga) changeOrderStatus(ctx context.Context, saga *entity.OrderSaga, to entity.OrderStatus, reason string) error {
+	current, err := s.orderRepo.CheckDeliveryStatus(ctx, saga.UserID, saga.OrderID)
+	if err != nil {
+		return err
+	}
+	from := entity.OrderStatus(current)
+	if from == to {
+		return nil
+	}
+	// Заказ уже отменен или завершен другим путем - переводить его некуда
+	if to == entity.StatusFailed && s.stateMachine.IsTerminal(from) {
+		return nil
+	}
+	if err := s.stateMachine.Transition(from, to); err != nil {
+		return err
+	}
+	return s.orderRepo.UpdateOrderStatus(ctx, saga.UserID, saga.OrderID, 0, &entity.OrderStatusChange{
+		OldStatus: from,
+		NewStatus: to,
+		ActorType: entity.ActorSystem,
+		Reason:    reason,
+		ChangedAt: time.Now().Unix(),
+	})
+}
+
+func (s *CreateOrderSaga) setState(ctx context.Context, saga *entity.OrderSaga, state entity.SagaState, sagaErr string) error {
+	if err := s.sagaRepo.UpdateSagaState(ctx, saga.ID, state, sagaErr, time.Now().Unix()); err != nil {
+		return err
+	}
+	saga.State = state
+	saga.Error = sagaErr
+	return nil
+}
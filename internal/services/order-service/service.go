@@ -3,36 +3,54 @@ package orderservice
 import (
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
 	"log/slog"
+	driverpb "logistics/api/protobuf/driver_service"
 	orderpb "logistics/api/protobuf/order_service"
+	warehousepb "logistics/api/protobuf/warehouse_service"
 	kfk "logistics/internal/kafka"
+	"logistics/internal/services/order-service/assignment"
 	"logistics/internal/services/order-service/domain"
+	"logistics/internal/services/order-service/saga"
+	"logistics/internal/services/order-service/statemachine"
+	"logistics/internal/services/order-service/tracking"
 	"logistics/internal/shared/entity"
 	"logistics/pkg/lib/logger/slogger"
 	"logistics/pkg/lib/utils"
 	"time"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/redis/go-redis/v9"
-	"github.com/segmentio/kafka-go"
 	"google.golang.org/grpc"
-	"google.golang.org/protobuf/types/known/timestamppb"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 )
 
 type OrderGRPCService struct {
 	orderpb.UnimplementedOrderServiceServer
-	orderRepo     domain.OrderRepositoryInterface
-	logger        *slog.Logger
-	redisClient   *redis.Client
-	kafkaConsumer *kfk.KafkaConsumer
+	orderRepo           domain.OrderRepositoryInterface
+	logger              *slog.Logger
+	redisClient         *redis.Client
+	assignments         *assignment.Manager
+	stateMachine        *statemachine.OrderStateMachine
+	warehouseGRPCClient warehousepb.WarehouseServiceClient
+	driverGRPCClient    driverpb.DriverServiceClient
+	createOrderSaga     *saga.CreateOrderSaga
+	tracker             *tracking.Hub
 }
 
-func NewOrderGRPCService(logger *slog.Logger, orderRepo domain.OrderRepositoryInterface, kafkaConsumer *kfk.KafkaConsumer, redisClient *redis.Client) *OrderGRPCService {
+func NewOrderGRPCService(logger *slog.Logger, orderRepo domain.OrderRepositoryInterface, assignments *assignment.Manager, redisClient *redis.Client, warehouseClient warehousepb.WarehouseServiceClient, driverClient driverpb.DriverServiceClient, createOrderSaga *saga.CreateOrderSaga, tracker *tracking.Hub) *OrderGRPCService {
 	return &OrderGRPCService{
-		orderRepo:     orderRepo,
-		logger:        logger,
-		redisClient:   redisClient,
-		kafkaConsumer: kafkaConsumer,
+		orderRepo:           orderRepo,
+		logger:              logger,
+		redisClient:         redisClient,
+		assignments:         assignments,
+		stateMachine:        statemachine.NewOrderStateMachine(),
+		warehouseGRPCClient: warehouseClient,
+		driverGRPCClient:    driverClient,
+		createOrderSaga:     createOrderSaga,
+		tracker:             tracker,
 	}
 }
 
@@ -41,44 +59,42 @@ func RegisterOrderServiceServer(s *grpc.Server, srv *OrderGRPCService) {
 }
 
 func (o *OrderGRPCService) GetOrderItemInfo(ctx context.Context, req *orderpb.GetOrderItemInfoRequest) (*orderpb.GetOrderItemInfoResponse, error) {
-	product_id, price, err := o.orderRepo.GetOrderItemInfo(ctx, req.ProductName)
+	// Цены принадлежат каталогу warehouse-service
+	resp, err := o.warehouseGRPCClient.GetProductPrices(ctx, &warehousepb.GetProductPricesRequest{
+		Names: []string{req.ProductName},
+	})
 	if err != nil {
 		o.logger.Error("failed to get item price", slog.String("status", "error"), slogger.Err(err))
 		return nil, err
 	}
+	if len(resp.Products) == 0 {
+		return nil, status.Errorf(codes.NotFound, "product %q not found", req.ProductName)
+	}
 	return &orderpb.GetOrderItemInfoResponse{
-		ProductId: int64(product_id),
-		Price:     price,
+		ProductId: resp.Products[0].ProductId,
+		Price:     resp.Products[0].UnitPrice,
 	}, nil
 }
 
 func (o *OrderGRPCService) CreateOrder(ctx context.Context, req *orderpb.CreateOrderRequest) (*orderpb.CreateOrderResponse, error) {
+	if err := o.stateMachine.Transition("", entity.StatusPending); err != nil {
+		return nil, err
+	}
 	order := &entity.Order{
-		UserID:          req.UserId,
-		Status:          entity.StatusPending,
-		Items:           utils.ConvertOrderItemToGoodsItem(req.Items),
-		DeliveryAddress: req.DeliveryAddress,
-		CreatedAt:       req.Time,
-	}
-	order.TotalAmount = 0
-	for _, item := range order.Items {
-
-		product_id, price, err := o.orderRepo.GetOrderItemInfo(ctx, item.ProductName)
-		if err != nil {
-			o.logger.Error("failed to get item price", slog.String("status", "error"), slogger.Err(err))
-			return nil, err
-		}
-		item.ProductID = int64(product_id)
-		item.Price = price
-		item.TotalPrice = price * float64(item.Quantity)
-		order.TotalAmount += item.Price * float64(item.Quantity)
+		UserID:           req.UserId,
+		Status:           entity.StatusPending,
+		Items:            utils.ConvertOrderItemToGoodsItem(req.Items),
+		DeliveryAddress:  req.DeliveryAddress,
+		CreatedAt:        req.Time,
+		DeliveryLocation: utils.ConvertOrderLocationToEntity(req.DeliveryLocation),
 	}
-
-	orderID, err := o.orderRepo.CreateOrder(ctx, order)
+	// Создание заказа проходит через сагу: склад, цены, запись заказа, списание и подтверждение
+	order, err := o.createOrderSaga.Execute(ctx, order)
 	if err != nil {
 		o.logger.Error("failed to create order", slog.String("status", "error"), slogger.Err(err))
 		return nil, err
 	}
+	orderID := order.ID
 
 	orderJSON, err := json.Marshal(*order)
 	if err != nil {
@@ -92,94 +108,86 @@ func (o *OrderGRPCService) CreateOrder(ctx context.Context, req *orderpb.CreateO
 	}
 
 	return &orderpb.CreateOrderResponse{
-		Order: &orderpb.Order{
-			Id:          orderID,
-			UserId:      order.UserID,
-			Items:       utils.ConvertGoodsItemSliceToOrderItemSlice(order.Items),
-			TotalAmount: order.TotalAmount,
-			CreatedAt:   timestamppb.New(time.Unix(order.CreatedAt, 0)),
-			Status:      string(order.Status),
-		},
+		Order: utils.ConvertOrderToProto(order),
 	}, nil
 
 }
 
+// AssignDriver - синхронная обертка над очередью назначений: ставит задачу и ждет ее завершения
 func (o *OrderGRPCService) AssignDriver(ctx context.Context, req *orderpb.AssignDriverRequest) (*orderpb.AssignDriverResponse, error) {
-	out := make(chan *kafka.Message, 1)
-	errCh := make(chan error, 1)
-
-	go func() {
-		kafkaMessage, err := o.kafkaConsumer.ReadMessage(ctx)
-		if err != nil {
-			errCh <- err
-			return
-		}
-		out <- kafkaMessage
-	}()
-
-	select {
-
-	case kafkamessage := <-out:
+	job, err := o.assignments.Enqueue(ctx, req.UserId, req.OrderId)
+	if err != nil {
+		o.logger.Error("failed to enqueue driver assignment", slog.Int64("order_id", req.OrderId), slogger.Err(err))
+		return nil, err
+	}
 
-		var message entity.DriverKafka
-		err := json.Unmarshal(kafkamessage.Value, &message)
-		if err != nil {
-			o.logger.Error("Failed to unmarshal message", slog.String("error", err.Error()))
-			return &orderpb.AssignDriverResponse{}, err
+	ticker := time.NewTicker(500 * time.Millisecond)
+	defer ticker.Stop()
+	for !job.Status.IsFinished() {
+		select {
+		case <-ctx.Done():
+			return nil, ctx.Err()
+		case <-ticker.C:
 		}
-		o.logger.Info("Received Kafka message", slog.String("key", string(kafkamessage.Key)), slog.String("value", string(kafkamessage.Value)))
-
-		stats, err := o.UpdateOrderStatus(ctx, &orderpb.UpdateOrderStatusRequest{
-			UserId:   req.UserId,
-			OrderId:  req.OrderId,
-			DriverId: message.ID,
-			Status:   string(entity.StatusInProgress),
-		})
+		job, err = o.assignments.Get(ctx, req.UserId, job.ID)
 		if err != nil {
-			o.logger.Error("Failed to update order status", slog.String("status", "error"), slog.String("error", err.Error()))
-			return &orderpb.AssignDriverResponse{}, err
-		}
-		if !stats.Success {
-			o.logger.Error("Not success, failed to update order status")
-			return &orderpb.AssignDriverResponse{}, err
+			return nil, err
 		}
+	}
 
-		if err := o.kafkaConsumer.CommitMessage(ctx, kafkamessage); err != nil {
-			o.logger.Error("Failed to commit message", slog.String("error", err.Error()))
-			return &orderpb.AssignDriverResponse{}, err
-		}
-		return &orderpb.AssignDriverResponse{
-			DriverId: message.ID,
-			OrderId:  req.OrderId,
-			Success:  true,
-			Message:  fmt.Sprintf("Driver: %s, Phone: %s, Car: %s, Licence Number: %s", message.Name, message.Phone, message.Car, message.LicenseNumber),
-		}, nil
+	if job.Status == entity.AssignmentFailed {
+		return nil, status.Errorf(codes.FailedPrecondition, "driver assignment failed: %s", job.Error)
+	}
+	return &orderpb.AssignDriverResponse{
+		DriverId: job.DriverID,
+		OrderId:  req.OrderId,
+		Success:  true,
+		Message:  fmt.Sprintf("Driver %d assigned", job.DriverID),
+	}, nil
+}
 
-	case err := <-errCh:
-		o.logger.Error("Failed to consume message", slog.String("error", err.Error()))
+func (o *OrderGRPCService) RequestDriverAssignment(ctx context.Context, req *orderpb.RequestDriverAssignmentRequest) (*orderpb.RequestDriverAssignmentResponse, error) {
+	job, err := o.assignments.Enqueue(ctx, req.UserId, req.OrderId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "order %d not found", req.OrderId)
+	}
+	if err != nil {
+		o.logger.Error("failed to enqueue driver assignment", slog.Int64("order_id", req.OrderId), slogger.Err(err))
 		return nil, err
-
-	case <-ctx.Done():
-		o.logger.Error("Context cancelled", slog.String("error", ctx.Err().Error()))
-		return nil, ctx.Err()
 	}
+	return &orderpb.RequestDriverAssignmentResponse{
+		Job: utils.ConvertAssignmentJobToProto(job),
+	}, nil
+}
 
+func (o *OrderGRPCService) GetAssignmentJob(ctx context.Context, req *orderpb.GetAssignmentJobRequest) (*orderpb.GetAssignmentJobResponse, error) {
+	job, err := o.assignments.Get(ctx, req.UserId, req.JobId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "assignment job %d not found", req.JobId)
+	}
+	if err != nil {
+		o.logger.Error("failed to get assignment job", slog.Int64("job_id", req.JobId), slogger.Err(err))
+		return nil, err
+	}
+	return &orderpb.GetAssignmentJobResponse{
+		Job: utils.ConvertAssignmentJobToProto(job),
+	}, nil
 }
 
 func (o *OrderGRPCService) CompleteDelivery(ctx context.Context, req *orderpb.CompleteDeliveryRequest) (*orderpb.CompleteDeliveryResponse, error) {
-	status, err := o.orderRepo.CheckDeliveryStatus(ctx, req.UserId, req.OrderId)
+	orderStatus, err := o.orderRepo.CheckDeliveryStatus(ctx, req.UserId, req.OrderId)
 	if err != nil {
 		o.logger.Error("failed to check delivery status", slog.String("status", "error"), slogger.Err(err))
 		return nil, err
 	}
-	if status != string(entity.StatusInProgress) {
-		return &orderpb.CompleteDeliveryResponse{
-			Success: false,
-			Message: fmt.Sprintf("Cannot complete delivery. Current order status is: %s", status),
-		}, nil
+	if err := o.stateMachine.Transition(entity.OrderStatus(orderStatus), entity.StatusDelivered); err != nil {
+		return nil, err
 	}
-	driverID, err := o.orderRepo.CompleteDelivery(ctx, req.UserId, req.OrderId)
+	driverID, err := o.orderRepo.CompleteDelivery(ctx, req.UserId, req.OrderId, time.Now().Unix())
 	if err != nil {
+		if errors.Is(err, domain.ErrOrderStatusConflict) {
+			return nil, status.Error(codes.Aborted, err.Error())
+		}
 		o.logger.Error("failed to complete delivery", slog.String("status", "error"), slogger.Err(err))
 		return nil, err
 	}
@@ -191,6 +199,83 @@ func (o *OrderGRPCService) CompleteDelivery(ctx context.Context, req *orderpb.Co
 
 }
 
+func (o *OrderGRPCService) CancelOrder(ctx context.Context, req *orderpb.CancelOrderRequest) (*orderpb.CancelOrderResponse, error) {
+	order, err := o.orderRepo.GetOrderDetails(ctx, req.UserId, req.OrderId)
+	if err != nil {
+		if errors.Is(err, pgx.ErrNoRows) {
+			return nil, status.Errorf(codes.NotFound, "order %d not found", req.OrderId)
+		}
+		o.logger.Error("failed to get order for cancellation", slog.String("status", "error"), slogger.Err(err))
+		return nil, err
+	}
+	if order.UserID != req.UserId {
+		return nil, status.Errorf(codes.NotFound, "order %d not found", req.OrderId)
+	}
+
+	reason := req.Reason
+	if reason == "" {
+		reason = "cancelled by user"
+	}
+
+	// Переход проверяется машиной состояний и CAS-обновлением, поэтому отмену зафиксирует только один запрос
+	if err := o.stateMachine.Transition(order.Status, entity.StatusCancelled); err != nil {
+		o.logger.Warn("rejected order status transition", slog.Int64("order_id", req.OrderId), slog.String("from", string(order.Status)), slog.String("to", string(entity.StatusCancelled)))
+		return nil, err
+	}
+	now := time.Now().Unix()
+	change := &entity.OrderStatusChange{
+		OldStatus: order.Status,
+		NewStatus: entity.StatusCancelled,
+		ActorType: entity.ActorUser,
+		ActorID:   req.UserId,
+		Reason:    reason,
+		ChangedAt: now,
+	}
+	resp := &orderpb.CancelOrderResponse{
+		Success:        true,
+		PreviousStatus: string(order.Status),
+		Message:        fmt.Sprintf("Order %d cancelled: %s", req.OrderId, reason),
+	}
+
+	// Возврат товара и освобождение водителя пишутся в outbox вместе с отменой
+	// и исполняются с повторами, поэтому сбой склада или driver-service их не потеряет
+	var commands []kfk.OutboxMessage
+	// Товар списывается со склада при создании заказа, поэтому возвращаем его из любого отменяемого статуса
+	if len(order.Items) > 0 {
+		command, err := domain.NewReturnStockCommand(req.OrderId, order.Items, now)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to cancel order: %v", err)
+		}
+		commands = append(commands, command)
+		resp.StockReturned = true
+	}
+	// Водитель закреплен за заказом только после назначения
+	if order.DriverID != nil && *order.DriverID != 0 && (order.Status == entity.StatusAssigned || order.Status == entity.StatusInProgress) {
+		command, err := domain.NewReleaseDriverCommand(req.OrderId, *order.DriverID, 0, now)
+		if err != nil {
+			return nil, status.Errorf(codes.Internal, "failed to cancel order: %v", err)
+		}
+		commands = append(commands, command)
+		resp.ReleasedDriverId = *order.DriverID
+	}
+
+	err = o.orderRepo.CancelOrder(ctx, req.UserId, req.OrderId, change, commands)
+	if errors.Is(err, domain.ErrOrderStatusConflict) {
+		return nil, status.Errorf(codes.Aborted, "order %d status was changed concurrently, retry the request", req.OrderId)
+	}
+	if err != nil {
+		o.logger.Error("failed to cancel order", slog.Int64("order_id", req.OrderId), slogger.Err(err))
+		return nil, status.Errorf(codes.Internal, "failed to cancel order: %v", err)
+	}
+	// Закешированный заказ устарел
+	if err := o.redisClient.Del(ctx, fmt.Sprintf("user:%d_order:%d", req.UserId, req.OrderId)).Err(); err != nil {
+		o.logger.Error("failed to invalidate cached order", slogger.Err(err))
+	}
+
+	o.logger.Info("order cancelled", slog.Int64("order_id", req.OrderId), slog.String("previous_status", string(order.Status)))
+	return resp, nil
+}
+
 func (o *OrderGRPCService) GetDeliveries(ctx context.Context, req *orderpb.GetDeliveriesByUserRequest) (*orderpb.GetDeliveriesByUserResponse, error) {
 	res, err := o.orderRepo.GetDeliveriesByUser(ctx, req.UserId)
 	if err != nil {
@@ -199,29 +284,7 @@ func (o *OrderGRPCService) GetDeliveries(ctx context.Context, req *orderpb.GetDe
 	}
 	orders := make([]*orderpb.Order, 0, len(res))
 	for _, order := range res {
-		items := make([]*orderpb.OrderItem, 0, len(order.Items))
-		for _, item := range order.Items {
-			items = append(items, &orderpb.OrderItem{
-				ProductId:   item.ProductID,
-				ProductName: item.ProductName,
-				Price:       item.Price,
-				Quantity:    item.Quantity,
-			})
-		}
-		var driverID int64
-		if order.DriverID != nil {
-			driverID = *order.DriverID
-		}
-		orders = append(orders, &orderpb.Order{
-			Id:              order.ID,
-			UserId:          order.UserID,
-			Status:          string(order.Status),
-			DeliveryAddress: order.DeliveryAddress,
-			Items:           items,
-			TotalAmount:     order.TotalAmount,
-			DriverId:        driverID,
-			CreatedAt:       timestamppb.New(time.Unix(order.CreatedAt, 0)),
-		})
+		orders = append(orders, utils.ConvertOrderToProto(order))
 	}
 	return &orderpb.GetDeliveriesByUserResponse{
 		Deliveries: orders,
@@ -238,31 +301,8 @@ func (o *OrderGRPCService) GetOrderDetails(ctx context.Context, req *orderpb.Get
 		if err != nil {
 			o.logger.Error("failed to unmarshal order from redis", slogger.Err(err))
 		} else {
-			items := make([]*orderpb.OrderItem, 0, len(order.Items))
-			for _, item := range order.Items {
-				items = append(items, &orderpb.OrderItem{
-					ProductId:   item.ProductID,
-					ProductName: item.ProductName,
-					Price:       item.Price,
-					Quantity:    item.Quantity,
-					TotalPrice:  item.TotalPrice,
-				})
-			}
-			var driverID int64
-			if order.DriverID != nil {
-				driverID = *order.DriverID
-			}
 			return &orderpb.GetOrderDetailsResponse{
-				Order: &orderpb.Order{
-					Id:              order.ID,
-					UserId:          order.UserID,
-					Status:          string(order.Status),
-					DeliveryAddress: order.DeliveryAddress,
-					Items:           items,
-					TotalAmount:     order.TotalAmount,
-					DriverId:        driverID,
-					CreatedAt:       timestamppb.New(time.Unix(order.CreatedAt, 0)),
-				},
+				Order: utils.ConvertOrderToProto(&order),
 			}, nil
 		}
 	}
@@ -275,31 +315,8 @@ func (o *OrderGRPCService) GetOrderDetails(ctx context.Context, req *orderpb.Get
 	if order == nil {
 		return &orderpb.GetOrderDetailsResponse{}, nil
 	}
-	items := make([]*orderpb.OrderItem, 0, len(order.Items))
-	for _, item := range order.Items {
-		items = append(items, &orderpb.OrderItem{
-			ProductId:   item.ProductID,
-			ProductName: item.ProductName,
-			Price:       item.Price,
-			Quantity:    item.Quantity,
-			TotalPrice:  item.TotalPrice,
-		})
-	}
-	var driverID int64
-	if order.DriverID != nil {
-		driverID = *order.DriverID
-	}
 	return &orderpb.GetOrderDetailsResponse{
-		Order: &orderpb.Order{
-			Id:              order.ID,
-			UserId:          order.UserID,
-			Status:          string(order.Status),
-			DeliveryAddress: order.DeliveryAddress,
-			Items:           items,
-			TotalAmount:     order.TotalAmount,
-			DriverId:        driverID,
-			CreatedAt:       timestamppb.New(time.Unix(order.CreatedAt, 0)),
-		},
+		Order: utils.ConvertOrderToProto(order),
 	}, nil
 }
 
@@ -311,30 +328,7 @@ func (o *OrderGRPCService) GetOrdersByUser(ctx context.Context, req *orderpb.Get
 	}
 	orders := make([]*orderpb.Order, 0, len(res))
 	for _, order := range res {
-		items := make([]*orderpb.OrderItem, 0, len(order.Items))
-		for _, item := range order.Items {
-			items = append(items, &orderpb.OrderItem{
-				ProductId:   item.ProductID,
-				ProductName: item.ProductName,
-				Price:       item.Price,
-				Quantity:    item.Quantity,
-				TotalPrice:  item.TotalPrice,
-			})
-		}
-		var driverID int64
-		if order.DriverID != nil {
-			driverID = *order.DriverID
-		}
-		orders = append(orders, &orderpb.Order{
-			Id:              order.ID,
-			UserId:          order.UserID,
-			Status:          string(order.Status),
-			DeliveryAddress: order.DeliveryAddress,
-			Items:           items,
-			TotalAmount:     order.TotalAmount,
-			DriverId:        driverID,
-			CreatedAt:       timestamppb.New(time.Unix(order.CreatedAt, 0)),
-		})
+		orders = append(orders, utils.ConvertOrderToProto(order))
 	}
 	return &orderpb.GetOrdersByUserResponse{
 		Orders: orders,
@@ -352,8 +346,55 @@ func (o *OrderGRPCService) CheckOrderStatus(ctx context.Context, req *orderpb.Ch
 	}, nil
 }
 
+func (o *OrderGRPCService) GetOrderTimeline(ctx context.Context, req *orderpb.GetOrderTimelineRequest) (*orderpb.GetOrderTimelineResponse, error) {
+	res, err := o.orderRepo.GetOrderStatusHistory(ctx, req.UserId, req.OrderId)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, status.Errorf(codes.NotFound, "order %d not found", req.OrderId)
+	}
+	if err != nil {
+		o.logger.Error("failed to get order timeline", slog.String("status", "error"), slogger.Err(err))
+		return nil, status.Errorf(codes.Internal, "failed to get order timeline: %v", err)
+	}
+	changes := make([]*orderpb.OrderStatusChange, 0, len(res))
+	for _, change := range res {
+		changes = append(changes, utils.ConvertOrderStatusChangeToProto(change))
+	}
+	return &orderpb.GetOrderTimelineResponse{
+		Changes: changes,
+	}, nil
+}
+
 func (o *OrderGRPCService) UpdateOrderStatus(ctx context.Context, req *orderpb.UpdateOrderStatusRequest) (*orderpb.UpdateOrderStatusResponse, error) {
-	err := o.orderRepo.UpdateOrderStatus(ctx, req.UserId, req.OrderId, req.DriverId, req.Status)
+	currentStatus, err := o.orderRepo.CheckDeliveryStatus(ctx, req.UserId, req.OrderId)
+	if err != nil {
+		o.logger.Error("failed to check order status", slog.String("status", "error"), slogger.Err(err))
+		return nil, err
+	}
+	return o.updateOrderStatus(ctx, entity.OrderStatus(currentStatus), req)
+}
+
+// updateOrderStatus применяет переход из уже прочитанного статуса fromStatus
+func (o *OrderGRPCService) updateOrderStatus(ctx context.Context, fromStatus entity.OrderStatus, req *orderpb.UpdateOrderStatusRequest) (*orderpb.UpdateOrderStatusResponse, error) {
+	if err := o.stateMachine.Transition(fromStatus, entity.OrderStatus(req.Status)); err != nil {
+		o.logger.Warn("rejected order status transition", slog.Int64("order_id", req.OrderId), slog.String("from", string(fromStatus)), slog.String("to", req.Status))
+		return nil, err
+	}
+	change := &entity.OrderStatusChange{
+		OldStatus: fromStatus,
+		NewStatus: entity.OrderStatus(req.Status),
+		ActorType: entity.ActorType(req.ActorType),
+		ActorID:   req.ActorId,
+		Reason:    req.Reason,
+		ChangedAt: time.Now().Unix(),
+	}
+	if change.ActorType == "" {
+		change.ActorType = entity.ActorUser
+		change.ActorID = req.UserId
+	}
+	err := o.orderRepo.UpdateOrderStatus(ctx, req.UserId, req.OrderId, req.DriverId, change)
+	if errors.Is(err, domain.ErrOrderStatusConflict) {
+		return nil, status.Errorf(codes.Aborted, "order %d status was changed concurrently, retry the request", req.OrderId)
+	}
 	if err != nil {
 		o.logger.Error("failed to update order status", slog.String("status", "error"), slogger.Err(err))
 		return &orderpb.UpdateOrderStatusResponse{
@@ -0,0 +1,91 @@
+package statemachine
+
+import (
+	"logistics/internal/shared/entity"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// OrderStateMachine - единственное место, где описаны допустимые переходы статусов заказа.
+// Заказ может двигаться только вперед по жизненному циклу, терминальные статусы не меняются.
+type OrderStateMachine struct {
+	transitions map[entity.OrderStatus]map[entity.OrderStatus]struct{}
+}
+
+func NewOrderStateMachine() *OrderStateMachine {
+	return &OrderStateMachine{
+		transitions: map[entity.OrderStatus]map[entity.OrderStatus]struct{}{
+			// пустой статус - заказ еще не создан
+			"": set(entity.StatusPending),
+			entity.StatusPending: set(
+				entity.StatusConfirmed,
+				entity.StatusRouteReady,
+				entity.StatusAssigned,
+				entity.StatusInProgress,
+				entity.StatusCancelled,
+				entity.StatusFailed,
+			),
+			entity.StatusConfirmed: set(
+				entity.StatusRouteReady,
+				entity.StatusAssigned,
+				entity.StatusInProgress,
+				entity.StatusCancelled,
+				entity.StatusFailed,
+			),
+			entity.StatusRouteReady: set(
+				entity.StatusAssigned,
+				entity.StatusInProgress,
+				entity.StatusCancelled,
+				entity.StatusFailed,
+			),
+			entity.StatusAssigned: set(
+				entity.StatusInProgress,
+				entity.StatusCancelled,
+				entity.StatusFailed,
+			),
+			entity.StatusInProgress: set(
+				entity.StatusDelivered,
+				entity.StatusCancelled,
+				entity.StatusFailed,
+			),
+			entity.StatusDelivered: set(),
+			entity.StatusCancelled: set(),
+			entity.StatusFailed:    set(),
+		},
+	}
+}
+
+// CanTransition сообщает, разрешен ли переход from -> to.
+func (m *OrderStateMachine) CanTransition(from, to entity.OrderStatus) bool {
+	allowed, ok := m.transitions[from]
+	if !ok {
+		return false
+	}
+	_, ok = allowed[to]
+	return ok
+}
+
+// Transition проверяет переход и возвращает gRPC-ошибку FailedPrecondition, если он запрещен.
+func (m *OrderStateMachine) Transition(from, to entity.OrderStatus) error {
+	if _, ok := m.transitions[to]; !ok || to == "" {
+		return status.Errorf(codes.InvalidArgument, "unknown order status: %q", to)
+	}
+	if !m.CanTransition(from, to) {
+		return status.Errorf(codes.FailedPrecondition, "order status transition from %q to %q is not allowed", from, to)
+	}
+	return nil
+}
+
+// IsTerminal возвращает true для статусов, из которых нет переходов.
+func (m *OrderStateMachine) IsTerminal(s entity.OrderStatus) bool {
+	return len(m.transitions[s]) == 0
+}
+
+func set(statuses ...entity.OrderStatus) map[entity.OrderStatus]struct{} {
+	res := make(map[entity.OrderStatus]struct{}, len(statuses))
+	for _, s := range statuses {
+		res[s] = struct{}{}
+	}
+	return res
+}
@@ -0,0 +1,101 @@
+package statemachine
+
+import (
+	"logistics/internal/shared/entity"
+	"slices"
+	"testing"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+var allStatuses = []entity.OrderStatus{
+	entity.StatusPending,
+	entity.StatusConfirmed,
+	entity.StatusRouteReady,
+	entity.StatusAssigned,
+	entity.StatusInProgress,
+	entity.StatusDelivered,
+	entity.StatusCancelled,
+	entity.StatusFailed,
+}
+
+// allowed - ожидаемая таблица переходов; все, чего в ней нет, запрещено
+var allowed = map[entity.OrderStatus][]entity.OrderStatus{
+	"": {entity.StatusPending},
+	entity.StatusPending: {
+		entity.StatusConfirmed, entity.StatusRouteReady, entity.StatusAssigned,
+		entity.StatusInProgress, entity.StatusCancelled, entity.StatusFailed,
+	},
+	entity.StatusConfirmed: {
+		entity.StatusRouteReady, entity.StatusAssigned, entity.StatusInProgress,
+		entity.StatusCancelled, entity.StatusFailed,
+	},
+	entity.StatusRouteReady: {
+		entity.StatusAssigned, entity.StatusInProgress, entity.StatusCancelled, entity.StatusFailed,
+	},
+	entity.StatusAssigned: {
+		entity.StatusInProgress, entity.StatusCancelled, entity.StatusFailed,
+	},
+	entity.StatusInProgress: {
+		entity.StatusDelivered, entity.StatusCancelled, entity.StatusFailed,
+	},
+}
+
+func TestCanTransition(t *testing.T) {
+	m := NewOrderStateMachine()
+	from := append([]entity.OrderStatus{""}, allStatuses...)
+	for _, f := range from {
+		for _, to := range allStatuses {
+			want := slices.Contains(allowed[f], to)
+			if got := m.CanTransition(f, to); got != want {
+				t.Errorf("CanTransition(%q, %q) = %v, want %v", f, to, got, want)
+			}
+		}
+	}
+}
+
+func TestTransition(t *testing.T) {
+	m := NewOrderStateMachine()
+	tests := []struct {
+		name string
+		from entity.OrderStatus
+		to   entity.OrderStatus
+		code codes.Code
+	}{
+		{"create", "", entity.StatusPending, codes.OK},
+		{"assign", entity.StatusConfirmed, entity.StatusAssigned, codes.OK},
+		{"deliver", entity.StatusInProgress, entity.StatusDelivered, codes.OK},
+		{"skip to delivered", entity.StatusPending, entity.StatusDelivered, codes.FailedPrecondition},
+		{"move backwards", entity.StatusAssigned, entity.StatusPending, codes.FailedPrecondition},
+		{"same status", entity.StatusAssigned, entity.StatusAssigned, codes.FailedPrecondition},
+		{"leave delivered", entity.StatusDelivered, entity.StatusCancelled, codes.FailedPrecondition},
+		{"leave cancelled", entity.StatusCancelled, entity.StatusInProgress, codes.FailedPrecondition},
+		{"leave failed", entity.StatusFailed, entity.StatusPending, codes.FailedPrecondition},
+		{"unknown from", "lost", entity.StatusPending, codes.FailedPrecondition},
+		{"unknown to", entity.StatusPending, "lost", codes.InvalidArgument},
+		{"empty to", entity.StatusPending, "", codes.InvalidArgument},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			err := m.Transition(tt.from, tt.to)
+			if got := status.Code(err); got != tt.code {
+				t.Fatalf("Transition(%q, %q) code = %v, want %v (err: %v)", tt.from, tt.to, got, tt.code, err)
+			}
+		})
+	}
+}
+
+func TestIsTerminal(t *testing.T) {
+	m := NewOrderStateMachine()
+	terminal := map[entity.OrderStatus]bool{
+		entity.StatusDelivered: true,
+		entity.StatusCancelled: true,
+		entity.StatusFailed:    true,
+	}
+	for _, s := range allStatuses {
+		if got := m.IsTerminal(s); got != terminal[s] {
+			t.Errorf("IsTerminal(%q) = %v, want %v", s, got, terminal[s])
+		}
+	}
+}
@@ -0,0 +1,146 @@
+package tracking
+
+import (
+	"context"
+	"encoding/json"
+	"log/slog"
+	"logistics/internal/services/order-service/domain"
+	"logistics/internal/shared/entity"
+	"logistics/pkg/lib/logger/slogger"
+	"sync"
+	"time"
+
+	"github.com/jackc/pgx/v5/pgxpool"
+)
+
+const (
+	// subscriberBuffer - сколько переходов может накопиться у медленного подписчика
+	subscriberBuffer = 16
+	reconnectDelay   = 2 * time.Second
+)
+
+// Hub слушает уведомления Postgres о переходах статуса и раздает их подписчикам заказа.
+// Закрытый канал подписки означает, что события могли быть потеряны и подписку нужно открыть заново.
+type Hub struct {
+	logger *slog.Logger
+	pool   *pgxpool.Pool
+
+	mu          sync.Mutex
+	nextID      int64
+	subscribers map[int64]map[int64]chan *entity.OrderStatusChange
+}
+
+func NewHub(logger *slog.Logger, pool *pgxpool.Pool) *Hub {
+	return &Hub{
+		logger:      logger,
+		pool:        pool,
+		subscribers: make(map[int64]map[int64]chan *entity.OrderStatusChange),
+	}
+}
+
+// Subscribe подписывает на переходы статуса заказа; возвращенную функцию нужно вызвать для отписки
+func (h *Hub) Subscribe(orderID int64) (<-chan *entity.OrderStatusChange, func()) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	h.nextID++
+	id := h.nextID
+	ch := make(chan *entity.OrderStatusChange, subscriberBuffer)
+	if h.subscribers[orderID] == nil {
+		h.subscribers[orderID] = make(map[int64]chan *entity.OrderStatusChange)
+	}
+	h.subscribers[orderID][id] = ch
+
+	return ch, func() {
+		h.mu.Lock()
+		defer h.mu.Unlock()
+		if subs, ok := h.subscribers[orderID]; ok {
+			if _, ok := subs[id]; ok {
+				delete(subs, id)
+				close(ch)
+			}
+			if len(subs) == 0 {
+				delete(h.subscribers, orderID)
+			}
+		}
+	}
+}
+
+// Run держит LISTEN на отдельном соединении и переподключается при обрыве
+func (h *Hub) Run(ctx context.Context) {
+	for {
+		err := h.listen(ctx)
+		if ctx.Err() != nil {
+			h.dropAll()
+			return
+		}
+		h.logger.Error("order status listener stopped", slogger.Err(err))
+		// Пока соединения не было, уведомления терялись: подписчики переподключатся и получат свежий снимок
+		h.dropAll()
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(reconnectDelay):
+		}
+	}
+}
+
+func (h *Hub) listen(ctx context.Context) error {
+	conn, err := h.pool.Acquire(ctx)
+	if err != nil {
+		return err
+	}
+	defer conn.Release()
+
+	if _, err := conn.Exec(ctx, "LISTEN "+domain.OrderStatusChannel); err != nil {
+		return err
+	}
+	h.logger.Info("Listening for order status changes", slog.String("channel", domain.OrderStatusChannel))
+
+	for {
+		notification, err := conn.Conn().WaitForNotification(ctx)
+		if err != nil {
+			// Соединение в состоянии LISTEN не возвращаем в пул
+			conn.Conn().Close(context.Background())
+			return err
+		}
+		var change entity.OrderStatusChange
+		if err := json.Unmarshal([]byte(notification.Payload), &change); err != nil {
+			h.logger.Error("failed to unmarshal order status notification", slogger.Err(err))
+			continue
+		}
+		h.broadcast(&change)
+	}
+}
+
+func (h *Hub) broadcast(change *entity.OrderStatusChange) {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for id, ch := range h.subscribers[change.OrderID] {
+		select {
+		case ch <- change:
+		default:
+			// Подписчик не успевает читать: отключаем его, чтобы не блокировать остальных
+			h.logger.Warn("order watcher fell behind", slog.Int64("order_id", change.OrderID))
+			delete(h.subscribers[change.OrderID], id)
+			close(ch)
+		}
+	}
+	if len(h.subscribers[change.OrderID]) == 0 {
+		delete(h.subscribers, change.OrderID)
+	}
+}
+
+func (h *Hub) dropAll() {
+	h.mu.Lock()
+	defer h.mu.Unlock()
+
+	for orderID, subs := range h.subscribers {
+		for _, ch := range subs {
+			close(ch)
+		}
+		delete(h.subscribers, orderID)
+	}
+}
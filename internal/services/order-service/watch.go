@@ -0,0 +1,162 @@
+package orderservice
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"log/slog"
+	driverpb "logistics/api/protobuf/driver_service"
+	orderpb "logistics/api/protobuf/order_service"
+	"logistics/internal/shared/entity"
+	rediscache "logistics/pkg/cache/redis"
+	"logistics/pkg/lib/logger/slogger"
+	"logistics/pkg/lib/utils"
+	"time"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/redis/go-redis/v9"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// WatchOrder отправляет снимок заказа, а затем переходы статуса и позиции водителя,
+// пока заказ не перейдет в конечный статус или клиент не отключится
+func (o *OrderGRPCService) WatchOrder(req *orderpb.WatchOrderRequest, stream grpc.ServerStreamingServer[orderpb.OrderEvent]) error {
+	ctx := stream.Context()
+
+	// Подписываемся до чтения снимка, чтобы не пропустить переход между ними
+	changes, unsubscribe := o.tracker.Subscribe(req.OrderId)
+	defer unsubscribe()
+
+	order, err := o.orderRepo.GetOrderDetails(ctx, req.UserId, req.OrderId)
+	if errors.Is(err, pgx.ErrNoRows) || (err == nil && order.UserID != req.UserId) {
+		return status.Errorf(codes.NotFound, "order %d not found", req.OrderId)
+	}
+	if err != nil {
+		o.logger.Error("failed to get order for watching", slog.Int64("order_id", req.OrderId), slogger.Err(err))
+		return status.Errorf(codes.Internal, "failed to get order: %v", err)
+	}
+
+	if err := stream.Send(&orderpb.OrderEvent{
+		OrderId: order.ID,
+		Event:   &orderpb.OrderEvent_Snapshot{Snapshot: utils.ConvertOrderToProto(order)},
+	}); err != nil {
+		return err
+	}
+	if o.stateMachine.IsTerminal(order.Status) {
+		return nil
+	}
+
+	positions := &driverPositions{service: o, stream: stream, orderID: order.ID}
+	defer positions.close()
+	positions.follow(ctx, order)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case change, ok := <-changes:
+			if !ok {
+				return status.Error(codes.Unavailable, "order watch interrupted, reconnect to get a fresh snapshot")
+			}
+			if err := stream.Send(&orderpb.OrderEvent{
+				OrderId: change.OrderID,
+				Event:   &orderpb.OrderEvent_StatusChange{StatusChange: utils.ConvertOrderStatusChangeToProto(change)},
+			}); err != nil {
+				return err
+			}
+			if o.stateMachine.IsTerminal(change.NewStatus) {
+				return nil
+			}
+			// Переход мог назначить или снять водителя
+			order, err := o.orderRepo.GetOrderDetails(ctx, req.UserId, req.OrderId)
+			if err != nil {
+				o.logger.Error("failed to refresh watched order", slog.Int64("order_id", req.OrderId), slogger.Err(err))
+				return status.Errorf(codes.Internal, "failed to get order: %v", err)
+			}
+			positions.follow(ctx, order)
+		case message, ok := <-positions.messages():
+			if !ok {
+				return status.Error(codes.Unavailable, "driver position feed interrupted, reconnect")
+			}
+			var location entity.DriverLocation
+			if err := json.Unmarshal([]byte(message.Payload), &location); err != nil {
+				o.logger.Error("failed to unmarshal driver position", slogger.Err(err))
+				continue
+			}
+			if err := positions.send(&location); err != nil {
+				return err
+			}
+		}
+	}
+}
+
+// driverPositions держит подписку на позиции водителя, который сейчас везет заказ
+type driverPositions struct {
+	service  *OrderGRPCService
+	stream   grpc.ServerStreamingServer[orderpb.OrderEvent]
+	orderID  int64
+	driverID int64
+	pubsub   *redis.PubSub
+}
+
+// follow переключает подписку на водителя заказа; позиции показываем только пока заказ назначен или в пути
+func (p *driverPositions) follow(ctx context.Context, order *entity.Order) {
+	var driverID int64
+	if order.DriverID != nil && (order.Status == entity.StatusAssigned || order.Status == entity.StatusInProgress) {
+		driverID = *order.DriverID
+	}
+	if driverID == p.driverID {
+		return
+	}
+	p.close()
+	p.driverID = driverID
+	if driverID == 0 {
+		return
+	}
+	p.pubsub = p.service.redisClient.Subscribe(ctx, rediscache.DriverLocationChannel(driverID))
+
+	// Последняя известная позиция, чтобы клиент не ждал следующего замера
+	lookupCtx, cancel := context.WithTimeout(ctx, 3*time.Second)
+	defer cancel()
+	resp, err := p.service.driverGRPCClient.GetDriverLocation(lookupCtx, &driverpb.GetDriverLocationRequest{DriverId: driverID})
+	if err != nil {
+		if status.Code(err) != codes.NotFound {
+			p.service.logger.Error("failed to get driver location", slog.Int64("driver_id", driverID), slogger.Err(err))
+		}
+		return
+	}
+	if err := p.send(utils.ConvertProtoToDriverLocation(resp)); err != nil {
+		p.service.logger.Error("failed to send driver position", slog.Int64("order_id", p.orderID), slogger.Err(err))
+	}
+}
+
+// messages возвращает nil-канал без подписки: select его просто не выбирает
+func (p *driverPositions) messages() <-chan *redis.Message {
+	if p.pubsub == nil {
+		return nil
+	}
+	return p.pubsub.Channel()
+}
+
+func (p *driverPositions) send(location *entity.DriverLocation) error {
+	return p.stream.Send(&orderpb.OrderEvent{
+		OrderId: p.orderID,
+		Event: &orderpb.OrderEvent_DriverPosition{DriverPosition: &orderpb.DriverPosition{
+			DriverId:   location.DriverID,
+			Location:   &orderpb.Location{Latitude: location.Location.Latitude, Longitude: location.Location.Longitude},
+			RecordedAt: location.RecordedAt,
+		}},
+	})
+}
+
+func (p *driverPositions) close() {
+	if p.pubsub == nil {
+		return
+	}
+	if err := p.pubsub.Close(); err != nil {
+		p.service.logger.Error("failed to close driver position subscription", slogger.Err(err))
+	}
+	p.pubsub = nil
+}
@@ -0,0 +1,190 @@
+package warehouseservice
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"math"
+	"regexp"
+	"strings"
+	"time"
+
+	warehousepb "logistics/api/protobuf/warehouse_service"
+	"logistics/internal/services/warehouse-service/domain"
+	"logistics/internal/shared/entity"
+	"logistics/pkg/lib/logger/slogger"
+	"logistics/pkg/lib/utils"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultProductsPageSize = 20
+	maxProductsPageSize     = 100
+)
+
+// skuPattern - латинские буквы, цифры, точка, дефис и подчеркивание
+var skuPattern = regexp.MustCompile(`^[A-Z0-9][A-Z0-9._-]{0,63}$`)
+
+func (s *WarehouseGRPCService) CreateProduct(ctx context.Context, req *warehousepb.CreateProductRequest) (*warehousepb.CreateProductResponse, error) {
+	product, err := validateProduct(req.Sku, req.Name, req.Description, req.UnitPrice, req.WeightGrams, req.Dimensions, req.ReorderPoint, req.ReorderQuantity)
+	if err != nil {
+		return nil, err
+	}
+	product.CreatedAt = time.Now().Unix()
+
+	created, err := s.warehouseRepo.CreateProduct(ctx, product)
+	if err != nil {
+		return nil, s.productError("failed to create product", err)
+	}
+	s.logger.Info("product created", slog.Int64("product_id", created.ID), slog.String("sku", created.SKU))
+	return &warehousepb.CreateProductResponse{Product: utils.ConvertProductToProto(created)}, nil
+}
+
+func (s *WarehouseGRPCService) GetProduct(ctx context.Context, req *warehousepb.GetProductRequest) (*warehousepb.GetProductResponse, error) {
+	if req.ProductId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+	product, err := s.warehouseRepo.GetProduct(ctx, req.ProductId)
+	if err != nil {
+		return nil, s.productError("failed to get product", err)
+	}
+	return &warehousepb.GetProductResponse{Product: utils.ConvertProductToProto(product)}, nil
+}
+
+func (s *WarehouseGRPCService) ListProducts(ctx context.Context, req *warehousepb.ListProductsRequest) (*warehousepb.ListProductsResponse, error) {
+	page, pageSize := req.Page, req.PageSize
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = defaultProductsPageSize
+	}
+	if pageSize > maxProductsPageSize {
+		pageSize = maxProductsPageSize
+	}
+
+	products, total, err := s.warehouseRepo.ListProducts(ctx, domain.ProductFilter{
+		IncludeInactive: req.IncludeInactive,
+		Limit:           int(pageSize),
+		Offset:          int(page-1) * int(pageSize),
+	})
+	if err != nil {
+		return nil, s.productError("failed to list products", err)
+	}
+	resp := &warehousepb.ListProductsResponse{
+		Products: make([]*warehousepb.Product, 0, len(products)),
+		Total:    total,
+		Page:     page,
+		PageSize: pageSize,
+	}
+	for _, product := range products {
+		resp.Products = append(resp.Products, utils.ConvertProductToProto(product))
+	}
+	return resp, nil
+}
+
+func (s *WarehouseGRPCService) UpdateProduct(ctx context.Context, req *warehousepb.UpdateProductRequest) (*warehousepb.UpdateProductResponse, error) {
+	if req.ProductId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+	product, err := validateProduct(req.Sku, req.Name, req.Description, req.UnitPrice, req.WeightGrams, req.Dimensions, req.ReorderPoint, req.ReorderQuantity)
+	if err != nil {
+		return nil, err
+	}
+	product.ID = req.ProductId
+	product.UpdatedAt = time.Now().Unix()
+	var active *bool
+	if req.Active != nil {
+		active = &req.Active.Value
+	}
+
+	updated, err := s.warehouseRepo.UpdateProduct(ctx, product, active)
+	if err != nil {
+		return nil, s.productError("failed to update product", err)
+	}
+	s.logger.Info("product updated", slog.Int64("product_id", updated.ID), slog.Float64("unit_price", updated.UnitPrice))
+	// Новая точка заказа может сразу перевести товар в дефицит
+	s.lowStock.Notify()
+	return &warehousepb.UpdateProductResponse{Product: utils.ConvertProductToProto(updated)}, nil
+}
+
+func (s *WarehouseGRPCService) DeleteProduct(ctx context.Context, req *warehousepb.DeleteProductRequest) (*warehousepb.DeleteProductResponse, error) {
+	if req.ProductId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+	product, err := s.warehouseRepo.DeactivateProduct(ctx, req.ProductId, time.Now().Unix())
+	if err != nil {
+		return nil, s.productError("failed to delete product", err)
+	}
+	s.logger.Info("product deactivated", slog.Int64("product_id", product.ID))
+	return &warehousepb.DeleteProductResponse{Product: utils.ConvertProductToProto(product)}, nil
+}
+
+func (s *WarehouseGRPCService) GetProductPrices(ctx context.Context, req *warehousepb.GetProductPricesRequest) (*warehousepb.GetProductPricesResponse, error) {
+	if len(req.Names) == 0 {
+		return &warehousepb.GetProductPricesResponse{}, nil
+	}
+	products, err := s.warehouseRepo.GetActiveProductsByName(ctx, req.Names)
+	if err != nil {
+		return nil, s.productError("failed to get product prices", err)
+	}
+	resp := &warehousepb.GetProductPricesResponse{
+		Products: make([]*warehousepb.Product, 0, len(products)),
+	}
+	for _, product := range products {
+		resp.Products = append(resp.Products, utils.ConvertProductToProto(product))
+	}
+	return resp, nil
+}
+
+// validateProduct проверяет и нормализует карточку товара
+func validateProduct(sku, name, description string, unitPrice float64, weightGrams int32, dimensions *warehousepb.Dimensions, reorderPoint, reorderQuantity int32) (*entity.Product, error) {
+	product := &entity.Product{
+		SKU:         strings.ToUpper(strings.TrimSpace(sku)),
+		Name:        strings.TrimSpace(name),
+		Description: strings.TrimSpace(description),
+		// Цена хранится с точностью до копеек
+		UnitPrice:   math.Round(unitPrice*100) / 100,
+		WeightGrams: weightGrams,
+		Dimensions: entity.Dimensions{
+			LengthMM: dimensions.GetLengthMm(),
+			WidthMM:  dimensions.GetWidthMm(),
+			HeightMM: dimensions.GetHeightMm(),
+		},
+		ReorderPoint:    reorderPoint,
+		ReorderQuantity: reorderQuantity,
+	}
+	if !skuPattern.MatchString(product.SKU) {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid sku %q", sku)
+	}
+	if product.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if math.IsNaN(unitPrice) || math.IsInf(unitPrice, 0) || product.UnitPrice < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid unit_price %v", unitPrice)
+	}
+	if product.WeightGrams < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid weight_grams %d", weightGrams)
+	}
+	if product.Dimensions.LengthMM < 0 || product.Dimensions.WidthMM < 0 || product.Dimensions.HeightMM < 0 {
+		return nil, status.Error(codes.InvalidArgument, "dimensions must not be negative")
+	}
+	if product.ReorderPoint < 0 || product.ReorderQuantity < 0 {
+		return nil, status.Error(codes.InvalidArgument, "reorder_point and reorder_quantity must not be negative")
+	}
+	return product, nil
+}
+
+// productError переводит ошибку репозитория каталога в gRPC-статус
+func (s *WarehouseGRPCService) productError(msg string, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrProductNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrDuplicateSKU), errors.Is(err, domain.ErrDuplicateProductName):
+		return status.Error(codes.AlreadyExists, err.Error())
+	}
+	s.logger.Error(msg, slog.String("status", "error"), slogger.Err(err))
+	return status.Errorf(codes.Internal, "%s: %v", msg, err)
+}
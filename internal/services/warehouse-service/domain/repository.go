@@ -2,11 +2,130 @@ package domain
 
 import (
 	"context"
+	"errors"
 	"logistics/internal/shared/entity"
 )
 
+// ErrInsufficientStock - на складе недостаточно товара для списания
+var ErrInsufficientStock = errors.New("insufficient stock")
+
+var (
+	// ErrReservationNotFound - у заказа нет действующего резерва
+	ErrReservationNotFound = errors.New("reservation not found")
+	// ErrReservationExpired - срок резерва истек до списания
+	ErrReservationExpired = errors.New("reservation expired")
+)
+
+var (
+	// ErrProductNotFound - товара нет в каталоге
+	ErrProductNotFound = errors.New("product not found")
+	// ErrDuplicateSKU - артикул уже занят другим товаром
+	ErrDuplicateSKU = errors.New("sku is already used by another product")
+	// ErrDuplicateProductName - наименование уже занято другим товаром
+	ErrDuplicateProductName = errors.New("product name is already used by another product")
+)
+
+var (
+	// ErrWarehouseNotFound - склада нет
+	ErrWarehouseNotFound = errors.New("warehouse not found")
+	// ErrWarehouseInactive - склад закрыт и не принимает товар
+	ErrWarehouseInactive = errors.New("warehouse is inactive")
+	// ErrDuplicateWarehouseName - название уже занято другим складом
+	ErrDuplicateWarehouseName = errors.New("warehouse name is already used by another warehouse")
+)
+
+var (
+	// ErrReceivingNotFound - документа приемки нет
+	ErrReceivingNotFound = errors.New("receiving document not found")
+	// ErrReceivingPosted - документ уже оприходован и не меняется
+	ErrReceivingPosted = errors.New("receiving document is already posted")
+	// ErrNothingReceived - в документе нет принятого товара
+	ErrNothingReceived = errors.New("nothing received in receiving document")
+)
+
+var (
+	// ErrTransferNotFound - перемещения нет
+	ErrTransferNotFound = errors.New("stock transfer not found")
+	// ErrTransferStatus - действие недоступно в текущем статусе перемещения
+	ErrTransferStatus = errors.New("stock transfer is in wrong status")
+)
+
+// ReceivingFilter - условия выборки документов приемки
+type ReceivingFilter struct {
+	Status entity.ReceivingStatus
+	Limit  int
+	Offset int
+}
+
+// TransferFilter - условия выборки перемещений; WarehouseID отбирает перемещения, где склад источник или получатель
+type TransferFilter struct {
+	Status      entity.TransferStatus
+	WarehouseID int64
+	Limit       int
+	Offset      int
+}
+
+// MovementFilter - условия выборки журнала движения товара; нулевые Since и Until не ограничивают период
+type MovementFilter struct {
+	ProductID   int64
+	WarehouseID int64
+	Reason      entity.MovementReason
+	Since       int64
+	Until       int64
+	Limit       int
+	Offset      int
+}
+
+// LotFilter - условия выборки партий; ExpiresBefore != 0 оставляет партии со сроком годности до этого момента
+type LotFilter struct {
+	WarehouseID   int64
+	ProductID     int64
+	ExpiresBefore int64
+}
+
+// ProductFilter - условия выборки каталога
+type ProductFilter struct {
+	IncludeInactive bool
+	Limit           int
+	Offset          int
+}
+
 type WarehouseRepositoryInterface interface {
-	CheckStockAvailability(ctx context.Context, orders []*entity.GoodsItem) (bool, error)
+	GetAvailableStock(ctx context.Context, productNames []string, warehouseID int64) ([]*entity.WarehouseStockItem, error)
 	GetWarehouseStock(ctx context.Context) ([]*entity.GoodsItem, error)
-	UpdateStock(ctx context.Context, items []*entity.GoodsItem) error
+	UpdateStock(ctx context.Context, orderID, warehouseID int64, items []*entity.GoodsItem, now int64) error
+	ReturnStock(ctx context.Context, orderID, warehouseID int64, items []*entity.GoodsItem, now int64) error
+	ReserveStock(ctx context.Context, orderID int64, items []*entity.GoodsItem, target *entity.Location, expiresAt, now int64) ([]entity.StockAllocation, int64, error)
+	CommitReservation(ctx context.Context, orderID, now int64) error
+	ReleaseReservation(ctx context.Context, orderID, now int64) (bool, error)
+	ReleaseExpiredReservations(ctx context.Context, now int64) (int64, error)
+	CreateProduct(ctx context.Context, product *entity.Product) (*entity.Product, error)
+	GetProduct(ctx context.Context, productID int64) (*entity.Product, error)
+	ListProducts(ctx context.Context, filter ProductFilter) ([]*entity.Product, int64, error)
+	UpdateProduct(ctx context.Context, product *entity.Product, active *bool) (*entity.Product, error)
+	DeactivateProduct(ctx context.Context, productID, now int64) (*entity.Product, error)
+	GetActiveProductsByName(ctx context.Context, names []string) ([]*entity.Product, error)
+	CreateReceivingDocument(ctx context.Context, document *entity.ReceivingDocument) (*entity.ReceivingDocument, error)
+	GetReceivingDocument(ctx context.Context, documentID int64) (*entity.ReceivingDocument, error)
+	ListReceivingDocuments(ctx context.Context, filter ReceivingFilter) ([]*entity.ReceivingDocument, int64, error)
+	RecordReceivedQuantities(ctx context.Context, documentID int64, lines []entity.ReceivingLine, now int64) (*entity.ReceivingDocument, error)
+	PostReceivingDocument(ctx context.Context, documentID, userID, now int64) (*entity.ReceivingDocument, error)
+	ListStockMovements(ctx context.Context, filter MovementFilter) ([]*entity.StockMovement, int64, error)
+	ReconcileStock(ctx context.Context, productIDs []int64) ([]*entity.StockDiscrepancy, int64, error)
+	CreateWarehouse(ctx context.Context, warehouse *entity.Warehouse) (*entity.Warehouse, error)
+	GetWarehouse(ctx context.Context, warehouseID int64) (*entity.Warehouse, error)
+	ListWarehouses(ctx context.Context, includeInactive bool) ([]*entity.Warehouse, error)
+	UpdateWarehouse(ctx context.Context, warehouse *entity.Warehouse, active *bool) (*entity.Warehouse, error)
+	GetWarehouseInventory(ctx context.Context, warehouseID int64) ([]*entity.WarehouseStockItem, error)
+	CreateTransfer(ctx context.Context, transfer *entity.StockTransfer) (*entity.StockTransfer, error)
+	GetTransfer(ctx context.Context, transferID int64) (*entity.StockTransfer, error)
+	ListTransfers(ctx context.Context, filter TransferFilter) ([]*entity.StockTransfer, int64, error)
+	AssignTransferDriver(ctx context.Context, transferID, driverID, now int64) (*entity.StockTransfer, error)
+	DispatchTransfer(ctx context.Context, transferID, userID, now int64) (*entity.StockTransfer, error)
+	ReceiveTransfer(ctx context.Context, transferID, userID, now int64) (*entity.StockTransfer, error)
+	DetectLowStock(ctx context.Context, now int64) ([]*entity.ReplenishmentItem, error)
+	ListReplenishment(ctx context.Context, warehouseID int64) ([]*entity.ReplenishmentItem, error)
+	ListStockLots(ctx context.Context, filter LotFilter) ([]*entity.StockLot, error)
+	ImportStock(ctx context.Context, rows []entity.StockImportRow, userID, now int64, dryRun bool) ([]*entity.StockImportResult, []entity.ImportRowError, error)
+	ExportStockMovements(ctx context.Context, filter MovementFilter, fn func(*entity.StockMovement) error) error
 }
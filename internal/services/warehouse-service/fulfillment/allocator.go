@@ -0,0 +1,101 @@
+package fulfillment
+
+import (
+	"fmt"
+	"sort"
+
+	"logistics/internal/services/warehouse-service/domain"
+	"logistics/internal/shared/entity"
+	"logistics/pkg/lib/utils"
+)
+
+// Stock - доступный остаток одного склада по товарам
+type Stock struct {
+	WarehouseID int64
+	Location    *entity.Location
+	Available   map[int64]int32
+}
+
+// Allocate распределяет товары заказа по складам. Заказ целиком отгружается с ближайшего склада,
+// где хватает всех товаров; если такого нет, каждый товар набирается с ближайших складов, где он есть.
+// Без точки доставки склады перебираются по ID
+func Allocate(target *entity.Location, demand map[int64]int32, stocks []Stock) ([]entity.StockAllocation, error) {
+	ranked := rank(target, stocks)
+	productIDs := make([]int64, 0, len(demand))
+	for productID := range demand {
+		productIDs = append(productIDs, productID)
+	}
+	sort.Slice(productIDs, func(i, j int) bool { return productIDs[i] < productIDs[j] })
+
+	for _, stock := range ranked {
+		if covers(stock, demand) {
+			allocations := make([]entity.StockAllocation, 0, len(productIDs))
+			for _, productID := range productIDs {
+				allocations = append(allocations, entity.StockAllocation{
+					WarehouseID: stock.WarehouseID,
+					ProductID:   productID,
+					Quantity:    demand[productID],
+				})
+			}
+			return allocations, nil
+		}
+	}
+
+	// Ни один склад не закрывает заказ целиком - делим его
+	var allocations []entity.StockAllocation
+	for _, productID := range productIDs {
+		remaining := demand[productID]
+		for _, stock := range ranked {
+			if remaining == 0 {
+				break
+			}
+			take := min(remaining, stock.Available[productID])
+			if take <= 0 {
+				continue
+			}
+			allocations = append(allocations, entity.StockAllocation{
+				WarehouseID: stock.WarehouseID,
+				ProductID:   productID,
+				Quantity:    take,
+			})
+			remaining -= take
+		}
+		if remaining > 0 {
+			return nil, fmt.Errorf("product %d: requested %d, available %d: %w",
+				productID, demand[productID], demand[productID]-remaining, domain.ErrInsufficientStock)
+		}
+	}
+	sort.SliceStable(allocations, func(i, j int) bool {
+		return allocations[i].WarehouseID < allocations[j].WarehouseID
+	})
+	return allocations, nil
+}
+
+// rank упорядочивает склады по расстоянию до точки доставки; склады без координат идут в конце
+func rank(target *entity.Location, stocks []Stock) []Stock {
+	ranked := make([]Stock, len(stocks))
+	copy(ranked, stocks)
+	sort.SliceStable(ranked, func(i, j int) bool {
+		a, b := ranked[i].Location, ranked[j].Location
+		if target != nil && (a == nil) != (b == nil) {
+			return a != nil
+		}
+		if target != nil && a != nil && b != nil {
+			da, db := utils.DistanceKm(*target, *a), utils.DistanceKm(*target, *b)
+			if da != db {
+				return da < db
+			}
+		}
+		return ranked[i].WarehouseID < ranked[j].WarehouseID
+	})
+	return ranked
+}
+
+func covers(stock Stock, demand map[int64]int32) bool {
+	for productID, quantity := range demand {
+		if stock.Available[productID] < quantity {
+			return false
+		}
+	}
+	return true
+}
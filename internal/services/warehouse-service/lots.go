@@ -0,0 +1,56 @@
+package warehouseservice
+
+import (
+	"context"
+	"log/slog"
+	"time"
+
+	warehousepb "logistics/api/protobuf/warehouse_service"
+	"logistics/internal/services/warehouse-service/domain"
+	"logistics/pkg/lib/logger/slogger"
+	"logistics/pkg/lib/utils"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxExpiringWithinDays - горизонт отчета по истекающим партиям
+const maxExpiringWithinDays = 365
+
+// ListStockLots возвращает партии с остатком, ближайшие к истечению срока годности первыми.
+// С expiring_within_days - только партии, срок которых истекает в эти дни или уже истек
+func (s *WarehouseGRPCService) ListStockLots(ctx context.Context, req *warehousepb.ListStockLotsRequest) (*warehousepb.ListStockLotsResponse, error) {
+	if req.WarehouseId < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid warehouse_id %d", req.WarehouseId)
+	}
+	if req.ProductId < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid product_id %d", req.ProductId)
+	}
+	if req.ExpiringWithinDays < 0 || req.ExpiringWithinDays > maxExpiringWithinDays {
+		return nil, status.Errorf(codes.InvalidArgument, "expiring_within_days must be between 0 and %d", maxExpiringWithinDays)
+	}
+	filter := domain.LotFilter{
+		WarehouseID: req.WarehouseId,
+		ProductID:   req.ProductId,
+	}
+	if req.ExpiringWithinDays > 0 {
+		now := req.Time
+		if now == 0 {
+			now = time.Now().Unix()
+		}
+		filter.ExpiresBefore = now + int64(req.ExpiringWithinDays)*int64((24*time.Hour).Seconds())
+	}
+
+	lots, err := s.warehouseRepo.ListStockLots(ctx, filter)
+	if err != nil {
+		s.logger.Error("failed to list stock lots", slog.Int64("warehouse_id", req.WarehouseId), slogger.Err(err))
+		return nil, status.Errorf(codes.Internal, "failed to list stock lots: %v", err)
+	}
+	resp := &warehousepb.ListStockLotsResponse{
+		Lots: make([]*warehousepb.StockLot, 0, len(lots)),
+	}
+	for _, lot := range lots {
+		resp.Lots = append(resp.Lots, utils.ConvertStockLotToProto(lot))
+	}
+	return resp, nil
+}
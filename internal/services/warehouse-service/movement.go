@@ -0,0 +1,138 @@
+package warehouseservice
+
+import (
+	"context"
+	"log/slog"
+
+	warehousepb "logistics/api/protobuf/warehouse_service"
+	"logistics/internal/services/warehouse-service/domain"
+	"logistics/internal/shared/entity"
+	"logistics/pkg/lib/logger/slogger"
+	"logistics/pkg/lib/utils"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultMovementsPageSize = 50
+	maxMovementsPageSize     = 500
+)
+
+func (s *WarehouseGRPCService) ListStockMovements(ctx context.Context, req *warehousepb.ListStockMovementsRequest) (*warehousepb.ListStockMovementsResponse, error) {
+	if req.ProductId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "product_id is required")
+	}
+	filter := domain.MovementFilter{
+		ProductID:   req.ProductId,
+		WarehouseID: req.WarehouseId,
+		Reason:      entity.MovementReason(req.Reason),
+		Since:       req.Since,
+		Until:       req.Until,
+	}
+	if filter.WarehouseID < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid warehouse_id %d", req.WarehouseId)
+	}
+	if filter.Reason != "" && !filter.Reason.IsValid() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid reason %q", req.Reason)
+	}
+	if filter.Since < 0 || filter.Until < 0 || (filter.Until != 0 && filter.Until <= filter.Since) {
+		return nil, status.Error(codes.InvalidArgument, "invalid period: until must be after since")
+	}
+
+	page, pageSize := req.Page, req.PageSize
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = defaultMovementsPageSize
+	}
+	if pageSize > maxMovementsPageSize {
+		pageSize = maxMovementsPageSize
+	}
+	filter.Limit = int(pageSize)
+	filter.Offset = int(page-1) * int(pageSize)
+
+	movements, total, err := s.warehouseRepo.ListStockMovements(ctx, filter)
+	if err != nil {
+		s.logger.Error("failed to list stock movements", slog.Int64("product_id", req.ProductId), slogger.Err(err))
+		return nil, status.Errorf(codes.Internal, "failed to list stock movements: %v", err)
+	}
+	resp := &warehousepb.ListStockMovementsResponse{
+		Movements: make([]*warehousepb.StockMovement, 0, len(movements)),
+		Total:     total,
+		Page:      page,
+		PageSize:  pageSize,
+	}
+	for _, movement := range movements {
+		resp.Movements = append(resp.Movements, utils.ConvertStockMovementToProto(movement))
+	}
+	return resp, nil
+}
+
+// ExportStockMovements выгружает журнал движения потоком, без постраничной разбивки
+func (s *WarehouseGRPCService) ExportStockMovements(req *warehousepb.ExportStockMovementsRequest, stream grpc.ServerStreamingServer[warehousepb.StockMovement]) error {
+	filter := domain.MovementFilter{
+		ProductID:   req.ProductId,
+		WarehouseID: req.WarehouseId,
+		Reason:      entity.MovementReason(req.Reason),
+		Since:       req.Since,
+		Until:       req.Until,
+	}
+	if filter.ProductID < 0 || filter.WarehouseID < 0 {
+		return status.Error(codes.InvalidArgument, "product_id and warehouse_id must not be negative")
+	}
+	if filter.Reason != "" && !filter.Reason.IsValid() {
+		return status.Errorf(codes.InvalidArgument, "invalid reason %q", req.Reason)
+	}
+	if filter.Since < 0 || filter.Until < 0 || (filter.Until != 0 && filter.Until <= filter.Since) {
+		return status.Error(codes.InvalidArgument, "invalid period: until must be after since")
+	}
+
+	var sendErr error
+	err := s.warehouseRepo.ExportStockMovements(stream.Context(), filter, func(movement *entity.StockMovement) error {
+		sendErr = stream.Send(utils.ConvertStockMovementToProto(movement))
+		return sendErr
+	})
+	if sendErr != nil {
+		// Клиент отключился, дальше выгружать некому
+		return sendErr
+	}
+	if err != nil {
+		s.logger.Error("failed to export stock movements", slogger.Err(err))
+		return status.Errorf(codes.Internal, "failed to export stock movements: %v", err)
+	}
+	return nil
+}
+
+// ReconcileStock проверяет, что остаток каждого товара равен сумме его движений по журналу
+func (s *WarehouseGRPCService) ReconcileStock(ctx context.Context, req *warehousepb.ReconcileStockRequest) (*warehousepb.ReconcileStockResponse, error) {
+	discrepancies, checked, err := s.warehouseRepo.ReconcileStock(ctx, req.ProductIds)
+	if err != nil {
+		s.logger.Error("failed to reconcile stock", slogger.Err(err))
+		return nil, status.Errorf(codes.Internal, "failed to reconcile stock: %v", err)
+	}
+
+	resp := &warehousepb.ReconcileStockResponse{
+		Checked:       checked,
+		Discrepancies: make([]*warehousepb.StockDiscrepancy, 0, len(discrepancies)),
+	}
+	for _, d := range discrepancies {
+		// Расхождение значит, что остаток меняли в обход журнала
+		s.logger.Warn("stock does not match movement ledger",
+			slog.Int64("warehouse_id", d.WarehouseID),
+			slog.Int64("product_id", d.ProductID),
+			slog.Int64("on_hand", d.OnHand),
+			slog.Int64("ledger_total", d.LedgerTotal),
+		)
+		resp.Discrepancies = append(resp.Discrepancies, &warehousepb.StockDiscrepancy{
+			ProductId:   d.ProductID,
+			ProductName: d.ProductName,
+			OnHand:      d.OnHand,
+			LedgerTotal: d.LedgerTotal,
+			WarehouseId: d.WarehouseID,
+		})
+	}
+	return resp, nil
+}
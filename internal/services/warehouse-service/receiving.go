@@ -0,0 +1,199 @@
+package warehouseservice
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"regexp"
+	"strings"
+	"time"
+
+	warehousepb "logistics/api/protobuf/warehouse_service"
+	"logistics/internal/services/warehouse-service/domain"
+	"logistics/internal/shared/entity"
+	"logistics/pkg/lib/logger/slogger"
+	"logistics/pkg/lib/utils"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultReceivingPageSize = 20
+	maxReceivingPageSize     = 100
+)
+
+func (s *WarehouseGRPCService) CreateReceivingDocument(ctx context.Context, req *warehousepb.CreateReceivingDocumentRequest) (*warehousepb.CreateReceivingDocumentResponse, error) {
+	document := &entity.ReceivingDocument{
+		WarehouseID: req.WarehouseId,
+		Supplier:    strings.TrimSpace(req.Supplier),
+		Reference:   strings.TrimSpace(req.Reference),
+		Note:        strings.TrimSpace(req.Note),
+		CreatedBy:   req.UserId,
+		CreatedAt:   time.Now().Unix(),
+	}
+	if document.WarehouseID <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "warehouse_id is required")
+	}
+	if document.Supplier == "" {
+		return nil, status.Error(codes.InvalidArgument, "supplier is required")
+	}
+	if len(req.Lines) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one line is required")
+	}
+	lines, err := validateReceivingLines(req.Lines)
+	if err != nil {
+		return nil, err
+	}
+	document.Lines = lines
+
+	// Закрытый склад товар не принимает
+	warehouse, err := s.warehouseRepo.GetWarehouse(ctx, document.WarehouseID)
+	if err != nil {
+		return nil, s.receivingError("failed to get warehouse", err)
+	}
+	if !warehouse.Active {
+		return nil, status.Errorf(codes.FailedPrecondition, "warehouse %d: %v", warehouse.ID, domain.ErrWarehouseInactive)
+	}
+
+	created, err := s.warehouseRepo.CreateReceivingDocument(ctx, document)
+	if err != nil {
+		return nil, s.receivingError("failed to create receiving document", err)
+	}
+	s.logger.Info("receiving document created",
+		slog.Int64("document_id", created.ID),
+		slog.Int64("warehouse_id", created.WarehouseID),
+		slog.String("supplier", created.Supplier),
+	)
+	return &warehousepb.CreateReceivingDocumentResponse{Document: utils.ConvertReceivingDocumentToProto(created)}, nil
+}
+
+func (s *WarehouseGRPCService) GetReceivingDocument(ctx context.Context, req *warehousepb.GetReceivingDocumentRequest) (*warehousepb.GetReceivingDocumentResponse, error) {
+	if req.DocumentId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "document_id is required")
+	}
+	document, err := s.warehouseRepo.GetReceivingDocument(ctx, req.DocumentId)
+	if err != nil {
+		return nil, s.receivingError("failed to get receiving document", err)
+	}
+	return &warehousepb.GetReceivingDocumentResponse{Document: utils.ConvertReceivingDocumentToProto(document)}, nil
+}
+
+func (s *WarehouseGRPCService) ListReceivingDocuments(ctx context.Context, req *warehousepb.ListReceivingDocumentsRequest) (*warehousepb.ListReceivingDocumentsResponse, error) {
+	filter := domain.ReceivingFilter{Status: entity.ReceivingStatus(req.Status)}
+	if filter.Status != "" && !filter.Status.IsValid() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid status %q", req.Status)
+	}
+
+	page, pageSize := req.Page, req.PageSize
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = defaultReceivingPageSize
+	}
+	if pageSize > maxReceivingPageSize {
+		pageSize = maxReceivingPageSize
+	}
+	filter.Limit = int(pageSize)
+	filter.Offset = int(page-1) * int(pageSize)
+
+	documents, total, err := s.warehouseRepo.ListReceivingDocuments(ctx, filter)
+	if err != nil {
+		return nil, s.receivingError("failed to list receiving documents", err)
+	}
+	resp := &warehousepb.ListReceivingDocumentsResponse{
+		Documents: make([]*warehousepb.ReceivingDocument, 0, len(documents)),
+		Total:     total,
+		Page:      page,
+		PageSize:  pageSize,
+	}
+	for _, document := range documents {
+		resp.Documents = append(resp.Documents, utils.ConvertReceivingDocumentToProto(document))
+	}
+	return resp, nil
+}
+
+func (s *WarehouseGRPCService) RecordReceivedQuantities(ctx context.Context, req *warehousepb.RecordReceivedQuantitiesRequest) (*warehousepb.RecordReceivedQuantitiesResponse, error) {
+	if req.DocumentId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "document_id is required")
+	}
+	if len(req.Lines) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one line is required")
+	}
+	lines, err := validateReceivingLines(req.Lines)
+	if err != nil {
+		return nil, err
+	}
+
+	document, err := s.warehouseRepo.RecordReceivedQuantities(ctx, req.DocumentId, lines, time.Now().Unix())
+	if err != nil {
+		return nil, s.receivingError("failed to record received quantities", err)
+	}
+	return &warehousepb.RecordReceivedQuantitiesResponse{Document: utils.ConvertReceivingDocumentToProto(document)}, nil
+}
+
+func (s *WarehouseGRPCService) PostReceivingDocument(ctx context.Context, req *warehousepb.PostReceivingDocumentRequest) (*warehousepb.PostReceivingDocumentResponse, error) {
+	if req.DocumentId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "document_id is required")
+	}
+	document, err := s.warehouseRepo.PostReceivingDocument(ctx, req.DocumentId, req.UserId, time.Now().Unix())
+	if err != nil {
+		return nil, s.receivingError("failed to post receiving document", err)
+	}
+	s.logger.Info("receiving document posted",
+		slog.Int64("document_id", document.ID),
+		slog.Int64("posted_by", document.PostedBy),
+		slog.Int("lines", len(document.Lines)),
+	)
+	return &warehousepb.PostReceivingDocumentResponse{Document: utils.ConvertReceivingDocumentToProto(document)}, nil
+}
+
+// lotNumberPattern - номер партии поставщика: латинские буквы, цифры, точка, дефис, косая черта и подчеркивание
+var lotNumberPattern = regexp.MustCompile(`^[A-Z0-9][A-Z0-9./_-]{0,63}$`)
+
+// validateReceivingLines проверяет строки документа: товар указан один раз, количества не отрицательные,
+// номер партии допустимый
+func validateReceivingLines(reqLines []*warehousepb.ReceivingLine) ([]entity.ReceivingLine, error) {
+	seen := make(map[int64]struct{}, len(reqLines))
+	lines := make([]entity.ReceivingLine, 0, len(reqLines))
+	for _, line := range reqLines {
+		if line.ProductId <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "product_id is required in every line")
+		}
+		if _, ok := seen[line.ProductId]; ok {
+			return nil, status.Errorf(codes.InvalidArgument, "product %d is listed more than once", line.ProductId)
+		}
+		seen[line.ProductId] = struct{}{}
+		if line.ExpectedQuantity < 0 || line.ReceivedQuantity < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "quantities of product %d must not be negative", line.ProductId)
+		}
+		lotNumber := strings.ToUpper(strings.TrimSpace(line.LotNumber))
+		if lotNumber != "" && !lotNumberPattern.MatchString(lotNumber) {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid lot_number %q for product %d", line.LotNumber, line.ProductId)
+		}
+		if line.ExpiresAt < 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid expires_at %d for product %d", line.ExpiresAt, line.ProductId)
+		}
+		lines = append(lines, entity.ReceivingLine{
+			ProductID:        line.ProductId,
+			ExpectedQuantity: line.ExpectedQuantity,
+			ReceivedQuantity: line.ReceivedQuantity,
+			LotNumber:        lotNumber,
+			ExpiresAt:        line.ExpiresAt,
+		})
+	}
+	return lines, nil
+}
+
+// receivingError переводит ошибку репозитория приемки в gRPC-статус
+func (s *WarehouseGRPCService) receivingError(msg string, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrReceivingNotFound), errors.Is(err, domain.ErrProductNotFound), errors.Is(err, domain.ErrWarehouseNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrReceivingPosted), errors.Is(err, domain.ErrNothingReceived):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	s.logger.Error(msg, slog.String("status", "error"), slogger.Err(err))
+	return status.Errorf(codes.Internal, "%s: %v", msg, err)
+}
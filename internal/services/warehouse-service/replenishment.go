@@ -0,0 +1,39 @@
+package warehouseservice
+
+import (
+	"context"
+	"log/slog"
+
+	warehousepb "logistics/api/protobuf/warehouse_service"
+	"logistics/pkg/lib/logger/slogger"
+	"logistics/pkg/lib/utils"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// ListReplenishment возвращает товары, доступный остаток которых ниже точки заказа,
+// с рекомендуемым количеством к заказу
+func (s *WarehouseGRPCService) ListReplenishment(ctx context.Context, req *warehousepb.ListReplenishmentRequest) (*warehousepb.ListReplenishmentResponse, error) {
+	if req.WarehouseId < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid warehouse_id %d", req.WarehouseId)
+	}
+	if req.WarehouseId != 0 {
+		if _, err := s.warehouseRepo.GetWarehouse(ctx, req.WarehouseId); err != nil {
+			return nil, s.warehouseError("failed to get warehouse", err)
+		}
+	}
+
+	items, err := s.warehouseRepo.ListReplenishment(ctx, req.WarehouseId)
+	if err != nil {
+		s.logger.Error("failed to list replenishment", slog.Int64("warehouse_id", req.WarehouseId), slogger.Err(err))
+		return nil, status.Errorf(codes.Internal, "failed to list replenishment: %v", err)
+	}
+	resp := &warehousepb.ListReplenishmentResponse{
+		Items: make([]*warehousepb.ReplenishmentItem, 0, len(items)),
+	}
+	for _, item := range items {
+		resp.Items = append(resp.Items, utils.ConvertReplenishmentItemToProto(item))
+	}
+	return resp, nil
+}
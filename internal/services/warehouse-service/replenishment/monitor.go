@@ -0,0 +1,78 @@
+package replenishment
+
+import (
+	"context"
+	"log/slog"
+	"logistics/internal/services/warehouse-service/domain"
+	"logistics/pkg/lib/logger/slogger"
+	"time"
+)
+
+// DefaultCheckInterval - период плановой проверки остатков, если их никто не менял
+const DefaultCheckInterval = 5 * time.Minute
+
+// Monitor ищет товары, опустившиеся ниже точки заказа, и публикует по ним stock.low.
+// Проверка запускается по таймеру и после каждого списания остатков
+type Monitor struct {
+	logger        *slog.Logger
+	warehouseRepo domain.WarehouseRepositoryInterface
+	interval      time.Duration
+	trigger       chan struct{}
+}
+
+func NewMonitor(logger *slog.Logger, warehouseRepo domain.WarehouseRepositoryInterface, interval time.Duration) *Monitor {
+	if interval <= 0 {
+		interval = DefaultCheckInterval
+	}
+	return &Monitor{
+		logger:        logger,
+		warehouseRepo: warehouseRepo,
+		interval:      interval,
+		trigger:       make(chan struct{}, 1),
+	}
+}
+
+// Notify просит провести внеочередную проверку. Не блокирует: несколько вызовов
+// до начала проверки схлопываются в один
+func (m *Monitor) Notify() {
+	if m == nil {
+		return
+	}
+	select {
+	case m.trigger <- struct{}{}:
+	default:
+	}
+}
+
+// Run работает до отмены контекста
+func (m *Monitor) Run(ctx context.Context) {
+	ticker := time.NewTicker(m.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			m.check(ctx)
+		case <-m.trigger:
+			m.check(ctx)
+		}
+	}
+}
+
+func (m *Monitor) check(ctx context.Context) {
+	detected, err := m.warehouseRepo.DetectLowStock(ctx, time.Now().Unix())
+	if err != nil {
+		m.logger.Error("failed to detect low stock", slogger.Err(err))
+		return
+	}
+	for _, item := range detected {
+		m.logger.Warn("stock below reorder point",
+			slog.Int64("warehouse_id", item.WarehouseID),
+			slog.Int64("product_id", item.ProductID),
+			slog.Int("available", int(item.Available)),
+			slog.Int("reorder_point", int(item.ReorderPoint)),
+		)
+	}
+}
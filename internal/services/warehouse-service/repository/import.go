@@ -0,0 +1,183 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"logistics/internal/services/warehouse-service/domain"
+	"logistics/internal/shared/entity"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// importProduct - товар каталога, на который ссылается строка загрузки
+type importProduct struct {
+	id        int64
+	sku       string
+	name      string
+	unitPrice float64
+	active    bool
+}
+
+// ImportStock приводит остатки складов к фактическим из пакета и обновляет цены; склад задан в каждой строке.
+// Излишек приходуется в партию без учета партий, недостача списывается по FEFO начиная с просроченных партий;
+// каждое изменение - движение adjustment. Строки, не прошедшие проверку, возвращаются в отчете, и тогда, как и
+// при dryRun, транзакция откатывается: пакет применяется целиком или не применяется вовсе
+func (w *WarehouseRepository) ImportStock(ctx context.Context, rows []entity.StockImportRow, userID, now int64, dryRun bool) ([]*entity.StockImportResult, []entity.ImportRowError, error) {
+	tx, err := w.pool.Begin(ctx)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	bySKU, byName, err := importProducts(ctx, tx, rows)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	results := make([]*entity.StockImportResult, 0, len(rows))
+	var rowErrors []entity.ImportRowError
+	rowError := func(line int32, format string, args ...any) {
+		rowErrors = append(rowErrors, entity.ImportRowError{Line: line, Message: fmt.Sprintf(format, args...)})
+	}
+	type stockKey struct{ warehouseID, productID int64 }
+	seen := make(map[stockKey]int32, len(rows))
+	// Цена общая для всех складов: в выгрузке по складам она повторяется и должна совпадать
+	prices := make(map[int64]entity.StockImportRow, len(rows))
+	for _, row := range rows {
+		product, ok := bySKU[row.SKU]
+		if row.SKU == "" {
+			product, ok = byName[row.ProductName]
+		}
+		if !ok {
+			rowError(row.Line, "%v", domain.ErrProductNotFound)
+			continue
+		}
+		key := stockKey{warehouseID: row.WarehouseID, productID: product.id}
+		if line, ok := seen[key]; ok {
+			rowError(row.Line, "product %s in warehouse %d is already listed in line %d", product.sku, row.WarehouseID, line)
+			continue
+		}
+		seen[key] = row.Line
+		if row.UnitPrice != nil {
+			if priced, ok := prices[product.id]; ok && *priced.UnitPrice != *row.UnitPrice {
+				rowError(row.Line, "unit_price %.2f of product %s differs from %.2f in line %d", *row.UnitPrice, product.sku, *priced.UnitPrice, priced.Line)
+				continue
+			}
+			prices[product.id] = row
+		}
+		if !product.active && row.Quantity > 0 {
+			rowError(row.Line, "product %s is deactivated, only zero quantity is accepted", product.sku)
+			continue
+		}
+
+		result := &entity.StockImportResult{
+			Line:         row.Line,
+			WarehouseID:  row.WarehouseID,
+			ProductID:    product.id,
+			SKU:          product.sku,
+			ProductName:  product.name,
+			NewQuantity:  row.Quantity,
+			OldUnitPrice: product.unitPrice,
+			NewUnitPrice: product.unitPrice,
+		}
+		if row.UnitPrice != nil {
+			result.NewUnitPrice = *row.UnitPrice
+		}
+
+		err := tx.QueryRow(ctx, `SELECT quantity FROM warehouse_stock WHERE warehouse_id = $1 AND product_id = $2 FOR UPDATE`,
+			row.WarehouseID, product.id).Scan(&result.OldQuantity)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil, fmt.Errorf("failed to lock stock of product %d in warehouse %d: %w", product.id, row.WarehouseID, err)
+		}
+		// Резервы считаем после блокировки отдельным запросом, иначе не увидим закоммиченные до нее
+		var reserved int32
+		err = tx.QueryRow(ctx, `SELECT `+reservedQuantitySQL+` FROM warehouse_stock ws WHERE ws.warehouse_id = $1 AND ws.product_id = $2`,
+			row.WarehouseID, product.id).Scan(&reserved)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return nil, nil, fmt.Errorf("failed to count reserved stock of product %d in warehouse %d: %w", product.id, row.WarehouseID, err)
+		}
+		// Зарезервированный товар уже обещан заказам, инвентаризация не может оставить его меньше
+		if row.Quantity < reserved {
+			rowError(row.Line, "quantity %d is below %d reserved for orders", row.Quantity, reserved)
+			continue
+		}
+
+		movement := entity.StockMovement{
+			WarehouseID: row.WarehouseID,
+			ProductID:   product.id,
+			Delta:       row.Quantity - result.OldQuantity,
+			Reason:      entity.MovementAdjustment,
+			ActorID:     userID,
+			CreatedAt:   now,
+		}
+		switch {
+		case movement.Delta > 0:
+			err = addToLot(ctx, tx, entity.StockLot{LotNumber: legacyLotNumber, ReceivedAt: now}, movement)
+		case movement.Delta < 0:
+			allocation := entity.StockAllocation{WarehouseID: row.WarehouseID, ProductID: product.id}
+			allocation.Lots, err = pickStocktakeLots(ctx, tx, row.WarehouseID, product.id, -movement.Delta, now)
+			if errors.Is(err, domain.ErrInsufficientStock) {
+				rowError(row.Line, "%v", err)
+				continue
+			}
+			if err == nil {
+				err = writeOffLots(ctx, tx, allocation, movement)
+			}
+		}
+		if err != nil {
+			return nil, nil, err
+		}
+
+		if result.NewUnitPrice != result.OldUnitPrice {
+			_, err := tx.Exec(ctx, `UPDATE products SET unit_price = $1, updated_at = $2 WHERE id = $3`, result.NewUnitPrice, now, product.id)
+			if err != nil {
+				return nil, nil, fmt.Errorf("failed to update price of product %d: %w", product.id, err)
+			}
+		}
+		results = append(results, result)
+	}
+
+	if len(rowErrors) > 0 || dryRun {
+		return results, rowErrors, nil
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return results, nil, nil
+}
+
+// importProducts находит товары строк загрузки по артикулу и наименованию
+func importProducts(ctx context.Context, tx pgx.Tx, rows []entity.StockImportRow) (map[string]importProduct, map[string]importProduct, error) {
+	skus := make([]string, 0, len(rows))
+	names := make([]string, 0, len(rows))
+	for _, row := range rows {
+		if row.SKU != "" {
+			skus = append(skus, row.SKU)
+		} else {
+			names = append(names, row.ProductName)
+		}
+	}
+	// Товары блокируются, чтобы цена и признак активности не изменились до конца загрузки
+	query := `SELECT id, sku, name, unit_price, is_active FROM products WHERE sku = ANY($1) OR name = ANY($2) ORDER BY id FOR UPDATE`
+	dbRows, err := tx.Query(ctx, query, skus, names)
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to query imported products: %w", err)
+	}
+	defer dbRows.Close()
+
+	bySKU := make(map[string]importProduct, len(skus))
+	byName := make(map[string]importProduct, len(names))
+	for dbRows.Next() {
+		var product importProduct
+		if err := dbRows.Scan(&product.id, &product.sku, &product.name, &product.unitPrice, &product.active); err != nil {
+			return nil, nil, fmt.Errorf("failed to scan imported product: %w", err)
+		}
+		bySKU[product.sku] = product
+		byName[product.name] = product
+	}
+	if err := dbRows.Err(); err != nil {
+		return nil, nil, fmt.Errorf("error iterating imported products: %w", err)
+	}
+	return bySKU, byName, nil
+}
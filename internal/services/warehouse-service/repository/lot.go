@@ -0,0 +1,199 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"logistics/internal/services/warehouse-service/domain"
+	"logistics/internal/shared/entity"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// legacyLotNumber - партия остатков без учета партий: принятых до его появления, возвратов без заказа
+// и излишков, найденных при инвентаризации
+const legacyLotNumber = "LEGACY"
+
+// expiredQuantitySQL - количество товара складской строки в просроченных партиях; такой товар не продается
+const expiredQuantitySQL = `COALESCE((SELECT SUM(l.quantity) FROM stock_lots l
+	WHERE l.warehouse_id = ws.warehouse_id AND l.product_id = ws.product_id AND l.expires_at > 0 AND l.expires_at <= EXTRACT(EPOCH FROM NOW())::INTEGER), 0)`
+
+// lotReservedSQL - количество товара партии, удерживаемое действующими резервами
+const lotReservedSQL = `COALESCE((SELECT SUM(rl.quantity) FROM stock_reservation_lots rl
+	JOIN stock_reservations r ON r.order_id = rl.order_id AND r.warehouse_id = l.warehouse_id AND r.product_id = l.product_id
+	WHERE rl.lot_id = l.id AND r.status = 'active' AND r.expires_at > EXTRACT(EPOCH FROM NOW())::INTEGER), 0)`
+
+// stockKey - складская строка
+type stockKey struct {
+	warehouseID int64
+	productID   int64
+}
+
+// pickLots выбирает партии складской строки по FEFO: сначала с ближайшим сроком годности, бессрочные - последними.
+// Просроченные партии и товар, удерживаемый резервами других заказов, не берутся. Выбранные партии блокируются
+func pickLots(ctx context.Context, tx pgx.Tx, warehouseID, productID int64, quantity int32, orderID, now int64) ([]entity.LotAllocation, error) {
+	return lockLots(ctx, tx, warehouseID, productID, quantity, orderID, now, false)
+}
+
+// pickStocktakeLots выбирает партии под недостачу, найденную при инвентаризации. Порядок тот же, что у pickLots,
+// но просроченные партии не пропускаются и списываются первыми; зарезервированный товар не берется
+func pickStocktakeLots(ctx context.Context, tx pgx.Tx, warehouseID, productID int64, quantity int32, now int64) ([]entity.LotAllocation, error) {
+	return lockLots(ctx, tx, warehouseID, productID, quantity, 0, now, true)
+}
+
+func lockLots(ctx context.Context, tx pgx.Tx, warehouseID, productID int64, quantity int32, orderID, now int64, includeExpired bool) ([]entity.LotAllocation, error) {
+	query := `SELECT l.id, l.lot_number, l.expires_at, l.quantity - COALESCE((SELECT SUM(rl.quantity) FROM stock_reservation_lots rl
+			JOIN stock_reservations r ON r.order_id = rl.order_id AND r.warehouse_id = l.warehouse_id AND r.product_id = l.product_id
+			WHERE rl.lot_id = l.id AND rl.order_id <> $3 AND r.status = 'active' AND r.expires_at > $4), 0)
+		FROM stock_lots l
+		WHERE l.warehouse_id = $1 AND l.product_id = $2 AND l.quantity > 0 AND ($5 OR l.expires_at = 0 OR l.expires_at > $4)
+		ORDER BY l.expires_at = 0, l.expires_at, l.received_at, l.id
+		FOR UPDATE OF l`
+	rows, err := tx.Query(ctx, query, warehouseID, productID, orderID, now, includeExpired)
+	if err != nil {
+		return nil, fmt.Errorf("failed to lock lots of product %d in warehouse %d: %w", productID, warehouseID, err)
+	}
+	defer rows.Close()
+
+	var lots []entity.LotAllocation
+	remaining := quantity
+	for rows.Next() {
+		var lot entity.LotAllocation
+		var available int32
+		if err := rows.Scan(&lot.LotID, &lot.LotNumber, &lot.ExpiresAt, &available); err != nil {
+			return nil, fmt.Errorf("failed to scan lot: %w", err)
+		}
+		if remaining == 0 || available <= 0 {
+			continue
+		}
+		lot.Quantity = min(remaining, available)
+		remaining -= lot.Quantity
+		lots = append(lots, lot)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating lots: %w", err)
+	}
+	if remaining > 0 {
+		return nil, fmt.Errorf("product %d in warehouse %d: requested %d, available in lots %d: %w",
+			productID, warehouseID, quantity, quantity-remaining, domain.ErrInsufficientStock)
+	}
+	return lots, nil
+}
+
+// reservationLots возвращает партии, выбранные под резервы заказа, по складским строкам
+func reservationLots(ctx context.Context, q queryer, orderID int64) (map[stockKey][]entity.LotAllocation, error) {
+	rows, err := q.Query(ctx, `SELECT l.warehouse_id, l.product_id, l.id, l.lot_number, l.expires_at, rl.quantity
+		FROM stock_reservation_lots rl JOIN stock_lots l ON l.id = rl.lot_id
+		WHERE rl.order_id = $1
+		ORDER BY l.warehouse_id, l.product_id, l.expires_at = 0, l.expires_at, l.id`, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get reserved lots of order %d: %w", orderID, err)
+	}
+	defer rows.Close()
+
+	lots := make(map[stockKey][]entity.LotAllocation)
+	for rows.Next() {
+		var key stockKey
+		var lot entity.LotAllocation
+		if err := rows.Scan(&key.warehouseID, &key.productID, &lot.LotID, &lot.LotNumber, &lot.ExpiresAt, &lot.Quantity); err != nil {
+			return nil, fmt.Errorf("failed to scan reserved lot: %w", err)
+		}
+		lots[key] = append(lots[key], lot)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating reserved lots: %w", err)
+	}
+	return lots, nil
+}
+
+// writeOffLots списывает товар складской строки из партий allocation.Lots и пишет движение по каждой партии.
+// movement задает причину, основание и время списания
+func writeOffLots(ctx context.Context, tx pgx.Tx, allocation entity.StockAllocation, movement entity.StockMovement) error {
+	var total int32
+	for _, lot := range allocation.Lots {
+		total += lot.Quantity
+	}
+	_, err := tx.Exec(ctx, `UPDATE warehouse_stock SET quantity = quantity - $1, last_updated = $2 WHERE warehouse_id = $3 AND product_id = $4`,
+		total, movement.CreatedAt, allocation.WarehouseID, allocation.ProductID)
+	if err != nil {
+		return fmt.Errorf("failed to write off product %d in warehouse %d: %w", allocation.ProductID, allocation.WarehouseID, err)
+	}
+
+	movement.WarehouseID = allocation.WarehouseID
+	movement.ProductID = allocation.ProductID
+	for _, lot := range allocation.Lots {
+		if _, err := tx.Exec(ctx, `UPDATE stock_lots SET quantity = quantity - $2 WHERE id = $1`, lot.LotID, lot.Quantity); err != nil {
+			return fmt.Errorf("failed to write off lot %s of product %d: %w", lot.LotNumber, allocation.ProductID, err)
+		}
+		movement.LotID = lot.LotID
+		movement.Delta = -lot.Quantity
+		if err := recordMovement(ctx, tx, movement); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// addToLot приходует movement.Delta товара на складскую строку и в партию: в существующую при movement.LotID != 0,
+// иначе в партию lot, найденную или созданную по номеру. Пишет движение по партии
+func addToLot(ctx context.Context, tx pgx.Tx, lot entity.StockLot, movement entity.StockMovement) error {
+	stockQuery := `INSERT INTO warehouse_stock (warehouse_id, product_id, quantity, last_updated) VALUES ($1, $2, $3, $4)
+		ON CONFLICT (warehouse_id, product_id) DO UPDATE SET quantity = warehouse_stock.quantity + EXCLUDED.quantity, last_updated = EXCLUDED.last_updated`
+	if _, err := tx.Exec(ctx, stockQuery, movement.WarehouseID, movement.ProductID, movement.Delta, movement.CreatedAt); err != nil {
+		return fmt.Errorf("failed to add stock for product %d to warehouse %d: %w", movement.ProductID, movement.WarehouseID, err)
+	}
+
+	if movement.LotID != 0 {
+		if _, err := tx.Exec(ctx, `UPDATE stock_lots SET quantity = quantity + $2 WHERE id = $1`, movement.LotID, movement.Delta); err != nil {
+			return fmt.Errorf("failed to add stock to lot %d: %w", movement.LotID, err)
+		}
+	} else {
+		// Повторное поступление партии с тем же номером пополняет ее, срок годности и дата поступления остаются прежними
+		lotQuery := `INSERT INTO stock_lots (warehouse_id, product_id, lot_number, expires_at, received_at, quantity) VALUES ($1, $2, $3, $4, $5, $6)
+			ON CONFLICT (warehouse_id, product_id, lot_number) DO UPDATE SET quantity = stock_lots.quantity + EXCLUDED.quantity
+			RETURNING id`
+		err := tx.QueryRow(ctx, lotQuery, movement.WarehouseID, movement.ProductID, lot.LotNumber, lot.ExpiresAt, lot.ReceivedAt, movement.Delta).Scan(&movement.LotID)
+		if err != nil {
+			return fmt.Errorf("failed to add stock to lot %s of product %d: %w", lot.LotNumber, movement.ProductID, err)
+		}
+	}
+	return recordMovement(ctx, tx, movement)
+}
+
+// ListStockLots возвращает партии с остатком по сроку годности: сначала истекающие, бессрочные - в конце.
+// filter.ExpiresBefore != 0 оставляет только партии со сроком годности до этого момента, включая просроченные
+func (w *WarehouseRepository) ListStockLots(ctx context.Context, filter domain.LotFilter) ([]*entity.StockLot, error) {
+	query := `SELECT l.id, l.warehouse_id, l.product_id, p.name, l.lot_number, l.expires_at, l.received_at, l.quantity, ` + lotReservedSQL + `
+		FROM stock_lots l JOIN products p ON p.id = l.product_id
+		WHERE l.quantity > 0 AND ($1 = 0 OR l.warehouse_id = $1) AND ($2 = 0 OR l.product_id = $2)
+			AND ($3 = 0 OR (l.expires_at > 0 AND l.expires_at <= $3))
+		ORDER BY l.expires_at = 0, l.expires_at, l.warehouse_id, l.product_id, l.id`
+	rows, err := w.pool.Query(ctx, query, filter.WarehouseID, filter.ProductID, filter.ExpiresBefore)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query stock lots: %w", err)
+	}
+	defer rows.Close()
+
+	var lots []*entity.StockLot
+	for rows.Next() {
+		var lot entity.StockLot
+		err := rows.Scan(
+			&lot.ID,
+			&lot.WarehouseID,
+			&lot.ProductID,
+			&lot.ProductName,
+			&lot.LotNumber,
+			&lot.ExpiresAt,
+			&lot.ReceivedAt,
+			&lot.Quantity,
+			&lot.Reserved,
+		)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan stock lot: %w", err)
+		}
+		lots = append(lots, &lot)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating stock lots: %w", err)
+	}
+	return lots, nil
+}
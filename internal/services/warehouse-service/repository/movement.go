@@ -0,0 +1,156 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"logistics/internal/services/warehouse-service/domain"
+	"logistics/internal/shared/entity"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const movementColumns = `id, warehouse_id, product_id, delta, reason, reference_id, actor_id, created_at, COALESCE(lot_id, 0)`
+
+// recordMovement пишет изменение остатка в журнал; вызывается в транзакции, которая меняет остаток
+func recordMovement(ctx context.Context, tx pgx.Tx, movement entity.StockMovement) error {
+	// Нулевое изменение остаток не меняет, журналу оно не нужно
+	if movement.Delta == 0 {
+		return nil
+	}
+	query := `INSERT INTO stock_movements (warehouse_id, product_id, delta, reason, reference_id, actor_id, created_at, lot_id)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, NULLIF($8, 0))`
+	_, err := tx.Exec(ctx, query,
+		movement.WarehouseID,
+		movement.ProductID,
+		movement.Delta,
+		movement.Reason,
+		movement.ReferenceID,
+		movement.ActorID,
+		movement.CreatedAt,
+		movement.LotID,
+	)
+	if err != nil {
+		return fmt.Errorf("failed to record %s movement of product %d in warehouse %d: %w", movement.Reason, movement.ProductID, movement.WarehouseID, err)
+	}
+	return nil
+}
+
+// ListStockMovements возвращает движения товара, новые сначала, и общее число подходящих записей
+func (w *WarehouseRepository) ListStockMovements(ctx context.Context, filter domain.MovementFilter) ([]*entity.StockMovement, int64, error) {
+	where := ` FROM stock_movements WHERE product_id = $1 AND ($2 = '' OR reason = $2)
+		AND ($3 = 0 OR created_at >= $3) AND ($4 = 0 OR created_at < $4) AND ($5 = 0 OR warehouse_id = $5)`
+	args := []any{filter.ProductID, string(filter.Reason), filter.Since, filter.Until, filter.WarehouseID}
+
+	var total int64
+	if err := w.pool.QueryRow(ctx, `SELECT COUNT(*)`+where, args...).Scan(&total); err != nil {
+		return nil, 0, fmt.Errorf("failed to count movements of product %d: %w", filter.ProductID, err)
+	}
+
+	query := `SELECT ` + movementColumns + where + ` ORDER BY id DESC LIMIT $6 OFFSET $7`
+	rows, err := w.pool.Query(ctx, query, append(args, filter.Limit, filter.Offset)...)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query movements of product %d: %w", filter.ProductID, err)
+	}
+	defer rows.Close()
+
+	movements := make([]*entity.StockMovement, 0, filter.Limit)
+	for rows.Next() {
+		var movement entity.StockMovement
+		err := rows.Scan(
+			&movement.ID,
+			&movement.WarehouseID,
+			&movement.ProductID,
+			&movement.Delta,
+			&movement.Reason,
+			&movement.ReferenceID,
+			&movement.ActorID,
+			&movement.CreatedAt,
+			&movement.LotID,
+		)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan movement: %w", err)
+		}
+		movements = append(movements, &movement)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating movements: %w", err)
+	}
+	return movements, total, nil
+}
+
+// ExportStockMovements передает fn движения по порядку записи в журнал. Нулевые ProductID и WarehouseID
+// не ограничивают выборку, Limit и Offset не используются. Ошибка fn прерывает выгрузку и возвращается как есть
+func (w *WarehouseRepository) ExportStockMovements(ctx context.Context, filter domain.MovementFilter, fn func(*entity.StockMovement) error) error {
+	query := `SELECT ` + movementColumns + ` FROM stock_movements
+		WHERE ($1 = 0 OR product_id = $1) AND ($2 = '' OR reason = $2)
+			AND ($3 = 0 OR created_at >= $3) AND ($4 = 0 OR created_at < $4) AND ($5 = 0 OR warehouse_id = $5)
+		ORDER BY id`
+	rows, err := w.pool.Query(ctx, query, filter.ProductID, string(filter.Reason), filter.Since, filter.Until, filter.WarehouseID)
+	if err != nil {
+		return fmt.Errorf("failed to query movements for export: %w", err)
+	}
+	defer rows.Close()
+
+	for rows.Next() {
+		var movement entity.StockMovement
+		err := rows.Scan(
+			&movement.ID,
+			&movement.WarehouseID,
+			&movement.ProductID,
+			&movement.Delta,
+			&movement.Reason,
+			&movement.ReferenceID,
+			&movement.ActorID,
+			&movement.CreatedAt,
+			&movement.LotID,
+		)
+		if err != nil {
+			return fmt.Errorf("failed to scan movement: %w", err)
+		}
+		if err := fn(&movement); err != nil {
+			return err
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating movements: %w", err)
+	}
+	return nil
+}
+
+// ReconcileStock сверяет остаток каждой складской строки с суммой ее движений по журналу.
+// Пустой productIDs - проверяются все товары; возвращает расхождения и число проверенных строк
+func (w *WarehouseRepository) ReconcileStock(ctx context.Context, productIDs []int64) ([]*entity.StockDiscrepancy, int64, error) {
+	// Остаток и журнал читаются одним запросом, поэтому видят один и тот же снимок данных
+	query := `SELECT ws.warehouse_id, ws.product_id, p.name, ws.quantity, COALESCE(m.total, 0)
+		FROM warehouse_stock ws
+		JOIN products p ON p.id = ws.product_id
+		LEFT JOIN (SELECT warehouse_id, product_id, SUM(delta) AS total FROM stock_movements GROUP BY warehouse_id, product_id) m
+			ON m.warehouse_id = ws.warehouse_id AND m.product_id = ws.product_id
+		WHERE cardinality($1::BIGINT[]) = 0 OR ws.product_id = ANY($1)
+		ORDER BY ws.warehouse_id, ws.product_id`
+	if productIDs == nil {
+		productIDs = []int64{}
+	}
+	rows, err := w.pool.Query(ctx, query, productIDs)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to reconcile stock: %w", err)
+	}
+	defer rows.Close()
+
+	var checked int64
+	var discrepancies []*entity.StockDiscrepancy
+	for rows.Next() {
+		var d entity.StockDiscrepancy
+		if err := rows.Scan(&d.WarehouseID, &d.ProductID, &d.ProductName, &d.OnHand, &d.LedgerTotal); err != nil {
+			return nil, 0, fmt.Errorf("failed to scan reconciliation row: %w", err)
+		}
+		checked++
+		if d.OnHand != d.LedgerTotal {
+			discrepancies = append(discrepancies, &d)
+		}
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating reconciliation rows: %w", err)
+	}
+	return discrepancies, checked, nil
+}
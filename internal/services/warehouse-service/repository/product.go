@@ -0,0 +1,190 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"logistics/internal/services/warehouse-service/domain"
+	"logistics/internal/shared/entity"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const productColumns = `id, sku, name, description, unit_price, weight_grams, length_mm, width_mm, height_mm,
+	reorder_point, reorder_quantity, is_active, created_at, updated_at`
+
+// scanProduct читает строку, выбранную по productColumns
+func scanProduct(row pgx.Row) (*entity.Product, error) {
+	var product entity.Product
+	err := row.Scan(
+		&product.ID,
+		&product.SKU,
+		&product.Name,
+		&product.Description,
+		&product.UnitPrice,
+		&product.WeightGrams,
+		&product.Dimensions.LengthMM,
+		&product.Dimensions.WidthMM,
+		&product.Dimensions.HeightMM,
+		&product.ReorderPoint,
+		&product.ReorderQuantity,
+		&product.Active,
+		&product.CreatedAt,
+		&product.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &product, nil
+}
+
+// productUniqueViolation переводит нарушение уникальности артикула или наименования в доменную ошибку
+func productUniqueViolation(err error) error {
+	var pgErr *pgconn.PgError
+	if !errors.As(err, &pgErr) || pgErr.Code != "23505" {
+		return nil
+	}
+	switch pgErr.ConstraintName {
+	case "products_sku_key":
+		return domain.ErrDuplicateSKU
+	case "products_name_key":
+		return domain.ErrDuplicateProductName
+	}
+	return nil
+}
+
+// CreateProduct добавляет товар в каталог. Складские строки появляются при первой приемке на склад
+func (w *WarehouseRepository) CreateProduct(ctx context.Context, product *entity.Product) (*entity.Product, error) {
+	query := `INSERT INTO products (sku, name, description, unit_price, weight_grams, length_mm, width_mm, height_mm,
+		reorder_point, reorder_quantity, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $8, $9, $10, $11, $11) RETURNING ` + productColumns
+	created, err := scanProduct(w.pool.QueryRow(ctx, query,
+		product.SKU,
+		product.Name,
+		product.Description,
+		product.UnitPrice,
+		product.WeightGrams,
+		product.Dimensions.LengthMM,
+		product.Dimensions.WidthMM,
+		product.Dimensions.HeightMM,
+		product.ReorderPoint,
+		product.ReorderQuantity,
+		product.CreatedAt,
+	))
+	if err != nil {
+		if domainErr := productUniqueViolation(err); domainErr != nil {
+			return nil, domainErr
+		}
+		return nil, fmt.Errorf("failed to create product: %w", err)
+	}
+	return created, nil
+}
+
+func (w *WarehouseRepository) GetProduct(ctx context.Context, productID int64) (*entity.Product, error) {
+	query := `SELECT ` + productColumns + ` FROM products WHERE id = $1`
+	product, err := scanProduct(w.pool.QueryRow(ctx, query, productID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("product %d: %w", productID, domain.ErrProductNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get product %d: %w", productID, err)
+	}
+	return product, nil
+}
+
+// ListProducts возвращает страницу каталога и общее число подходящих товаров
+func (w *WarehouseRepository) ListProducts(ctx context.Context, filter domain.ProductFilter) ([]*entity.Product, int64, error) {
+	var total int64
+	err := w.pool.QueryRow(ctx, `SELECT COUNT(*) FROM products WHERE $1 OR is_active`, filter.IncludeInactive).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count products: %w", err)
+	}
+
+	query := `SELECT ` + productColumns + ` FROM products WHERE $1 OR is_active ORDER BY id LIMIT $2 OFFSET $3`
+	rows, err := w.pool.Query(ctx, query, filter.IncludeInactive, filter.Limit, filter.Offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query products: %w", err)
+	}
+	defer rows.Close()
+
+	products := make([]*entity.Product, 0, filter.Limit)
+	for rows.Next() {
+		product, err := scanProduct(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan product: %w", err)
+		}
+		products = append(products, product)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating products: %w", err)
+	}
+	return products, total, nil
+}
+
+// UpdateProduct заменяет карточку товара; active == nil оставляет признак активности как есть
+func (w *WarehouseRepository) UpdateProduct(ctx context.Context, product *entity.Product, active *bool) (*entity.Product, error) {
+	query := `UPDATE products SET sku = $1, name = $2, description = $3, unit_price = $4, weight_grams = $5,
+		length_mm = $6, width_mm = $7, height_mm = $8, reorder_point = $9, reorder_quantity = $10,
+		is_active = COALESCE($11, is_active), updated_at = $12
+		WHERE id = $13 RETURNING ` + productColumns
+	updated, err := scanProduct(w.pool.QueryRow(ctx, query,
+		product.SKU,
+		product.Name,
+		product.Description,
+		product.UnitPrice,
+		product.WeightGrams,
+		product.Dimensions.LengthMM,
+		product.Dimensions.WidthMM,
+		product.Dimensions.HeightMM,
+		product.ReorderPoint,
+		product.ReorderQuantity,
+		active,
+		product.UpdatedAt,
+		product.ID,
+	))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("product %d: %w", product.ID, domain.ErrProductNotFound)
+	}
+	if err != nil {
+		if domainErr := productUniqueViolation(err); domainErr != nil {
+			return nil, domainErr
+		}
+		return nil, fmt.Errorf("failed to update product %d: %w", product.ID, err)
+	}
+	return updated, nil
+}
+
+func (w *WarehouseRepository) DeactivateProduct(ctx context.Context, productID, now int64) (*entity.Product, error) {
+	query := `UPDATE products SET is_active = FALSE, updated_at = $2 WHERE id = $1 RETURNING ` + productColumns
+	product, err := scanProduct(w.pool.QueryRow(ctx, query, productID, now))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("product %d: %w", productID, domain.ErrProductNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to deactivate product %d: %w", productID, err)
+	}
+	return product, nil
+}
+
+func (w *WarehouseRepository) GetActiveProductsByName(ctx context.Context, names []string) ([]*entity.Product, error) {
+	query := `SELECT ` + productColumns + ` FROM products WHERE name = ANY($1) AND is_active`
+	rows, err := w.pool.Query(ctx, query, names)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query products by name: %w", err)
+	}
+	defer rows.Close()
+
+	var products []*entity.Product
+	for rows.Next() {
+		product, err := scanProduct(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan product: %w", err)
+		}
+		products = append(products, product)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating products: %w", err)
+	}
+	return products, nil
+}
@@ -0,0 +1,281 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"logistics/internal/services/warehouse-service/domain"
+	"logistics/internal/shared/entity"
+	"strings"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+// queryer - общее у пула и транзакции, чтобы читать документ и внутри, и вне транзакции
+type queryer interface {
+	Query(ctx context.Context, sql string, args ...any) (pgx.Rows, error)
+	QueryRow(ctx context.Context, sql string, args ...any) pgx.Row
+}
+
+const receivingColumns = `id, warehouse_id, supplier, reference, note, status, created_by, posted_by, created_at, updated_at, posted_at`
+
+func scanReceivingDocument(row pgx.Row) (*entity.ReceivingDocument, error) {
+	var document entity.ReceivingDocument
+	err := row.Scan(
+		&document.ID,
+		&document.WarehouseID,
+		&document.Supplier,
+		&document.Reference,
+		&document.Note,
+		&document.Status,
+		&document.CreatedBy,
+		&document.PostedBy,
+		&document.CreatedAt,
+		&document.UpdatedAt,
+		&document.PostedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &document, nil
+}
+
+// unknownProduct переводит нарушение внешнего ключа на каталог в доменную ошибку
+func unknownProduct(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23503" && strings.HasSuffix(pgErr.ConstraintName, "product_id_fkey") {
+		return domain.ErrProductNotFound
+	}
+	return nil
+}
+
+func isForeignKeyViolation(err error, constraint string) bool {
+	var pgErr *pgconn.PgError
+	return errors.As(err, &pgErr) && pgErr.Code == "23503" && pgErr.ConstraintName == constraint
+}
+
+func (w *WarehouseRepository) CreateReceivingDocument(ctx context.Context, document *entity.ReceivingDocument) (*entity.ReceivingDocument, error) {
+	tx, err := w.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `INSERT INTO receiving_documents (warehouse_id, supplier, reference, note, status, created_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $7) RETURNING id`
+	var documentID int64
+	err = tx.QueryRow(ctx, query, document.WarehouseID, document.Supplier, document.Reference, document.Note, entity.ReceivingDraft, document.CreatedBy, document.CreatedAt).Scan(&documentID)
+	if err != nil {
+		if isForeignKeyViolation(err, "receiving_documents_warehouse_id_fkey") {
+			return nil, fmt.Errorf("warehouse %d: %w", document.WarehouseID, domain.ErrWarehouseNotFound)
+		}
+		return nil, fmt.Errorf("failed to create receiving document: %w", err)
+	}
+
+	lineQuery := `INSERT INTO receiving_lines (document_id, product_id, expected_quantity, received_quantity, lot_number, expires_at)
+		VALUES ($1, $2, $3, $4, $5, $6)`
+	for _, line := range document.Lines {
+		if _, err := tx.Exec(ctx, lineQuery, documentID, line.ProductID, line.ExpectedQuantity, line.ReceivedQuantity, line.LotNumber, line.ExpiresAt); err != nil {
+			if domainErr := unknownProduct(err); domainErr != nil {
+				return nil, fmt.Errorf("product %d: %w", line.ProductID, domainErr)
+			}
+			return nil, fmt.Errorf("failed to add product %d to receiving document: %w", line.ProductID, err)
+		}
+	}
+
+	created, err := loadReceivingDocument(ctx, tx, documentID)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return created, nil
+}
+
+func (w *WarehouseRepository) GetReceivingDocument(ctx context.Context, documentID int64) (*entity.ReceivingDocument, error) {
+	return loadReceivingDocument(ctx, w.pool, documentID)
+}
+
+func (w *WarehouseRepository) ListReceivingDocuments(ctx context.Context, filter domain.ReceivingFilter) ([]*entity.ReceivingDocument, int64, error) {
+	var total int64
+	err := w.pool.QueryRow(ctx, `SELECT COUNT(*) FROM receiving_documents WHERE $1 = '' OR status = $1`, string(filter.Status)).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count receiving documents: %w", err)
+	}
+
+	query := `SELECT ` + receivingColumns + ` FROM receiving_documents WHERE $1 = '' OR status = $1
+		ORDER BY id DESC LIMIT $2 OFFSET $3`
+	rows, err := w.pool.Query(ctx, query, string(filter.Status), filter.Limit, filter.Offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query receiving documents: %w", err)
+	}
+	defer rows.Close()
+
+	documents := make([]*entity.ReceivingDocument, 0, filter.Limit)
+	for rows.Next() {
+		document, err := scanReceivingDocument(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan receiving document: %w", err)
+		}
+		documents = append(documents, document)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating receiving documents: %w", err)
+	}
+	return documents, total, nil
+}
+
+// RecordReceivedQuantities задает принятое количество по строкам черновика.
+// Товар, которого не было в ожидаемых строках, добавляется строкой с нулевым ожидаемым количеством
+func (w *WarehouseRepository) RecordReceivedQuantities(ctx context.Context, documentID int64, lines []entity.ReceivingLine, now int64) (*entity.ReceivingDocument, error) {
+	tx, err := w.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := lockDraftReceiving(ctx, tx, documentID); err != nil {
+		return nil, err
+	}
+
+	// Партия, не указанная при отметке, остается той, что задана раньше
+	upsertQuery := `INSERT INTO receiving_lines (document_id, product_id, received_quantity, lot_number, expires_at) VALUES ($1, $2, $3, $4, $5)
+		ON CONFLICT (document_id, product_id) DO UPDATE SET received_quantity = EXCLUDED.received_quantity,
+			lot_number = COALESCE(NULLIF(EXCLUDED.lot_number, ''), receiving_lines.lot_number),
+			expires_at = CASE WHEN EXCLUDED.lot_number <> '' OR EXCLUDED.expires_at > 0 THEN EXCLUDED.expires_at ELSE receiving_lines.expires_at END`
+	for _, line := range lines {
+		if _, err := tx.Exec(ctx, upsertQuery, documentID, line.ProductID, line.ReceivedQuantity, line.LotNumber, line.ExpiresAt); err != nil {
+			if domainErr := unknownProduct(err); domainErr != nil {
+				return nil, fmt.Errorf("product %d: %w", line.ProductID, domainErr)
+			}
+			return nil, fmt.Errorf("failed to record received product %d: %w", line.ProductID, err)
+		}
+	}
+	if _, err := tx.Exec(ctx, `UPDATE receiving_documents SET updated_at = $2 WHERE id = $1`, documentID, now); err != nil {
+		return nil, fmt.Errorf("failed to update receiving document %d: %w", documentID, err)
+	}
+
+	document, err := loadReceivingDocument(ctx, tx, documentID)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return document, nil
+}
+
+// PostReceivingDocument приходует принятый товар на склад и закрывает документ.
+// Каждая оприходованная строка попадает в stock_receipts
+func (w *WarehouseRepository) PostReceivingDocument(ctx context.Context, documentID, userID, now int64) (*entity.ReceivingDocument, error) {
+	tx, err := w.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := lockDraftReceiving(ctx, tx, documentID); err != nil {
+		return nil, err
+	}
+	document, err := loadReceivingDocument(ctx, tx, documentID)
+	if err != nil {
+		return nil, err
+	}
+
+	receiptQuery := `INSERT INTO stock_receipts (document_id, product_id, quantity, posted_by, posted_at) VALUES ($1, $2, $3, $4, $5)`
+
+	posted := 0
+	// Строки идут по product_id, поэтому блокировки складских строк берутся в одном порядке
+	for _, line := range document.Lines {
+		if line.ReceivedQuantity == 0 {
+			continue
+		}
+		lot := entity.StockLot{LotNumber: line.LotNumber, ExpiresAt: line.ExpiresAt, ReceivedAt: now}
+		if lot.LotNumber == "" {
+			lot.LotNumber = fmt.Sprintf("RCV-%d", documentID)
+		}
+		err := addToLot(ctx, tx, lot, entity.StockMovement{
+			WarehouseID: document.WarehouseID,
+			ProductID:   line.ProductID,
+			Delta:       line.ReceivedQuantity,
+			Reason:      entity.MovementReceipt,
+			ReferenceID: documentID,
+			ActorID:     userID,
+			CreatedAt:   now,
+		})
+		if err != nil {
+			return nil, err
+		}
+		if _, err := tx.Exec(ctx, receiptQuery, documentID, line.ProductID, line.ReceivedQuantity, userID, now); err != nil {
+			return nil, fmt.Errorf("failed to record receipt of product %d: %w", line.ProductID, err)
+		}
+		posted++
+	}
+	if posted == 0 {
+		return nil, fmt.Errorf("receiving document %d: %w", documentID, domain.ErrNothingReceived)
+	}
+
+	_, err = tx.Exec(ctx, `UPDATE receiving_documents SET status = $2, posted_by = $3, posted_at = $4, updated_at = $4 WHERE id = $1`,
+		documentID, entity.ReceivingPosted, userID, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to post receiving document %d: %w", documentID, err)
+	}
+
+	document.Status = entity.ReceivingPosted
+	document.PostedBy = userID
+	document.PostedAt = now
+	document.UpdatedAt = now
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return document, nil
+}
+
+// lockDraftReceiving блокирует документ и проверяет, что он еще не оприходован
+func lockDraftReceiving(ctx context.Context, tx pgx.Tx, documentID int64) error {
+	var status entity.ReceivingStatus
+	err := tx.QueryRow(ctx, `SELECT status FROM receiving_documents WHERE id = $1 FOR UPDATE`, documentID).Scan(&status)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("receiving document %d: %w", documentID, domain.ErrReceivingNotFound)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to lock receiving document %d: %w", documentID, err)
+	}
+	if status != entity.ReceivingDraft {
+		return fmt.Errorf("receiving document %d: %w", documentID, domain.ErrReceivingPosted)
+	}
+	return nil
+}
+
+// loadReceivingDocument читает документ вместе со строками
+func loadReceivingDocument(ctx context.Context, q queryer, documentID int64) (*entity.ReceivingDocument, error) {
+	query := `SELECT ` + receivingColumns + ` FROM receiving_documents WHERE id = $1`
+	document, err := scanReceivingDocument(q.QueryRow(ctx, query, documentID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("receiving document %d: %w", documentID, domain.ErrReceivingNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get receiving document %d: %w", documentID, err)
+	}
+
+	rows, err := q.Query(ctx, `SELECT l.product_id, p.name, l.expected_quantity, l.received_quantity, l.lot_number, l.expires_at
+		FROM receiving_lines l JOIN products p ON p.id = l.product_id
+		WHERE l.document_id = $1 ORDER BY l.product_id`, documentID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lines of receiving document %d: %w", documentID, err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var line entity.ReceivingLine
+		if err := rows.Scan(&line.ProductID, &line.ProductName, &line.ExpectedQuantity, &line.ReceivedQuantity, &line.LotNumber, &line.ExpiresAt); err != nil {
+			return nil, fmt.Errorf("failed to scan receiving line: %w", err)
+		}
+		document.Lines = append(document.Lines, line)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating receiving lines: %w", err)
+	}
+	return document, nil
+}
@@ -0,0 +1,144 @@
+package repository
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	kfk "logistics/internal/kafka"
+	"logistics/internal/shared/entity"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// belowReorderPointSQL - складские строки активных товаров на активных складах,
+// доступный остаток которых ниже точки заказа
+const belowReorderPointSQL = `SELECT * FROM (
+		SELECT ws.warehouse_id, ws.product_id, p.sku, p.name, ws.quantity, ` + reservedQuantitySQL + ` AS reserved,
+			p.reorder_point, p.reorder_quantity
+		FROM warehouse_stock ws
+		JOIN products p ON p.id = ws.product_id
+		JOIN warehouses w ON w.id = ws.warehouse_id
+		WHERE p.is_active AND w.is_active AND p.reorder_point > 0
+	) s WHERE s.quantity - s.reserved < s.reorder_point`
+
+func scanReplenishmentItem(row pgx.Row, extra ...any) (*entity.ReplenishmentItem, error) {
+	var item entity.ReplenishmentItem
+	dest := []any{
+		&item.WarehouseID,
+		&item.ProductID,
+		&item.SKU,
+		&item.ProductName,
+		&item.Quantity,
+		&item.Reserved,
+		&item.ReorderPoint,
+		&item.ReorderQuantity,
+	}
+	if err := row.Scan(append(dest, extra...)...); err != nil {
+		return nil, err
+	}
+	item.Available = item.Quantity - item.Reserved
+	// Заказываем обычную партию, но не меньше, чем нужно, чтобы вернуться к точке заказа
+	item.SuggestedQuantity = max(item.ReorderQuantity, item.ReorderPoint-item.Available)
+	return &item, nil
+}
+
+// DetectLowStock находит товары, опустившиеся ниже точки заказа после прошлой проверки,
+// и ставит по каждому событие stock.low в outbox. Восстановленные остатки снимаются с учета,
+// поэтому следующее падение снова даст событие
+func (w *WarehouseRepository) DetectLowStock(ctx context.Context, now int64) ([]*entity.ReplenishmentItem, error) {
+	tx, err := w.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	_, err = tx.Exec(ctx, `DELETE FROM low_stock_alerts a WHERE NOT EXISTS (
+		SELECT 1 FROM (`+belowReorderPointSQL+`) low WHERE low.warehouse_id = a.warehouse_id AND low.product_id = a.product_id)`)
+	if err != nil {
+		return nil, fmt.Errorf("failed to clear recovered low stock alerts: %w", err)
+	}
+
+	query := `SELECT low.* FROM (` + belowReorderPointSQL + `) low
+		WHERE NOT EXISTS (SELECT 1 FROM low_stock_alerts a WHERE a.warehouse_id = low.warehouse_id AND a.product_id = low.product_id)
+		ORDER BY low.warehouse_id, low.product_id`
+	rows, err := tx.Query(ctx, query)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query low stock: %w", err)
+	}
+	var candidates []*entity.ReplenishmentItem
+	for rows.Next() {
+		item, err := scanReplenishmentItem(rows)
+		if err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan low stock item: %w", err)
+		}
+		candidates = append(candidates, item)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating low stock: %w", err)
+	}
+
+	alertQuery := `INSERT INTO low_stock_alerts (warehouse_id, product_id, available, reorder_point, detected_at)
+		VALUES ($1, $2, $3, $4, $5) ON CONFLICT DO NOTHING`
+	var detected []*entity.ReplenishmentItem
+	for _, item := range candidates {
+		tag, err := tx.Exec(ctx, alertQuery, item.WarehouseID, item.ProductID, item.Available, item.ReorderPoint, now)
+		if err != nil {
+			return nil, fmt.Errorf("failed to record low stock of product %d in warehouse %d: %w", item.ProductID, item.WarehouseID, err)
+		}
+		// Параллельная проверка уже отправила событие по этому товару
+		if tag.RowsAffected() == 0 {
+			continue
+		}
+		item.DetectedAt = now
+
+		payload, err := json.Marshal(item)
+		if err != nil {
+			return nil, fmt.Errorf("failed to marshal low stock event: %w", err)
+		}
+		err = kfk.EnqueueOutbox(ctx, tx, kfk.WarehouseOutboxTable, kfk.OutboxMessage{
+			EventType: kfk.EventStockLow,
+			Key:       fmt.Sprintf("%d:%d", item.WarehouseID, item.ProductID),
+			Payload:   payload,
+			CreatedAt: now,
+		})
+		if err != nil {
+			return nil, err
+		}
+		detected = append(detected, item)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return detected, nil
+}
+
+// ListReplenishment возвращает товары ниже точки заказа с рекомендуемым количеством к заказу
+func (w *WarehouseRepository) ListReplenishment(ctx context.Context, warehouseID int64) ([]*entity.ReplenishmentItem, error) {
+	query := `SELECT low.*, COALESCE(a.detected_at, 0) FROM (` + belowReorderPointSQL + `) low
+		LEFT JOIN low_stock_alerts a ON a.warehouse_id = low.warehouse_id AND a.product_id = low.product_id
+		WHERE $1 = 0 OR low.warehouse_id = $1
+		ORDER BY low.warehouse_id, low.product_id`
+	rows, err := w.pool.Query(ctx, query, warehouseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query replenishment list: %w", err)
+	}
+	defer rows.Close()
+
+	var items []*entity.ReplenishmentItem
+	for rows.Next() {
+		var detectedAt int64
+		item, err := scanReplenishmentItem(rows, &detectedAt)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan replenishment item: %w", err)
+		}
+		item.DetectedAt = detectedAt
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating replenishment list: %w", err)
+	}
+	return items, nil
+}
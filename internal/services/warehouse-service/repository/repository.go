@@ -3,8 +3,11 @@ package repository
 import (
 	"context"
 	"fmt"
+	"logistics/internal/services/warehouse-service/domain"
 	"logistics/internal/shared/entity"
+	"sort"
 
+	"github.com/jackc/pgx/v5"
 	"github.com/jackc/pgx/v5/pgxpool"
 )
 
@@ -18,52 +21,52 @@ func NewWarehouseRepository(pool *pgxpool.Pool) *WarehouseRepository {
 	}
 }
 
-func (w *WarehouseRepository) CheckStockAvailability(ctx context.Context, orders []*entity.GoodsItem) (bool, error) {
-	if len(orders) == 0 {
-		return true, nil
+// GetAvailableStock возвращает доступные остатки товаров по активным складам: на складе минус действующие резервы
+// и просроченные партии.
+// warehouseID == 0 - все склады. Снятые с продажи товары считаются отсутствующими
+func (w *WarehouseRepository) GetAvailableStock(ctx context.Context, productNames []string, warehouseID int64) ([]*entity.WarehouseStockItem, error) {
+	if len(productNames) == 0 {
+		return nil, nil
 	}
+	query := `SELECT ws.warehouse_id, ws.product_id, p.name, ws.quantity, ws.quantity - ` + reservedQuantitySQL + ` - ` + expiredQuantitySQL + `, ws.last_updated
+		FROM warehouse_stock ws
+		JOIN products p ON p.id = ws.product_id
+		JOIN warehouses w ON w.id = ws.warehouse_id
+		WHERE p.name = ANY($1) AND p.is_active AND w.is_active AND ($2 = 0 OR ws.warehouse_id = $2)
+		ORDER BY ws.warehouse_id, ws.product_id`
 
-	productNames := make([]string, 0, len(orders))
-	for _, item := range orders {
-		productNames = append(productNames, item.ProductName)
-	}
-
-	// Получаем текущие остатки по именам продуктов
-	query := `SELECT product_name, quantity FROM warehouse_stock WHERE product_name = ANY($1)`
-
-	rows, err := w.pool.Query(ctx, query, productNames)
+	rows, err := w.pool.Query(ctx, query, productNames, warehouseID)
 	if err != nil {
-		return false, fmt.Errorf("failed to get stock: %w", err)
+		return nil, fmt.Errorf("failed to get stock: %w", err)
 	}
 	defer rows.Close()
 
-	// Создаем мапу остатков по именам продуктов
-	stock := make(map[string]int32)
+	var items []*entity.WarehouseStockItem
 	for rows.Next() {
-		var productName string
-		var quantity int32
-		if err := rows.Scan(&productName, &quantity); err != nil {
-			return false, err
+		item := &entity.WarehouseStockItem{}
+		if err := rows.Scan(&item.WarehouseID, &item.ProductID, &item.ProductName, &item.Quantity, &item.Available, &item.LastUpdated); err != nil {
+			return nil, fmt.Errorf("failed to scan stock: %w", err)
 		}
-		stock[productName] = quantity
+		item.Reserved = item.Quantity - item.Available
+		items = append(items, item)
 	}
-
-	// Проверяем достаточность остатков
-	for _, item := range orders {
-		available, exists := stock[item.ProductName]
-		if !exists {
-			return false, nil // Товар не найден на складе
-		}
-		if available < item.Quantity {
-			return false, nil // Недостаточно товара
-		}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating stock rows: %w", err)
 	}
-
-	return true, nil
+	return items, nil
 }
 
+// GetWarehouseStock возвращает витрину: доступный остаток каждого товара суммарно по активным складам
 func (w *WarehouseRepository) GetWarehouseStock(ctx context.Context) ([]*entity.GoodsItem, error) {
-	query := `SELECT product_id, product_name, quantity, price, last_updated FROM warehouse_stock WHERE quantity > 0`
+	query := `SELECT product_id, product_name, available, price, last_updated FROM (
+		SELECT ws.product_id, p.name AS product_name, SUM(ws.quantity - ` + reservedQuantitySQL + ` - ` + expiredQuantitySQL + `)::INTEGER AS available,
+			p.unit_price AS price, MAX(ws.last_updated) AS last_updated
+		FROM warehouse_stock ws
+		JOIN products p ON p.id = ws.product_id
+		JOIN warehouses w ON w.id = ws.warehouse_id
+		WHERE p.is_active AND w.is_active
+		GROUP BY ws.product_id, p.name, p.unit_price) stock WHERE available > 0
+		ORDER BY product_id`
 
 	rows, err := w.pool.Query(ctx, query)
 	if err != nil {
@@ -97,7 +100,8 @@ func (w *WarehouseRepository) GetWarehouseStock(ctx context.Context) ([]*entity.
 	return items, nil
 }
 
-func (w *WarehouseRepository) UpdateStock(ctx context.Context, items []*entity.GoodsItem) error {
+// UpdateStock списывает товар без резерва. warehouseID == 0 - склады подбираются по наличию
+func (w *WarehouseRepository) UpdateStock(ctx context.Context, orderID, warehouseID int64, items []*entity.GoodsItem, now int64) error {
 	if len(items) == 0 {
 		return nil
 	}
@@ -109,35 +113,178 @@ func (w *WarehouseRepository) UpdateStock(ctx context.Context, items []*entity.G
 	}
 	defer tx.Rollback(ctx)
 
-	// Получаем текущие количества товаров для проверки
-	checkQuery := `SELECT quantity FROM warehouse_stock WHERE product_id = $1`
+	// Повторное списание по тому же заказу ничего не меняет
+	if orderID != 0 {
+		applied, err := registerOrderOperation(ctx, tx, orderID, operationWriteOff)
+		if err != nil {
+			return err
+		}
+		if !applied {
+			return nil
+		}
+	}
 
-	updateQuery := `UPDATE warehouse_stock SET quantity = quantity - $1, last_updated = $2 WHERE product_id = $3`
+	// Распределение блокирует складские строки и учитывает чужие резервы
+	allocations, err := allocateStock(ctx, tx, items, warehouseID, nil)
+	if err != nil {
+		return err
+	}
 
-	// Выполняем обновление для каждого товара в транзакции
-	for _, item := range items {
-		// Проверяем, достаточно ли товара на складе
-		var currentQuantity int
-		err := tx.QueryRow(ctx, checkQuery, item.ProductID).Scan(&currentQuantity)
+	for _, allocation := range allocations {
+		if allocation.Lots, err = pickLots(ctx, tx, allocation.WarehouseID, allocation.ProductID, allocation.Quantity, orderID, now); err != nil {
+			return err
+		}
+		err = writeOffLots(ctx, tx, allocation, entity.StockMovement{
+			Reason:      entity.MovementOrder,
+			ReferenceID: orderID,
+			CreatedAt:   now,
+		})
 		if err != nil {
-			return fmt.Errorf("failed to get current quantity for product %d: %w", item.ProductID, err)
+			return err
 		}
+	}
 
-		// Вычитаем количество заказанного товара
-		_, err = tx.Exec(ctx, updateQuery,
-			item.Quantity,    // количество для вычитания
-			item.LastUpdated, // время обновления
-			item.ProductID,   // ID товара
-		)
+	// Фиксируем транзакцию
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+// ReturnStock возвращает товар на склад. Товар заказа возвращается туда, откуда был списан, по журналу движений;
+// без заказа - на склад warehouseID
+func (w *WarehouseRepository) ReturnStock(ctx context.Context, orderID, warehouseID int64, items []*entity.GoodsItem, now int64) error {
+	if len(items) == 0 {
+		return nil
+	}
+
+	tx, err := w.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	returns := make([]entity.StockMovement, 0, len(items))
+	if orderID != 0 {
+		// Возвращаем только то, что действительно было списано по заказу, и только один раз
+		var writtenOff bool
+		err := tx.QueryRow(ctx, `SELECT EXISTS(SELECT 1 FROM stock_order_operations WHERE order_id = $1 AND operation = $2)`, orderID, operationWriteOff).Scan(&writtenOff)
+		if err != nil {
+			return fmt.Errorf("failed to check write-off for order %d: %w", orderID, err)
+		}
+		if !writtenOff {
+			return nil
+		}
+		applied, err := registerOrderOperation(ctx, tx, orderID, operationReturn)
 		if err != nil {
-			return fmt.Errorf("failed to update stock for product %d: %w", item.ProductID, err)
+			return err
+		}
+		if !applied {
+			return nil
+		}
+		if returns, err = orderReturns(ctx, tx, orderID, items); err != nil {
+			return err
+		}
+	} else {
+		for _, item := range items {
+			returns = append(returns, entity.StockMovement{WarehouseID: warehouseID, ProductID: item.ProductID, Delta: item.Quantity})
+		}
+	}
+
+	// Возвращаем ранее списанное количество товара на склад: в те партии, из которых оно списано.
+	// Товар без известной партии возвращается в партию остатков без учета партий
+	for _, movement := range returns {
+		movement.Reason = entity.MovementReturn
+		movement.ReferenceID = orderID
+		movement.CreatedAt = now
+		err := addToLot(ctx, tx, entity.StockLot{LotNumber: legacyLotNumber, ReceivedAt: now}, movement)
+		if err != nil {
+			if domainErr := unknownProduct(err); domainErr != nil {
+				return fmt.Errorf("product %d: %w", movement.ProductID, domainErr)
+			}
+			if isForeignKeyViolation(err, "warehouse_stock_warehouse_id_fkey") {
+				return fmt.Errorf("warehouse %d: %w", movement.WarehouseID, domain.ErrWarehouseNotFound)
+			}
+			return fmt.Errorf("failed to return stock for product %d to warehouse %d: %w", movement.ProductID, movement.WarehouseID, err)
 		}
 	}
 
-	// Фиксируем транзакцию
 	if err := tx.Commit(ctx); err != nil {
 		return fmt.Errorf("failed to commit transaction: %w", err)
 	}
 
 	return nil
 }
+
+// orderReturns раскладывает возвращаемые позиции заказа по складам и партиям, из которых они были списаны.
+// Вернуть можно не больше списанного. Заказы, списанные до появления журнала, возвращаются на основной склад
+func orderReturns(ctx context.Context, tx pgx.Tx, orderID int64, items []*entity.GoodsItem) ([]entity.StockMovement, error) {
+	rows, err := tx.Query(ctx, `SELECT warehouse_id, product_id, COALESCE(lot_id, 0), -SUM(delta)::INTEGER FROM stock_movements
+		WHERE reason = $1 AND reference_id = $2 GROUP BY warehouse_id, product_id, lot_id HAVING SUM(delta) < 0
+		ORDER BY warehouse_id, product_id, lot_id`, entity.MovementOrder, orderID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get write-offs of order %d: %w", orderID, err)
+	}
+	writtenOff := make(map[int64][]entity.StockMovement)
+	for rows.Next() {
+		var writeOff entity.StockMovement
+		if err := rows.Scan(&writeOff.WarehouseID, &writeOff.ProductID, &writeOff.LotID, &writeOff.Delta); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan write-off: %w", err)
+		}
+		writtenOff[writeOff.ProductID] = append(writtenOff[writeOff.ProductID], writeOff)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating write-offs: %w", err)
+	}
+
+	var returns []entity.StockMovement
+	for _, item := range items {
+		if len(writtenOff) == 0 {
+			returns = append(returns, entity.StockMovement{WarehouseID: defaultWarehouseID, ProductID: item.ProductID, Delta: item.Quantity})
+			continue
+		}
+		remaining := item.Quantity
+		for i := range writtenOff[item.ProductID] {
+			writeOff := &writtenOff[item.ProductID][i]
+			take := min(remaining, writeOff.Delta)
+			if take <= 0 {
+				continue
+			}
+			returns = append(returns, entity.StockMovement{WarehouseID: writeOff.WarehouseID, ProductID: item.ProductID, LotID: writeOff.LotID, Delta: take})
+			writeOff.Delta -= take
+			remaining -= take
+		}
+	}
+	// Строки склада блокируются в том же порядке, что и при списании
+	sort.Slice(returns, func(i, j int) bool {
+		if returns[i].WarehouseID != returns[j].WarehouseID {
+			return returns[i].WarehouseID < returns[j].WarehouseID
+		}
+		if returns[i].ProductID != returns[j].ProductID {
+			return returns[i].ProductID < returns[j].ProductID
+		}
+		return returns[i].LotID < returns[j].LotID
+	})
+	return returns, nil
+}
+
+const (
+	operationWriteOff = "write_off"
+	operationReturn   = "return"
+)
+
+// defaultWarehouseID - склад, на котором лежали все остатки до появления нескольких складов
+const defaultWarehouseID = 1
+
+// registerOrderOperation отмечает складскую операцию по заказу; false - операция уже выполнялась
+func registerOrderOperation(ctx context.Context, tx pgx.Tx, orderID int64, operation string) (bool, error) {
+	query := `INSERT INTO stock_order_operations (order_id, operation, created_at) VALUES ($1, $2, EXTRACT(EPOCH FROM NOW())::INTEGER) ON CONFLICT DO NOTHING`
+	tag, err := tx.Exec(ctx, query, orderID, operation)
+	if err != nil {
+		return false, fmt.Errorf("failed to register %s for order %d: %w", operation, orderID, err)
+	}
+	return tag.RowsAffected() == 1, nil
+}
@@ -0,0 +1,302 @@
+package repository
+
+import (
+	"context"
+	"fmt"
+	"logistics/internal/services/warehouse-service/domain"
+	"logistics/internal/services/warehouse-service/fulfillment"
+	"logistics/internal/shared/entity"
+
+	"github.com/jackc/pgx/v5"
+)
+
+// reservedQuantitySQL - количество товара, удерживаемое действующими резервами
+const reservedQuantitySQL = `COALESCE((SELECT SUM(r.quantity) FROM stock_reservations r
+	WHERE r.warehouse_id = ws.warehouse_id AND r.product_id = ws.product_id AND r.status = 'active' AND r.expires_at > EXTRACT(EPOCH FROM NOW())::INTEGER), 0)`
+
+// ReserveStock резервирует товар заказа на складах, выбранных по наличию и близости к точке доставки
+func (w *WarehouseRepository) ReserveStock(ctx context.Context, orderID int64, items []*entity.GoodsItem, target *entity.Location, expiresAt, now int64) ([]entity.StockAllocation, int64, error) {
+	tx, err := w.pool.Begin(ctx)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	// Повторный резерв по тому же заказу возвращает уже действующий
+	rows, err := tx.Query(ctx, `SELECT warehouse_id, product_id, quantity, expires_at FROM stock_reservations
+		WHERE order_id = $1 AND (status = 'committed' OR (status = 'active' AND expires_at > $2))
+		ORDER BY warehouse_id, product_id`, orderID, now)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to check reservations for order %d: %w", orderID, err)
+	}
+	var existing []entity.StockAllocation
+	var existingExpiresAt int64
+	for rows.Next() {
+		var allocation entity.StockAllocation
+		var reservationExpiresAt int64
+		if err := rows.Scan(&allocation.WarehouseID, &allocation.ProductID, &allocation.Quantity, &reservationExpiresAt); err != nil {
+			rows.Close()
+			return nil, 0, fmt.Errorf("failed to scan reservation: %w", err)
+		}
+		existing = append(existing, allocation)
+		existingExpiresAt = max(existingExpiresAt, reservationExpiresAt)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating reservations: %w", err)
+	}
+	if len(existing) > 0 {
+		lots, err := reservationLots(ctx, tx, orderID)
+		if err != nil {
+			return nil, 0, err
+		}
+		for i := range existing {
+			existing[i].Lots = lots[stockKey{existing[i].WarehouseID, existing[i].ProductID}]
+		}
+		return existing, existingExpiresAt, nil
+	}
+
+	// Снятые резервы прошлой попытки могли быть на других складах и в других партиях - они больше не нужны
+	if _, err := tx.Exec(ctx, `DELETE FROM stock_reservation_lots WHERE order_id = $1`, orderID); err != nil {
+		return nil, 0, fmt.Errorf("failed to clear stale reserved lots for order %d: %w", orderID, err)
+	}
+	if _, err := tx.Exec(ctx, `DELETE FROM stock_reservations WHERE order_id = $1 AND status IN ('released', 'expired')`, orderID); err != nil {
+		return nil, 0, fmt.Errorf("failed to clear stale reservations for order %d: %w", orderID, err)
+	}
+
+	allocations, err := allocateStock(ctx, tx, items, 0, target)
+	if err != nil {
+		return nil, 0, err
+	}
+
+	insertQuery := `INSERT INTO stock_reservations (order_id, warehouse_id, product_id, quantity, status, expires_at, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, 'active', $5, $6, $6)`
+	lotQuery := `INSERT INTO stock_reservation_lots (order_id, lot_id, quantity) VALUES ($1, $2, $3)`
+
+	for i, allocation := range allocations {
+		if _, err := tx.Exec(ctx, insertQuery, orderID, allocation.WarehouseID, allocation.ProductID, allocation.Quantity, expiresAt, now); err != nil {
+			return nil, 0, fmt.Errorf("failed to reserve product %d in warehouse %d for order %d: %w", allocation.ProductID, allocation.WarehouseID, orderID, err)
+		}
+		// Внутри склада товар берется из партий с ближайшим сроком годности
+		lots, err := pickLots(ctx, tx, allocation.WarehouseID, allocation.ProductID, allocation.Quantity, orderID, now)
+		if err != nil {
+			return nil, 0, err
+		}
+		for _, lot := range lots {
+			if _, err := tx.Exec(ctx, lotQuery, orderID, lot.LotID, lot.Quantity); err != nil {
+				return nil, 0, fmt.Errorf("failed to reserve lot %s of product %d for order %d: %w", lot.LotNumber, allocation.ProductID, orderID, err)
+			}
+		}
+		allocations[i].Lots = lots
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return nil, 0, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return allocations, expiresAt, nil
+}
+
+// allocateStock блокирует складские строки товаров и распределяет позиции по складам.
+// warehouseID != 0 ограничивает выбор одним складом
+func allocateStock(ctx context.Context, tx pgx.Tx, items []*entity.GoodsItem, warehouseID int64, target *entity.Location) ([]entity.StockAllocation, error) {
+	productIDs := make([]int64, 0, len(items))
+	names := make([]string, 0, len(items))
+	for _, item := range items {
+		productIDs = append(productIDs, item.ProductID)
+		names = append(names, item.ProductName)
+	}
+
+	// Позиция может прийти только с наименованием - находим товар в каталоге
+	rows, err := tx.Query(ctx, `SELECT id, name FROM products WHERE is_active AND (id = ANY($1) OR name = ANY($2))`, productIDs, names)
+	if err != nil {
+		return nil, fmt.Errorf("failed to resolve products: %w", err)
+	}
+	active := make(map[int64]bool)
+	byName := make(map[string]int64)
+	for rows.Next() {
+		var id int64
+		var name string
+		if err := rows.Scan(&id, &name); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan product: %w", err)
+		}
+		active[id] = true
+		byName[name] = id
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating products: %w", err)
+	}
+
+	demand := make(map[int64]int32, len(items))
+	for _, item := range items {
+		productID := item.ProductID
+		if productID == 0 {
+			productID = byName[item.ProductName]
+		}
+		// Снятый с продажи товар считается отсутствующим
+		if !active[productID] {
+			return nil, fmt.Errorf("product %q: %w", item.ProductName, domain.ErrInsufficientStock)
+		}
+		demand[productID] += item.Quantity
+	}
+	ids := make([]int64, 0, len(demand))
+	for productID := range demand {
+		ids = append(ids, productID)
+	}
+
+	// Блокируем строки склада в одном порядке, чтобы параллельные резервы не взаимоблокировались
+	lockQuery := `SELECT ws.warehouse_id FROM warehouse_stock ws JOIN warehouses w ON w.id = ws.warehouse_id
+		WHERE w.is_active AND ws.product_id = ANY($1) AND ($2 = 0 OR ws.warehouse_id = $2)
+		ORDER BY ws.warehouse_id, ws.product_id FOR UPDATE OF ws`
+	if _, err := tx.Exec(ctx, lockQuery, ids, warehouseID); err != nil {
+		return nil, fmt.Errorf("failed to lock stock: %w", err)
+	}
+
+	// Остаток считаем отдельным запросом уже под блокировкой: в READ COMMITTED подзапросы
+	// запроса с FOR UPDATE видят снимок до ожидания и не замечают резервы, закоммиченные
+	// державшей блокировку транзакцией. Товар просроченных партий в доступный остаток не входит
+	stockQuery := `SELECT ws.warehouse_id, ws.product_id, ws.quantity - ` + reservedQuantitySQL + ` - ` + expiredQuantitySQL + `, w.latitude, w.longitude
+		FROM warehouse_stock ws JOIN warehouses w ON w.id = ws.warehouse_id
+		WHERE w.is_active AND ws.product_id = ANY($1) AND ($2 = 0 OR ws.warehouse_id = $2)
+		ORDER BY ws.warehouse_id, ws.product_id`
+	rows, err = tx.Query(ctx, stockQuery, ids, warehouseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read stock: %w", err)
+	}
+	var stocks []fulfillment.Stock
+	for rows.Next() {
+		var stockWarehouseID, productID int64
+		var available int32
+		var latitude, longitude *float64
+		if err := rows.Scan(&stockWarehouseID, &productID, &available, &latitude, &longitude); err != nil {
+			rows.Close()
+			return nil, fmt.Errorf("failed to scan stock: %w", err)
+		}
+		if len(stocks) == 0 || stocks[len(stocks)-1].WarehouseID != stockWarehouseID {
+			stock := fulfillment.Stock{WarehouseID: stockWarehouseID, Available: make(map[int64]int32)}
+			if latitude != nil && longitude != nil {
+				stock.Location = &entity.Location{Latitude: *latitude, Longitude: *longitude}
+			}
+			stocks = append(stocks, stock)
+		}
+		stocks[len(stocks)-1].Available[productID] = available
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating stock rows: %w", err)
+	}
+
+	return fulfillment.Allocate(target, demand, stocks)
+}
+
+func (w *WarehouseRepository) CommitReservation(ctx context.Context, orderID, now int64) error {
+	tx, err := w.pool.Begin(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	rows, err := tx.Query(ctx, `SELECT warehouse_id, product_id, quantity, status, expires_at FROM stock_reservations
+		WHERE order_id = $1 ORDER BY warehouse_id, product_id FOR UPDATE`, orderID)
+	if err != nil {
+		return fmt.Errorf("failed to get reservations for order %d: %w", orderID, err)
+	}
+	var reservations []entity.StockReservation
+	for rows.Next() {
+		var r entity.StockReservation
+		if err := rows.Scan(&r.WarehouseID, &r.ProductID, &r.Quantity, &r.Status, &r.ExpiresAt); err != nil {
+			rows.Close()
+			return fmt.Errorf("failed to scan reservation: %w", err)
+		}
+		reservations = append(reservations, r)
+	}
+	rows.Close()
+	if err := rows.Err(); err != nil {
+		return fmt.Errorf("error iterating reservations: %w", err)
+	}
+
+	if len(reservations) == 0 {
+		return fmt.Errorf("order %d: %w", orderID, domain.ErrReservationNotFound)
+	}
+	committed := 0
+	for _, r := range reservations {
+		switch {
+		case r.Status == entity.ReservationCommitted:
+			committed++
+		case r.Status != entity.ReservationActive:
+			return fmt.Errorf("order %d: reservation is %s: %w", orderID, r.Status, domain.ErrReservationNotFound)
+		case r.ExpiresAt <= now:
+			return fmt.Errorf("order %d: %w", orderID, domain.ErrReservationExpired)
+		}
+	}
+	// Резерв уже списан - повторный commit ничего не меняет
+	if committed == len(reservations) {
+		return nil
+	}
+
+	// Отмечаем списание, чтобы ReturnStock мог вернуть товар по этому заказу
+	applied, err := registerOrderOperation(ctx, tx, orderID, operationWriteOff)
+	if err != nil {
+		return err
+	}
+
+	if applied {
+		lots, err := reservationLots(ctx, tx, orderID)
+		if err != nil {
+			return err
+		}
+		for _, r := range reservations {
+			allocation := entity.StockAllocation{
+				WarehouseID: r.WarehouseID,
+				ProductID:   r.ProductID,
+				Quantity:    r.Quantity,
+				Lots:        lots[stockKey{r.WarehouseID, r.ProductID}],
+			}
+			// Резервы, сделанные до учета партий, списываются из партий по FEFO в момент списания
+			if len(allocation.Lots) == 0 {
+				if allocation.Lots, err = pickLots(ctx, tx, r.WarehouseID, r.ProductID, r.Quantity, orderID, now); err != nil {
+					return err
+				}
+			}
+			err := writeOffLots(ctx, tx, allocation, entity.StockMovement{
+				Reason:      entity.MovementOrder,
+				ReferenceID: orderID,
+				CreatedAt:   now,
+			})
+			if err != nil {
+				return err
+			}
+		}
+	}
+
+	_, err = tx.Exec(ctx, `UPDATE stock_reservations SET status = 'committed', updated_at = $2 WHERE order_id = $1 AND status = 'active'`, orderID, now)
+	if err != nil {
+		return fmt.Errorf("failed to commit reservations for order %d: %w", orderID, err)
+	}
+
+	if err := tx.Commit(ctx); err != nil {
+		return fmt.Errorf("failed to commit transaction: %w", err)
+	}
+
+	return nil
+}
+
+func (w *WarehouseRepository) ReleaseReservation(ctx context.Context, orderID, now int64) (bool, error) {
+	query := `UPDATE stock_reservations SET status = 'released', updated_at = $2 WHERE order_id = $1 AND status = 'active'`
+	tag, err := w.pool.Exec(ctx, query, orderID, now)
+	if err != nil {
+		return false, fmt.Errorf("failed to release reservations for order %d: %w", orderID, err)
+	}
+	return tag.RowsAffected() > 0, nil
+}
+
+func (w *WarehouseRepository) ReleaseExpiredReservations(ctx context.Context, now int64) (int64, error) {
+	query := `UPDATE stock_reservations SET status = 'expired', updated_at = $1 WHERE status = 'active' AND expires_at <= $1`
+	tag, err := w.pool.Exec(ctx, query, now)
+	if err != nil {
+		return 0, fmt.Errorf("failed to release expired reservations: %w", err)
+	}
+	return tag.RowsAffected(), nil
+}
@@ -0,0 +1,347 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"logistics/internal/services/warehouse-service/domain"
+	"logistics/internal/shared/entity"
+
+	"github.com/jackc/pgx/v5"
+)
+
+const transferColumns = `id, source_warehouse_id, destination_warehouse_id, status, driver_id, note,
+	created_by, dispatched_by, received_by, created_at, updated_at, dispatched_at, received_at`
+
+func scanTransfer(row pgx.Row) (*entity.StockTransfer, error) {
+	var transfer entity.StockTransfer
+	err := row.Scan(
+		&transfer.ID,
+		&transfer.SourceWarehouseID,
+		&transfer.DestinationWarehouseID,
+		&transfer.Status,
+		&transfer.DriverID,
+		&transfer.Note,
+		&transfer.CreatedBy,
+		&transfer.DispatchedBy,
+		&transfer.ReceivedBy,
+		&transfer.CreatedAt,
+		&transfer.UpdatedAt,
+		&transfer.DispatchedAt,
+		&transfer.ReceivedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	return &transfer, nil
+}
+
+func (w *WarehouseRepository) CreateTransfer(ctx context.Context, transfer *entity.StockTransfer) (*entity.StockTransfer, error) {
+	tx, err := w.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	query := `INSERT INTO stock_transfers (source_warehouse_id, destination_warehouse_id, status, driver_id, note, created_by, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $6, $7, $7) RETURNING id`
+	var transferID int64
+	err = tx.QueryRow(ctx, query,
+		transfer.SourceWarehouseID,
+		transfer.DestinationWarehouseID,
+		entity.TransferDraft,
+		transfer.DriverID,
+		transfer.Note,
+		transfer.CreatedBy,
+		transfer.CreatedAt,
+	).Scan(&transferID)
+	if err != nil {
+		if isForeignKeyViolation(err, "stock_transfers_source_warehouse_id_fkey") {
+			return nil, fmt.Errorf("warehouse %d: %w", transfer.SourceWarehouseID, domain.ErrWarehouseNotFound)
+		}
+		if isForeignKeyViolation(err, "stock_transfers_destination_warehouse_id_fkey") {
+			return nil, fmt.Errorf("warehouse %d: %w", transfer.DestinationWarehouseID, domain.ErrWarehouseNotFound)
+		}
+		return nil, fmt.Errorf("failed to create stock transfer: %w", err)
+	}
+
+	lineQuery := `INSERT INTO stock_transfer_lines (transfer_id, product_id, quantity) VALUES ($1, $2, $3)`
+	for _, line := range transfer.Lines {
+		if _, err := tx.Exec(ctx, lineQuery, transferID, line.ProductID, line.Quantity); err != nil {
+			if domainErr := unknownProduct(err); domainErr != nil {
+				return nil, fmt.Errorf("product %d: %w", line.ProductID, domainErr)
+			}
+			return nil, fmt.Errorf("failed to add product %d to stock transfer: %w", line.ProductID, err)
+		}
+	}
+
+	created, err := loadTransfer(ctx, tx, transferID)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return created, nil
+}
+
+func (w *WarehouseRepository) GetTransfer(ctx context.Context, transferID int64) (*entity.StockTransfer, error) {
+	return loadTransfer(ctx, w.pool, transferID)
+}
+
+func (w *WarehouseRepository) ListTransfers(ctx context.Context, filter domain.TransferFilter) ([]*entity.StockTransfer, int64, error) {
+	where := `($1 = '' OR status = $1) AND ($2 = 0 OR source_warehouse_id = $2 OR destination_warehouse_id = $2)`
+	var total int64
+	err := w.pool.QueryRow(ctx, `SELECT COUNT(*) FROM stock_transfers WHERE `+where, string(filter.Status), filter.WarehouseID).Scan(&total)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to count stock transfers: %w", err)
+	}
+
+	query := `SELECT ` + transferColumns + ` FROM stock_transfers WHERE ` + where + `
+		ORDER BY id DESC LIMIT $3 OFFSET $4`
+	rows, err := w.pool.Query(ctx, query, string(filter.Status), filter.WarehouseID, filter.Limit, filter.Offset)
+	if err != nil {
+		return nil, 0, fmt.Errorf("failed to query stock transfers: %w", err)
+	}
+	defer rows.Close()
+
+	transfers := make([]*entity.StockTransfer, 0, filter.Limit)
+	for rows.Next() {
+		transfer, err := scanTransfer(rows)
+		if err != nil {
+			return nil, 0, fmt.Errorf("failed to scan stock transfer: %w", err)
+		}
+		transfers = append(transfers, transfer)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, 0, fmt.Errorf("error iterating stock transfers: %w", err)
+	}
+	return transfers, total, nil
+}
+
+// AssignTransferDriver назначает водителя на перемещение до отправки; driverID == 0 снимает назначение
+func (w *WarehouseRepository) AssignTransferDriver(ctx context.Context, transferID, driverID, now int64) (*entity.StockTransfer, error) {
+	tx, err := w.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := lockTransfer(ctx, tx, transferID, entity.TransferDraft); err != nil {
+		return nil, err
+	}
+	_, err = tx.Exec(ctx, `UPDATE stock_transfers SET driver_id = $2, updated_at = $3 WHERE id = $1`, transferID, driverID, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to assign driver to stock transfer %d: %w", transferID, err)
+	}
+
+	transfer, err := loadTransfer(ctx, tx, transferID)
+	if err != nil {
+		return nil, err
+	}
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return transfer, nil
+}
+
+// DispatchTransfer списывает товар со склада-источника и переводит перемещение в in_transit.
+// Зарезервированный под заказы товар не перемещается
+func (w *WarehouseRepository) DispatchTransfer(ctx context.Context, transferID, userID, now int64) (*entity.StockTransfer, error) {
+	tx, err := w.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := lockTransfer(ctx, tx, transferID, entity.TransferDraft); err != nil {
+		return nil, err
+	}
+	transfer, err := loadTransfer(ctx, tx, transferID)
+	if err != nil {
+		return nil, err
+	}
+
+	// Строки идут по product_id, поэтому блокировки складских строк берутся в одном порядке.
+	// Доступный остаток считается отдельным запросом после блокировки, чтобы увидеть резервы,
+	// закоммиченные до нее. Товар уходит из партий по FEFO, просроченные партии не перемещаются
+	lockQuery := `SELECT 1 FROM warehouse_stock WHERE warehouse_id = $1 AND product_id = $2 FOR UPDATE`
+	availableQuery := `SELECT ws.quantity - ` + reservedQuantitySQL + ` - ` + expiredQuantitySQL + ` FROM warehouse_stock ws
+		WHERE ws.warehouse_id = $1 AND ws.product_id = $2`
+	lotQuery := `INSERT INTO stock_transfer_lots (transfer_id, product_id, lot_number, expires_at, received_at, quantity)
+		SELECT $1, product_id, lot_number, expires_at, received_at, $3 FROM stock_lots WHERE id = $2`
+	for _, line := range transfer.Lines {
+		if _, err := tx.Exec(ctx, lockQuery, transfer.SourceWarehouseID, line.ProductID); err != nil {
+			return nil, fmt.Errorf("failed to lock stock of product %d: %w", line.ProductID, err)
+		}
+		var available int32
+		err := tx.QueryRow(ctx, availableQuery, transfer.SourceWarehouseID, line.ProductID).Scan(&available)
+		if err != nil && !errors.Is(err, pgx.ErrNoRows) {
+			return nil, fmt.Errorf("failed to read stock of product %d: %w", line.ProductID, err)
+		}
+		if available < line.Quantity {
+			return nil, fmt.Errorf("product %d: requested %d, available %d: %w", line.ProductID, line.Quantity, available, domain.ErrInsufficientStock)
+		}
+		allocation := entity.StockAllocation{WarehouseID: transfer.SourceWarehouseID, ProductID: line.ProductID, Quantity: line.Quantity}
+		if allocation.Lots, err = pickLots(ctx, tx, allocation.WarehouseID, allocation.ProductID, allocation.Quantity, 0, now); err != nil {
+			return nil, err
+		}
+		for _, lot := range allocation.Lots {
+			if _, err := tx.Exec(ctx, lotQuery, transferID, lot.LotID, lot.Quantity); err != nil {
+				return nil, fmt.Errorf("failed to record lot %s of product %d in transit: %w", lot.LotNumber, line.ProductID, err)
+			}
+		}
+		err = writeOffLots(ctx, tx, allocation, entity.StockMovement{
+			Reason:      entity.MovementTransfer,
+			ReferenceID: transferID,
+			ActorID:     userID,
+			CreatedAt:   now,
+		})
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	_, err = tx.Exec(ctx, `UPDATE stock_transfers SET status = $2, dispatched_by = $3, dispatched_at = $4, updated_at = $4 WHERE id = $1`,
+		transferID, entity.TransferInTransit, userID, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to dispatch stock transfer %d: %w", transferID, err)
+	}
+
+	transfer.Status = entity.TransferInTransit
+	transfer.DispatchedBy = userID
+	transfer.DispatchedAt = now
+	transfer.UpdatedAt = now
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return transfer, nil
+}
+
+// ReceiveTransfer приходует товар в пути на склад-получатель и закрывает перемещение
+func (w *WarehouseRepository) ReceiveTransfer(ctx context.Context, transferID, userID, now int64) (*entity.StockTransfer, error) {
+	tx, err := w.pool.Begin(ctx)
+	if err != nil {
+		return nil, fmt.Errorf("failed to begin transaction: %w", err)
+	}
+	defer tx.Rollback(ctx)
+
+	if err := lockTransfer(ctx, tx, transferID, entity.TransferInTransit); err != nil {
+		return nil, err
+	}
+	transfer, err := loadTransfer(ctx, tx, transferID)
+	if err != nil {
+		return nil, err
+	}
+
+	lots, err := transferLots(ctx, tx, transferID)
+	if err != nil {
+		return nil, err
+	}
+	for _, line := range transfer.Lines {
+		// Перемещения, отправленные до учета партий, приходуются в партию без учета партий
+		lineLots := lots[line.ProductID]
+		if len(lineLots) == 0 {
+			lineLots = []entity.StockLot{{LotNumber: legacyLotNumber, ReceivedAt: now, Quantity: line.Quantity}}
+		}
+		for _, lot := range lineLots {
+			err := addToLot(ctx, tx, lot, entity.StockMovement{
+				WarehouseID: transfer.DestinationWarehouseID,
+				ProductID:   line.ProductID,
+				Delta:       lot.Quantity,
+				Reason:      entity.MovementTransfer,
+				ReferenceID: transferID,
+				ActorID:     userID,
+				CreatedAt:   now,
+			})
+			if err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	_, err = tx.Exec(ctx, `UPDATE stock_transfers SET status = $2, received_by = $3, received_at = $4, updated_at = $4 WHERE id = $1`,
+		transferID, entity.TransferReceived, userID, now)
+	if err != nil {
+		return nil, fmt.Errorf("failed to receive stock transfer %d: %w", transferID, err)
+	}
+
+	transfer.Status = entity.TransferReceived
+	transfer.ReceivedBy = userID
+	transfer.ReceivedAt = now
+	transfer.UpdatedAt = now
+	if err := tx.Commit(ctx); err != nil {
+		return nil, fmt.Errorf("failed to commit transaction: %w", err)
+	}
+	return transfer, nil
+}
+
+// transferLots возвращает партии товара в пути по товарам
+func transferLots(ctx context.Context, q queryer, transferID int64) (map[int64][]entity.StockLot, error) {
+	rows, err := q.Query(ctx, `SELECT product_id, lot_number, expires_at, received_at, quantity FROM stock_transfer_lots
+		WHERE transfer_id = $1 ORDER BY product_id, lot_number`, transferID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lots of stock transfer %d: %w", transferID, err)
+	}
+	defer rows.Close()
+
+	lots := make(map[int64][]entity.StockLot)
+	for rows.Next() {
+		var lot entity.StockLot
+		if err := rows.Scan(&lot.ProductID, &lot.LotNumber, &lot.ExpiresAt, &lot.ReceivedAt, &lot.Quantity); err != nil {
+			return nil, fmt.Errorf("failed to scan stock transfer lot: %w", err)
+		}
+		lots[lot.ProductID] = append(lots[lot.ProductID], lot)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating stock transfer lots: %w", err)
+	}
+	return lots, nil
+}
+
+// lockTransfer блокирует перемещение и проверяет, что оно в ожидаемом статусе
+func lockTransfer(ctx context.Context, tx pgx.Tx, transferID int64, expected entity.TransferStatus) error {
+	var status entity.TransferStatus
+	err := tx.QueryRow(ctx, `SELECT status FROM stock_transfers WHERE id = $1 FOR UPDATE`, transferID).Scan(&status)
+	if errors.Is(err, pgx.ErrNoRows) {
+		return fmt.Errorf("stock transfer %d: %w", transferID, domain.ErrTransferNotFound)
+	}
+	if err != nil {
+		return fmt.Errorf("failed to lock stock transfer %d: %w", transferID, err)
+	}
+	if status != expected {
+		return fmt.Errorf("stock transfer %d is %s, expected %s: %w", transferID, status, expected, domain.ErrTransferStatus)
+	}
+	return nil
+}
+
+// loadTransfer читает перемещение вместе со строками
+func loadTransfer(ctx context.Context, q queryer, transferID int64) (*entity.StockTransfer, error) {
+	query := `SELECT ` + transferColumns + ` FROM stock_transfers WHERE id = $1`
+	transfer, err := scanTransfer(q.QueryRow(ctx, query, transferID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("stock transfer %d: %w", transferID, domain.ErrTransferNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get stock transfer %d: %w", transferID, err)
+	}
+
+	rows, err := q.Query(ctx, `SELECT l.product_id, p.name, l.quantity
+		FROM stock_transfer_lines l JOIN products p ON p.id = l.product_id
+		WHERE l.transfer_id = $1 ORDER BY l.product_id`, transferID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get lines of stock transfer %d: %w", transferID, err)
+	}
+	defer rows.Close()
+	for rows.Next() {
+		var line entity.TransferLine
+		if err := rows.Scan(&line.ProductID, &line.ProductName, &line.Quantity); err != nil {
+			return nil, fmt.Errorf("failed to scan stock transfer line: %w", err)
+		}
+		transfer.Lines = append(transfer.Lines, line)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating stock transfer lines: %w", err)
+	}
+	return transfer, nil
+}
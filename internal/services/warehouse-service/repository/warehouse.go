@@ -0,0 +1,155 @@
+package repository
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"logistics/internal/services/warehouse-service/domain"
+	"logistics/internal/shared/entity"
+
+	"github.com/jackc/pgx/v5"
+	"github.com/jackc/pgx/v5/pgconn"
+)
+
+const warehouseColumns = `id, name, address, latitude, longitude, is_active, created_at, updated_at`
+
+// scanWarehouse читает строку, выбранную по warehouseColumns
+func scanWarehouse(row pgx.Row) (*entity.Warehouse, error) {
+	var warehouse entity.Warehouse
+	var latitude, longitude *float64
+	err := row.Scan(
+		&warehouse.ID,
+		&warehouse.Name,
+		&warehouse.Address,
+		&latitude,
+		&longitude,
+		&warehouse.Active,
+		&warehouse.CreatedAt,
+		&warehouse.UpdatedAt,
+	)
+	if err != nil {
+		return nil, err
+	}
+	if latitude != nil && longitude != nil {
+		warehouse.Location = &entity.Location{Latitude: *latitude, Longitude: *longitude}
+	}
+	return &warehouse, nil
+}
+
+func warehouseCoordinates(warehouse *entity.Warehouse) (latitude, longitude *float64) {
+	if warehouse.Location == nil {
+		return nil, nil
+	}
+	return &warehouse.Location.Latitude, &warehouse.Location.Longitude
+}
+
+// warehouseUniqueViolation переводит нарушение уникальности названия склада в доменную ошибку
+func warehouseUniqueViolation(err error) error {
+	var pgErr *pgconn.PgError
+	if errors.As(err, &pgErr) && pgErr.Code == "23505" && pgErr.ConstraintName == "warehouses_name_key" {
+		return domain.ErrDuplicateWarehouseName
+	}
+	return nil
+}
+
+func (w *WarehouseRepository) CreateWarehouse(ctx context.Context, warehouse *entity.Warehouse) (*entity.Warehouse, error) {
+	latitude, longitude := warehouseCoordinates(warehouse)
+	query := `INSERT INTO warehouses (name, address, latitude, longitude, created_at, updated_at)
+		VALUES ($1, $2, $3, $4, $5, $5) RETURNING ` + warehouseColumns
+	created, err := scanWarehouse(w.pool.QueryRow(ctx, query, warehouse.Name, warehouse.Address, latitude, longitude, warehouse.CreatedAt))
+	if err != nil {
+		if domainErr := warehouseUniqueViolation(err); domainErr != nil {
+			return nil, domainErr
+		}
+		return nil, fmt.Errorf("failed to create warehouse: %w", err)
+	}
+	return created, nil
+}
+
+func (w *WarehouseRepository) GetWarehouse(ctx context.Context, warehouseID int64) (*entity.Warehouse, error) {
+	query := `SELECT ` + warehouseColumns + ` FROM warehouses WHERE id = $1`
+	warehouse, err := scanWarehouse(w.pool.QueryRow(ctx, query, warehouseID))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("warehouse %d: %w", warehouseID, domain.ErrWarehouseNotFound)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("failed to get warehouse %d: %w", warehouseID, err)
+	}
+	return warehouse, nil
+}
+
+func (w *WarehouseRepository) ListWarehouses(ctx context.Context, includeInactive bool) ([]*entity.Warehouse, error) {
+	query := `SELECT ` + warehouseColumns + ` FROM warehouses WHERE $1 OR is_active ORDER BY id`
+	rows, err := w.pool.Query(ctx, query, includeInactive)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query warehouses: %w", err)
+	}
+	defer rows.Close()
+
+	var warehouses []*entity.Warehouse
+	for rows.Next() {
+		warehouse, err := scanWarehouse(rows)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan warehouse: %w", err)
+		}
+		warehouses = append(warehouses, warehouse)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating warehouses: %w", err)
+	}
+	return warehouses, nil
+}
+
+// UpdateWarehouse заменяет данные склада; active == nil оставляет признак активности как есть
+func (w *WarehouseRepository) UpdateWarehouse(ctx context.Context, warehouse *entity.Warehouse, active *bool) (*entity.Warehouse, error) {
+	latitude, longitude := warehouseCoordinates(warehouse)
+	query := `UPDATE warehouses SET name = $1, address = $2, latitude = $3, longitude = $4,
+		is_active = COALESCE($5, is_active), updated_at = $6
+		WHERE id = $7 RETURNING ` + warehouseColumns
+	updated, err := scanWarehouse(w.pool.QueryRow(ctx, query,
+		warehouse.Name,
+		warehouse.Address,
+		latitude,
+		longitude,
+		active,
+		warehouse.UpdatedAt,
+		warehouse.ID,
+	))
+	if errors.Is(err, pgx.ErrNoRows) {
+		return nil, fmt.Errorf("warehouse %d: %w", warehouse.ID, domain.ErrWarehouseNotFound)
+	}
+	if err != nil {
+		if domainErr := warehouseUniqueViolation(err); domainErr != nil {
+			return nil, domainErr
+		}
+		return nil, fmt.Errorf("failed to update warehouse %d: %w", warehouse.ID, err)
+	}
+	return updated, nil
+}
+
+// GetWarehouseInventory возвращает остатки склада с учетом действующих резервов
+func (w *WarehouseRepository) GetWarehouseInventory(ctx context.Context, warehouseID int64) ([]*entity.WarehouseStockItem, error) {
+	query := `SELECT ws.warehouse_id, ws.product_id, p.name, ws.quantity, ` + reservedQuantitySQL + `, ws.last_updated, p.sku, p.unit_price
+		FROM warehouse_stock ws JOIN products p ON p.id = ws.product_id
+		WHERE ws.warehouse_id = $1 ORDER BY ws.product_id`
+	rows, err := w.pool.Query(ctx, query, warehouseID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to query inventory of warehouse %d: %w", warehouseID, err)
+	}
+	defer rows.Close()
+
+	var items []*entity.WarehouseStockItem
+	for rows.Next() {
+		item := &entity.WarehouseStockItem{}
+		err := rows.Scan(&item.WarehouseID, &item.ProductID, &item.ProductName, &item.Quantity, &item.Reserved, &item.LastUpdated, &item.SKU, &item.UnitPrice)
+		if err != nil {
+			return nil, fmt.Errorf("failed to scan inventory item: %w", err)
+		}
+		item.Available = item.Quantity - item.Reserved
+		items = append(items, item)
+	}
+	if err := rows.Err(); err != nil {
+		return nil, fmt.Errorf("error iterating inventory: %w", err)
+	}
+	return items, nil
+}
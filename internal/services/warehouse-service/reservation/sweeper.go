@@ -0,0 +1,60 @@
+package reservation
+
+import (
+	"context"
+	"log/slog"
+	"logistics/internal/services/warehouse-service/domain"
+	"logistics/pkg/lib/logger/slogger"
+	"time"
+)
+
+const (
+	// DefaultTTL - срок резерва, если клиент не передал свой
+	DefaultTTL = 15 * time.Minute
+	// DefaultSweepInterval - период проверки истекших резервов
+	DefaultSweepInterval = 30 * time.Second
+)
+
+// Sweeper периодически снимает истекшие резервы, возвращая товар в доступный остаток
+type Sweeper struct {
+	logger        *slog.Logger
+	warehouseRepo domain.WarehouseRepositoryInterface
+	interval      time.Duration
+}
+
+func NewSweeper(logger *slog.Logger, warehouseRepo domain.WarehouseRepositoryInterface, interval time.Duration) *Sweeper {
+	if interval <= 0 {
+		interval = DefaultSweepInterval
+	}
+	return &Sweeper{
+		logger:        logger,
+		warehouseRepo: warehouseRepo,
+		interval:      interval,
+	}
+}
+
+// Run работает до отмены контекста
+func (s *Sweeper) Run(ctx context.Context) {
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.sweep(ctx)
+		}
+	}
+}
+
+func (s *Sweeper) sweep(ctx context.Context) {
+	released, err := s.warehouseRepo.ReleaseExpiredReservations(ctx, time.Now().Unix())
+	if err != nil {
+		s.logger.Error("failed to release expired reservations", slogger.Err(err))
+		return
+	}
+	if released > 0 {
+		s.logger.Info("expired reservations released", slog.Int64("count", released))
+	}
+}
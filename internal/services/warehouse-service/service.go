@@ -2,27 +2,40 @@ package warehouseservice
 
 import (
 	"context"
+	"errors"
+	"fmt"
 	"log/slog"
+	"time"
 
+	driverpb "logistics/api/protobuf/driver_service"
 	warehousepb "logistics/api/protobuf/warehouse_service"
 	"logistics/internal/services/warehouse-service/domain"
+	"logistics/internal/services/warehouse-service/replenishment"
+	"logistics/internal/services/warehouse-service/reservation"
+	"logistics/internal/shared/entity"
 	"logistics/pkg/lib/logger/slogger"
 	"logistics/pkg/lib/utils"
 
 	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
 	"google.golang.org/protobuf/types/known/emptypb"
 )
 
 type WarehouseGRPCService struct {
 	warehousepb.UnimplementedWarehouseServiceServer
-	warehouseRepo domain.WarehouseRepositoryInterface
-	logger        *slog.Logger
+	warehouseRepo    domain.WarehouseRepositoryInterface
+	driverGRPCClient driverpb.DriverServiceClient
+	lowStock         *replenishment.Monitor
+	logger           *slog.Logger
 }
 
-func NewWarehouseGRPCService(logger *slog.Logger, warehouseRepo domain.WarehouseRepositoryInterface) *WarehouseGRPCService {
+func NewWarehouseGRPCService(logger *slog.Logger, warehouseRepo domain.WarehouseRepositoryInterface, driverClient driverpb.DriverServiceClient, lowStock *replenishment.Monitor) *WarehouseGRPCService {
 	return &WarehouseGRPCService{
-		warehouseRepo: warehouseRepo,
-		logger:        logger,
+		warehouseRepo:    warehouseRepo,
+		driverGRPCClient: driverClient,
+		lowStock:         lowStock,
+		logger:           logger,
 	}
 }
 
@@ -30,14 +43,45 @@ func RegisterWarehouseServiceServer(s *grpc.Server, srv *WarehouseGRPCService) {
 	warehousepb.RegisterWarehouseServiceServer(s, srv)
 }
 
+// CheckStockAvailability проверяет остатки на одном складе или суммарно по всем активным складам
+// и возвращает доступное количество запрошенных товаров на каждом складе
 func (s *WarehouseGRPCService) CheckStockAvailability(ctx context.Context, req *warehousepb.CheckStockRequest) (*warehousepb.CheckStockResponse, error) {
+	if req.WarehouseId < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid warehouse_id %d", req.WarehouseId)
+	}
 	goodsItems := utils.ConvertStockItemsToOrderItems(req.Items)
-	available, err := s.warehouseRepo.CheckStockAvailability(ctx, goodsItems)
+	names := make([]string, 0, len(goodsItems))
+	for _, item := range goodsItems {
+		names = append(names, item.ProductName)
+	}
+	stock, err := s.warehouseRepo.GetAvailableStock(ctx, names, req.WarehouseId)
 	if err != nil {
 		s.logger.Error("failed to check stock availability", slog.String("status", "error"), slogger.Err(err))
 		return nil, err
 	}
-	return &warehousepb.CheckStockResponse{Available: available}, nil
+
+	resp := &warehousepb.CheckStockResponse{
+		Available: true,
+		Items:     make([]*warehousepb.StockItemWithWarehouse, 0, len(stock)),
+	}
+	available := make(map[string]int32, len(goodsItems))
+	for _, item := range stock {
+		available[item.ProductName] += item.Available
+		resp.Items = append(resp.Items, &warehousepb.StockItemWithWarehouse{
+			ProductName: item.ProductName,
+			Quantity:    item.Available,
+			WarehouseId: item.WarehouseID,
+			ProductId:   item.ProductID,
+		})
+	}
+	for _, item := range goodsItems {
+		if available[item.ProductName] < item.Quantity {
+			resp.Available = false
+			resp.Message = fmt.Sprintf("product %q: requested %d, available %d", item.ProductName, item.Quantity, available[item.ProductName])
+			break
+		}
+	}
+	return resp, nil
 }
 
 func (s *WarehouseGRPCService) GetWarehouseStock(ctx context.Context, req *emptypb.Empty) (*warehousepb.GetWarehouseStockResponse, error) {
@@ -54,12 +98,148 @@ func (s *WarehouseGRPCService) GetWarehouseStock(ctx context.Context, req *empty
 
 func (s *WarehouseGRPCService) UpdateStock(ctx context.Context, req *warehousepb.UpdateStockRequest) (*warehousepb.UpdateStockResponse, error) {
 	stockItems := utils.ConvertStockItemsToOrderItems(req.Items)
-	err := s.warehouseRepo.UpdateStock(ctx, stockItems)
+	now := req.Time
+	if now == 0 {
+		now = time.Now().Unix()
+	}
+	err := s.warehouseRepo.UpdateStock(ctx, req.OrderId, req.WarehouseId, stockItems, now)
 	if err != nil {
-		s.logger.Error("failed to update stock", slog.String("status", "error"), slogger.Err(err))
+		s.logger.Error("failed to update stock", slog.Int64("order_id", req.OrderId), slog.String("status", "error"), slogger.Err(err))
+		if errors.Is(err, domain.ErrInsufficientStock) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
 		return nil, err
 	}
+	s.lowStock.Notify()
 	return &warehousepb.UpdateStockResponse{
 		Success: true,
 	}, nil
 }
+
+func (s *WarehouseGRPCService) ReturnStock(ctx context.Context, req *warehousepb.ReturnStockRequest) (*warehousepb.ReturnStockResponse, error) {
+	// Без заказа неизвестно, с какого склада товар был списан
+	if req.OrderId == 0 && req.WarehouseId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "warehouse_id is required without order_id")
+	}
+	stockItems := utils.ConvertStockItemsToOrderItems(req.Items)
+	for _, item := range stockItems {
+		if item.Quantity <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid quantity %d for product %d", item.Quantity, item.ProductID)
+		}
+	}
+	now := req.Time
+	if now == 0 {
+		now = time.Now().Unix()
+	}
+	err := s.warehouseRepo.ReturnStock(ctx, req.OrderId, req.WarehouseId, stockItems, now)
+	if err != nil {
+		s.logger.Error("failed to return stock", slog.Int64("order_id", req.OrderId), slog.String("status", "error"), slogger.Err(err))
+		if errors.Is(err, domain.ErrWarehouseNotFound) || errors.Is(err, domain.ErrProductNotFound) {
+			return nil, status.Error(codes.NotFound, err.Error())
+		}
+		return nil, err
+	}
+	s.logger.Info("stock returned", slog.Int64("order_id", req.OrderId), slog.Int("items", len(stockItems)))
+	return &warehousepb.ReturnStockResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *WarehouseGRPCService) ReserveStock(ctx context.Context, req *warehousepb.ReserveStockRequest) (*warehousepb.ReserveStockResponse, error) {
+	if req.OrderId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "order_id is required")
+	}
+	if len(req.Items) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "items are required")
+	}
+	stockItems := utils.ConvertStockItemsToOrderItems(req.Items)
+	for _, item := range stockItems {
+		if item.Quantity <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid quantity %d for product %q", item.Quantity, item.ProductName)
+		}
+	}
+
+	now := req.Time
+	if now == 0 {
+		now = time.Now().Unix()
+	}
+	ttl := time.Duration(req.TtlSeconds) * time.Second
+	if ttl <= 0 {
+		ttl = reservation.DefaultTTL
+	}
+
+	var target *entity.Location
+	if req.DeliveryLocation != nil {
+		target = &entity.Location{Latitude: req.DeliveryLocation.Latitude, Longitude: req.DeliveryLocation.Longitude}
+	}
+
+	allocations, expiresAt, err := s.warehouseRepo.ReserveStock(ctx, req.OrderId, stockItems, target, now+int64(ttl.Seconds()), now)
+	if err != nil {
+		s.logger.Error("failed to reserve stock", slog.Int64("order_id", req.OrderId), slog.String("status", "error"), slogger.Err(err))
+		if errors.Is(err, domain.ErrInsufficientStock) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, err
+	}
+	s.logger.Info("stock reserved",
+		slog.Int64("order_id", req.OrderId),
+		slog.Int64("expires_at", expiresAt),
+		slog.Int("warehouses", countWarehouses(allocations)),
+	)
+	// Резерв уменьшает доступный остаток так же, как списание
+	s.lowStock.Notify()
+	return &warehousepb.ReserveStockResponse{
+		Success:     true,
+		ExpiresAt:   expiresAt,
+		Allocations: utils.ConvertStockAllocationsToProto(allocations),
+	}, nil
+}
+
+func (s *WarehouseGRPCService) CommitReservation(ctx context.Context, req *warehousepb.CommitReservationRequest) (*warehousepb.CommitReservationResponse, error) {
+	if req.OrderId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "order_id is required")
+	}
+	now := req.Time
+	if now == 0 {
+		now = time.Now().Unix()
+	}
+	err := s.warehouseRepo.CommitReservation(ctx, req.OrderId, now)
+	if err != nil {
+		s.logger.Error("failed to commit reservation", slog.Int64("order_id", req.OrderId), slog.String("status", "error"), slogger.Err(err))
+		if errors.Is(err, domain.ErrReservationNotFound) || errors.Is(err, domain.ErrReservationExpired) {
+			return nil, status.Error(codes.FailedPrecondition, err.Error())
+		}
+		return nil, err
+	}
+	return &warehousepb.CommitReservationResponse{
+		Success: true,
+	}, nil
+}
+
+func (s *WarehouseGRPCService) ReleaseReservation(ctx context.Context, req *warehousepb.ReleaseReservationRequest) (*warehousepb.ReleaseReservationResponse, error) {
+	if req.OrderId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "order_id is required")
+	}
+	now := req.Time
+	if now == 0 {
+		now = time.Now().Unix()
+	}
+	released, err := s.warehouseRepo.ReleaseReservation(ctx, req.OrderId, now)
+	if err != nil {
+		s.logger.Error("failed to release reservation", slog.Int64("order_id", req.OrderId), slog.String("status", "error"), slogger.Err(err))
+		return nil, err
+	}
+	return &warehousepb.ReleaseReservationResponse{
+		Success:  true,
+		Released: released,
+	}, nil
+}
+
+// countWarehouses - сколько складов отгружают заказ; больше одного - заказ разделен
+func countWarehouses(allocations []entity.StockAllocation) int {
+	warehouses := make(map[int64]struct{}, len(allocations))
+	for _, allocation := range allocations {
+		warehouses[allocation.WarehouseID] = struct{}{}
+	}
+	return len(warehouses)
+}
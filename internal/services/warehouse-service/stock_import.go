@@ -0,0 +1,171 @@
+package warehouseservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"math"
+	"slices"
+	"strings"
+	"time"
+
+	warehousepb "logistics/api/protobuf/warehouse_service"
+	"logistics/internal/services/warehouse-service/domain"
+	"logistics/internal/shared/entity"
+	"logistics/pkg/lib/logger/slogger"
+	"logistics/pkg/lib/utils"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+// maxImportRows - предел строк в одном пакете загрузки остатков
+const maxImportRows = 10000
+
+// ImportStock приводит остатки складов к фактическим и обновляет цены одним пакетом. Пакет с ошибками
+// не применяется: в ответе отчет по всем строкам, чтобы файл можно было исправить за один раз
+func (s *WarehouseGRPCService) ImportStock(ctx context.Context, req *warehousepb.ImportStockRequest) (*warehousepb.ImportStockResponse, error) {
+	if req.WarehouseId < 0 {
+		return nil, status.Error(codes.InvalidArgument, "invalid warehouse_id")
+	}
+	if len(req.Rows) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one row is required")
+	}
+	if len(req.Rows) > maxImportRows {
+		return nil, status.Errorf(codes.InvalidArgument, "too many rows: %d, at most %d are accepted", len(req.Rows), maxImportRows)
+	}
+	if req.WarehouseId > 0 {
+		warehouse, err := s.warehouseRepo.GetWarehouse(ctx, req.WarehouseId)
+		if err != nil {
+			return nil, s.warehouseError("failed to get warehouse", err)
+		}
+		if !warehouse.Active {
+			return nil, status.Errorf(codes.FailedPrecondition, "warehouse %d: %v", warehouse.ID, domain.ErrWarehouseInactive)
+		}
+	}
+
+	rows, rowErrors := validateImportRows(req.Rows, req.WarehouseId)
+	rows, warehouseErrors, err := s.checkImportWarehouses(ctx, rows)
+	if err != nil {
+		return nil, err
+	}
+	rowErrors = append(rowErrors, warehouseErrors...)
+	// Строки с ошибками формата не применяются, остальные все равно проверяются по каталогу и остаткам
+	dryRun := req.DryRun || len(rowErrors) > 0
+	var results []*entity.StockImportResult
+	if len(rows) > 0 {
+		var stockErrors []entity.ImportRowError
+		results, stockErrors, err = s.warehouseRepo.ImportStock(ctx, rows, req.UserId, time.Now().Unix(), dryRun)
+		if err != nil {
+			s.logger.Error("failed to import stock", slog.Int64("warehouse_id", req.WarehouseId), slogger.Err(err))
+			return nil, status.Errorf(codes.Internal, "failed to import stock: %v", err)
+		}
+		rowErrors = append(rowErrors, stockErrors...)
+	}
+	slices.SortStableFunc(rowErrors, func(a, b entity.ImportRowError) int { return int(a.Line - b.Line) })
+
+	resp := &warehousepb.ImportStockResponse{
+		Applied: !dryRun && len(rowErrors) == 0,
+		Results: make([]*warehousepb.ImportStockResult, 0, len(results)),
+		Errors:  make([]*warehousepb.ImportRowError, 0, len(rowErrors)),
+	}
+	for _, result := range results {
+		resp.Results = append(resp.Results, utils.ConvertStockImportResultToProto(result))
+	}
+	for _, rowErr := range rowErrors {
+		resp.Errors = append(resp.Errors, &warehousepb.ImportRowError{Line: rowErr.Line, Message: rowErr.Message})
+	}
+	if resp.Applied {
+		s.lowStock.Notify()
+		s.logger.Info("stock imported",
+			slog.Int64("warehouse_id", req.WarehouseId),
+			slog.Int("rows", len(results)),
+			slog.Int64("user_id", req.UserId),
+		)
+	}
+	return resp, nil
+}
+
+// checkImportWarehouses отклоняет строки неизвестных и закрытых складов
+func (s *WarehouseGRPCService) checkImportWarehouses(ctx context.Context, rows []entity.StockImportRow) ([]entity.StockImportRow, []entity.ImportRowError, error) {
+	problems := make(map[int64]error)
+	checked := make(map[int64]bool)
+	for _, row := range rows {
+		if checked[row.WarehouseID] {
+			continue
+		}
+		checked[row.WarehouseID] = true
+		warehouse, err := s.warehouseRepo.GetWarehouse(ctx, row.WarehouseID)
+		switch {
+		case errors.Is(err, domain.ErrWarehouseNotFound):
+			problems[row.WarehouseID] = err
+		case err != nil:
+			return nil, nil, s.warehouseError("failed to get warehouse", err)
+		case !warehouse.Active:
+			problems[row.WarehouseID] = domain.ErrWarehouseInactive
+		}
+	}
+	valid := rows[:0]
+	var rowErrors []entity.ImportRowError
+	for _, row := range rows {
+		if err, ok := problems[row.WarehouseID]; ok {
+			rowErrors = append(rowErrors, entity.ImportRowError{Line: row.Line, Message: fmt.Sprintf("warehouse %d: %v", row.WarehouseID, err)})
+			continue
+		}
+		valid = append(valid, row)
+	}
+	return valid, rowErrors, nil
+}
+
+// validateImportRows нормализует строки загрузки и проверяет их формат; строки с ошибками в пакет не попадают.
+// Строкам без склада достается warehouseID, строки с другим складом при заданном warehouseID отклоняются,
+// чтобы выгрузку одного склада нельзя было по ошибке загрузить в другой
+func validateImportRows(rows []*warehousepb.ImportStockRow, warehouseID int64) ([]entity.StockImportRow, []entity.ImportRowError) {
+	valid := make([]entity.StockImportRow, 0, len(rows))
+	var rowErrors []entity.ImportRowError
+	for i, row := range rows {
+		line := row.Line
+		if line <= 0 {
+			line = int32(i + 1)
+		}
+		importRow := entity.StockImportRow{
+			Line:        line,
+			WarehouseID: row.WarehouseId,
+			SKU:         strings.ToUpper(strings.TrimSpace(row.Sku)),
+			ProductName: strings.TrimSpace(row.ProductName),
+			Quantity:    row.Quantity,
+		}
+		if importRow.WarehouseID == 0 {
+			importRow.WarehouseID = warehouseID
+		}
+		var msg string
+		switch {
+		case importRow.WarehouseID <= 0:
+			msg = "warehouse_id is required"
+		case warehouseID > 0 && importRow.WarehouseID != warehouseID:
+			msg = fmt.Sprintf("warehouse_id %d does not match warehouse %d of the import", importRow.WarehouseID, warehouseID)
+		case importRow.SKU == "" && importRow.ProductName == "":
+			msg = "sku or product_name is required"
+		case importRow.SKU != "" && !skuPattern.MatchString(importRow.SKU):
+			msg = fmt.Sprintf("invalid sku %q", row.Sku)
+		case importRow.Quantity < 0:
+			msg = fmt.Sprintf("invalid quantity %d", row.Quantity)
+		}
+		if msg == "" && row.UnitPrice != nil {
+			price := row.UnitPrice.Value
+			if math.IsNaN(price) || math.IsInf(price, 0) || price < 0 {
+				msg = fmt.Sprintf("invalid unit_price %v", price)
+			}
+			// Цена хранится с точностью до копеек
+			price = math.Round(price*100) / 100
+			importRow.UnitPrice = &price
+		}
+		if msg != "" {
+			rowErrors = append(rowErrors, entity.ImportRowError{Line: line, Message: msg})
+			continue
+		}
+		valid = append(valid, importRow)
+	}
+	return valid, rowErrors
+}
@@ -0,0 +1,281 @@
+package warehouseservice
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"log/slog"
+	"strings"
+	"time"
+
+	driverpb "logistics/api/protobuf/driver_service"
+	warehousepb "logistics/api/protobuf/warehouse_service"
+	"logistics/internal/services/warehouse-service/domain"
+	"logistics/internal/shared/entity"
+	"logistics/pkg/lib/logger/slogger"
+	"logistics/pkg/lib/utils"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	defaultTransfersPageSize = 20
+	maxTransfersPageSize     = 100
+)
+
+func (s *WarehouseGRPCService) CreateTransfer(ctx context.Context, req *warehousepb.CreateTransferRequest) (*warehousepb.CreateTransferResponse, error) {
+	transfer := &entity.StockTransfer{
+		SourceWarehouseID:      req.SourceWarehouseId,
+		DestinationWarehouseID: req.DestinationWarehouseId,
+		DriverID:               req.DriverId,
+		Note:                   strings.TrimSpace(req.Note),
+		CreatedBy:              req.UserId,
+		CreatedAt:              time.Now().Unix(),
+	}
+	if transfer.SourceWarehouseID <= 0 || transfer.DestinationWarehouseID <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "source_warehouse_id and destination_warehouse_id are required")
+	}
+	if transfer.SourceWarehouseID == transfer.DestinationWarehouseID {
+		return nil, status.Error(codes.InvalidArgument, "source and destination warehouses must differ")
+	}
+	if transfer.DriverID < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid driver_id %d", req.DriverId)
+	}
+	if len(req.Lines) == 0 {
+		return nil, status.Error(codes.InvalidArgument, "at least one line is required")
+	}
+	seen := make(map[int64]struct{}, len(req.Lines))
+	for _, line := range req.Lines {
+		if line.ProductId <= 0 {
+			return nil, status.Error(codes.InvalidArgument, "product_id is required in every line")
+		}
+		if _, ok := seen[line.ProductId]; ok {
+			return nil, status.Errorf(codes.InvalidArgument, "product %d is listed more than once", line.ProductId)
+		}
+		seen[line.ProductId] = struct{}{}
+		if line.Quantity <= 0 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid quantity %d for product %d", line.Quantity, line.ProductId)
+		}
+		transfer.Lines = append(transfer.Lines, entity.TransferLine{ProductID: line.ProductId, Quantity: line.Quantity})
+	}
+
+	// Со склада-источника товар можно вывозить и после закрытия, а закрытый склад-получатель товар не принимает
+	if _, err := s.warehouseRepo.GetWarehouse(ctx, transfer.SourceWarehouseID); err != nil {
+		return nil, s.transferError("failed to get source warehouse", err)
+	}
+	destination, err := s.warehouseRepo.GetWarehouse(ctx, transfer.DestinationWarehouseID)
+	if err != nil {
+		return nil, s.transferError("failed to get destination warehouse", err)
+	}
+	if !destination.Active {
+		return nil, status.Errorf(codes.FailedPrecondition, "warehouse %d: %v", destination.ID, domain.ErrWarehouseInactive)
+	}
+	if transfer.DriverID != 0 {
+		if _, err := s.activeDriver(ctx, transfer.DriverID); err != nil {
+			return nil, err
+		}
+	}
+
+	created, err := s.warehouseRepo.CreateTransfer(ctx, transfer)
+	if err != nil {
+		return nil, s.transferError("failed to create stock transfer", err)
+	}
+	s.logger.Info("stock transfer created",
+		slog.Int64("transfer_id", created.ID),
+		slog.Int64("source_warehouse_id", created.SourceWarehouseID),
+		slog.Int64("destination_warehouse_id", created.DestinationWarehouseID),
+	)
+	return &warehousepb.CreateTransferResponse{Transfer: utils.ConvertTransferToProto(created)}, nil
+}
+
+func (s *WarehouseGRPCService) GetTransfer(ctx context.Context, req *warehousepb.GetTransferRequest) (*warehousepb.GetTransferResponse, error) {
+	if req.TransferId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "transfer_id is required")
+	}
+	transfer, err := s.warehouseRepo.GetTransfer(ctx, req.TransferId)
+	if err != nil {
+		return nil, s.transferError("failed to get stock transfer", err)
+	}
+	return &warehousepb.GetTransferResponse{Transfer: utils.ConvertTransferToProto(transfer)}, nil
+}
+
+func (s *WarehouseGRPCService) ListTransfers(ctx context.Context, req *warehousepb.ListTransfersRequest) (*warehousepb.ListTransfersResponse, error) {
+	filter := domain.TransferFilter{
+		Status:      entity.TransferStatus(req.Status),
+		WarehouseID: req.WarehouseId,
+	}
+	if filter.Status != "" && !filter.Status.IsValid() {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid status %q", req.Status)
+	}
+	if filter.WarehouseID < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid warehouse_id %d", req.WarehouseId)
+	}
+
+	page, pageSize := req.Page, req.PageSize
+	if page <= 0 {
+		page = 1
+	}
+	if pageSize <= 0 {
+		pageSize = defaultTransfersPageSize
+	}
+	if pageSize > maxTransfersPageSize {
+		pageSize = maxTransfersPageSize
+	}
+	filter.Limit = int(pageSize)
+	filter.Offset = int(page-1) * int(pageSize)
+
+	transfers, total, err := s.warehouseRepo.ListTransfers(ctx, filter)
+	if err != nil {
+		return nil, s.transferError("failed to list stock transfers", err)
+	}
+	resp := &warehousepb.ListTransfersResponse{
+		Transfers: make([]*warehousepb.Transfer, 0, len(transfers)),
+		Total:     total,
+		Page:      page,
+		PageSize:  pageSize,
+	}
+	for _, transfer := range transfers {
+		resp.Transfers = append(resp.Transfers, utils.ConvertTransferToProto(transfer))
+	}
+	return resp, nil
+}
+
+func (s *WarehouseGRPCService) AssignTransferDriver(ctx context.Context, req *warehousepb.AssignTransferDriverRequest) (*warehousepb.AssignTransferDriverResponse, error) {
+	if req.TransferId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "transfer_id is required")
+	}
+	if req.DriverId < 0 {
+		return nil, status.Errorf(codes.InvalidArgument, "invalid driver_id %d", req.DriverId)
+	}
+	if req.DriverId != 0 {
+		if _, err := s.activeDriver(ctx, req.DriverId); err != nil {
+			return nil, err
+		}
+	}
+
+	transfer, err := s.warehouseRepo.AssignTransferDriver(ctx, req.TransferId, req.DriverId, time.Now().Unix())
+	if err != nil {
+		return nil, s.transferError("failed to assign driver to stock transfer", err)
+	}
+	s.logger.Info("stock transfer driver assigned", slog.Int64("transfer_id", transfer.ID), slog.Int64("driver_id", transfer.DriverID))
+	return &warehousepb.AssignTransferDriverResponse{Transfer: utils.ConvertTransferToProto(transfer)}, nil
+}
+
+// DispatchTransfer списывает товар со склада-источника; назначенный водитель резервируется до списания
+func (s *WarehouseGRPCService) DispatchTransfer(ctx context.Context, req *warehousepb.DispatchTransferRequest) (*warehousepb.DispatchTransferResponse, error) {
+	if req.TransferId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "transfer_id is required")
+	}
+	current, err := s.warehouseRepo.GetTransfer(ctx, req.TransferId)
+	if err != nil {
+		return nil, s.transferError("failed to get stock transfer", err)
+	}
+	// Водитель занимается условно (available -> busy), поэтому параллельный заказ или
+	// другое перемещение не получат его одновременно с этим
+	var reservedAt int64
+	if current.DriverID != 0 && current.Status == entity.TransferDraft {
+		if _, err := s.activeDriver(ctx, current.DriverID); err != nil {
+			return nil, err
+		}
+		resp, err := s.driverGRPCClient.ReserveDriver(ctx, &driverpb.ReserveDriverRequest{DriverId: current.DriverID})
+		if status.Code(err) == codes.FailedPrecondition {
+			return nil, status.Errorf(codes.FailedPrecondition, "driver %d is not available", current.DriverID)
+		}
+		if err != nil {
+			s.logger.Error("failed to reserve transfer driver", slog.Int64("driver_id", current.DriverID), slogger.Err(err))
+			return nil, status.Errorf(codes.Unavailable, "failed to reserve driver %d: %v", current.DriverID, err)
+		}
+		reservedAt = resp.ReservedAt
+	}
+
+	transfer, err := s.warehouseRepo.DispatchTransfer(ctx, req.TransferId, req.UserId, time.Now().Unix())
+	if err != nil {
+		if reservedAt != 0 {
+			s.releaseTransferDriver(context.WithoutCancel(ctx), current, reservedAt)
+		}
+		return nil, s.transferError("failed to dispatch stock transfer", err)
+	}
+	s.lowStock.Notify()
+	s.logger.Info("stock transfer dispatched", slog.Int64("transfer_id", transfer.ID), slog.Int("lines", len(transfer.Lines)))
+	return &warehousepb.DispatchTransferResponse{Transfer: utils.ConvertTransferToProto(transfer)}, nil
+}
+
+// ReceiveTransfer приходует товар на склад-получатель и освобождает водителя
+func (s *WarehouseGRPCService) ReceiveTransfer(ctx context.Context, req *warehousepb.ReceiveTransferRequest) (*warehousepb.ReceiveTransferResponse, error) {
+	if req.TransferId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "transfer_id is required")
+	}
+	transfer, err := s.warehouseRepo.ReceiveTransfer(ctx, req.TransferId, req.UserId, time.Now().Unix())
+	if err != nil {
+		return nil, s.transferError("failed to receive stock transfer", err)
+	}
+	s.setTransferDriverStatus(ctx, transfer, entity.DriverStatusAvailable)
+	s.logger.Info("stock transfer received", slog.Int64("transfer_id", transfer.ID), slog.Int("lines", len(transfer.Lines)))
+	return &warehousepb.ReceiveTransferResponse{Transfer: utils.ConvertTransferToProto(transfer)}, nil
+}
+
+// activeDriver проверяет в driver-service, что водитель есть и не уволен
+func (s *WarehouseGRPCService) activeDriver(ctx context.Context, driverID int64) (*driverpb.Driver, error) {
+	resp, err := s.driverGRPCClient.GetDriver(ctx, &driverpb.GetDriverRequest{DriverId: driverID})
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, status.Errorf(codes.NotFound, "driver %d not found", driverID)
+		}
+		s.logger.Error("failed to get driver", slog.Int64("driver_id", driverID), slogger.Err(err))
+		return nil, status.Errorf(codes.Unavailable, "failed to get driver %d: %v", driverID, err)
+	}
+	if !resp.Driver.GetActive() {
+		return nil, status.Errorf(codes.FailedPrecondition, "driver %d is deactivated", driverID)
+	}
+	return resp.Driver, nil
+}
+
+// releaseTransferDriver снимает резерв водителя, если перемещение не удалось отправить
+func (s *WarehouseGRPCService) releaseTransferDriver(ctx context.Context, transfer *entity.StockTransfer, reservedAt int64) {
+	_, err := s.driverGRPCClient.ReleaseDriver(ctx, &driverpb.ReleaseDriverRequest{
+		DriverId:   transfer.DriverID,
+		ReservedAt: reservedAt,
+	})
+	if err != nil {
+		s.logger.Error("failed to release transfer driver",
+			slog.Int64("transfer_id", transfer.ID),
+			slog.Int64("driver_id", transfer.DriverID),
+			slogger.Err(err),
+		)
+	}
+}
+
+// setTransferDriverStatus меняет статус водителя перемещения. Остатки к этому моменту уже изменены,
+// поэтому сбой только логируется - статус водителя можно поправить вручную
+func (s *WarehouseGRPCService) setTransferDriverStatus(ctx context.Context, transfer *entity.StockTransfer, driverStatus entity.DriverStatus) {
+	if transfer.DriverID == 0 {
+		return
+	}
+	resp, err := s.driverGRPCClient.UpdateDriverStatus(ctx, &driverpb.UpdateDriverStatusRequest{
+		DriverId: transfer.DriverID,
+		Status:   string(driverStatus),
+	})
+	if err == nil && !resp.Success {
+		err = fmt.Errorf("driver-service rejected status %s", driverStatus)
+	}
+	if err != nil {
+		s.logger.Error("failed to update transfer driver status",
+			slog.Int64("transfer_id", transfer.ID),
+			slog.Int64("driver_id", transfer.DriverID),
+			slogger.Err(err),
+		)
+	}
+}
+
+// transferError переводит ошибку репозитория перемещений в gRPC-статус
+func (s *WarehouseGRPCService) transferError(msg string, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrTransferNotFound), errors.Is(err, domain.ErrWarehouseNotFound), errors.Is(err, domain.ErrProductNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrTransferStatus), errors.Is(err, domain.ErrInsufficientStock):
+		return status.Error(codes.FailedPrecondition, err.Error())
+	}
+	s.logger.Error(msg, slog.String("status", "error"), slogger.Err(err))
+	return status.Errorf(codes.Internal, "%s: %v", msg, err)
+}
@@ -0,0 +1,146 @@
+package warehouseservice
+
+import (
+	"context"
+	"errors"
+	"log/slog"
+	"math"
+	"strings"
+	"time"
+
+	warehousepb "logistics/api/protobuf/warehouse_service"
+	"logistics/internal/services/warehouse-service/domain"
+	"logistics/internal/shared/entity"
+	"logistics/pkg/lib/logger/slogger"
+	"logistics/pkg/lib/utils"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+)
+
+func (s *WarehouseGRPCService) CreateWarehouse(ctx context.Context, req *warehousepb.CreateWarehouseRequest) (*warehousepb.CreateWarehouseResponse, error) {
+	warehouse, err := validateWarehouse(req.Name, req.Address, req.Location)
+	if err != nil {
+		return nil, err
+	}
+	warehouse.CreatedAt = time.Now().Unix()
+
+	created, err := s.warehouseRepo.CreateWarehouse(ctx, warehouse)
+	if err != nil {
+		return nil, s.warehouseError("failed to create warehouse", err)
+	}
+	s.logger.Info("warehouse created", slog.Int64("warehouse_id", created.ID), slog.String("name", created.Name))
+	return &warehousepb.CreateWarehouseResponse{Warehouse: utils.ConvertWarehouseToProto(created)}, nil
+}
+
+func (s *WarehouseGRPCService) GetWarehouse(ctx context.Context, req *warehousepb.GetWarehouseRequest) (*warehousepb.GetWarehouseResponse, error) {
+	if req.WarehouseId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "warehouse_id is required")
+	}
+	warehouse, err := s.warehouseRepo.GetWarehouse(ctx, req.WarehouseId)
+	if err != nil {
+		return nil, s.warehouseError("failed to get warehouse", err)
+	}
+	return &warehousepb.GetWarehouseResponse{Warehouse: utils.ConvertWarehouseToProto(warehouse)}, nil
+}
+
+func (s *WarehouseGRPCService) ListWarehouses(ctx context.Context, req *warehousepb.ListWarehousesRequest) (*warehousepb.ListWarehousesResponse, error) {
+	warehouses, err := s.warehouseRepo.ListWarehouses(ctx, req.IncludeInactive)
+	if err != nil {
+		return nil, s.warehouseError("failed to list warehouses", err)
+	}
+	resp := &warehousepb.ListWarehousesResponse{
+		Warehouses: make([]*warehousepb.Warehouse, 0, len(warehouses)),
+	}
+	for _, warehouse := range warehouses {
+		resp.Warehouses = append(resp.Warehouses, utils.ConvertWarehouseToProto(warehouse))
+	}
+	return resp, nil
+}
+
+func (s *WarehouseGRPCService) UpdateWarehouse(ctx context.Context, req *warehousepb.UpdateWarehouseRequest) (*warehousepb.UpdateWarehouseResponse, error) {
+	if req.WarehouseId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "warehouse_id is required")
+	}
+	warehouse, err := validateWarehouse(req.Name, req.Address, req.Location)
+	if err != nil {
+		return nil, err
+	}
+	warehouse.ID = req.WarehouseId
+	warehouse.UpdatedAt = time.Now().Unix()
+	var active *bool
+	if req.Active != nil {
+		active = &req.Active.Value
+	}
+
+	updated, err := s.warehouseRepo.UpdateWarehouse(ctx, warehouse, active)
+	if err != nil {
+		return nil, s.warehouseError("failed to update warehouse", err)
+	}
+	s.logger.Info("warehouse updated", slog.Int64("warehouse_id", updated.ID), slog.Bool("active", updated.Active))
+	return &warehousepb.UpdateWarehouseResponse{Warehouse: utils.ConvertWarehouseToProto(updated)}, nil
+}
+
+// GetWarehouseInventory возвращает остатки одного склада с учетом резервов
+func (s *WarehouseGRPCService) GetWarehouseInventory(ctx context.Context, req *warehousepb.GetWarehouseInventoryRequest) (*warehousepb.GetWarehouseInventoryResponse, error) {
+	if req.WarehouseId <= 0 {
+		return nil, status.Error(codes.InvalidArgument, "warehouse_id is required")
+	}
+	warehouse, err := s.warehouseRepo.GetWarehouse(ctx, req.WarehouseId)
+	if err != nil {
+		return nil, s.warehouseError("failed to get warehouse", err)
+	}
+	items, err := s.warehouseRepo.GetWarehouseInventory(ctx, req.WarehouseId)
+	if err != nil {
+		return nil, s.warehouseError("failed to get warehouse inventory", err)
+	}
+
+	resp := &warehousepb.GetWarehouseInventoryResponse{
+		Warehouse: utils.ConvertWarehouseToProto(warehouse),
+		Items:     make([]*warehousepb.WarehouseStockItem, 0, len(items)),
+	}
+	for _, item := range items {
+		resp.Items = append(resp.Items, &warehousepb.WarehouseStockItem{
+			ProductId:   item.ProductID,
+			ProductName: item.ProductName,
+			Quantity:    item.Quantity,
+			Reserved:    item.Reserved,
+			Available:   item.Available,
+			LastUpdated: item.LastUpdated,
+			Sku:         item.SKU,
+			UnitPrice:   item.UnitPrice,
+		})
+	}
+	return resp, nil
+}
+
+// validateWarehouse проверяет и нормализует данные склада; координаты необязательны
+func validateWarehouse(name, address string, location *warehousepb.Location) (*entity.Warehouse, error) {
+	warehouse := &entity.Warehouse{
+		Name:    strings.TrimSpace(name),
+		Address: strings.TrimSpace(address),
+	}
+	if warehouse.Name == "" {
+		return nil, status.Error(codes.InvalidArgument, "name is required")
+	}
+	if location != nil {
+		lat, lng := location.Latitude, location.Longitude
+		if math.IsNaN(lat) || lat < -90 || lat > 90 || math.IsNaN(lng) || lng < -180 || lng > 180 {
+			return nil, status.Errorf(codes.InvalidArgument, "invalid location %v, %v", lat, lng)
+		}
+		warehouse.Location = &entity.Location{Latitude: lat, Longitude: lng}
+	}
+	return warehouse, nil
+}
+
+// warehouseError переводит ошибку репозитория складов в gRPC-статус
+func (s *WarehouseGRPCService) warehouseError(msg string, err error) error {
+	switch {
+	case errors.Is(err, domain.ErrWarehouseNotFound):
+		return status.Error(codes.NotFound, err.Error())
+	case errors.Is(err, domain.ErrDuplicateWarehouseName):
+		return status.Error(codes.AlreadyExists, err.Error())
+	}
+	s.logger.Error(msg, slog.String("status", "error"), slogger.Err(err))
+	return status.Errorf(codes.Internal, "%s: %v", msg, err)
+}
@@ -0,0 +1,28 @@
+package entity
+
+// AssignmentJob - асинхронное назначение водителя на заказ
+// @Description Задача назначения водителя
+type AssignmentJob struct {
+	ID        int64               `json:"id" db:"id" example:"1"`
+	OrderID   int64               `json:"order_id" db:"order_id" example:"1"`
+	UserID    int64               `json:"user_id" db:"user_id" example:"123"`
+	Status    AssignmentJobStatus `json:"status" db:"status" example:"queued"`
+	DriverID  int64               `json:"driver_id,omitempty" db:"driver_id" example:"456"`
+	Error     string              `json:"error,omitempty" db:"error"`
+	CreatedAt int64               `json:"created_at" db:"created_at" example:"1694966400"`
+	UpdatedAt int64               `json:"updated_at" db:"updated_at" example:"1694966400"`
+}
+
+type AssignmentJobStatus string
+
+const (
+	AssignmentQueued    AssignmentJobStatus = "queued"    // ждет обработки воркером
+	AssignmentSearching AssignmentJobStatus = "searching" // водитель запрошен, ждем событие из Kafka
+	AssignmentAssigned  AssignmentJobStatus = "assigned"  // водитель назначен на заказ
+	AssignmentFailed    AssignmentJobStatus = "failed"    // назначить водителя не удалось
+)
+
+// IsFinished возвращает true, если задача больше не изменится
+func (s AssignmentJobStatus) IsFinished() bool {
+	return s == AssignmentAssigned || s == AssignmentFailed
+}
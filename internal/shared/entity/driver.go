@@ -8,10 +8,26 @@ type Driver struct {
 	LicenseNumber string       `json:"license_number" db:"license_number"`
 	Car           string       `json:"car" db:"car"`
 	Status        DriverStatus `json:"status" db:"status"`
+	// Location - последняя известная позиция водителя
+	Location          *Location `json:"location,omitempty"`
+	LocationUpdatedAt int64     `json:"location_updated_at,omitempty" db:"location_updated_at"`
+	LastAssignedAt    int64     `json:"last_assigned_at,omitempty" db:"last_assigned_at"`
+	Email             string    `json:"email,omitempty" db:"email"`
+	// Active - false у уволенных водителей: их не назначают на заказы
+	Active bool `json:"active" db:"is_active"`
 }
 
 type DriverStatus string
 
+// IsValid проверяет, что статус входит в известный набор
+func (s DriverStatus) IsValid() bool {
+	switch s {
+	case DriverStatusOffline, DriverStatusAvailable, DriverStatusBusy, DriverStatusBreak, DriverStatusUnavailable:
+		return true
+	}
+	return false
+}
+
 const (
 	DriverStatusOffline     DriverStatus = "offline"
 	DriverStatusAvailable   DriverStatus = "available"   // свободен, может взять заказ
@@ -20,9 +36,11 @@ const (
 	DriverStatusUnavailable DriverStatus = "unavailable" // недоступен (болеет, отпуск)
 )
 
+// Msg - сообщение о найденном водителе, привязанное к заказу
 type Msg struct {
-	OrderId int64
-	Driver  DriverKafka
+	OrderId   int64       `json:"order_id"`
+	Driver    DriverKafka `json:"driver"`
+	Timestamp int64       `json:"timestamp"`
 }
 
 type DriverKafka struct {
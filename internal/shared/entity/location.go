@@ -0,0 +1,16 @@
+package entity
+
+// Location - географические координаты точки
+// @Description Координаты точки
+type Location struct {
+	Latitude  float64 `json:"latitude" example:"55.7558"`
+	Longitude float64 `json:"longitude" example:"37.6173"`
+}
+
+// DriverLocation - замер позиции водителя
+// @Description Позиция водителя на момент замера
+type DriverLocation struct {
+	DriverID   int64    `json:"driver_id" example:"7"`
+	Location   Location `json:"location"`
+	RecordedAt int64    `json:"recorded_at" example:"1718000000"`
+}
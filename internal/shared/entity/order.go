@@ -11,6 +11,10 @@ type Order struct {
 	TotalAmount     float64     `json:"total_amount" db:"total_amount" example:"15000.50"`
 	DriverID        *int64      `json:"driver_id,omitempty" db:"driver_id" example:"456"`
 	CreatedAt       int64       `json:"created_at" db:"created_at" example:"1694966400"`
+	// DeliveryLocation - геокодированный адрес доставки
+	DeliveryLocation *Location `json:"delivery_location,omitempty"`
+	// Allocations - с каких складов отгружается заказ; несколько складов - заказ разделен
+	Allocations []StockAllocation `json:"allocations,omitempty"`
 }
 type OrderStatus string
 
@@ -35,3 +39,24 @@ type GoodsItem struct {
 	TotalPrice  float64 `json:"total_price" db:"total_price" example:"15000.00"`
 	LastUpdated int64   `json:"last_updated,,omitempty" db:"last_updated" example:"1694966400"`
 }
+
+// OrderStatusChange - запись истории переходов статуса заказа
+// @Description Переход статуса заказа
+type OrderStatusChange struct {
+	ID        int64       `json:"id" db:"id" example:"1"`
+	OrderID   int64       `json:"order_id" db:"order_id" example:"1"`
+	OldStatus OrderStatus `json:"old_status" db:"old_status" example:"pending"`
+	NewStatus OrderStatus `json:"new_status" db:"new_status" example:"in_progress"`
+	ActorType ActorType   `json:"actor_type" db:"actor_type" example:"user"`
+	ActorID   int64       `json:"actor_id" db:"actor_id" example:"123"`
+	Reason    string      `json:"reason,omitempty" db:"reason" example:"driver assigned"`
+	ChangedAt int64       `json:"changed_at" db:"changed_at" example:"1694966400"`
+}
+
+type ActorType string
+
+const (
+	ActorUser   ActorType = "user"   // действие пользователя
+	ActorDriver ActorType = "driver" // действие водителя
+	ActorSystem ActorType = "system" // автоматическое действие сервиса
+)
@@ -0,0 +1,27 @@
+package entity
+
+// Product - карточка товара в каталоге склада
+// @Description Товар каталога
+type Product struct {
+	ID          int64      `json:"id" db:"id" example:"1"`
+	SKU         string     `json:"sku" db:"sku" example:"SKU-000001"`
+	Name        string     `json:"name" db:"name" example:"Ноутбук ASUS ROG"`
+	Description string     `json:"description" db:"description" example:"Игровой ноутбук 15.6\""`
+	UnitPrice   float64    `json:"unit_price" db:"unit_price" example:"899.99"`
+	WeightGrams int32      `json:"weight_grams" db:"weight_grams" example:"2300"`
+	Dimensions  Dimensions `json:"dimensions"`
+	// ReorderPoint - доступный остаток на складе, ниже которого товар пора пополнять; 0 - без контроля
+	ReorderPoint int32 `json:"reorder_point" db:"reorder_point" example:"5"`
+	// ReorderQuantity - сколько обычно заказывается у поставщика за раз
+	ReorderQuantity int32 `json:"reorder_quantity" db:"reorder_quantity" example:"20"`
+	Active          bool  `json:"active" db:"is_active" example:"true"`
+	CreatedAt       int64 `json:"created_at" db:"created_at" example:"1757808000"`
+	UpdatedAt       int64 `json:"updated_at" db:"updated_at" example:"1757808000"`
+}
+
+// Dimensions - габариты товара в упаковке, мм
+type Dimensions struct {
+	LengthMM int32 `json:"length_mm" db:"length_mm" example:"360"`
+	WidthMM  int32 `json:"width_mm" db:"width_mm" example:"260"`
+	HeightMM int32 `json:"height_mm" db:"height_mm" example:"30"`
+}
@@ -0,0 +1,31 @@
+package entity
+
+// OrderSaga - сохраненное состояние саги создания заказа
+type OrderSaga struct {
+	ID        int64     `json:"id" db:"id"`
+	OrderID   int64     `json:"order_id" db:"order_id"`
+	UserID    int64     `json:"user_id" db:"user_id"`
+	State     SagaState `json:"state" db:"state"`
+	Order     Order     `json:"order" db:"payload"`
+	Error     string    `json:"error,omitempty" db:"error"`
+	CreatedAt int64     `json:"created_at" db:"created_at"`
+	UpdatedAt int64     `json:"updated_at" db:"updated_at"`
+}
+
+type SagaState string
+
+const (
+	SagaStarted       SagaState = "started"        // сага создана, заказа еще нет
+	SagaOrderCreated  SagaState = "order_created"  // заказ записан, склад не тронут
+	SagaStockUpdating SagaState = "stock_updating" // товар резервируется и списывается со склада
+	SagaStockUpdated  SagaState = "stock_updated"  // товар списан со склада
+	SagaCompleted     SagaState = "completed"      // заказ подтвержден
+	SagaCompensating  SagaState = "compensating"   // выполняются компенсирующие действия
+	SagaCompensated   SagaState = "compensated"    // компенсация завершена, заказ в статусе failed
+	SagaFailed        SagaState = "failed"         // сага прервана до создания заказа
+)
+
+// IsFinished возвращает true, если сага больше не требует действий
+func (s SagaState) IsFinished() bool {
+	return s == SagaCompleted || s == SagaCompensated || s == SagaFailed
+}
@@ -8,3 +8,22 @@ type User struct {
 	Password           string `json:"password" binding:"required"`
 	TimeOfRegistration int64  `json:"time_of_registration"`
 }
+
+// Role - роль пользователя; пользователь может иметь несколько ролей
+type Role string
+
+const (
+	RoleAdmin      Role = "admin"      // полный доступ, включая управление каталогом и складами
+	RoleDispatcher Role = "dispatcher" // управление водителями, складами и перемещениями
+	RoleDriver     Role = "driver"     // водитель, выполняет доставки
+	RoleCustomer   Role = "customer"   // покупатель, роль по умолчанию при регистрации
+)
+
+// IsValid проверяет, что роль входит в известный набор
+func (r Role) IsValid() bool {
+	switch r {
+	case RoleAdmin, RoleDispatcher, RoleDriver, RoleCustomer:
+		return true
+	}
+	return false
+}
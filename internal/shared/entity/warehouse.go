@@ -0,0 +1,264 @@
+package entity
+
+// StockReservation - удержание товара под заказ до списания
+type StockReservation struct {
+	ID          int64             `json:"id" db:"id"`
+	OrderID     int64             `json:"order_id" db:"order_id"`
+	WarehouseID int64             `json:"warehouse_id" db:"warehouse_id"`
+	ProductID   int64             `json:"product_id" db:"product_id"`
+	Quantity    int32             `json:"quantity" db:"quantity"`
+	Status      ReservationStatus `json:"status" db:"status"`
+	ExpiresAt   int64             `json:"expires_at" db:"expires_at"`
+	CreatedAt   int64             `json:"created_at" db:"created_at"`
+	UpdatedAt   int64             `json:"updated_at" db:"updated_at"`
+}
+
+type ReservationStatus string
+
+const (
+	ReservationActive    ReservationStatus = "active"    // товар удерживается под заказ
+	ReservationCommitted ReservationStatus = "committed" // товар списан со склада
+	ReservationReleased  ReservationStatus = "released"  // резерв снят по запросу
+	ReservationExpired   ReservationStatus = "expired"   // резерв снят по истечении срока
+)
+
+// Warehouse - склад, с которого отгружаются заказы
+// @Description Склад
+type Warehouse struct {
+	ID        int64     `json:"id" db:"id" example:"1"`
+	Name      string    `json:"name" db:"name" example:"Склад Север"`
+	Address   string    `json:"address" db:"address" example:"г. Москва, ул. Складская, д. 1"`
+	Location  *Location `json:"location,omitempty"`
+	Active    bool      `json:"active" db:"is_active" example:"true"`
+	CreatedAt int64     `json:"created_at" db:"created_at" example:"1757808000"`
+	UpdatedAt int64     `json:"updated_at" db:"updated_at" example:"1757808000"`
+}
+
+// WarehouseStockItem - остаток товара на конкретном складе
+// @Description Остаток товара на складе
+type WarehouseStockItem struct {
+	WarehouseID int64   `json:"warehouse_id" example:"1"`
+	ProductID   int64   `json:"product_id" example:"1"`
+	ProductName string  `json:"product_name" example:"Ноутбук ASUS ROG"`
+	Quantity    int32   `json:"quantity" example:"12"`
+	Reserved    int32   `json:"reserved" example:"2"`
+	Available   int32   `json:"available" example:"10"`
+	LastUpdated int64   `json:"last_updated" example:"1757808000"`
+	SKU         string  `json:"sku,omitempty" example:"SKU-000001"`
+	UnitPrice   float64 `json:"unit_price,omitempty" example:"1999.99"`
+}
+
+// ReplenishmentItem - товар, доступный остаток которого на складе опустился ниже точки заказа
+// @Description Товар, который нужно пополнить, и рекомендуемое количество к заказу
+type ReplenishmentItem struct {
+	WarehouseID       int64  `json:"warehouse_id" example:"1"`
+	ProductID         int64  `json:"product_id" example:"1"`
+	SKU               string `json:"sku" example:"SKU-000001"`
+	ProductName       string `json:"product_name" example:"Ноутбук ASUS ROG"`
+	Quantity          int32  `json:"quantity" example:"4"`
+	Reserved          int32  `json:"reserved" example:"1"`
+	Available         int32  `json:"available" example:"3"`
+	ReorderPoint      int32  `json:"reorder_point" example:"5"`
+	ReorderQuantity   int32  `json:"reorder_quantity" example:"20"`
+	SuggestedQuantity int32  `json:"suggested_quantity" example:"20"`
+	// DetectedAt - когда отправлено событие stock.low; 0 - фоновая проверка еще не прошла
+	DetectedAt int64 `json:"detected_at,omitempty" example:"1757808000"`
+}
+
+// StockAllocation - часть заказа, которую отгружает конкретный склад
+// @Description Количество товара заказа, отгружаемое со склада
+type StockAllocation struct {
+	WarehouseID int64 `json:"warehouse_id" example:"1"`
+	ProductID   int64 `json:"product_id" example:"1"`
+	Quantity    int32 `json:"quantity" example:"2"`
+	// Lots - партии, из которых собирается количество, по FEFO
+	Lots []LotAllocation `json:"lots,omitempty"`
+}
+
+// LotAllocation - количество товара, взятое из одной партии
+// @Description Часть позиции, собранная из партии
+type LotAllocation struct {
+	LotID     int64  `json:"lot_id" example:"1"`
+	LotNumber string `json:"lot_number" example:"L2025-0917"`
+	ExpiresAt int64  `json:"expires_at,omitempty" example:"1760659200"`
+	Quantity  int32  `json:"quantity" example:"2"`
+}
+
+// StockLot - партия товара на складе
+// @Description Партия товара: номер, срок годности, дата поступления и остаток
+type StockLot struct {
+	ID          int64  `json:"id" example:"1"`
+	WarehouseID int64  `json:"warehouse_id" example:"1"`
+	ProductID   int64  `json:"product_id" example:"1"`
+	ProductName string `json:"product_name,omitempty" example:"Молоко 3,2%"`
+	LotNumber   string `json:"lot_number" example:"L2025-0917"`
+	// ExpiresAt - срок годности, unix-время; 0 - товар не портится
+	ExpiresAt  int64 `json:"expires_at" example:"1760659200"`
+	ReceivedAt int64 `json:"received_at" example:"1758067200"`
+	Quantity   int32 `json:"quantity" example:"40"`
+	Reserved   int32 `json:"reserved" example:"5"`
+}
+
+// ReceivingDocument - документ приемки товара от поставщика
+// @Description Документ приемки
+type ReceivingDocument struct {
+	ID          int64           `json:"id" db:"id" example:"1"`
+	WarehouseID int64           `json:"warehouse_id" db:"warehouse_id" example:"1"`
+	Supplier    string          `json:"supplier" db:"supplier" example:"ООО Поставщик"`
+	Reference   string          `json:"reference,omitempty" db:"reference" example:"ТН-2025-0142"`
+	Note        string          `json:"note,omitempty" db:"note"`
+	Status      ReceivingStatus `json:"status" db:"status" example:"draft"`
+	Lines       []ReceivingLine `json:"lines,omitempty"`
+	CreatedBy   int64           `json:"created_by" db:"created_by" example:"1"`
+	PostedBy    int64           `json:"posted_by,omitempty" db:"posted_by" example:"1"`
+	CreatedAt   int64           `json:"created_at" db:"created_at" example:"1757808000"`
+	UpdatedAt   int64           `json:"updated_at" db:"updated_at" example:"1757808000"`
+	PostedAt    int64           `json:"posted_at,omitempty" db:"posted_at" example:"1757894400"`
+}
+
+// ReceivingLine - строка документа приемки
+type ReceivingLine struct {
+	ProductID        int64  `json:"product_id" db:"product_id" example:"1"`
+	ProductName      string `json:"product_name,omitempty" db:"product_name" example:"Ноутбук ASUS ROG"`
+	ExpectedQuantity int32  `json:"expected_quantity" db:"expected_quantity" example:"10"`
+	ReceivedQuantity int32  `json:"received_quantity" db:"received_quantity" example:"9"`
+	// LotNumber - партия, в которую приходуется товар; пустой - партия по номеру документа
+	LotNumber string `json:"lot_number,omitempty" db:"lot_number" example:"L2025-0917"`
+	// ExpiresAt - срок годности партии, unix-время; 0 - товар не портится
+	ExpiresAt int64 `json:"expires_at,omitempty" db:"expires_at" example:"1760659200"`
+}
+
+type ReceivingStatus string
+
+const (
+	ReceivingDraft  ReceivingStatus = "draft"  // товар принимается, количества можно менять
+	ReceivingPosted ReceivingStatus = "posted" // принятое оприходовано на склад
+)
+
+// IsValid проверяет, что статус входит в известный набор
+func (s ReceivingStatus) IsValid() bool {
+	switch s {
+	case ReceivingDraft, ReceivingPosted:
+		return true
+	}
+	return false
+}
+
+// StockTransfer - перемещение товара между складами
+// @Description Документ перемещения
+type StockTransfer struct {
+	ID                     int64          `json:"id" db:"id" example:"1"`
+	SourceWarehouseID      int64          `json:"source_warehouse_id" db:"source_warehouse_id" example:"1"`
+	DestinationWarehouseID int64          `json:"destination_warehouse_id" db:"destination_warehouse_id" example:"2"`
+	Status                 TransferStatus `json:"status" db:"status" example:"draft"`
+	DriverID               int64          `json:"driver_id,omitempty" db:"driver_id" example:"3"`
+	Note                   string         `json:"note,omitempty" db:"note"`
+	Lines                  []TransferLine `json:"lines,omitempty"`
+	CreatedBy              int64          `json:"created_by" db:"created_by" example:"1"`
+	DispatchedBy           int64          `json:"dispatched_by,omitempty" db:"dispatched_by" example:"1"`
+	ReceivedBy             int64          `json:"received_by,omitempty" db:"received_by" example:"1"`
+	CreatedAt              int64          `json:"created_at" db:"created_at" example:"1757808000"`
+	UpdatedAt              int64          `json:"updated_at" db:"updated_at" example:"1757808000"`
+	DispatchedAt           int64          `json:"dispatched_at,omitempty" db:"dispatched_at" example:"1757811600"`
+	ReceivedAt             int64          `json:"received_at,omitempty" db:"received_at" example:"1757822400"`
+}
+
+// TransferLine - строка перемещения
+type TransferLine struct {
+	ProductID   int64  `json:"product_id" db:"product_id" example:"1"`
+	ProductName string `json:"product_name,omitempty" db:"product_name" example:"Ноутбук ASUS ROG"`
+	Quantity    int32  `json:"quantity" db:"quantity" example:"5"`
+}
+
+type TransferStatus string
+
+const (
+	TransferDraft     TransferStatus = "draft"      // состав и водитель еще меняются, остатки не тронуты
+	TransferInTransit TransferStatus = "in_transit" // товар списан со склада-источника и в пути
+	TransferReceived  TransferStatus = "received"   // товар оприходован на складе-получателе
+)
+
+// IsValid проверяет, что статус входит в известный набор
+func (s TransferStatus) IsValid() bool {
+	switch s {
+	case TransferDraft, TransferInTransit, TransferReceived:
+		return true
+	}
+	return false
+}
+
+// StockMovement - запись журнала движения товара
+// @Description Изменение остатка товара с причиной и ссылкой на документ
+type StockMovement struct {
+	ID          int64          `json:"id" db:"id" example:"1"`
+	WarehouseID int64          `json:"warehouse_id" db:"warehouse_id" example:"1"`
+	ProductID   int64          `json:"product_id" db:"product_id" example:"1"`
+	Delta       int32          `json:"delta" db:"delta" example:"-2"`
+	Reason      MovementReason `json:"reason" db:"reason" example:"order"`
+	ReferenceID int64          `json:"reference_id,omitempty" db:"reference_id" example:"42"`
+	ActorID     int64          `json:"actor_id,omitempty" db:"actor_id" example:"1"`
+	CreatedAt   int64          `json:"created_at" db:"created_at" example:"1757808000"`
+	// LotID - партия, остаток которой изменился; 0 - запись до учета партий
+	LotID int64 `json:"lot_id,omitempty" db:"lot_id" example:"1"`
+}
+
+type MovementReason string
+
+const (
+	MovementOrder      MovementReason = "order"      // списание по заказу, reference - заказ
+	MovementReturn     MovementReason = "return"     // возврат по заказу, reference - заказ
+	MovementReceipt    MovementReason = "receipt"    // приемка, reference - документ приемки
+	MovementAdjustment MovementReason = "adjustment" // ручная корректировка и начальные остатки
+	MovementTransfer   MovementReason = "transfer"   // перемещение, reference - документ перемещения
+)
+
+// IsValid проверяет, что причина входит в известный набор
+func (r MovementReason) IsValid() bool {
+	switch r {
+	case MovementOrder, MovementReturn, MovementReceipt, MovementAdjustment, MovementTransfer:
+		return true
+	}
+	return false
+}
+
+// StockDiscrepancy - расхождение остатка с суммой движений по журналу
+// @Description Товар, у которого остаток не совпадает с журналом
+type StockDiscrepancy struct {
+	WarehouseID int64  `json:"warehouse_id" example:"1"`
+	ProductID   int64  `json:"product_id" example:"1"`
+	ProductName string `json:"product_name" example:"Ноутбук ASUS ROG"`
+	OnHand      int64  `json:"on_hand" example:"10"`
+	LedgerTotal int64  `json:"ledger_total" example:"12"`
+}
+
+// StockImportRow - строка пакетной загрузки остатков: фактический остаток товара на складе и, при необходимости, новая цена
+type StockImportRow struct {
+	Line        int32
+	WarehouseID int64
+	SKU         string
+	ProductName string
+	Quantity    int32
+	// UnitPrice - nil оставляет цену без изменений
+	UnitPrice *float64
+}
+
+// StockImportResult - изменение, которое вносит строка загрузки
+// @Description Остаток и цена товара до и после загрузки
+type StockImportResult struct {
+	Line         int32   `json:"line" example:"2"`
+	WarehouseID  int64   `json:"warehouse_id" example:"1"`
+	ProductID    int64   `json:"product_id" example:"1"`
+	SKU          string  `json:"sku" example:"SKU-000001"`
+	ProductName  string  `json:"product_name" example:"Ноутбук ASUS ROG"`
+	OldQuantity  int32   `json:"old_quantity" example:"10"`
+	NewQuantity  int32   `json:"new_quantity" example:"12"`
+	OldUnitPrice float64 `json:"old_unit_price" example:"1999.99"`
+	NewUnitPrice float64 `json:"new_unit_price" example:"1899.99"`
+}
+
+// ImportRowError - строка загрузки, не прошедшая проверку
+// @Description Ошибка в строке файла загрузки
+type ImportRowError struct {
+	Line    int32  `json:"line" example:"3"`
+	Message string `json:"message" example:"product not found"`
+}
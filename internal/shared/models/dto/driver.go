@@ -0,0 +1,18 @@
+package dto
+
+// DriverRequest - анкетные данные водителя
+// @Description Данные водителя для создания и изменения
+type DriverRequest struct {
+	Name          string `json:"name" validate:"required" example:"Иванов Петр Сергеевич"`
+	Phone         string `json:"phone" validate:"required" example:"+79161234567"`
+	Email         string `json:"email,omitempty" example:"ivanov@mail.ru"`
+	LicenseNumber string `json:"license_number" validate:"required" example:"AB123456"`
+	Car           string `json:"car" validate:"required" example:"Toyota Camry 2020, гос.номер А123АА777"`
+}
+
+// CreateDriverRequest - запрос на создание водителя
+// @Description Новый водитель; статус по умолчанию offline
+type CreateDriverRequest struct {
+	DriverRequest
+	Status string `json:"status,omitempty" example:"offline"`
+}
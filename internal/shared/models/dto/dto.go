@@ -28,6 +28,14 @@ type AuthResponse struct {
 	User        UserInfo `json:"user"`
 }
 
+// RefreshResponse - ответ после обновления сессии
+// @Description Новый access token; новый refresh token приходит в cookie
+type RefreshResponse struct {
+	AccessToken string   `json:"access_token" example:"eyJhbGciOiJIUzI1NiIsInR5cCI6IkpXVCJ9..."`
+	UserID      uint     `json:"user_id" example:"1"`
+	Roles       []string `json:"roles" example:"customer"`
+}
+
 type UserInfo struct {
 	ID        uint   `json:"id" example:"1"`
 	Email     string `json:"email" example:"user@example.com"`
@@ -11,6 +11,8 @@ type CreateOrderRequest struct {
 	UserID          int64             `json:"user_id" validate:"required" example:"123"`
 	DeliveryAddress string            `json:"delivery_address" validate:"required" example:"ул. Пушкина, д. 10"`
 	Items           []CreateOrderItem `json:"items" validate:"required,min=1"`
+	// DeliveryLocation - координаты адреса доставки, используются при подборе ближайшего водителя
+	DeliveryLocation *entity.Location `json:"delivery_location,omitempty"`
 }
 
 type CreateOrderItem struct {
@@ -25,6 +27,12 @@ type CreateOrderResponse struct {
 	Message string        `json:"message" example:"Order created successfully"`
 }
 
+// CancelOrderRequest - запрос на отмену заказа
+// @Description Причина отмены заказа
+type CancelOrderRequest struct {
+	Reason string `json:"reason" example:"Передумал покупать"`
+}
+
 // OrderStatusResponse - ответ со статусом заказа
 // @Description Информация о статусе заказа
 type OrderStatusResponse struct {
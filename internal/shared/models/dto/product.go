@@ -0,0 +1,24 @@
+package dto
+
+import "logistics/internal/shared/entity"
+
+// ProductRequest - карточка товара
+// @Description Данные товара для создания и изменения
+type ProductRequest struct {
+	SKU         string            `json:"sku" validate:"required" example:"SKU-000001"`
+	Name        string            `json:"name" validate:"required" example:"Ноутбук ASUS ROG"`
+	Description string            `json:"description,omitempty" example:"Игровой ноутбук 15.6\""`
+	UnitPrice   float64           `json:"unit_price" validate:"min=0" example:"899.99"`
+	WeightGrams int32             `json:"weight_grams,omitempty" example:"2300"`
+	Dimensions  entity.Dimensions `json:"dimensions"`
+	// ReorderPoint - порог доступного остатка на складе, ниже которого товар нужно дозаказать; 0 - не отслеживать
+	ReorderPoint    int32 `json:"reorder_point,omitempty" validate:"min=0" example:"5"`
+	ReorderQuantity int32 `json:"reorder_quantity,omitempty" validate:"min=0" example:"20"`
+}
+
+// UpdateProductRequest - запрос на изменение товара
+// @Description Карточка товара целиком; active не передан - признак не меняется
+type UpdateProductRequest struct {
+	ProductRequest
+	Active *bool `json:"active,omitempty" example:"true"`
+}
@@ -0,0 +1,38 @@
+package dto
+
+// ReceivingLineRequest - строка документа приемки
+// @Description Товар и ожидаемое количество; принятое можно указать сразу
+type ReceivingLineRequest struct {
+	ProductID        int64 `json:"product_id" validate:"required" example:"1"`
+	ExpectedQuantity int32 `json:"expected_quantity" validate:"min=0" example:"10"`
+	ReceivedQuantity int32 `json:"received_quantity,omitempty" validate:"min=0" example:"0"`
+	// LotNumber - номер партии поставщика; пустой - партия по номеру документа
+	LotNumber string `json:"lot_number,omitempty" example:"L2025-0917"`
+	// ExpiresAt - срок годности партии, unix-время; 0 - товар не портится
+	ExpiresAt int64 `json:"expires_at,omitempty" validate:"min=0" example:"1760659200"`
+}
+
+// CreateReceivingRequest - запрос на создание документа приемки
+// @Description Склад, поставщик и ожидаемые строки поставки
+type CreateReceivingRequest struct {
+	WarehouseID int64                  `json:"warehouse_id" validate:"required" example:"1"`
+	Supplier    string                 `json:"supplier" validate:"required" example:"ООО Поставщик"`
+	Reference   string                 `json:"reference,omitempty" example:"ТН-2025-0142"`
+	Note        string                 `json:"note,omitempty" example:"Поставка по договору 17"`
+	Lines       []ReceivingLineRequest `json:"lines" validate:"required"`
+}
+
+// ReceivedLineRequest - фактически принятое количество товара
+type ReceivedLineRequest struct {
+	ProductID        int64 `json:"product_id" validate:"required" example:"1"`
+	ReceivedQuantity int32 `json:"received_quantity" validate:"min=0" example:"9"`
+	// LotNumber и ExpiresAt не переданы - остается партия, указанная раньше
+	LotNumber string `json:"lot_number,omitempty" example:"L2025-0917"`
+	ExpiresAt int64  `json:"expires_at,omitempty" validate:"min=0" example:"1760659200"`
+}
+
+// RecordReceivedRequest - запрос на отметку принятого товара
+// @Description Принятые количества; товар вне ожидаемых строк добавляется в документ
+type RecordReceivedRequest struct {
+	Lines []ReceivedLineRequest `json:"lines" validate:"required"`
+}
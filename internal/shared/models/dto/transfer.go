@@ -0,0 +1,23 @@
+package dto
+
+// TransferLineRequest - товар и количество к перемещению
+type TransferLineRequest struct {
+	ProductID int64 `json:"product_id" validate:"required" example:"1"`
+	Quantity  int32 `json:"quantity" validate:"required,min=1" example:"5"`
+}
+
+// CreateTransferRequest - запрос на создание перемещения
+// @Description Склад-источник, склад-получатель и строки; водителя можно назначить сразу
+type CreateTransferRequest struct {
+	SourceWarehouseID      int64                 `json:"source_warehouse_id" validate:"required" example:"1"`
+	DestinationWarehouseID int64                 `json:"destination_warehouse_id" validate:"required" example:"2"`
+	DriverID               int64                 `json:"driver_id,omitempty" example:"3"`
+	Note                   string                `json:"note,omitempty" example:"Пополнение склада Север"`
+	Lines                  []TransferLineRequest `json:"lines" validate:"required"`
+}
+
+// AssignTransferDriverRequest - запрос на назначение водителя
+// @Description driver_id 0 снимает водителя с перемещения
+type AssignTransferDriverRequest struct {
+	DriverID int64 `json:"driver_id" example:"3"`
+}
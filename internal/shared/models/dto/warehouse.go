@@ -0,0 +1,18 @@
+package dto
+
+import "logistics/internal/shared/entity"
+
+// WarehouseRequest - данные склада
+// @Description Название, адрес и координаты склада; без координат склад выбирается последним
+type WarehouseRequest struct {
+	Name     string           `json:"name" validate:"required" example:"Склад Север"`
+	Address  string           `json:"address,omitempty" example:"г. Москва, ул. Складская, д. 1"`
+	Location *entity.Location `json:"location,omitempty"`
+}
+
+// UpdateWarehouseRequest - запрос на изменение склада
+// @Description Данные склада целиком; active не передан - признак не меняется
+type UpdateWarehouseRequest struct {
+	WarehouseRequest
+	Active *bool `json:"active,omitempty" example:"true"`
+}
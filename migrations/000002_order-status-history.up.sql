@@ -0,0 +1,11 @@
+CREATE TABLE order_status_history (
+    id SERIAL PRIMARY KEY,
+    order_id INTEGER NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    old_status VARCHAR(50) NOT NULL DEFAULT '',
+    new_status VARCHAR(50) NOT NULL,
+    actor_type VARCHAR(20) NOT NULL,
+    actor_id INTEGER NOT NULL DEFAULT 0,
+    reason TEXT NOT NULL DEFAULT '',
+    changed_at INTEGER NOT NULL
+);
+CREATE INDEX idx_order_status_history_order_id ON order_status_history(order_id, changed_at);
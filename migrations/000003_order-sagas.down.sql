@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS stock_order_operations CASCADE;
+DROP TABLE IF EXISTS order_sagas CASCADE;
\ No newline at end of file
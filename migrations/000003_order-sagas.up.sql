@@ -0,0 +1,19 @@
+CREATE TABLE order_sagas (
+    id SERIAL PRIMARY KEY,
+    order_id INTEGER NOT NULL DEFAULT 0,
+    user_id INTEGER NOT NULL,
+    state VARCHAR(50) NOT NULL,
+    payload JSONB NOT NULL,
+    error TEXT NOT NULL DEFAULT '',
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL
+);
+CREATE INDEX idx_order_sagas_state ON order_sagas(state);
+CREATE INDEX idx_order_sagas_order_id ON order_sagas(order_id);
+
+CREATE TABLE stock_order_operations (
+    order_id INTEGER NOT NULL,
+    operation VARCHAR(20) NOT NULL,
+    created_at INTEGER NOT NULL,
+    PRIMARY KEY (order_id, operation)
+);
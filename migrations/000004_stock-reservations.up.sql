@@ -0,0 +1,13 @@
+CREATE TABLE stock_reservations (
+    id SERIAL PRIMARY KEY,
+    order_id INTEGER NOT NULL,
+    product_id INTEGER NOT NULL REFERENCES warehouse_stock(product_id),
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    status VARCHAR(20) NOT NULL DEFAULT 'active',
+    expires_at INTEGER NOT NULL,
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL,
+    UNIQUE (order_id, product_id)
+);
+CREATE INDEX idx_stock_reservations_active ON stock_reservations(product_id) WHERE status = 'active';
+CREATE INDEX idx_stock_reservations_expires_at ON stock_reservations(expires_at) WHERE status = 'active';
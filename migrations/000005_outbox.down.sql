@@ -0,0 +1,2 @@
+DROP TABLE IF EXISTS driver_outbox;
+DROP TABLE IF EXISTS order_outbox;
@@ -0,0 +1,25 @@
+CREATE TABLE order_outbox (
+    id BIGSERIAL PRIMARY KEY,
+    event_type VARCHAR(100) NOT NULL,
+    message_key VARCHAR(255) NOT NULL DEFAULT '',
+    payload JSONB NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at INTEGER NOT NULL,
+    next_attempt_at INTEGER NOT NULL,
+    sent_at INTEGER
+);
+CREATE INDEX idx_order_outbox_pending ON order_outbox(next_attempt_at, id) WHERE sent_at IS NULL;
+
+CREATE TABLE driver_outbox (
+    id BIGSERIAL PRIMARY KEY,
+    event_type VARCHAR(100) NOT NULL,
+    message_key VARCHAR(255) NOT NULL DEFAULT '',
+    payload JSONB NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at INTEGER NOT NULL,
+    next_attempt_at INTEGER NOT NULL,
+    sent_at INTEGER
+);
+CREATE INDEX idx_driver_outbox_pending ON driver_outbox(next_attempt_at, id) WHERE sent_at IS NULL;
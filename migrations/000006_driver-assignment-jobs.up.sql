@@ -0,0 +1,13 @@
+CREATE TABLE driver_assignment_jobs (
+    id SERIAL PRIMARY KEY,
+    order_id INTEGER NOT NULL,
+    user_id INTEGER NOT NULL,
+    status VARCHAR(20) NOT NULL,
+    driver_id INTEGER NOT NULL DEFAULT 0,
+    error TEXT NOT NULL DEFAULT '',
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL
+);
+-- На заказ может быть только одно незавершенное назначение
+CREATE UNIQUE INDEX idx_driver_assignment_jobs_active ON driver_assignment_jobs(order_id) WHERE status IN ('queued', 'searching');
+CREATE INDEX idx_driver_assignment_jobs_status ON driver_assignment_jobs(status, updated_at);
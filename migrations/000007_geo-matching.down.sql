@@ -0,0 +1,7 @@
+ALTER TABLE orders DROP COLUMN IF EXISTS delivery_longitude;
+ALTER TABLE orders DROP COLUMN IF EXISTS delivery_latitude;
+
+ALTER TABLE drivers DROP COLUMN IF EXISTS last_assigned_at;
+ALTER TABLE drivers DROP COLUMN IF EXISTS location_updated_at;
+ALTER TABLE drivers DROP COLUMN IF EXISTS longitude;
+ALTER TABLE drivers DROP COLUMN IF EXISTS latitude;
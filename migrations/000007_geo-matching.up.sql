@@ -0,0 +1,7 @@
+ALTER TABLE drivers ADD COLUMN latitude DOUBLE PRECISION;
+ALTER TABLE drivers ADD COLUMN longitude DOUBLE PRECISION;
+ALTER TABLE drivers ADD COLUMN location_updated_at INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE drivers ADD COLUMN last_assigned_at INTEGER NOT NULL DEFAULT 0;
+
+ALTER TABLE orders ADD COLUMN delivery_latitude DOUBLE PRECISION;
+ALTER TABLE orders ADD COLUMN delivery_longitude DOUBLE PRECISION;
@@ -0,0 +1,8 @@
+CREATE TABLE driver_location_history (
+    id BIGSERIAL PRIMARY KEY,
+    driver_id INTEGER NOT NULL REFERENCES drivers(id) ON DELETE CASCADE,
+    latitude DOUBLE PRECISION NOT NULL,
+    longitude DOUBLE PRECISION NOT NULL,
+    recorded_at INTEGER NOT NULL
+);
+CREATE INDEX idx_driver_location_history_driver ON driver_location_history(driver_id, recorded_at DESC, id DESC);
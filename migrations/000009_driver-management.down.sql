@@ -0,0 +1,4 @@
+DROP INDEX IF EXISTS drivers_phone_key;
+ALTER TABLE drivers DROP COLUMN IF EXISTS is_active;
+
+ALTER TABLE users DROP COLUMN IF EXISTS is_admin;
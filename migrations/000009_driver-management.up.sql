@@ -0,0 +1,8 @@
+ALTER TABLE users ADD COLUMN is_admin BOOLEAN NOT NULL DEFAULT FALSE;
+
+ALTER TABLE drivers ADD COLUMN is_active BOOLEAN NOT NULL DEFAULT TRUE;
+-- Телефон, как и номер удостоверения, однозначно определяет водителя.
+-- Среди водителей с одинаковым телефоном номер остается за первым, остальным он помечается их id до ручной правки
+UPDATE drivers d SET phone = LEFT(d.phone, 20 - LENGTH('#' || d.id)) || '#' || d.id
+WHERE EXISTS (SELECT 1 FROM drivers o WHERE o.phone = d.phone AND o.id < d.id);
+CREATE UNIQUE INDEX drivers_phone_key ON drivers(phone);
@@ -0,0 +1,14 @@
+DROP INDEX IF EXISTS idx_warehouse_stock_quantity;
+ALTER TABLE warehouse_stock DROP CONSTRAINT IF EXISTS warehouse_stock_product_id_fkey;
+CREATE SEQUENCE warehouse_stock_product_id_seq OWNED BY warehouse_stock.product_id;
+SELECT setval('warehouse_stock_product_id_seq', COALESCE((SELECT MAX(product_id) FROM warehouse_stock), 0) + 1, false);
+ALTER TABLE warehouse_stock ALTER COLUMN product_id SET DEFAULT nextval('warehouse_stock_product_id_seq');
+ALTER TABLE warehouse_stock ADD COLUMN product_name VARCHAR(255);
+ALTER TABLE warehouse_stock ADD COLUMN price DECIMAL(10, 2);
+UPDATE warehouse_stock ws SET product_name = p.name, price = p.unit_price FROM products p WHERE p.id = ws.product_id;
+ALTER TABLE warehouse_stock ALTER COLUMN product_name SET NOT NULL;
+ALTER TABLE warehouse_stock ALTER COLUMN price SET NOT NULL;
+CREATE INDEX idx_warehouse_stock_name_quantity ON warehouse_stock(product_name, quantity);
+CREATE INDEX idx_warehouse_stock_quantity_price ON warehouse_stock(quantity, price);
+
+DROP TABLE IF EXISTS products;
@@ -0,0 +1,30 @@
+CREATE TABLE products (
+    id SERIAL PRIMARY KEY,
+    sku VARCHAR(64) NOT NULL UNIQUE,
+    name VARCHAR(255) NOT NULL UNIQUE,
+    description TEXT NOT NULL DEFAULT '',
+    unit_price DECIMAL(10, 2) NOT NULL CHECK (unit_price >= 0),
+    weight_grams INTEGER NOT NULL DEFAULT 0 CHECK (weight_grams >= 0),
+    length_mm INTEGER NOT NULL DEFAULT 0 CHECK (length_mm >= 0),
+    width_mm INTEGER NOT NULL DEFAULT 0 CHECK (width_mm >= 0),
+    height_mm INTEGER NOT NULL DEFAULT 0 CHECK (height_mm >= 0),
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL
+);
+
+-- Каталог получает те же ID, что и складские строки, поэтому ссылки на product_id остаются верными
+INSERT INTO products (id, sku, name, unit_price, created_at, updated_at)
+SELECT product_id, 'SKU-' || LPAD(product_id::TEXT, 6, '0'), product_name, price, last_updated, last_updated
+FROM warehouse_stock;
+SELECT setval('products_id_seq', COALESCE((SELECT MAX(id) FROM products), 0) + 1, false);
+
+-- Склад хранит только остатки, наименование и цена берутся из каталога
+DROP INDEX IF EXISTS idx_warehouse_stock_name_quantity;
+DROP INDEX IF EXISTS idx_warehouse_stock_quantity_price;
+ALTER TABLE warehouse_stock DROP COLUMN product_name;
+ALTER TABLE warehouse_stock DROP COLUMN price;
+ALTER TABLE warehouse_stock ALTER COLUMN product_id DROP DEFAULT;
+DROP SEQUENCE IF EXISTS warehouse_stock_product_id_seq;
+ALTER TABLE warehouse_stock ADD CONSTRAINT warehouse_stock_product_id_fkey FOREIGN KEY (product_id) REFERENCES products(id);
+CREATE INDEX idx_warehouse_stock_quantity ON warehouse_stock(quantity);
@@ -0,0 +1,3 @@
+DROP TABLE IF EXISTS stock_receipts;
+DROP TABLE IF EXISTS receiving_lines;
+DROP TABLE IF EXISTS receiving_documents;
@@ -0,0 +1,34 @@
+CREATE TABLE receiving_documents (
+    id SERIAL PRIMARY KEY,
+    supplier VARCHAR(255) NOT NULL,
+    reference VARCHAR(100) NOT NULL DEFAULT '',
+    note TEXT NOT NULL DEFAULT '',
+    status VARCHAR(20) NOT NULL DEFAULT 'draft',
+    created_by INTEGER NOT NULL,
+    posted_by INTEGER NOT NULL DEFAULT 0,
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL,
+    posted_at INTEGER NOT NULL DEFAULT 0
+);
+CREATE INDEX idx_receiving_documents_status ON receiving_documents(status, created_at);
+
+CREATE TABLE receiving_lines (
+    id SERIAL PRIMARY KEY,
+    document_id INTEGER NOT NULL REFERENCES receiving_documents(id) ON DELETE CASCADE,
+    product_id INTEGER NOT NULL REFERENCES products(id),
+    expected_quantity INTEGER NOT NULL DEFAULT 0 CHECK (expected_quantity >= 0),
+    received_quantity INTEGER NOT NULL DEFAULT 0 CHECK (received_quantity >= 0),
+    UNIQUE (document_id, product_id)
+);
+
+-- Каждое оприходование строки документа: откуда пришел товар на склад
+CREATE TABLE stock_receipts (
+    id SERIAL PRIMARY KEY,
+    document_id INTEGER NOT NULL REFERENCES receiving_documents(id),
+    product_id INTEGER NOT NULL REFERENCES products(id),
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    posted_by INTEGER NOT NULL,
+    posted_at INTEGER NOT NULL,
+    UNIQUE (document_id, product_id)
+);
+CREATE INDEX idx_stock_receipts_product ON stock_receipts(product_id, posted_at);
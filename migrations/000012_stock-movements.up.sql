@@ -0,0 +1,28 @@
+-- Журнал движения товара: каждое изменение остатка пишется сюда в той же транзакции
+CREATE TABLE stock_movements (
+    id BIGSERIAL PRIMARY KEY,
+    product_id INTEGER NOT NULL REFERENCES products(id),
+    delta INTEGER NOT NULL CHECK (delta <> 0),
+    reason VARCHAR(20) NOT NULL CHECK (reason IN ('order', 'return', 'receipt', 'adjustment', 'transfer')),
+    reference_id BIGINT NOT NULL DEFAULT 0,
+    actor_id INTEGER NOT NULL DEFAULT 0,
+    created_at INTEGER NOT NULL
+);
+CREATE INDEX idx_stock_movements_product ON stock_movements(product_id, created_at);
+CREATE INDEX idx_stock_movements_reference ON stock_movements(reason, reference_id);
+
+-- Журнал только дополняется: исправления проводятся новой записью adjustment
+CREATE FUNCTION stock_movements_append_only() RETURNS TRIGGER AS $$
+BEGIN
+    RAISE EXCEPTION 'stock_movements is append-only';
+END;
+$$ LANGUAGE plpgsql;
+
+CREATE TRIGGER stock_movements_append_only
+    BEFORE UPDATE OR DELETE ON stock_movements
+    FOR EACH ROW EXECUTE FUNCTION stock_movements_append_only();
+
+-- Начальные остатки заводим корректировкой, чтобы сумма движений сошлась с остатком
+INSERT INTO stock_movements (product_id, delta, reason, created_at)
+SELECT product_id, quantity, 'adjustment', EXTRACT(EPOCH FROM NOW())::INTEGER
+FROM warehouse_stock WHERE quantity <> 0;
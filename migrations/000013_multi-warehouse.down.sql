@@ -0,0 +1,31 @@
+DROP TABLE IF EXISTS order_allocations;
+
+-- Остатки других складов сводятся в одну строку на товар
+ALTER TABLE receiving_documents DROP COLUMN IF EXISTS warehouse_id;
+DROP INDEX IF EXISTS idx_stock_movements_warehouse;
+ALTER TABLE stock_movements DROP COLUMN IF EXISTS warehouse_id;
+
+DROP INDEX IF EXISTS idx_stock_reservations_active;
+ALTER TABLE stock_reservations DROP CONSTRAINT IF EXISTS stock_reservations_order_stock_key;
+ALTER TABLE stock_reservations DROP CONSTRAINT IF EXISTS stock_reservations_stock_fkey;
+DELETE FROM stock_reservations r USING stock_reservations d
+    WHERE r.order_id = d.order_id AND r.product_id = d.product_id AND r.id > d.id;
+ALTER TABLE stock_reservations DROP COLUMN IF EXISTS warehouse_id;
+ALTER TABLE stock_reservations ADD CONSTRAINT stock_reservations_order_id_product_id_key UNIQUE (order_id, product_id);
+CREATE INDEX idx_stock_reservations_active ON stock_reservations(product_id) WHERE status = 'active';
+
+DROP INDEX IF EXISTS idx_warehouse_stock_product;
+CREATE TABLE warehouse_stock_merged AS
+    SELECT product_id, SUM(quantity)::INTEGER AS quantity, MAX(last_updated) AS last_updated
+    FROM warehouse_stock GROUP BY product_id;
+DELETE FROM warehouse_stock;
+ALTER TABLE warehouse_stock DROP CONSTRAINT warehouse_stock_pkey;
+ALTER TABLE warehouse_stock DROP COLUMN warehouse_id;
+INSERT INTO warehouse_stock (product_id, quantity, last_updated)
+    SELECT product_id, quantity, last_updated FROM warehouse_stock_merged;
+DROP TABLE warehouse_stock_merged;
+ALTER TABLE warehouse_stock ADD PRIMARY KEY (product_id);
+ALTER TABLE stock_reservations ADD CONSTRAINT stock_reservations_product_id_fkey
+    FOREIGN KEY (product_id) REFERENCES warehouse_stock(product_id);
+
+DROP TABLE IF EXISTS warehouses;
@@ -0,0 +1,51 @@
+CREATE TABLE warehouses (
+    id SERIAL PRIMARY KEY,
+    name VARCHAR(255) NOT NULL UNIQUE,
+    address TEXT NOT NULL DEFAULT '',
+    latitude DOUBLE PRECISION CHECK (latitude BETWEEN -90 AND 90),
+    longitude DOUBLE PRECISION CHECK (longitude BETWEEN -180 AND 180),
+    is_active BOOLEAN NOT NULL DEFAULT TRUE,
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL
+);
+
+-- Все имеющиеся остатки относятся к единственному до сих пор складу.
+-- Координаты ему задаются через API, до этого при выборе ближайшего склада он идет последним
+INSERT INTO warehouses (id, name, created_at, updated_at)
+VALUES (1, 'Основной склад', EXTRACT(EPOCH FROM NOW())::INTEGER, EXTRACT(EPOCH FROM NOW())::INTEGER);
+SELECT setval('warehouses_id_seq', 2, false);
+
+-- Остаток ведется по паре (склад, товар)
+ALTER TABLE stock_reservations DROP CONSTRAINT stock_reservations_product_id_fkey;
+ALTER TABLE warehouse_stock ADD COLUMN warehouse_id INTEGER NOT NULL DEFAULT 1 REFERENCES warehouses(id);
+ALTER TABLE warehouse_stock ALTER COLUMN warehouse_id DROP DEFAULT;
+ALTER TABLE warehouse_stock DROP CONSTRAINT warehouse_stock_pkey;
+ALTER TABLE warehouse_stock ADD PRIMARY KEY (warehouse_id, product_id);
+CREATE INDEX idx_warehouse_stock_product ON warehouse_stock(product_id);
+
+-- Резерв держит товар на конкретном складе
+ALTER TABLE stock_reservations ADD COLUMN warehouse_id INTEGER NOT NULL DEFAULT 1;
+ALTER TABLE stock_reservations ALTER COLUMN warehouse_id DROP DEFAULT;
+ALTER TABLE stock_reservations ADD CONSTRAINT stock_reservations_stock_fkey
+    FOREIGN KEY (warehouse_id, product_id) REFERENCES warehouse_stock(warehouse_id, product_id);
+ALTER TABLE stock_reservations DROP CONSTRAINT stock_reservations_order_id_product_id_key;
+ALTER TABLE stock_reservations ADD CONSTRAINT stock_reservations_order_stock_key UNIQUE (order_id, warehouse_id, product_id);
+DROP INDEX IF EXISTS idx_stock_reservations_active;
+CREATE INDEX idx_stock_reservations_active ON stock_reservations(warehouse_id, product_id) WHERE status = 'active';
+
+ALTER TABLE stock_movements ADD COLUMN warehouse_id INTEGER NOT NULL DEFAULT 1 REFERENCES warehouses(id);
+ALTER TABLE stock_movements ALTER COLUMN warehouse_id DROP DEFAULT;
+CREATE INDEX idx_stock_movements_warehouse ON stock_movements(warehouse_id, product_id);
+
+ALTER TABLE receiving_documents ADD COLUMN warehouse_id INTEGER NOT NULL DEFAULT 1 REFERENCES warehouses(id);
+ALTER TABLE receiving_documents ALTER COLUMN warehouse_id DROP DEFAULT;
+
+-- Какой склад отгружает какую часть заказа; при нехватке на одном складе заказ делится.
+-- Таблица принадлежит order-service, поэтому склад и товар хранятся без внешних ключей на таблицы склада
+CREATE TABLE order_allocations (
+    order_id INTEGER NOT NULL REFERENCES orders(id) ON DELETE CASCADE,
+    warehouse_id INTEGER NOT NULL,
+    product_id INTEGER NOT NULL,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    PRIMARY KEY (order_id, warehouse_id, product_id)
+);
@@ -0,0 +1,28 @@
+-- Перемещение товара между складами: draft -> in_transit -> received
+CREATE TABLE stock_transfers (
+    id SERIAL PRIMARY KEY,
+    source_warehouse_id INTEGER NOT NULL REFERENCES warehouses(id),
+    destination_warehouse_id INTEGER NOT NULL REFERENCES warehouses(id),
+    status VARCHAR(20) NOT NULL DEFAULT 'draft' CHECK (status IN ('draft', 'in_transit', 'received')),
+    -- Водитель из driver-service, который везет товар; 0 - не назначен
+    driver_id INTEGER NOT NULL DEFAULT 0,
+    note TEXT NOT NULL DEFAULT '',
+    created_by INTEGER NOT NULL,
+    dispatched_by INTEGER NOT NULL DEFAULT 0,
+    received_by INTEGER NOT NULL DEFAULT 0,
+    created_at INTEGER NOT NULL,
+    updated_at INTEGER NOT NULL,
+    dispatched_at INTEGER NOT NULL DEFAULT 0,
+    received_at INTEGER NOT NULL DEFAULT 0,
+    CHECK (source_warehouse_id <> destination_warehouse_id)
+);
+CREATE INDEX idx_stock_transfers_status ON stock_transfers(status, created_at);
+CREATE INDEX idx_stock_transfers_source ON stock_transfers(source_warehouse_id);
+CREATE INDEX idx_stock_transfers_destination ON stock_transfers(destination_warehouse_id);
+
+CREATE TABLE stock_transfer_lines (
+    transfer_id INTEGER NOT NULL REFERENCES stock_transfers(id) ON DELETE CASCADE,
+    product_id INTEGER NOT NULL REFERENCES products(id),
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    PRIMARY KEY (transfer_id, product_id)
+);
@@ -0,0 +1,4 @@
+DROP TABLE IF EXISTS warehouse_outbox;
+DROP TABLE IF EXISTS low_stock_alerts;
+ALTER TABLE products DROP COLUMN IF EXISTS reorder_quantity;
+ALTER TABLE products DROP COLUMN IF EXISTS reorder_point;
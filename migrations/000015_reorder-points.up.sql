@@ -0,0 +1,28 @@
+-- Точка заказа: доступный остаток на складе ниже reorder_point - пора пополнять на reorder_quantity.
+-- 0 в reorder_point отключает контроль для товара
+ALTER TABLE products ADD COLUMN reorder_point INTEGER NOT NULL DEFAULT 0 CHECK (reorder_point >= 0);
+ALTER TABLE products ADD COLUMN reorder_quantity INTEGER NOT NULL DEFAULT 0 CHECK (reorder_quantity >= 0);
+
+-- Товары, о нехватке которых уже сообщено; строка удаляется, когда остаток восстановлен,
+-- поэтому событие stock.low уходит один раз на каждое падение ниже точки заказа
+CREATE TABLE low_stock_alerts (
+    warehouse_id INTEGER NOT NULL REFERENCES warehouses(id),
+    product_id INTEGER NOT NULL REFERENCES products(id),
+    available INTEGER NOT NULL,
+    reorder_point INTEGER NOT NULL,
+    detected_at INTEGER NOT NULL,
+    PRIMARY KEY (warehouse_id, product_id)
+);
+
+CREATE TABLE warehouse_outbox (
+    id BIGSERIAL PRIMARY KEY,
+    event_type VARCHAR(100) NOT NULL,
+    message_key VARCHAR(255) NOT NULL DEFAULT '',
+    payload JSONB NOT NULL,
+    attempts INTEGER NOT NULL DEFAULT 0,
+    last_error TEXT NOT NULL DEFAULT '',
+    created_at INTEGER NOT NULL,
+    next_attempt_at INTEGER NOT NULL,
+    sent_at INTEGER
+);
+CREATE INDEX idx_warehouse_outbox_pending ON warehouse_outbox(next_attempt_at, id) WHERE sent_at IS NULL;
@@ -0,0 +1,7 @@
+DROP TABLE IF EXISTS order_item_lots;
+DROP TABLE IF EXISTS stock_transfer_lots;
+ALTER TABLE receiving_lines DROP COLUMN IF EXISTS expires_at;
+ALTER TABLE receiving_lines DROP COLUMN IF EXISTS lot_number;
+ALTER TABLE stock_movements DROP COLUMN IF EXISTS lot_id;
+DROP TABLE IF EXISTS stock_reservation_lots;
+DROP TABLE IF EXISTS stock_lots;
@@ -0,0 +1,59 @@
+-- Партия товара на складе: номер, срок годности и дата поступления.
+-- Остаток складской строки warehouse_stock равен сумме остатков ее партий
+CREATE TABLE stock_lots (
+    id SERIAL PRIMARY KEY,
+    warehouse_id INTEGER NOT NULL,
+    product_id INTEGER NOT NULL,
+    lot_number VARCHAR(64) NOT NULL,
+    -- Срок годности, unix-время; 0 - товар не портится
+    expires_at INTEGER NOT NULL DEFAULT 0 CHECK (expires_at >= 0),
+    received_at INTEGER NOT NULL,
+    quantity INTEGER NOT NULL DEFAULT 0 CHECK (quantity >= 0),
+    FOREIGN KEY (warehouse_id, product_id) REFERENCES warehouse_stock(warehouse_id, product_id),
+    UNIQUE (warehouse_id, product_id, lot_number)
+);
+CREATE INDEX idx_stock_lots_expiry ON stock_lots(expires_at) WHERE quantity > 0 AND expires_at > 0;
+
+-- Остатки, принятые до учета партий, становятся одной бессрочной партией
+INSERT INTO stock_lots (warehouse_id, product_id, lot_number, received_at, quantity)
+SELECT warehouse_id, product_id, 'LEGACY', last_updated, quantity FROM warehouse_stock WHERE quantity > 0;
+
+-- Партии, выбранные под резерв заказа по FEFO; списываются при подтверждении резерва
+CREATE TABLE stock_reservation_lots (
+    order_id INTEGER NOT NULL,
+    lot_id INTEGER NOT NULL REFERENCES stock_lots(id),
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    PRIMARY KEY (order_id, lot_id)
+);
+CREATE INDEX idx_stock_reservation_lots_lot ON stock_reservation_lots(lot_id);
+
+-- Движение остатка пишется по партиям; у записей до учета партий lot_id пуст
+ALTER TABLE stock_movements ADD COLUMN lot_id INTEGER REFERENCES stock_lots(id);
+
+-- Партия, в которую приходуется строка приемки; пустой номер - партия по номеру документа
+ALTER TABLE receiving_lines ADD COLUMN lot_number VARCHAR(64) NOT NULL DEFAULT '';
+ALTER TABLE receiving_lines ADD COLUMN expires_at INTEGER NOT NULL DEFAULT 0 CHECK (expires_at >= 0);
+
+-- Партии товара в пути: на складе-получателе они сохраняют номер, срок годности и дату поступления
+CREATE TABLE stock_transfer_lots (
+    transfer_id INTEGER NOT NULL REFERENCES stock_transfers(id) ON DELETE CASCADE,
+    product_id INTEGER NOT NULL REFERENCES products(id),
+    lot_number VARCHAR(64) NOT NULL,
+    expires_at INTEGER NOT NULL DEFAULT 0,
+    received_at INTEGER NOT NULL,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    PRIMARY KEY (transfer_id, product_id, lot_number)
+);
+
+-- Из каких партий собрана позиция заказа. Таблица принадлежит order-service,
+-- поэтому склад и партия хранятся без внешних ключей на таблицы склада
+CREATE TABLE order_item_lots (
+    order_item_id INTEGER NOT NULL REFERENCES order_items(id) ON DELETE CASCADE,
+    warehouse_id INTEGER NOT NULL,
+    lot_id INTEGER NOT NULL,
+    lot_number VARCHAR(64) NOT NULL,
+    expires_at INTEGER NOT NULL DEFAULT 0,
+    quantity INTEGER NOT NULL CHECK (quantity > 0),
+    PRIMARY KEY (order_item_id, lot_id)
+);
+CREATE INDEX idx_order_item_lots_lot ON order_item_lots(lot_id);
@@ -0,0 +1,11 @@
+-- Пользователь может совмещать роли, например диспетчер, который сам делает заказы
+CREATE TABLE user_roles (
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    role VARCHAR(20) NOT NULL CHECK (role IN ('admin', 'dispatcher', 'driver', 'customer')),
+    PRIMARY KEY (user_id, role)
+);
+
+INSERT INTO user_roles (user_id, role) SELECT id, 'customer' FROM users;
+INSERT INTO user_roles (user_id, role) SELECT id, 'admin' FROM users WHERE is_admin;
+
+ALTER TABLE users DROP COLUMN is_admin;
@@ -0,0 +1,8 @@
+-- Открытые значения токенов не восстановить, поэтому все сессии завершаются
+DELETE FROM refresh_tokens;
+ALTER TABLE refresh_tokens DROP COLUMN IF EXISTS rotated_at;
+ALTER TABLE refresh_tokens DROP COLUMN IF EXISTS created_at;
+ALTER TABLE refresh_tokens DROP COLUMN IF EXISTS family_id;
+ALTER TABLE refresh_tokens DROP COLUMN IF EXISTS token_hash;
+ALTER TABLE refresh_tokens ADD COLUMN token TEXT NOT NULL UNIQUE;
+DROP TABLE IF EXISTS refresh_token_families;
@@ -0,0 +1,31 @@
+-- Семейство - цепочка refresh токенов одного входа: каждая ротация гасит токен и выпускает следующий.
+-- Повторное предъявление погашенного токена значит, что его украли, и отзывает все семейство
+CREATE TABLE refresh_token_families (
+    id SERIAL PRIMARY KEY,
+    user_id INTEGER NOT NULL REFERENCES users(id) ON DELETE CASCADE,
+    created_at INTEGER NOT NULL,
+    revoked_at INTEGER,
+    revoke_reason VARCHAR(20)
+);
+CREATE INDEX idx_refresh_token_families_user ON refresh_token_families(user_id);
+
+-- Токены хранятся как SHA-256: по содержимому таблицы войти нельзя
+ALTER TABLE refresh_tokens ADD COLUMN token_hash CHAR(64);
+ALTER TABLE refresh_tokens ADD COLUMN family_id INTEGER REFERENCES refresh_token_families(id) ON DELETE CASCADE;
+ALTER TABLE refresh_tokens ADD COLUMN created_at INTEGER NOT NULL DEFAULT 0;
+ALTER TABLE refresh_tokens ADD COLUMN rotated_at INTEGER;
+
+-- Каждый действующий токен становится отдельным семейством
+ALTER TABLE refresh_token_families ADD COLUMN legacy_token_id INTEGER;
+INSERT INTO refresh_token_families (user_id, created_at, legacy_token_id)
+SELECT user_id, EXTRACT(EPOCH FROM NOW())::INTEGER, id FROM refresh_tokens;
+UPDATE refresh_tokens t SET family_id = f.id, token_hash = encode(sha256(convert_to(t.token, 'UTF8')), 'hex')
+FROM refresh_token_families f WHERE f.legacy_token_id = t.id;
+ALTER TABLE refresh_token_families DROP COLUMN legacy_token_id;
+
+ALTER TABLE refresh_tokens DROP COLUMN token;
+ALTER TABLE refresh_tokens ALTER COLUMN token_hash SET NOT NULL;
+ALTER TABLE refresh_tokens ALTER COLUMN family_id SET NOT NULL;
+ALTER TABLE refresh_tokens ALTER COLUMN created_at DROP DEFAULT;
+CREATE UNIQUE INDEX refresh_tokens_token_hash_key ON refresh_tokens(token_hash);
+CREATE INDEX idx_refresh_tokens_family ON refresh_tokens(family_id);
@@ -0,0 +1,2 @@
+-- Колонку is_admin возвращает откат 000017; здесь восстанавливать нечего
+SELECT 1;
@@ -0,0 +1,9 @@
+-- Базы, где 000017 прошла без переноса is_admin в роли, еще хранят колонку:
+-- переносим оставшихся администраторов в user_roles и удаляем ее
+DO $$
+BEGIN
+    IF EXISTS (SELECT 1 FROM information_schema.columns WHERE table_name = 'users' AND column_name = 'is_admin') THEN
+        EXECUTE 'INSERT INTO user_roles (user_id, role) SELECT id, ''admin'' FROM users WHERE is_admin ON CONFLICT DO NOTHING';
+        EXECUTE 'ALTER TABLE users DROP COLUMN is_admin';
+    END IF;
+END $$;
@@ -0,0 +1,8 @@
+package redis
+
+import "fmt"
+
+// DriverLocationChannel - канал Pub/Sub, в который driver-service публикует новые позиции водителя
+func DriverLocationChannel(driverID int64) string {
+	return fmt.Sprintf("driver:%d_location_updates", driverID)
+}
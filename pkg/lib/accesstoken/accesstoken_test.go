@@ -0,0 +1,287 @@
+package accesstoken
+
+import (
+	"context"
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/x509"
+	"encoding/pem"
+	"errors"
+	"log/slog"
+	"os"
+	"path/filepath"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+func newEd25519Key(t *testing.T, id string) *signingKey {
+	t.Helper()
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate ed25519 key: %v", err)
+	}
+	key, err := newSigningKey(id, private)
+	if err != nil {
+		t.Fatalf("newSigningKey(%q) error = %v", id, err)
+	}
+	return key
+}
+
+func newRSAKey(t *testing.T, id string) *signingKey {
+	t.Helper()
+	private, err := rsa.GenerateKey(rand.Reader, minRSABits)
+	if err != nil {
+		t.Fatalf("failed to generate rsa key: %v", err)
+	}
+	key, err := newSigningKey(id, private)
+	if err != nil {
+		t.Fatalf("newSigningKey(%q) error = %v", id, err)
+	}
+	return key
+}
+
+func mustKeyset(t *testing.T, active *signingKey, keys ...*signingKey) *Keyset {
+	t.Helper()
+	ks, err := newKeyset(active, append([]*signingKey{active}, keys...))
+	if err != nil {
+		t.Fatalf("newKeyset() error = %v", err)
+	}
+	return ks
+}
+
+// signWith подписывает claims произвольным методом и ключом с заданным kid, минуя проверки Keyset
+func signWith(t *testing.T, method jwt.SigningMethod, kid string, private any, claims Claims) string {
+	t.Helper()
+	token := jwt.NewWithClaims(method, claims)
+	if kid != "" {
+		token.Header["kid"] = kid
+	}
+	signed, err := token.SignedString(private)
+	if err != nil {
+		t.Fatalf("failed to sign token: %v", err)
+	}
+	return signed
+}
+
+func newTestVerifier(source KeySource) *Verifier {
+	return NewVerifier(slog.New(slog.DiscardHandler), source)
+}
+
+func staticSource(ks *Keyset) KeySource {
+	return func(context.Context) (JWKS, error) { return ks.JWKS(), nil }
+}
+
+func TestVerify(t *testing.T) {
+	active := newEd25519Key(t, "active")
+	retiring := newRSAKey(t, "retiring")
+	stranger := newEd25519Key(t, "stranger")
+	otherRSA := newRSAKey(t, "other-rsa")
+	ks := mustKeyset(t, active, retiring)
+	verifier := newTestVerifier(staticSource(ks))
+
+	now := time.Now()
+	valid := NewClaims(42, []string{"customer"}, now, time.Hour)
+
+	tests := []struct {
+		name  string
+		token string
+		valid bool
+	}{
+		{"active key", signWith(t, jwt.SigningMethodEdDSA, "active", active.private, valid), true},
+		{"retiring key", signWith(t, jwt.SigningMethodRS256, "retiring", retiring.private, valid), true},
+		{"expired within leeway", signWith(t, jwt.SigningMethodEdDSA, "active", active.private, NewClaims(42, nil, now.Add(-time.Hour-leeway/2), time.Hour)), true},
+		{"unknown kid", signWith(t, jwt.SigningMethodEdDSA, "stranger", stranger.private, valid), false},
+		{"known kid signed by another key", signWith(t, jwt.SigningMethodEdDSA, "active", stranger.private, valid), false},
+		{"no kid", signWith(t, jwt.SigningMethodEdDSA, "", active.private, valid), false},
+		{"alg does not match key", signWith(t, jwt.SigningMethodRS256, "active", otherRSA.private, valid), false},
+		{"rsa key used as hmac secret", signWith(t, jwt.SigningMethodHS256, "retiring", x509.MarshalPKCS1PublicKey(&retiring.private.(*rsa.PrivateKey).PublicKey), valid), false},
+		{"alg none", signWith(t, jwt.SigningMethodNone, "active", jwt.UnsafeAllowNoneSignatureType, valid), false},
+		{"expired", signWith(t, jwt.SigningMethodEdDSA, "active", active.private, NewClaims(42, nil, now.Add(-2*time.Hour), time.Hour)), false},
+		{"no expiration", signWith(t, jwt.SigningMethodEdDSA, "active", active.private, Claims{RegisteredClaims: jwt.RegisteredClaims{Subject: "42"}}), false},
+		{"invalid subject", signWith(t, jwt.SigningMethodEdDSA, "active", active.private, NewClaims(0, nil, now, time.Hour)), false},
+		{"malformed", "not.a.token", false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			for name, verify := range map[string]func(string) (*Claims, error){
+				"verifier": func(token string) (*Claims, error) { return verifier.Verify(context.Background(), token) },
+				"keyset":   ks.Parse,
+			} {
+				claims, err := verify(tt.token)
+				if !tt.valid {
+					if !errors.Is(err, ErrInvalidToken) {
+						t.Errorf("%s: error = %v, want %v", name, err, ErrInvalidToken)
+					}
+					continue
+				}
+				if err != nil {
+					t.Fatalf("%s: error = %v", name, err)
+				}
+				if userID, _ := claims.UserID(); userID != 42 {
+					t.Errorf("%s: user id = %d, want 42", name, userID)
+				}
+			}
+		})
+	}
+}
+
+func TestSignUsesActiveKey(t *testing.T) {
+	active := newRSAKey(t, "active")
+	ks := mustKeyset(t, active, newEd25519Key(t, "retiring"))
+
+	signed, err := ks.Sign(NewClaims(7, []string{"admin", "dispatcher"}, time.Now(), time.Minute))
+	if err != nil {
+		t.Fatalf("Sign() error = %v", err)
+	}
+	token, _, err := jwt.NewParser().ParseUnverified(signed, &Claims{})
+	if err != nil {
+		t.Fatalf("ParseUnverified() error = %v", err)
+	}
+	if kid := token.Header["kid"]; kid != "active" {
+		t.Errorf("kid = %v, want active", kid)
+	}
+	if alg := token.Method.Alg(); alg != jwt.SigningMethodRS256.Alg() {
+		t.Errorf("alg = %s, want %s", alg, jwt.SigningMethodRS256.Alg())
+	}
+	claims, err := ks.Parse(signed)
+	if err != nil {
+		t.Fatalf("Parse() error = %v", err)
+	}
+	if len(claims.Roles) != 2 || claims.Roles[0] != "admin" || claims.Roles[1] != "dispatcher" {
+		t.Errorf("roles = %v, want [admin dispatcher]", claims.Roles)
+	}
+}
+
+func TestVerifierRefreshesOnUnknownKid(t *testing.T) {
+	old := newEd25519Key(t, "old")
+	rotated := newEd25519Key(t, "rotated")
+	current := atomic.Pointer[Keyset]{}
+	current.Store(mustKeyset(t, old))
+	var calls atomic.Int32
+	verifier := newTestVerifier(func(context.Context) (JWKS, error) {
+		calls.Add(1)
+		return current.Load().JWKS(), nil
+	})
+	ctx := context.Background()
+	claims := NewClaims(1, nil, time.Now(), time.Hour)
+
+	if _, err := verifier.Verify(ctx, signWith(t, jwt.SigningMethodEdDSA, "old", old.private, claims)); err != nil {
+		t.Fatalf("Verify() with old key error = %v", err)
+	}
+	// Ротация: незнакомый kid сразу подтягивает новый набор, но только если с прошлого запроса прошло minRefreshInterval
+	current.Store(mustKeyset(t, rotated, old))
+	verifier.attemptedAt = time.Now().Add(-minRefreshInterval)
+	if _, err := verifier.Verify(ctx, signWith(t, jwt.SigningMethodEdDSA, "rotated", rotated.private, claims)); err != nil {
+		t.Fatalf("Verify() with rotated key error = %v", err)
+	}
+	if got := calls.Load(); got != 2 {
+		t.Fatalf("source calls = %d, want 2", got)
+	}
+
+	stranger := newEd25519Key(t, "stranger")
+	for range 3 {
+		if _, err := verifier.Verify(ctx, signWith(t, jwt.SigningMethodEdDSA, "stranger", stranger.private, claims)); !errors.Is(err, ErrInvalidToken) {
+			t.Fatalf("Verify() with unknown kid error = %v, want %v", err, ErrInvalidToken)
+		}
+	}
+	if got := calls.Load(); got != 2 {
+		t.Errorf("source calls after unknown kids = %d, want 2: refresh must be rate limited", got)
+	}
+}
+
+func TestJWKSRoundTrip(t *testing.T) {
+	ks := mustKeyset(t, newEd25519Key(t, "ed"), newRSAKey(t, "rsa"))
+	jwks := ks.JWKS()
+	if len(jwks.Keys) != 2 || jwks.Keys[0].Kid != "ed" {
+		t.Fatalf("JWKS() = %+v, want active key ed first", jwks)
+	}
+	for _, jwk := range jwks.Keys {
+		key, err := jwk.verificationKey()
+		if err != nil {
+			t.Fatalf("verificationKey(%q) error = %v", jwk.Kid, err)
+		}
+		if key.jwk() != jwk {
+			t.Errorf("round trip of %q = %+v, want %+v", jwk.Kid, key.jwk(), jwk)
+		}
+	}
+
+	tampered := jwks.Keys[0]
+	tampered.Alg = jwt.SigningMethodRS256.Alg()
+	if _, err := tampered.verificationKey(); err == nil {
+		t.Errorf("verificationKey() accepted ed25519 key declared as %s", tampered.Alg)
+	}
+}
+
+func writeKeyFile(t *testing.T, private crypto.Signer) string {
+	t.Helper()
+	der, err := x509.MarshalPKCS8PrivateKey(private)
+	if err != nil {
+		t.Fatalf("failed to marshal private key: %v", err)
+	}
+	path := filepath.Join(t.TempDir(), "key.pem")
+	if err := os.WriteFile(path, pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), 0o600); err != nil {
+		t.Fatalf("failed to write private key: %v", err)
+	}
+	return path
+}
+
+func TestNewKeyset(t *testing.T) {
+	_, edPrivate, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatalf("failed to generate ed25519 key: %v", err)
+	}
+	weakRSA, err := rsa.GenerateKey(rand.Reader, 1024)
+	if err != nil {
+		t.Fatalf("failed to generate rsa key: %v", err)
+	}
+	edFile := writeKeyFile(t, edPrivate)
+	weakFile := writeKeyFile(t, weakRSA)
+
+	tests := []struct {
+		name  string
+		keys  []KeyConfig
+		valid bool
+	}{
+		{"one active key", []KeyConfig{{ID: "a", PrivateKeyFile: edFile, Status: KeyStatusActive}}, true},
+		{"active and retiring", []KeyConfig{
+			{ID: "a", PrivateKeyFile: edFile, Status: KeyStatusActive},
+			{ID: "b", PrivateKeyFile: edFile, Status: KeyStatusRetiring},
+		}, true},
+		{"no keys", nil, false},
+		{"no active key", []KeyConfig{{ID: "a", PrivateKeyFile: edFile, Status: KeyStatusRetiring}}, false},
+		{"two active keys", []KeyConfig{
+			{ID: "a", PrivateKeyFile: edFile, Status: KeyStatusActive},
+			{ID: "b", PrivateKeyFile: edFile, Status: KeyStatusActive},
+		}, false},
+		{"duplicate kid", []KeyConfig{
+			{ID: "a", PrivateKeyFile: edFile, Status: KeyStatusActive},
+			{ID: "a", PrivateKeyFile: edFile, Status: KeyStatusRetiring},
+		}, false},
+		{"missing kid", []KeyConfig{{PrivateKeyFile: edFile, Status: KeyStatusActive}}, false},
+		{"unknown status", []KeyConfig{{ID: "a", PrivateKeyFile: edFile, Status: "primary"}}, false},
+		{"missing file", []KeyConfig{{ID: "a", PrivateKeyFile: filepath.Join(t.TempDir(), "missing.pem"), Status: KeyStatusActive}}, false},
+		{"weak rsa key", []KeyConfig{{ID: "a", PrivateKeyFile: weakFile, Status: KeyStatusActive}}, false},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			ks, err := NewKeyset(Config{Keys: tt.keys})
+			if !tt.valid {
+				if err == nil {
+					t.Fatalf("NewKeyset() error = nil, want error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("NewKeyset() error = %v", err)
+			}
+			if ks.ActiveKeyID() != "a" {
+				t.Errorf("ActiveKeyID() = %q, want a", ks.ActiveKeyID())
+			}
+		})
+	}
+}
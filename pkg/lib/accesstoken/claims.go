@@ -0,0 +1,74 @@
+package accesstoken
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// ErrInvalidToken - токен не прошел проверку подписи, срока действия или содержимого
+var ErrInvalidToken = errors.New("invalid access token")
+
+// leeway - допустимое расхождение часов auth-service и проверяющих сервисов
+const leeway = 30 * time.Second
+
+// Claims - содержимое access токена
+type Claims struct {
+	jwt.RegisteredClaims
+	Roles []string `json:"roles"`
+}
+
+// NewClaims собирает содержимое токена пользователя со сроком действия ttl
+func NewClaims(userID int64, roles []string, now time.Time, ttl time.Duration) Claims {
+	return Claims{
+		RegisteredClaims: jwt.RegisteredClaims{
+			Subject:   strconv.FormatInt(userID, 10),
+			IssuedAt:  jwt.NewNumericDate(now),
+			ExpiresAt: jwt.NewNumericDate(now.Add(ttl)),
+		},
+		Roles: roles,
+	}
+}
+
+// UserID возвращает идентификатор пользователя из subject
+func (c *Claims) UserID() (int64, error) {
+	userID, err := strconv.ParseInt(c.Subject, 10, 64)
+	if err != nil || userID <= 0 {
+		return 0, fmt.Errorf("%w: invalid subject %q", ErrInvalidToken, c.Subject)
+	}
+	return userID, nil
+}
+
+// parse проверяет подпись ключом из lookup по kid заголовка. Алгоритм берется из ключа, а не из токена,
+// поэтому подменить его в заголовке (alg=none, HS256 с открытым ключом вместо секрета) не получится
+func parse(tokenString string, lookup func(kid string) (verificationKey, bool)) (*Claims, error) {
+	claims := &Claims{}
+	_, err := jwt.ParseWithClaims(tokenString, claims, func(token *jwt.Token) (interface{}, error) {
+		kid, _ := token.Header["kid"].(string)
+		if kid == "" {
+			return nil, errors.New("token has no kid header")
+		}
+		key, ok := lookup(kid)
+		if !ok {
+			return nil, fmt.Errorf("unknown key %q", kid)
+		}
+		if token.Method.Alg() != key.method.Alg() {
+			return nil, fmt.Errorf("key %q expects %s, token is signed with %s", kid, key.method.Alg(), token.Method.Alg())
+		}
+		return key.public, nil
+	},
+		jwt.WithValidMethods([]string{jwt.SigningMethodRS256.Alg(), jwt.SigningMethodEdDSA.Alg()}),
+		jwt.WithExpirationRequired(),
+		jwt.WithLeeway(leeway),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("%w: %v", ErrInvalidToken, err)
+	}
+	if _, err := claims.UserID(); err != nil {
+		return nil, err
+	}
+	return claims, nil
+}
@@ -0,0 +1,111 @@
+package accesstoken
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rsa"
+	"encoding/base64"
+	"fmt"
+	"math/big"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+// minRSABits - RSA-ключи короче 2048 бит не принимаются ни для подписи, ни для проверки
+const minRSABits = 2048
+
+// JWK - открытый ключ в формате RFC 7517: RSA (n, e) или Ed25519 (crv, x)
+type JWK struct {
+	Kty string `json:"kty"`
+	Kid string `json:"kid"`
+	Use string `json:"use"`
+	Alg string `json:"alg"`
+	N   string `json:"n,omitempty"`
+	E   string `json:"e,omitempty"`
+	Crv string `json:"crv,omitempty"`
+	X   string `json:"x,omitempty"`
+}
+
+// JWKS - набор открытых ключей, которыми можно проверить выпущенные токены
+type JWKS struct {
+	Keys []JWK `json:"keys"`
+}
+
+// verificationKey - открытый ключ с алгоритмом, которым он проверяет подпись
+type verificationKey struct {
+	id     string
+	method jwt.SigningMethod
+	public crypto.PublicKey
+}
+
+// methodFor выбирает алгоритм подписи по типу ключа: RS256 для RSA, EdDSA для Ed25519
+func methodFor(public crypto.PublicKey) (jwt.SigningMethod, error) {
+	switch key := public.(type) {
+	case *rsa.PublicKey:
+		if key.N.BitLen() < minRSABits {
+			return nil, fmt.Errorf("rsa key is %d bits, at least %d are required", key.N.BitLen(), minRSABits)
+		}
+		return jwt.SigningMethodRS256, nil
+	case ed25519.PublicKey:
+		return jwt.SigningMethodEdDSA, nil
+	default:
+		return nil, fmt.Errorf("unsupported key type %T", public)
+	}
+}
+
+func (k verificationKey) jwk() JWK {
+	jwk := JWK{Kid: k.id, Use: "sig", Alg: k.method.Alg()}
+	switch key := k.public.(type) {
+	case *rsa.PublicKey:
+		jwk.Kty = "RSA"
+		jwk.N = base64.RawURLEncoding.EncodeToString(key.N.Bytes())
+		jwk.E = base64.RawURLEncoding.EncodeToString(big.NewInt(int64(key.E)).Bytes())
+	case ed25519.PublicKey:
+		jwk.Kty = "OKP"
+		jwk.Crv = "Ed25519"
+		jwk.X = base64.RawURLEncoding.EncodeToString(key)
+	}
+	return jwk
+}
+
+// verificationKey восстанавливает открытый ключ из JWK и проверяет, что заявленный alg ему соответствует
+func (j JWK) verificationKey() (verificationKey, error) {
+	if j.Kid == "" {
+		return verificationKey{}, fmt.Errorf("key has no kid")
+	}
+	if j.Use != "" && j.Use != "sig" {
+		return verificationKey{}, fmt.Errorf("key %q is not a signing key", j.Kid)
+	}
+	var public crypto.PublicKey
+	switch j.Kty {
+	case "RSA":
+		n, err := base64.RawURLEncoding.DecodeString(j.N)
+		if err != nil {
+			return verificationKey{}, fmt.Errorf("key %q: invalid modulus: %w", j.Kid, err)
+		}
+		e, err := base64.RawURLEncoding.DecodeString(j.E)
+		if err != nil || len(e) == 0 || len(e) > 4 {
+			return verificationKey{}, fmt.Errorf("key %q: invalid exponent", j.Kid)
+		}
+		public = &rsa.PublicKey{N: new(big.Int).SetBytes(n), E: int(new(big.Int).SetBytes(e).Int64())}
+	case "OKP":
+		if j.Crv != "Ed25519" {
+			return verificationKey{}, fmt.Errorf("key %q: unsupported curve %q", j.Kid, j.Crv)
+		}
+		x, err := base64.RawURLEncoding.DecodeString(j.X)
+		if err != nil || len(x) != ed25519.PublicKeySize {
+			return verificationKey{}, fmt.Errorf("key %q: invalid ed25519 public key", j.Kid)
+		}
+		public = ed25519.PublicKey(x)
+	default:
+		return verificationKey{}, fmt.Errorf("key %q: unsupported key type %q", j.Kid, j.Kty)
+	}
+	method, err := methodFor(public)
+	if err != nil {
+		return verificationKey{}, fmt.Errorf("key %q: %w", j.Kid, err)
+	}
+	if j.Alg != "" && j.Alg != method.Alg() {
+		return verificationKey{}, fmt.Errorf("key %q: alg %s does not match key type %s", j.Kid, j.Alg, j.Kty)
+	}
+	return verificationKey{id: j.Kid, method: method, public: public}, nil
+}
@@ -0,0 +1,197 @@
+package accesstoken
+
+import (
+	"crypto"
+	"crypto/ed25519"
+	"crypto/rand"
+	"crypto/x509"
+	"encoding/hex"
+	"encoding/pem"
+	"errors"
+	"fmt"
+	"os"
+
+	"github.com/golang-jwt/jwt/v5"
+)
+
+const (
+	// KeyStatusActive - ключ подписывает новые токены; такой ключ в наборе ровно один
+	KeyStatusActive = "active"
+	// KeyStatusRetiring - ключ больше не подписывает, но публикуется и проверяет токены, выпущенные до ротации.
+	// Убирать его из конфигурации можно не раньше, чем истечет срок жизни access токена
+	KeyStatusRetiring = "retiring"
+)
+
+// Config - ключи подписи access токенов auth-service
+type Config struct {
+	Keys []KeyConfig `mapstructure:"keys"`
+	// AllowEphemeralKey разрешает запуск без ключей с временным ключом из GenerateKeyset. Только для разработки
+	AllowEphemeralKey bool `mapstructure:"allow_ephemeral_key"`
+}
+
+// KeyConfig - ключ подписи. PrivateKeyFile - PEM с RSA (от 2048 бит) или Ed25519 ключом в PKCS#8 либо PKCS#1.
+// Алгоритм выбирается по типу ключа: RS256 или EdDSA
+type KeyConfig struct {
+	ID             string `mapstructure:"kid"`
+	PrivateKeyFile string `mapstructure:"private_key_file"`
+	Status         string `mapstructure:"status"`
+}
+
+type signingKey struct {
+	verificationKey
+	private crypto.Signer
+}
+
+// Keyset подписывает токены активным ключом и проверяет их любым ключом набора
+type Keyset struct {
+	active *signingKey
+	keys   map[string]*signingKey
+	// order - порядок ключей в JWKS: активный первым
+	order []string
+}
+
+// NewKeyset загружает ключи из конфигурации
+func NewKeyset(cfg Config) (*Keyset, error) {
+	if len(cfg.Keys) == 0 {
+		return nil, errors.New("no signing keys configured")
+	}
+	var active *signingKey
+	keys := make([]*signingKey, 0, len(cfg.Keys))
+	for _, keyCfg := range cfg.Keys {
+		if keyCfg.ID == "" {
+			return nil, errors.New("signing key without kid")
+		}
+		if keyCfg.Status != KeyStatusActive && keyCfg.Status != KeyStatusRetiring {
+			return nil, fmt.Errorf("key %q: unknown status %q", keyCfg.ID, keyCfg.Status)
+		}
+		data, err := os.ReadFile(keyCfg.PrivateKeyFile)
+		if err != nil {
+			return nil, fmt.Errorf("key %q: failed to read private key: %w", keyCfg.ID, err)
+		}
+		private, err := parsePrivateKey(data)
+		if err != nil {
+			return nil, fmt.Errorf("key %q: %w", keyCfg.ID, err)
+		}
+		key, err := newSigningKey(keyCfg.ID, private)
+		if err != nil {
+			return nil, fmt.Errorf("key %q: %w", keyCfg.ID, err)
+		}
+		if keyCfg.Status == KeyStatusActive {
+			if active != nil {
+				return nil, fmt.Errorf("keys %q and %q are both active, exactly one active key is required", active.id, key.id)
+			}
+			active = key
+		}
+		keys = append(keys, key)
+	}
+	if active == nil {
+		return nil, errors.New("no active signing key configured")
+	}
+	return newKeyset(active, keys)
+}
+
+// GenerateKeyset создает набор из одного Ed25519 ключа, который живет только в памяти процесса.
+// Подходит для локального запуска: после перезапуска все выданные access токены перестают проверяться
+func GenerateKeyset() (*Keyset, error) {
+	_, private, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		return nil, fmt.Errorf("failed to generate signing key: %w", err)
+	}
+	id := make([]byte, 8)
+	if _, err := rand.Read(id); err != nil {
+		return nil, fmt.Errorf("failed to generate key id: %w", err)
+	}
+	key, err := newSigningKey("ephemeral-"+hex.EncodeToString(id), private)
+	if err != nil {
+		return nil, err
+	}
+	return newKeyset(key, []*signingKey{key})
+}
+
+func newKeyset(active *signingKey, keys []*signingKey) (*Keyset, error) {
+	ks := &Keyset{active: active, keys: make(map[string]*signingKey, len(keys)), order: []string{active.id}}
+	for _, key := range keys {
+		if _, ok := ks.keys[key.id]; ok {
+			return nil, fmt.Errorf("duplicate key id %q", key.id)
+		}
+		ks.keys[key.id] = key
+		if key != active {
+			ks.order = append(ks.order, key.id)
+		}
+	}
+	return ks, nil
+}
+
+func newSigningKey(id string, private crypto.Signer) (*signingKey, error) {
+	method, err := methodFor(private.Public())
+	if err != nil {
+		return nil, err
+	}
+	return &signingKey{
+		verificationKey: verificationKey{id: id, method: method, public: private.Public()},
+		private:         private,
+	}, nil
+}
+
+func parsePrivateKey(data []byte) (crypto.Signer, error) {
+	block, _ := pem.Decode(data)
+	if block == nil {
+		return nil, errors.New("private key file is not PEM encoded")
+	}
+	switch block.Type {
+	case "PRIVATE KEY":
+		key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse PKCS#8 private key: %w", err)
+		}
+		signer, ok := key.(crypto.Signer)
+		if !ok {
+			return nil, fmt.Errorf("unsupported private key type %T", key)
+		}
+		return signer, nil
+	case "RSA PRIVATE KEY":
+		key, err := x509.ParsePKCS1PrivateKey(block.Bytes)
+		if err != nil {
+			return nil, fmt.Errorf("failed to parse PKCS#1 private key: %w", err)
+		}
+		return key, nil
+	default:
+		return nil, fmt.Errorf("unsupported PEM block %q", block.Type)
+	}
+}
+
+// ActiveKeyID - kid ключа, которым подписываются новые токены
+func (ks *Keyset) ActiveKeyID() string {
+	return ks.active.id
+}
+
+// Sign подписывает claims активным ключом и записывает его kid в заголовок
+func (ks *Keyset) Sign(claims Claims) (string, error) {
+	token := jwt.NewWithClaims(ks.active.method, claims)
+	token.Header["kid"] = ks.active.id
+	signed, err := token.SignedString(ks.active.private)
+	if err != nil {
+		return "", fmt.Errorf("failed to sign token with key %q: %w", ks.active.id, err)
+	}
+	return signed, nil
+}
+
+// Parse проверяет токен любым ключом набора, включая выводимые из оборота
+func (ks *Keyset) Parse(tokenString string) (*Claims, error) {
+	return parse(tokenString, func(kid string) (verificationKey, bool) {
+		key, ok := ks.keys[kid]
+		if !ok {
+			return verificationKey{}, false
+		}
+		return key.verificationKey, true
+	})
+}
+
+// JWKS возвращает открытые части всех ключей набора
+func (ks *Keyset) JWKS() JWKS {
+	jwks := JWKS{Keys: make([]JWK, 0, len(ks.order))}
+	for _, id := range ks.order {
+		jwks.Keys = append(jwks.Keys, ks.keys[id].jwk())
+	}
+	return jwks
+}
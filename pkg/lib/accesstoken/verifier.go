@@ -0,0 +1,129 @@
+package accesstoken
+
+import (
+	"context"
+	"fmt"
+	"log/slog"
+	"logistics/pkg/lib/logger/slogger"
+	"sync"
+	"time"
+)
+
+// minRefreshInterval - не чаще этого набор ключей перезапрашивается из-за неизвестного kid,
+// иначе токены с выдуманными kid превращаются в поток запросов к auth-service
+const minRefreshInterval = 30 * time.Second
+
+// KeySource возвращает текущий набор открытых ключей auth-service
+type KeySource func(ctx context.Context) (JWKS, error)
+
+// Verifier проверяет access токены локально по закешированному набору открытых ключей.
+// Набор обновляется по расписанию и сразу, когда приходит токен с незнакомым kid - так подхватывается новый ключ после ротации
+type Verifier struct {
+	source KeySource
+	logger *slog.Logger
+
+	mu   sync.RWMutex
+	keys map[string]verificationKey
+	jwks JWKS
+
+	// refreshMu не дает нескольким запросам одновременно перезапрашивать ключи
+	refreshMu   sync.Mutex
+	attemptedAt time.Time
+}
+
+func NewVerifier(logger *slog.Logger, source KeySource) *Verifier {
+	return &Verifier{
+		source: source,
+		logger: logger,
+		keys:   make(map[string]verificationKey),
+		jwks:   JWKS{Keys: []JWK{}},
+	}
+}
+
+// Run обновляет набор ключей каждые interval до отмены ctx
+func (v *Verifier) Run(ctx context.Context, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := v.refresh(ctx, true); err != nil {
+			v.logger.Error("failed to refresh access token keys", slogger.Err(err))
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// Verify проверяет подпись и срок действия токена и возвращает его содержимое
+func (v *Verifier) Verify(ctx context.Context, tokenString string) (*Claims, error) {
+	return parse(tokenString, func(kid string) (verificationKey, bool) {
+		if key, ok := v.lookup(kid); ok {
+			return key, true
+		}
+		if err := v.refresh(ctx, false); err != nil {
+			v.logger.Error("failed to refresh access token keys", slog.String("kid", kid), slogger.Err(err))
+			return verificationKey{}, false
+		}
+		return v.lookup(kid)
+	})
+}
+
+// JWKS возвращает закешированный набор открытых ключей; пустой кеш сначала заполняется
+func (v *Verifier) JWKS(ctx context.Context) (JWKS, error) {
+	v.mu.RLock()
+	jwks := v.jwks
+	v.mu.RUnlock()
+	if len(jwks.Keys) > 0 {
+		return jwks, nil
+	}
+	if err := v.refresh(ctx, false); err != nil {
+		return JWKS{}, err
+	}
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	return v.jwks, nil
+}
+
+func (v *Verifier) lookup(kid string) (verificationKey, bool) {
+	v.mu.RLock()
+	defer v.mu.RUnlock()
+	key, ok := v.keys[kid]
+	return key, ok
+}
+
+// refresh перезапрашивает ключи; без force - не чаще minRefreshInterval
+func (v *Verifier) refresh(ctx context.Context, force bool) error {
+	v.refreshMu.Lock()
+	defer v.refreshMu.Unlock()
+	if !force && time.Since(v.attemptedAt) < minRefreshInterval {
+		return nil
+	}
+	v.attemptedAt = time.Now()
+
+	jwks, err := v.source(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to fetch keys: %w", err)
+	}
+	keys := make(map[string]verificationKey, len(jwks.Keys))
+	published := make([]JWK, 0, len(jwks.Keys))
+	for _, jwk := range jwks.Keys {
+		key, err := jwk.verificationKey()
+		if err != nil {
+			v.logger.Warn("skipping unusable access token key", slog.String("kid", jwk.Kid), slogger.Err(err))
+			continue
+		}
+		keys[key.id] = key
+		published = append(published, key.jwk())
+	}
+	if len(keys) == 0 {
+		return fmt.Errorf("auth service returned no usable keys")
+	}
+
+	v.mu.Lock()
+	v.keys = keys
+	v.jwks = JWKS{Keys: published}
+	v.mu.Unlock()
+	return nil
+}
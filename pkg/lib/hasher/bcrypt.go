@@ -0,0 +1,43 @@
+package hasher
+
+import (
+	"errors"
+	"fmt"
+
+	"golang.org/x/crypto/bcrypt"
+)
+
+const (
+	defaultBcryptCost = 12
+	minBcryptCost     = 10
+	maxBcryptCost     = bcrypt.MaxCost
+)
+
+// bcryptScheme - bcrypt учитывает только первые 72 байта пароля, поэтому более длинные пароли отклоняются при хешировании
+type bcryptScheme struct {
+	cost int
+}
+
+func (s *bcryptScheme) hash(password string) (string, error) {
+	hash, err := bcrypt.GenerateFromPassword([]byte(password), s.cost)
+	if err != nil {
+		return "", fmt.Errorf("failed to hash password with bcrypt: %w", err)
+	}
+	return string(hash), nil
+}
+
+func (s *bcryptScheme) verify(password, encoded string) error {
+	err := bcrypt.CompareHashAndPassword([]byte(encoded), []byte(password))
+	if errors.Is(err, bcrypt.ErrMismatchedHashAndPassword) {
+		return ErrMismatch
+	}
+	if err != nil {
+		return fmt.Errorf("%w: %v", ErrUnknownFormat, err)
+	}
+	return nil
+}
+
+func (s *bcryptScheme) outdated(encoded string) bool {
+	cost, err := bcrypt.Cost([]byte(encoded))
+	return err != nil || cost != s.cost
+}
@@ -0,0 +1,107 @@
+package hasher
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+)
+
+var (
+	// ErrMismatch - пароль не совпадает с хешем
+	ErrMismatch = errors.New("password does not match")
+	// ErrUnknownFormat - хеш записан неизвестным алгоритмом или поврежден
+	ErrUnknownFormat = errors.New("unknown password hash format")
+)
+
+const (
+	AlgorithmArgon2id = "argon2id"
+	AlgorithmBcrypt   = "bcrypt"
+)
+
+// Config - настройки хеширования паролей; нулевые значения заменяются рекомендуемыми
+type Config struct {
+	// Algorithm - argon2id или bcrypt, по умолчанию argon2id
+	Algorithm string       `mapstructure:"algorithm"`
+	Argon2id  Argon2Params `mapstructure:"argon2id"`
+	// BcryptCost - стоимость bcrypt, от 10 до 31
+	BcryptCost int `mapstructure:"bcrypt_cost"`
+}
+
+// scheme - алгоритм хеширования. Соль и параметры хранятся в самой строке хеша,
+// поэтому старые хеши проверяются и после смены настроек
+type scheme interface {
+	hash(password string) (string, error)
+	// verify возвращает ErrMismatch, если пароль не подходит
+	verify(password, encoded string) error
+	// outdated сообщает, что хеш записан с другими параметрами и его стоит пересчитать
+	outdated(encoded string) bool
+}
+
+// Hasher хеширует новые пароли выбранным алгоритмом и проверяет пароли,
+// сохраненные любым из поддерживаемых, включая устаревший SHA-1
+type Hasher struct {
+	current  scheme
+	argon2id *argon2idScheme
+	bcrypt   *bcryptScheme
+	legacy   *legacySHA1Scheme
+}
+
+// New создает Hasher. legacySecret - секрет, с которым считались SHA-1-хеши до перехода на argon2id;
+// пустой секрет отключает проверку таких хешей
+func New(cfg Config, legacySecret string) (*Hasher, error) {
+	params := cfg.Argon2id.withDefaults()
+	cost := cfg.BcryptCost
+	if cost == 0 {
+		cost = defaultBcryptCost
+	}
+	if cost < minBcryptCost || cost > maxBcryptCost {
+		return nil, fmt.Errorf("invalid bcrypt cost %d", cost)
+	}
+
+	h := &Hasher{
+		argon2id: &argon2idScheme{params: params},
+		bcrypt:   &bcryptScheme{cost: cost},
+	}
+	if legacySecret != "" {
+		h.legacy = &legacySHA1Scheme{secret: legacySecret}
+	}
+	switch cfg.Algorithm {
+	case "", AlgorithmArgon2id:
+		h.current = h.argon2id
+	case AlgorithmBcrypt:
+		h.current = h.bcrypt
+	default:
+		return nil, fmt.Errorf("unknown password hashing algorithm %q", cfg.Algorithm)
+	}
+	return h, nil
+}
+
+// Hash хеширует пароль текущим алгоритмом со случайной солью
+func (h *Hasher) Hash(password string) (string, error) {
+	return h.current.hash(password)
+}
+
+// Verify проверяет пароль. rehash true значит, что пароль верный, но хеш записан
+// другим алгоритмом или с другими параметрами и его стоит заменить на Hash(password)
+func (h *Hasher) Verify(password, encoded string) (rehash bool, err error) {
+	s := h.schemeOf(encoded)
+	if s == nil {
+		return false, ErrUnknownFormat
+	}
+	if err := s.verify(password, encoded); err != nil {
+		return false, err
+	}
+	return s != h.current || s.outdated(encoded), nil
+}
+
+func (h *Hasher) schemeOf(encoded string) scheme {
+	switch {
+	case strings.HasPrefix(encoded, "$argon2id$"):
+		return h.argon2id
+	case strings.HasPrefix(encoded, "$2a$"), strings.HasPrefix(encoded, "$2b$"), strings.HasPrefix(encoded, "$2y$"):
+		return h.bcrypt
+	case h.legacy != nil && h.legacy.matchesFormat(encoded):
+		return h.legacy
+	}
+	return nil
+}
@@ -0,0 +1,117 @@
+package hasher
+
+import (
+	"crypto/sha1"
+	"encoding/hex"
+	"errors"
+	"testing"
+)
+
+const (
+	password     = "correct horse battery staple"
+	legacySecret = "legacy-secret"
+)
+
+// testArgon2 - минимальные параметры, чтобы тесты не тратили 64 МиБ на каждый хеш
+var testArgon2 = Argon2Params{MemoryKiB: 64, Iterations: 1, Parallelism: 1}
+
+func newTestHasher(t *testing.T, cfg Config, secret string) *Hasher {
+	t.Helper()
+	h, err := New(cfg, secret)
+	if err != nil {
+		t.Fatalf("New(%+v) error = %v", cfg, err)
+	}
+	return h
+}
+
+func mustHash(t *testing.T, h *Hasher, password string) string {
+	t.Helper()
+	encoded, err := h.Hash(password)
+	if err != nil {
+		t.Fatalf("Hash() error = %v", err)
+	}
+	return encoded
+}
+
+func legacyHash(secret, password string) string {
+	digest := sha1.Sum([]byte(password))
+	return hex.EncodeToString(append([]byte(secret), digest[:]...))
+}
+
+func TestVerify(t *testing.T) {
+	argonH := newTestHasher(t, Config{Argon2id: testArgon2, BcryptCost: minBcryptCost}, legacySecret)
+	argonStronger := newTestHasher(t, Config{Argon2id: Argon2Params{MemoryKiB: 128, Iterations: 2, Parallelism: 1}}, "")
+	bcryptH := newTestHasher(t, Config{Algorithm: AlgorithmBcrypt, Argon2id: testArgon2, BcryptCost: minBcryptCost}, legacySecret)
+	bcryptStronger := newTestHasher(t, Config{Algorithm: AlgorithmBcrypt, BcryptCost: minBcryptCost + 1}, "")
+	noLegacy := newTestHasher(t, Config{Argon2id: testArgon2}, "")
+
+	argonHash := mustHash(t, argonH, password)
+	bcryptHash := mustHash(t, bcryptH, password)
+
+	tests := []struct {
+		name     string
+		hasher   *Hasher
+		password string
+		encoded  string
+		rehash   bool
+		err      error
+	}{
+		{"argon2id current params", argonH, password, argonHash, false, nil},
+		{"argon2id other params", argonH, password, mustHash(t, argonStronger, password), true, nil},
+		{"argon2id wrong password", argonH, "wrong", argonHash, false, ErrMismatch},
+		{"argon2id corrupted", argonH, password, argonHash[:len(argonHash)-10] + "$", false, ErrUnknownFormat},
+		{"argon2id under bcrypt", bcryptH, password, argonHash, true, nil},
+		{"bcrypt current cost", bcryptH, password, bcryptHash, false, nil},
+		{"bcrypt other cost", bcryptH, password, mustHash(t, bcryptStronger, password), true, nil},
+		{"bcrypt wrong password", bcryptH, "wrong", bcryptHash, false, ErrMismatch},
+		{"bcrypt under argon2id", argonH, password, bcryptHash, true, nil},
+		{"legacy sha1", argonH, password, legacyHash(legacySecret, password), true, nil},
+		{"legacy sha1 under bcrypt", bcryptH, password, legacyHash(legacySecret, password), true, nil},
+		{"legacy sha1 wrong password", argonH, "wrong", legacyHash(legacySecret, password), false, ErrMismatch},
+		{"legacy sha1 other secret", argonH, password, legacyHash("other-secret", password), false, ErrUnknownFormat},
+		{"legacy sha1 disabled", noLegacy, password, legacyHash(legacySecret, password), false, ErrUnknownFormat},
+		{"unknown format", argonH, password, "plaintext", false, ErrUnknownFormat},
+		{"empty hash", argonH, password, "", false, ErrUnknownFormat},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			rehash, err := tt.hasher.Verify(tt.password, tt.encoded)
+			if !errors.Is(err, tt.err) {
+				t.Fatalf("Verify() error = %v, want %v", err, tt.err)
+			}
+			if rehash != tt.rehash {
+				t.Errorf("Verify() rehash = %v, want %v", rehash, tt.rehash)
+			}
+		})
+	}
+}
+
+func TestHashUsesRandomSalt(t *testing.T) {
+	for _, algorithm := range []string{AlgorithmArgon2id, AlgorithmBcrypt} {
+		t.Run(algorithm, func(t *testing.T) {
+			h := newTestHasher(t, Config{Algorithm: algorithm, Argon2id: testArgon2, BcryptCost: minBcryptCost}, "")
+			first, second := mustHash(t, h, password), mustHash(t, h, password)
+			if first == second {
+				t.Fatalf("Hash() returned the same hash twice: %s", first)
+			}
+		})
+	}
+}
+
+func TestNewRejectsInvalidConfig(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  Config
+	}{
+		{"unknown algorithm", Config{Algorithm: "md5"}},
+		{"bcrypt cost too low", Config{Algorithm: AlgorithmBcrypt, BcryptCost: minBcryptCost - 1}},
+		{"bcrypt cost too high", Config{Algorithm: AlgorithmBcrypt, BcryptCost: maxBcryptCost + 1}},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, err := New(tt.cfg, ""); err == nil {
+				t.Fatalf("New(%+v) error = nil, want error", tt.cfg)
+			}
+		})
+	}
+}
@@ -0,0 +1,36 @@
+package hasher
+
+import (
+	"crypto/sha1"
+	"crypto/subtle"
+	"encoding/hex"
+	"strings"
+)
+
+// legacySHA1Scheme проверяет пароли, сохраненные до перехода на argon2id: hex(секрет || SHA-1(пароль)).
+// Соли нет, поэтому новые хеши так не считаются, а найденные пересчитываются при первом входе
+type legacySHA1Scheme struct {
+	secret string
+}
+
+func (s *legacySHA1Scheme) hash(string) (string, error) {
+	return "", ErrUnknownFormat
+}
+
+func (s *legacySHA1Scheme) verify(password, encoded string) error {
+	digest := sha1.Sum([]byte(password))
+	expected := hex.EncodeToString(append([]byte(s.secret), digest[:]...))
+	if subtle.ConstantTimeCompare([]byte(expected), []byte(encoded)) != 1 {
+		return ErrMismatch
+	}
+	return nil
+}
+
+func (s *legacySHA1Scheme) outdated(string) bool {
+	return true
+}
+
+func (s *legacySHA1Scheme) matchesFormat(encoded string) bool {
+	prefix := hex.EncodeToString([]byte(s.secret))
+	return len(encoded) == len(prefix)+2*sha1.Size && strings.HasPrefix(encoded, prefix)
+}
@@ -1,9 +1,15 @@
 package utils
 
 import (
+	authpb "logistics/api/protobuf/auth_service"
+	driverpb "logistics/api/protobuf/driver_service"
 	orderpb "logistics/api/protobuf/order_service"
 	warehousepb "logistics/api/protobuf/warehouse_service"
 	"logistics/internal/shared/entity"
+	"logistics/pkg/lib/accesstoken"
+	"time"
+
+	"google.golang.org/protobuf/types/known/timestamppb"
 )
 
 func ConvertStockItemsToOrderItems(stockItems []*warehousepb.StockItem) []*entity.GoodsItem {
@@ -81,3 +87,614 @@ func ConvertGoodsItemSliceToOrderItemSlice(goodsItems []entity.GoodsItem) []*ord
 	}
 	return orderItems
 }
+
+func ConvertOrderStatusChanges(changes []*orderpb.OrderStatusChange) []entity.OrderStatusChange {
+	res := make([]entity.OrderStatusChange, len(changes))
+	for i, change := range changes {
+		res[i] = ConvertOrderStatusChange(change)
+	}
+	return res
+}
+
+func ConvertOrderStatusChange(change *orderpb.OrderStatusChange) entity.OrderStatusChange {
+	return entity.OrderStatusChange{
+		ID:        change.Id,
+		OrderID:   change.OrderId,
+		OldStatus: entity.OrderStatus(change.OldStatus),
+		NewStatus: entity.OrderStatus(change.NewStatus),
+		ActorType: entity.ActorType(change.ActorType),
+		ActorID:   change.ActorId,
+		Reason:    change.Reason,
+		ChangedAt: change.ChangedAt.AsTime().Unix(),
+	}
+}
+
+func ConvertOrderStatusChangeToProto(change *entity.OrderStatusChange) *orderpb.OrderStatusChange {
+	return &orderpb.OrderStatusChange{
+		Id:        change.ID,
+		OrderId:   change.OrderID,
+		OldStatus: string(change.OldStatus),
+		NewStatus: string(change.NewStatus),
+		ActorType: string(change.ActorType),
+		ActorId:   change.ActorID,
+		Reason:    change.Reason,
+		ChangedAt: timestamppb.New(time.Unix(change.ChangedAt, 0)),
+	}
+}
+
+func ConvertOrderToProto(order *entity.Order) *orderpb.Order {
+	var driverID int64
+	if order.DriverID != nil {
+		driverID = *order.DriverID
+	}
+	return &orderpb.Order{
+		Id:               order.ID,
+		UserId:           order.UserID,
+		Status:           string(order.Status),
+		DeliveryAddress:  order.DeliveryAddress,
+		Items:            ConvertGoodsItemSliceToOrderItemSlice(order.Items),
+		TotalAmount:      order.TotalAmount,
+		DriverId:         driverID,
+		CreatedAt:        timestamppb.New(time.Unix(order.CreatedAt, 0)),
+		DeliveryLocation: ConvertEntityLocationToOrder(order.DeliveryLocation),
+		Allocations:      ConvertOrderAllocationsToProto(order.Allocations),
+	}
+}
+
+func ConvertProtoToOrder(order *orderpb.Order) *entity.Order {
+	res := &entity.Order{
+		ID:               order.Id,
+		UserID:           order.UserId,
+		Status:           entity.OrderStatus(order.Status),
+		DeliveryAddress:  order.DeliveryAddress,
+		Items:            ConvertOrderItemToGoodsItem(order.Items),
+		TotalAmount:      order.TotalAmount,
+		CreatedAt:        order.CreatedAt.AsTime().Unix(),
+		DeliveryLocation: ConvertOrderLocationToEntity(order.DeliveryLocation),
+		Allocations:      ConvertProtoToOrderAllocations(order.Allocations),
+	}
+	if order.DriverId != 0 {
+		driverID := order.DriverId
+		res.DriverID = &driverID
+	}
+	return res
+}
+
+func ConvertGoodsItemsToWarehouseStockItems(goodsItems []entity.GoodsItem, time int64) []*warehousepb.StockItem {
+	stockItems := make([]*warehousepb.StockItem, len(goodsItems))
+
+	for i, item := range goodsItems {
+		stockItems[i] = &warehousepb.StockItem{
+			ProductId:   item.ProductID,
+			ProductName: item.ProductName,
+			Quantity:    item.Quantity,
+			Time:        time,
+		}
+	}
+	return stockItems
+}
+
+func ConvertAssignmentJobToProto(job *entity.AssignmentJob) *orderpb.AssignmentJob {
+	return &orderpb.AssignmentJob{
+		Id:        job.ID,
+		OrderId:   job.OrderID,
+		Status:    string(job.Status),
+		DriverId:  job.DriverID,
+		Error:     job.Error,
+		CreatedAt: job.CreatedAt,
+		UpdatedAt: job.UpdatedAt,
+	}
+}
+
+func ConvertProtoToAssignmentJob(job *orderpb.AssignmentJob) *entity.AssignmentJob {
+	return &entity.AssignmentJob{
+		ID:        job.Id,
+		OrderID:   job.OrderId,
+		Status:    entity.AssignmentJobStatus(job.Status),
+		DriverID:  job.DriverId,
+		Error:     job.Error,
+		CreatedAt: job.CreatedAt,
+		UpdatedAt: job.UpdatedAt,
+	}
+}
+
+func ConvertDriverToProto(driver *entity.Driver) *driverpb.Driver {
+	res := &driverpb.Driver{
+		DriverId: driver.ID,
+		Name:     driver.Name,
+		Phone:    driver.Phone,
+		Status:   string(driver.Status),
+		Vehicle: &driverpb.Vehicle{
+			Model:        driver.Car,
+			LicensePlate: driver.LicenseNumber,
+		},
+		LastAssignedAt: driver.LastAssignedAt,
+		Email:          driver.Email,
+		LicenseNumber:  driver.LicenseNumber,
+		Active:         driver.Active,
+	}
+	if driver.Location != nil {
+		res.Location = &driverpb.Location{
+			Latitude:  driver.Location.Latitude,
+			Longitude: driver.Location.Longitude,
+		}
+	}
+	return res
+}
+
+func ConvertProtoToDriver(driver *driverpb.Driver) *entity.Driver {
+	res := &entity.Driver{
+		ID:             driver.DriverId,
+		Name:           driver.Name,
+		Phone:          driver.Phone,
+		LicenseNumber:  driver.LicenseNumber,
+		Car:            driver.Vehicle.GetModel(),
+		Status:         entity.DriverStatus(driver.Status),
+		LastAssignedAt: driver.LastAssignedAt,
+		Email:          driver.Email,
+		Active:         driver.Active,
+	}
+	if driver.Location != nil {
+		res.Location = &entity.Location{
+			Latitude:  driver.Location.Latitude,
+			Longitude: driver.Location.Longitude,
+		}
+	}
+	return res
+}
+
+func ConvertDriverLocationToProto(location *entity.DriverLocation) *driverpb.GetDriverLocationResponse {
+	return &driverpb.GetDriverLocationResponse{
+		DriverId:   location.DriverID,
+		Location:   &driverpb.Location{Latitude: location.Location.Latitude, Longitude: location.Location.Longitude},
+		RecordedAt: location.RecordedAt,
+	}
+}
+
+func ConvertProtoToDriverLocation(location *driverpb.GetDriverLocationResponse) *entity.DriverLocation {
+	return &entity.DriverLocation{
+		DriverID:   location.DriverId,
+		Location:   entity.Location{Latitude: location.Location.GetLatitude(), Longitude: location.Location.GetLongitude()},
+		RecordedAt: location.RecordedAt,
+	}
+}
+
+func ConvertOrderLocationToEntity(location *orderpb.Location) *entity.Location {
+	if location == nil {
+		return nil
+	}
+	return &entity.Location{
+		Latitude:  location.Latitude,
+		Longitude: location.Longitude,
+	}
+}
+
+func ConvertEntityLocationToOrder(location *entity.Location) *orderpb.Location {
+	if location == nil {
+		return nil
+	}
+	return &orderpb.Location{
+		Latitude:  location.Latitude,
+		Longitude: location.Longitude,
+	}
+}
+
+func ConvertProductToProto(product *entity.Product) *warehousepb.Product {
+	return &warehousepb.Product{
+		ProductId:   product.ID,
+		Sku:         product.SKU,
+		Name:        product.Name,
+		Description: product.Description,
+		UnitPrice:   product.UnitPrice,
+		WeightGrams: product.WeightGrams,
+		Dimensions: &warehousepb.Dimensions{
+			LengthMm: product.Dimensions.LengthMM,
+			WidthMm:  product.Dimensions.WidthMM,
+			HeightMm: product.Dimensions.HeightMM,
+		},
+		ReorderPoint:    product.ReorderPoint,
+		ReorderQuantity: product.ReorderQuantity,
+		Active:          product.Active,
+		CreatedAt:       product.CreatedAt,
+		UpdatedAt:       product.UpdatedAt,
+	}
+}
+
+func ConvertProtoToProduct(product *warehousepb.Product) *entity.Product {
+	return &entity.Product{
+		ID:          product.ProductId,
+		SKU:         product.Sku,
+		Name:        product.Name,
+		Description: product.Description,
+		UnitPrice:   product.UnitPrice,
+		WeightGrams: product.WeightGrams,
+		Dimensions: entity.Dimensions{
+			LengthMM: product.Dimensions.GetLengthMm(),
+			WidthMM:  product.Dimensions.GetWidthMm(),
+			HeightMM: product.Dimensions.GetHeightMm(),
+		},
+		ReorderPoint:    product.ReorderPoint,
+		ReorderQuantity: product.ReorderQuantity,
+		Active:          product.Active,
+		CreatedAt:       product.CreatedAt,
+		UpdatedAt:       product.UpdatedAt,
+	}
+}
+
+func ConvertReceivingDocumentToProto(document *entity.ReceivingDocument) *warehousepb.ReceivingDocument {
+	lines := make([]*warehousepb.ReceivingLine, 0, len(document.Lines))
+	for _, line := range document.Lines {
+		lines = append(lines, &warehousepb.ReceivingLine{
+			ProductId:        line.ProductID,
+			ProductName:      line.ProductName,
+			ExpectedQuantity: line.ExpectedQuantity,
+			ReceivedQuantity: line.ReceivedQuantity,
+			LotNumber:        line.LotNumber,
+			ExpiresAt:        line.ExpiresAt,
+		})
+	}
+	return &warehousepb.ReceivingDocument{
+		DocumentId:  document.ID,
+		WarehouseId: document.WarehouseID,
+		Supplier:    document.Supplier,
+		Reference:   document.Reference,
+		Note:        document.Note,
+		Status:      string(document.Status),
+		Lines:       lines,
+		CreatedBy:   document.CreatedBy,
+		PostedBy:    document.PostedBy,
+		CreatedAt:   document.CreatedAt,
+		UpdatedAt:   document.UpdatedAt,
+		PostedAt:    document.PostedAt,
+	}
+}
+
+func ConvertProtoToReceivingDocument(document *warehousepb.ReceivingDocument) *entity.ReceivingDocument {
+	lines := make([]entity.ReceivingLine, 0, len(document.Lines))
+	for _, line := range document.Lines {
+		lines = append(lines, entity.ReceivingLine{
+			ProductID:        line.ProductId,
+			ProductName:      line.ProductName,
+			ExpectedQuantity: line.ExpectedQuantity,
+			ReceivedQuantity: line.ReceivedQuantity,
+			LotNumber:        line.LotNumber,
+			ExpiresAt:        line.ExpiresAt,
+		})
+	}
+	return &entity.ReceivingDocument{
+		ID:          document.DocumentId,
+		WarehouseID: document.WarehouseId,
+		Supplier:    document.Supplier,
+		Reference:   document.Reference,
+		Note:        document.Note,
+		Status:      entity.ReceivingStatus(document.Status),
+		Lines:       lines,
+		CreatedBy:   document.CreatedBy,
+		PostedBy:    document.PostedBy,
+		CreatedAt:   document.CreatedAt,
+		UpdatedAt:   document.UpdatedAt,
+		PostedAt:    document.PostedAt,
+	}
+}
+
+func ConvertStockMovementToProto(movement *entity.StockMovement) *warehousepb.StockMovement {
+	return &warehousepb.StockMovement{
+		Id:          movement.ID,
+		WarehouseId: movement.WarehouseID,
+		ProductId:   movement.ProductID,
+		Delta:       movement.Delta,
+		Reason:      string(movement.Reason),
+		ReferenceId: movement.ReferenceID,
+		ActorId:     movement.ActorID,
+		CreatedAt:   movement.CreatedAt,
+		LotId:       movement.LotID,
+	}
+}
+
+func ConvertProtoToStockMovement(movement *warehousepb.StockMovement) *entity.StockMovement {
+	return &entity.StockMovement{
+		ID:          movement.Id,
+		WarehouseID: movement.WarehouseId,
+		ProductID:   movement.ProductId,
+		Delta:       movement.Delta,
+		Reason:      entity.MovementReason(movement.Reason),
+		ReferenceID: movement.ReferenceId,
+		ActorID:     movement.ActorId,
+		CreatedAt:   movement.CreatedAt,
+		LotID:       movement.LotId,
+	}
+}
+
+func ConvertStockAllocationsToProto(allocations []entity.StockAllocation) []*warehousepb.StockAllocation {
+	res := make([]*warehousepb.StockAllocation, 0, len(allocations))
+	for _, allocation := range allocations {
+		lots := make([]*warehousepb.AllocationLot, 0, len(allocation.Lots))
+		for _, lot := range allocation.Lots {
+			lots = append(lots, &warehousepb.AllocationLot{
+				LotId:     lot.LotID,
+				LotNumber: lot.LotNumber,
+				ExpiresAt: lot.ExpiresAt,
+				Quantity:  lot.Quantity,
+			})
+		}
+		res = append(res, &warehousepb.StockAllocation{
+			WarehouseId: allocation.WarehouseID,
+			ProductId:   allocation.ProductID,
+			Quantity:    allocation.Quantity,
+			Lots:        lots,
+		})
+	}
+	return res
+}
+
+func ConvertProtoToStockAllocations(allocations []*warehousepb.StockAllocation) []entity.StockAllocation {
+	res := make([]entity.StockAllocation, 0, len(allocations))
+	for _, allocation := range allocations {
+		var lots []entity.LotAllocation
+		for _, lot := range allocation.Lots {
+			lots = append(lots, entity.LotAllocation{
+				LotID:     lot.LotId,
+				LotNumber: lot.LotNumber,
+				ExpiresAt: lot.ExpiresAt,
+				Quantity:  lot.Quantity,
+			})
+		}
+		res = append(res, entity.StockAllocation{
+			WarehouseID: allocation.WarehouseId,
+			ProductID:   allocation.ProductId,
+			Quantity:    allocation.Quantity,
+			Lots:        lots,
+		})
+	}
+	return res
+}
+
+func ConvertOrderAllocationsToProto(allocations []entity.StockAllocation) []*orderpb.OrderAllocation {
+	res := make([]*orderpb.OrderAllocation, 0, len(allocations))
+	for _, allocation := range allocations {
+		lots := make([]*orderpb.OrderAllocationLot, 0, len(allocation.Lots))
+		for _, lot := range allocation.Lots {
+			lots = append(lots, &orderpb.OrderAllocationLot{
+				LotId:     lot.LotID,
+				LotNumber: lot.LotNumber,
+				ExpiresAt: lot.ExpiresAt,
+				Quantity:  lot.Quantity,
+			})
+		}
+		res = append(res, &orderpb.OrderAllocation{
+			WarehouseId: allocation.WarehouseID,
+			ProductId:   allocation.ProductID,
+			Quantity:    allocation.Quantity,
+			Lots:        lots,
+		})
+	}
+	return res
+}
+
+func ConvertProtoToOrderAllocations(allocations []*orderpb.OrderAllocation) []entity.StockAllocation {
+	res := make([]entity.StockAllocation, 0, len(allocations))
+	for _, allocation := range allocations {
+		var lots []entity.LotAllocation
+		for _, lot := range allocation.Lots {
+			lots = append(lots, entity.LotAllocation{
+				LotID:     lot.LotId,
+				LotNumber: lot.LotNumber,
+				ExpiresAt: lot.ExpiresAt,
+				Quantity:  lot.Quantity,
+			})
+		}
+		res = append(res, entity.StockAllocation{
+			WarehouseID: allocation.WarehouseId,
+			ProductID:   allocation.ProductId,
+			Quantity:    allocation.Quantity,
+			Lots:        lots,
+		})
+	}
+	return res
+}
+
+func ConvertWarehouseToProto(warehouse *entity.Warehouse) *warehousepb.Warehouse {
+	res := &warehousepb.Warehouse{
+		WarehouseId: warehouse.ID,
+		Name:        warehouse.Name,
+		Address:     warehouse.Address,
+		Active:      warehouse.Active,
+		CreatedAt:   warehouse.CreatedAt,
+		UpdatedAt:   warehouse.UpdatedAt,
+	}
+	if warehouse.Location != nil {
+		res.Location = &warehousepb.Location{Latitude: warehouse.Location.Latitude, Longitude: warehouse.Location.Longitude}
+	}
+	return res
+}
+
+func ConvertProtoToWarehouse(warehouse *warehousepb.Warehouse) *entity.Warehouse {
+	res := &entity.Warehouse{
+		ID:        warehouse.WarehouseId,
+		Name:      warehouse.Name,
+		Address:   warehouse.Address,
+		Active:    warehouse.Active,
+		CreatedAt: warehouse.CreatedAt,
+		UpdatedAt: warehouse.UpdatedAt,
+	}
+	if warehouse.Location != nil {
+		res.Location = &entity.Location{Latitude: warehouse.Location.Latitude, Longitude: warehouse.Location.Longitude}
+	}
+	return res
+}
+
+func ConvertTransferToProto(transfer *entity.StockTransfer) *warehousepb.Transfer {
+	lines := make([]*warehousepb.TransferLine, 0, len(transfer.Lines))
+	for _, line := range transfer.Lines {
+		lines = append(lines, &warehousepb.TransferLine{
+			ProductId:   line.ProductID,
+			ProductName: line.ProductName,
+			Quantity:    line.Quantity,
+		})
+	}
+	return &warehousepb.Transfer{
+		TransferId:             transfer.ID,
+		SourceWarehouseId:      transfer.SourceWarehouseID,
+		DestinationWarehouseId: transfer.DestinationWarehouseID,
+		Status:                 string(transfer.Status),
+		DriverId:               transfer.DriverID,
+		Note:                   transfer.Note,
+		Lines:                  lines,
+		CreatedBy:              transfer.CreatedBy,
+		DispatchedBy:           transfer.DispatchedBy,
+		ReceivedBy:             transfer.ReceivedBy,
+		CreatedAt:              transfer.CreatedAt,
+		UpdatedAt:              transfer.UpdatedAt,
+		DispatchedAt:           transfer.DispatchedAt,
+		ReceivedAt:             transfer.ReceivedAt,
+	}
+}
+
+func ConvertProtoToTransfer(transfer *warehousepb.Transfer) *entity.StockTransfer {
+	lines := make([]entity.TransferLine, 0, len(transfer.Lines))
+	for _, line := range transfer.Lines {
+		lines = append(lines, entity.TransferLine{
+			ProductID:   line.ProductId,
+			ProductName: line.ProductName,
+			Quantity:    line.Quantity,
+		})
+	}
+	return &entity.StockTransfer{
+		ID:                     transfer.TransferId,
+		SourceWarehouseID:      transfer.SourceWarehouseId,
+		DestinationWarehouseID: transfer.DestinationWarehouseId,
+		Status:                 entity.TransferStatus(transfer.Status),
+		DriverID:               transfer.DriverId,
+		Note:                   transfer.Note,
+		Lines:                  lines,
+		CreatedBy:              transfer.CreatedBy,
+		DispatchedBy:           transfer.DispatchedBy,
+		ReceivedBy:             transfer.ReceivedBy,
+		CreatedAt:              transfer.CreatedAt,
+		UpdatedAt:              transfer.UpdatedAt,
+		DispatchedAt:           transfer.DispatchedAt,
+		ReceivedAt:             transfer.ReceivedAt,
+	}
+}
+
+func ConvertReplenishmentItemToProto(item *entity.ReplenishmentItem) *warehousepb.ReplenishmentItem {
+	return &warehousepb.ReplenishmentItem{
+		WarehouseId:       item.WarehouseID,
+		ProductId:         item.ProductID,
+		Sku:               item.SKU,
+		ProductName:       item.ProductName,
+		Quantity:          item.Quantity,
+		Reserved:          item.Reserved,
+		Available:         item.Available,
+		ReorderPoint:      item.ReorderPoint,
+		ReorderQuantity:   item.ReorderQuantity,
+		SuggestedQuantity: item.SuggestedQuantity,
+		DetectedAt:        item.DetectedAt,
+	}
+}
+
+func ConvertProtoToReplenishmentItem(item *warehousepb.ReplenishmentItem) *entity.ReplenishmentItem {
+	return &entity.ReplenishmentItem{
+		WarehouseID:       item.WarehouseId,
+		ProductID:         item.ProductId,
+		SKU:               item.Sku,
+		ProductName:       item.ProductName,
+		Quantity:          item.Quantity,
+		Reserved:          item.Reserved,
+		Available:         item.Available,
+		ReorderPoint:      item.ReorderPoint,
+		ReorderQuantity:   item.ReorderQuantity,
+		SuggestedQuantity: item.SuggestedQuantity,
+		DetectedAt:        item.DetectedAt,
+	}
+}
+
+func ConvertStockLotToProto(lot *entity.StockLot) *warehousepb.StockLot {
+	return &warehousepb.StockLot{
+		LotId:       lot.ID,
+		WarehouseId: lot.WarehouseID,
+		ProductId:   lot.ProductID,
+		ProductName: lot.ProductName,
+		LotNumber:   lot.LotNumber,
+		ExpiresAt:   lot.ExpiresAt,
+		ReceivedAt:  lot.ReceivedAt,
+		Quantity:    lot.Quantity,
+		Reserved:    lot.Reserved,
+	}
+}
+
+func ConvertProtoToStockLot(lot *warehousepb.StockLot) *entity.StockLot {
+	return &entity.StockLot{
+		ID:          lot.LotId,
+		WarehouseID: lot.WarehouseId,
+		ProductID:   lot.ProductId,
+		ProductName: lot.ProductName,
+		LotNumber:   lot.LotNumber,
+		ExpiresAt:   lot.ExpiresAt,
+		ReceivedAt:  lot.ReceivedAt,
+		Quantity:    lot.Quantity,
+		Reserved:    lot.Reserved,
+	}
+}
+
+func ConvertStockImportResultToProto(result *entity.StockImportResult) *warehousepb.ImportStockResult {
+	return &warehousepb.ImportStockResult{
+		Line:         result.Line,
+		WarehouseId:  result.WarehouseID,
+		ProductId:    result.ProductID,
+		Sku:          result.SKU,
+		ProductName:  result.ProductName,
+		OldQuantity:  result.OldQuantity,
+		NewQuantity:  result.NewQuantity,
+		OldUnitPrice: result.OldUnitPrice,
+		NewUnitPrice: result.NewUnitPrice,
+	}
+}
+
+func ConvertProtoToStockImportResult(result *warehousepb.ImportStockResult) entity.StockImportResult {
+	return entity.StockImportResult{
+		Line:         result.Line,
+		WarehouseID:  result.WarehouseId,
+		ProductID:    result.ProductId,
+		SKU:          result.Sku,
+		ProductName:  result.ProductName,
+		OldQuantity:  result.OldQuantity,
+		NewQuantity:  result.NewQuantity,
+		OldUnitPrice: result.OldUnitPrice,
+		NewUnitPrice: result.NewUnitPrice,
+	}
+}
+
+func ConvertJWKSToProto(jwks accesstoken.JWKS) *authpb.GetJWKSResponse {
+	keys := make([]*authpb.JSONWebKey, len(jwks.Keys))
+	for i, key := range jwks.Keys {
+		keys[i] = &authpb.JSONWebKey{
+			Kty: key.Kty,
+			Kid: key.Kid,
+			Use: key.Use,
+			Alg: key.Alg,
+			N:   key.N,
+			E:   key.E,
+			Crv: key.Crv,
+			X:   key.X,
+		}
+	}
+	return &authpb.GetJWKSResponse{Keys: keys}
+}
+
+func ConvertProtoToJWKS(resp *authpb.GetJWKSResponse) accesstoken.JWKS {
+	keys := make([]accesstoken.JWK, len(resp.Keys))
+	for i, key := range resp.Keys {
+		keys[i] = accesstoken.JWK{
+			Kty: key.Kty,
+			Kid: key.Kid,
+			Use: key.Use,
+			Alg: key.Alg,
+			N:   key.N,
+			E:   key.E,
+			Crv: key.Crv,
+			X:   key.X,
+		}
+	}
+	return accesstoken.JWKS{Keys: keys}
+}
@@ -0,0 +1,23 @@
+package utils
+
+import (
+	"math"
+
+	"logistics/internal/shared/entity"
+)
+
+const earthRadiusKm = 6371.0
+
+// DistanceKm - расстояние большого круга между точками по формуле гаверсинусов
+func DistanceKm(a, b entity.Location) float64 {
+	lat1, lat2 := toRadians(a.Latitude), toRadians(b.Latitude)
+	dLat := lat2 - lat1
+	dLon := toRadians(b.Longitude - a.Longitude)
+
+	h := math.Sin(dLat/2)*math.Sin(dLat/2) + math.Cos(lat1)*math.Cos(lat2)*math.Sin(dLon/2)*math.Sin(dLon/2)
+	return 2 * earthRadiusKm * math.Asin(math.Min(1, math.Sqrt(h)))
+}
+
+func toRadians(deg float64) float64 {
+	return deg * math.Pi / 180
+}
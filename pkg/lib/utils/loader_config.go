@@ -5,6 +5,8 @@ import (
 	"log/slog"
 	"logistics/internal/kafka"
 	"logistics/pkg/cache/redis"
+	"logistics/pkg/lib/accesstoken"
+	"logistics/pkg/lib/hasher"
 	"logistics/pkg/lib/logger/slogger"
 	"os"
 
@@ -48,6 +50,20 @@ type ServiceConfig struct {
 	DbConfig    DBConfig          `mapstructure:"database"`
 	RedisConfig redis.RedisConfig `mapstructure:"redis_config"`
 	KafkaConfig kafka.KafkaConfig `mapstructure:"kafka_config"`
+	Matching    MatchingConfig    `mapstructure:"matching"`
+	// PasswordHashing - настройки хеширования паролей auth-service
+	PasswordHashing hasher.Config `mapstructure:"password_hashing"`
+	// TokenSigning - ключи подписи access токенов auth-service
+	TokenSigning accesstoken.Config `mapstructure:"token_signing"`
+}
+
+// MatchingConfig - настройки подбора водителя
+type MatchingConfig struct {
+	// Strategy - random, nearest или least_recently_used
+	Strategy string `mapstructure:"strategy"`
+	// PickupLatitude/PickupLongitude - точка склада, если у заказа нет координат доставки
+	PickupLatitude  float64 `mapstructure:"pickup_latitude"`
+	PickupLongitude float64 `mapstructure:"pickup_longitude"`
 }
 type DBConfig struct {
 	Driver string `yaml:"driver"`